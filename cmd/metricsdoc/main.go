@@ -0,0 +1,153 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// metricsdoc generates METRICS.md from the metric catalog of the plugin. Since building the catalog normally requires
+// a running Mesos master and agent, the metrics snapshots are read from the recorded fixtures in the "fixtures"
+// directory instead. To regenerate METRICS.md, run `go generate` in the root of this repository.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos"
+	"github.com/intelsdi-x/snap/core"
+)
+
+const header = `<!-- This file is generated by cmd/metricsdoc from the metric catalog. DO NOT EDIT; run "go generate" instead. -->
+# snap collector plugin - mesos
+
+## Collected Metrics
+
+This plugin has the ability to gather the following metrics:
+
+`
+
+var (
+	masterSnapshot = flag.String("master", "cmd/metricsdoc/fixtures/master-snapshot.json", "recorded metrics snapshot of a Mesos master")
+	agentSnapshot  = flag.String("agent", "cmd/metricsdoc/fixtures/agent-snapshot.json", "recorded metrics snapshot of a Mesos agent")
+	output         = flag.String("o", "METRICS.md", "file to write the documentation to")
+)
+
+func main() {
+	flag.Parse()
+
+	masterData, err := readSnapshot(*masterSnapshot)
+	if err != nil {
+		fail(err)
+	}
+
+	agentData, err := readSnapshot(*agentSnapshot)
+	if err != nil {
+		fail(err)
+	}
+
+	mts, err := mesos.CatalogFromSnapshots(masterData, agentData)
+	if err != nil {
+		fail(err)
+	}
+
+	rows := [][]string{{"Namespace", "Data Type", "Unit", "Description"}}
+	for _, mt := range mts {
+		rows = append(rows, []string{
+			namespaceString(mt.Namespace()), mesos.DataType(mt.Namespace()), mt.Unit(), mt.Description(),
+		})
+	}
+	sort.Sort(byNamespace(rows[1:]))
+
+	if err := ioutil.WriteFile(*output, render(rows), 0644); err != nil {
+		fail(err)
+	}
+}
+
+func readSnapshot(path string) (map[string]float64, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]float64{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal error: %s: %v", path, err)
+	}
+
+	return data, nil
+}
+
+// Render a namespace the way METRICS.md always has, e.g. "/intel/mesos/master/[framework_id]/resources/cpus".
+func namespaceString(namespace core.Namespace) string {
+	elements := []string{}
+	for i := range namespace {
+		if namespace[i].IsDynamic() {
+			elements = append(elements, "["+namespace[i].Name+"]")
+		} else {
+			elements = append(elements, namespace[i].Value)
+		}
+	}
+	return "/" + strings.Join(elements, "/")
+}
+
+// Render the rows as a Markdown table, padding each column to the width of its widest cell.
+func render(rows [][]string) []byte {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for r, row := range rows {
+		cells := []string{}
+		for i, cell := range row {
+			if i == len(row)-1 {
+				cells = append(cells, cell)
+			} else {
+				cells = append(cells, fmt.Sprintf("%-*s", widths[i], cell))
+			}
+		}
+		buf.WriteString(strings.TrimRight(strings.Join(cells, " | "), " ") + "\n")
+
+		if r == 0 {
+			separators := []string{}
+			for i := range row {
+				separators = append(separators, strings.Repeat("-", widths[i]))
+			}
+			buf.WriteString(strings.Join(separators, "-|-") + "\n")
+		}
+	}
+	return buf.Bytes()
+}
+
+type byNamespace [][]string
+
+func (r byNamespace) Len() int           { return len(r) }
+func (r byNamespace) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r byNamespace) Less(i, j int) bool { return r[i][0] < r[j][0] }
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "metricsdoc:", err)
+	os.Exit(1)
+}
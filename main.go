@@ -23,6 +23,8 @@ import (
 	"github.com/intelsdi-x/snap/control/plugin"
 )
 
+//go:generate go run cmd/metricsdoc/main.go -o METRICS.md
+
 // plugin bootstrap
 func main() {
 	plugin.Start(
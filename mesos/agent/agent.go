@@ -17,14 +17,17 @@ limitations under the License.
 package agent
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/operator"
 	"github.com/intelsdi-x/snap-plugin-utilities/ns"
 	"github.com/intelsdi-x/snap-plugin-utilities/str"
 )
@@ -32,33 +35,143 @@ import (
 // The "/monitor/statistics" endpoint returns an array of JSON objects. Its top-level structure isn't defined by a
 // protobuf, but the "statistics" object (and everything under it) is. For the actual Mesos implementation, see
 // https://github.com/apache/mesos/blob/0.28.1/src/slave/monitor.cpp#L130-L148
+//
+// The "/containers" endpoint on newer agents returns the same structure, with one entry per container rather than per
+// executor, and adds the container ID, the ID of the parent container (for nested containers), and the container
+// status. These fields are left empty when the statistics come from "/monitor/statistics".
 type Executor struct {
-	ID         string                        `json:"executor_id"`
-	Name       string                        `json:"executor_name"`
-	Source     string                        `json:"source"`
-	Framework  string                        `json:"framework_id"`
-	Statistics *mesos_pb2.ResourceStatistics `json:"statistics"`
+	ID                string                     `json:"executor_id"`
+	Name              string                     `json:"executor_name"`
+	Source            string                     `json:"source"`
+	Framework         string                     `json:"framework_id"`
+	ContainerID       string                     `json:"container_id"`
+	ParentContainerID string                     `json:"parent_container_id"`
+	Status            *mesos_pb2.ContainerStatus `json:"status"`
+	Statistics        ResourceStatistics         `json:"statistics"`
 }
 
-// The "/slave(1)/flags" endpoint on a Mesos agent returns an object that contains a single object "flags".
+// Hosts that are known not to provide the "/containers" endpoint, so that they aren't asked for it on every collection.
+var containersUnsupported = struct {
+	sync.Mutex
+	hosts map[string]bool
+}{hosts: map[string]bool{}}
+
+// The flags endpoint on a Mesos agent returns an object that contains a single object "flags".
 type Flags struct {
 	Flags map[string]string
 }
 
-// Get the configuration flags from the Mesos agent and return them as a map.
+// Returned by GetFlags when none of the known flags endpoints exist on an agent.
+var ErrFlagsNotFound = errors.New("error: unable to find the flags endpoint on the agent")
+
+// The flags endpoint that was found for each host, so that it only has to be probed for once.
+var flagsPaths = struct {
+	sync.Mutex
+	hosts map[string]string
+}{hosts: map[string]string{}}
+
+// Get the configuration flags from the Mesos agent and return them as a map. Depending on the version of Mesos and the
+// libprocess ID of the agent, the flags are available at "/flags", "/slave(1)/flags", or under the PID reported by
+// the agent's "/state" endpoint; they're tried in the order most likely for the agent's version, and the first one
+// that exists is remembered for the host. Returns ErrFlagsNotFound if
+// none of them exist, or the underlying error if the agent couldn't be queried.
 func GetFlags(host string) (map[string]string, error) {
 	log.Debug("Getting configuration flags from host ", host)
+
+	flagsPaths.Lock()
+	path, ok := flagsPaths.hosts[host]
+	flagsPaths.Unlock()
+
+	if ok {
+		flags, err := fetchFlags(host, path)
+		if err == nil {
+			return flags, nil
+		}
+		if !client.IsNotFound(err) {
+			return nil, err
+		}
+		// The agent might have been upgraded or restarted with a different PID since the endpoint was found
+		log.Debug("Flags endpoint ", path, " no longer exists on host ", host, ", probing again")
+	}
+
+	// Agents before Mesos 1.0 are more likely to only provide the flags under their libprocess ID, so try that first
+	candidates := []string{"/flags", "/slave(1)/flags"}
+	if version, err := client.GetVersion(host); err == nil && !client.VersionAtLeast(version, "1.0") {
+		candidates = []string{"/slave(1)/flags", "/flags"}
+	}
+	for _, candidate := range candidates {
+		if flags, err := probeFlags(host, candidate); err != ErrFlagsNotFound {
+			return flags, err
+		}
+	}
+
+	candidate, err := getFlagsPathFromState(host)
+	if err != nil {
+		return nil, err
+	}
+	if str.Contains(candidates, candidate) {
+		log.Error(ErrFlagsNotFound)
+		return nil, ErrFlagsNotFound
+	}
+
+	flags, err := probeFlags(host, candidate)
+	if err == ErrFlagsNotFound {
+		log.Error(err)
+	}
+	return flags, err
+}
+
+// Fetch the flags from the given path, and remember the path for the host if it exists. Returns ErrFlagsNotFound if
+// the agent doesn't provide the path.
+func probeFlags(host string, path string) (map[string]string, error) {
+	flags, err := fetchFlags(host, path)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, ErrFlagsNotFound
+		}
+		return nil, err
+	}
+
+	log.Debug("Found flags endpoint ", path, " on host ", host)
+	flagsPaths.Lock()
+	flagsPaths.hosts[host] = path
+	flagsPaths.Unlock()
+
+	return flags, nil
+}
+
+func fetchFlags(host string, path string) (map[string]string, error) {
 	flags := &Flags{}
 
-	c := client.NewClient(host, "/slave(1)/flags", time.Duration(5))
+	c := client.NewClient(host, path, 5*time.Second)
 	if err := c.Fetch(&flags); err != nil {
-		log.Error(err)
 		return nil, err
 	}
 
 	return flags.Flags, nil
 }
 
+// Build the path of the flags endpoint from the PID in the agent's state, e.g. "slave(1)@10.0.0.1:5051" becomes
+// "/slave(1)/flags".
+func getFlagsPathFromState(host string) (string, error) {
+	state, err := GetState(host)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.Error(ErrFlagsNotFound)
+			return "", ErrFlagsNotFound
+		}
+		return "", err
+	}
+
+	id := strings.SplitN(state.PID, "@", 2)[0]
+	if id == "" {
+		log.Error(ErrFlagsNotFound)
+		return "", ErrFlagsNotFound
+	}
+
+	return "/" + id + "/flags", nil
+}
+
 // Collect metrics from the '/metrics/snapshot' endpoint on the agent.  The '/metrics/snapshot' endpoint returns JSON,
 // and all metrics contained in the endpoint use a string as the key, and a double (float64) for the value. For example:
 //
@@ -68,11 +181,20 @@ func GetFlags(host string) (map[string]string, error) {
 //
 // Note that, as of Mesos 0.28.x, "slave" is being renamed to "agent" and this effort isn't yet complete. For more
 // information, see https://issues.apache.org/jira/browse/MESOS-1478.
+//
+// If the agent provides the operator API, the same metrics are collected using GET_METRICS instead.
 func GetMetricsSnapshot(host string) (map[string]float64, error) {
+	if operator.Supported(host) {
+		data, err := operator.GetAgentMetrics(host)
+		if err == nil || !client.IsNotFound(err) {
+			return data, err
+		}
+	}
+
 	log.Debug("Getting metrics snapshot from host ", host)
 	data := map[string]float64{}
 
-	c := client.NewClient(host, "/metrics/snapshot", time.Duration(5))
+	c := client.NewClient(host, "/metrics/snapshot", 5*time.Second)
 	if err := c.Fetch(&data); err != nil {
 		log.Error(err)
 		return nil, err
@@ -84,13 +206,17 @@ func GetMetricsSnapshot(host string) (map[string]float64, error) {
 // Collect metrics from the '/monitor/statistics' endpoint on the agent. This endpoint returns JSON, and all metrics
 // contained in the endpoint use a string as the key. Depending on features enabled on the Mesos agent, additional
 // metrics might be available under either the "statistics" object, or additional nested objects (e.g. "perf") as
-// defined by the Executor structure, and the structures in mesos_pb2.ResourceStatistics.
+// defined by the Executor structure, and the structures in ResourceStatistics.
 func GetMonitoringStatistics(host string) ([]Executor, error) {
-	log.Debug("Getting monitoring statistics from host ", host)
-	var executors []Executor
+	return GetMonitoringStatisticsWithTimeout(host, 30*time.Second)
+}
 
-	c := client.NewClient(host, "/monitor/statistics", time.Duration(30))
-	if err := c.Fetch(&executors); err != nil {
+// Same as GetMonitoringStatistics, but with a timeout other than the default, e.g. when fetching the
+// statistics of many agents at once.
+func GetMonitoringStatisticsWithTimeout(host string, timeout time.Duration) ([]Executor, error) {
+	log.Debug("Getting monitoring statistics from host ", host)
+	executors, err := fetchExecutors(host, "/monitor/statistics", timeout)
+	if err != nil {
 		log.Error(err)
 		return nil, err
 	}
@@ -98,28 +224,107 @@ func GetMonitoringStatistics(host string) ([]Executor, error) {
 	return executors, nil
 }
 
-// Recursively traverse the Executor struct, building "/"-delimited strings that resemble snap metric types. If a given
-// feature is not enabled on a Mesos agent (e.g. the network isolator), then those metrics will be removed from the
-// metric types returned by this function.
-func GetMonitoringStatisticsMetricTypes(host string) ([]string, error) {
-	log.Debug("Getting monitoring statistics metrics type from host ", host)
+// Collect metrics from the '/containers' endpoint on the agent, including nested containers. This endpoint is only
+// available on newer versions of Mesos; if the agent doesn't provide it, fall back to '/monitor/statistics'. Note that
+// the statistics for each container are structured the same way as those returned by GetMonitoringStatistics(). If
+// the agent provides the operator API, the containers are collected using GET_CONTAINERS instead.
+func GetContainers(host string) ([]Executor, error) {
+	if operator.Supported(host) {
+		containers, err := getContainersFromOperator(host)
+		if err == nil || !client.IsNotFound(err) {
+			return containers, err
+		}
+	}
+
+	containersUnsupported.Lock()
+	unsupported := containersUnsupported.hosts[host]
+	containersUnsupported.Unlock()
+
+	if unsupported {
+		return GetMonitoringStatistics(host)
+	}
+
+	log.Debug("Getting containers from host ", host)
+	containers, err := fetchExecutors(host, "/containers?nested=true", 30*time.Second)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.Warn("Host ", host, " doesn't provide the /containers endpoint, falling back to /monitor/statistics")
+			containersUnsupported.Lock()
+			containersUnsupported.hosts[host] = true
+			containersUnsupported.Unlock()
+			return GetMonitoringStatistics(host)
+		}
+		log.Error(err)
+		return nil, err
+	}
+
+	return containers, nil
+}
+
+func getContainersFromOperator(host string) ([]Executor, error) {
+	response, err := operator.GetContainers(host, true)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := []Executor{}
+	for _, container := range response {
+		executor := Executor{
+			ID:                container.GetExecutorId().GetValue(),
+			Name:              container.GetExecutorName(),
+			Framework:         container.GetFrameworkId().GetValue(),
+			ContainerID:       container.GetContainerId().GetValue(),
+			ParentContainerID: container.GetContainerId().GetParent().GetValue(),
+		}
+		if container.ContainerStatus != nil {
+			executor.Status = &mesos_pb2.ContainerStatus{}
+			if err := operator.Devolve(container.ContainerStatus, executor.Status); err != nil {
+				log.Warn("Unable to convert the status of container ", executor.ContainerID, ": ", err)
+				executor.Status = nil
+			}
+		}
+		if container.ResourceStatistics != nil {
+			executor.Statistics = container.ResourceStatistics
+		}
+		containers = append(containers, executor)
+	}
+	return containers, nil
+}
+
+// Recursively traverse the given ResourceStatistics struct, building "/"-delimited strings that resemble snap metric
+// types. This returns every statistic that version of Mesos could report, regardless of the features enabled on a
+// given agent.
+func GetResourceStatisticsMetricTypes(statistics ResourceStatistics) ([]string, error) {
 	// TODO(roger): supporting NetTrafficControlStatistics means adding another dynamic metric to the plugin.
 	// When we're ready to do this, remove ns.InspectEmptyContainers(ns.AlwaysFalse) so this defaults to true.
 	namespaces := []string{}
 	err := ns.FromCompositeObject(
-		&mesos_pb2.ResourceStatistics{}, "", &namespaces, ns.InspectEmptyContainers(ns.AlwaysFalse))
+		statistics, "", &namespaces, ns.InspectEmptyContainers(ns.AlwaysFalse))
 	if err != nil {
 		log.Error(err)
 		return nil, err
 	}
+	return namespaces, nil
+}
 
-	// Avoid returning a metric type that is impossible to collect on this system
-	flags, err := GetFlags(host)
+// Recursively traverse the Executor struct, building "/"-delimited strings that resemble snap metric types. If a given
+// feature is not enabled on a Mesos agent (e.g. the network isolator), then those metrics will be removed from the
+// metric types returned by this function.
+func GetMonitoringStatisticsMetricTypes(host string) ([]string, error) {
+	log.Debug("Getting monitoring statistics metrics type from host ", host)
+	namespaces, err := GetResourceStatisticsMetricTypes(NewResourceStatistics(host))
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
 
+	// Avoid returning a metric type that is impossible to collect on this system. If the flags can't be retrieved, the
+	// enabled isolators are unknown, so only the metrics that don't depend on an optional isolator are returned.
+	flags, err := GetFlags(host)
+	if err != nil {
+		log.Warn("Unable to get flags from host ", host, " (", err, "), assuming no optional isolators are enabled")
+		flags = map[string]string{}
+	}
+
 	// Isolators are defined using a comma-separated string passed to the "--isolation" flag on the Mesos agent.
 	// See https://github.com/apache/mesos/blob/0.28.1/src/slave/containerizer/mesos/containerizer.cpp#L196-L223
 	isolators := strings.Split(flags["isolation"], ",")
@@ -128,16 +333,17 @@ func GetMonitoringStatisticsMetricTypes(host string) ([]string, error) {
 		log.Debug("Isolator cgroups/perf_event is enabled on host ", host)
 		// Expects a perf event from the output of `perf list`. Mesos then normalizes the event name. See
 		// https://github.com/apache/mesos/blob/0.28.1/src/linux/perf.cpp#L65-L71
+		supported := []string{}
+		for _, namespace := range namespaces {
+			if strings.HasPrefix(namespace, "perf/") && namespace != "perf/timestamp" && namespace != "perf/duration" {
+				supported = append(supported, namespace)
+			}
+		}
 		namespaces = deleteFromSlice(namespaces, "^perf/.*")
-		var normalizedPerfEvents []string
-		perfEvents := strings.Split(flags["perf_events"], ",")
 
-		for _, event := range perfEvents {
-			log.Debug("Adding perf event ", event, " to metrics catalog")
-			event = fmt.Sprintf("perf/%s", normalizePerfEventName(event))
-			normalizedPerfEvents = append(normalizedPerfEvents, event)
-		}
-		namespaces = append(namespaces, normalizedPerfEvents...)
+		// The time and duration of the perf sample are reported regardless of the events being sampled
+		namespaces = append(namespaces, "perf/timestamp", "perf/duration")
+		namespaces = append(namespaces, validatePerfEvents(host, supported, strings.Split(flags["perf_events"], ","))...)
 	} else {
 		log.Debug("Isolator cgroups/perf_event is not enabled on host ", host)
 		namespaces = deleteFromSlice(namespaces, "^perf.*")
@@ -156,6 +362,56 @@ func GetMonitoringStatisticsMetricTypes(host string) ([]string, error) {
 	return namespaces, nil
 }
 
+// Build the perf metric types for the events passed to the "--perf_events" flag on the agent. An event is dropped
+// (and logged) if it isn't defined in PerfStatistics, e.g. because of a typo or because this version of Mesos doesn't
+// support it, or if a live sample from the agent shows that none of its executors report it. If no sample is
+// available yet, for example because no executors are running, only the PerfStatistics check is applied.
+func validatePerfEvents(host string, supported []string, events []string) []string {
+	var sample []interface{}
+	executors, err := GetMonitoringStatistics(host)
+	if err != nil {
+		log.Warn("Unable to get a perf sample from host ", host, ", perf events won't be checked against it: ", err)
+	}
+	for _, exec := range executors {
+		if perf := perfStatistics(exec.Statistics); perf != nil {
+			sample = append(sample, perf)
+		}
+	}
+
+	namespaces := []string{}
+	for _, event := range events {
+		if strings.TrimSpace(event) == "" {
+			continue
+		}
+		namespace := fmt.Sprintf("perf/%s", normalizePerfEventName(strings.TrimSpace(event)))
+
+		if !str.Contains(supported, namespace) {
+			log.Warn("Dropping perf event ", event, " on host ", host, ": it isn't supported by Mesos")
+			continue
+		}
+
+		if len(sample) > 0 {
+			sampled := false
+			for _, perf := range sample {
+				if ns.GetValueByNamespace(perf, strings.Split(namespace, "/")[1:]) != nil {
+					sampled = true
+					break
+				}
+			}
+			if !sampled {
+				log.Warn("Dropping perf event ", event, " on host ", host, ": it isn't reported by any executor")
+				continue
+			}
+		}
+
+		log.Debug("Adding perf event ", event, " to metrics catalog")
+		if !str.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
 // Normalizes a perf event, based on https://github.com/apache/mesos/blob/0.28.1/src/linux/perf.cpp#L65-L71
 func normalizePerfEventName(s string) string {
 	normalized := strings.ToLower(s)
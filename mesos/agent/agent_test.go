@@ -20,13 +20,19 @@ package agent
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_agent"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -66,6 +72,109 @@ func TestGetFlags(t *testing.T) {
 	})
 }
 
+func TestGetFlags_Discovery(t *testing.T) {
+	requests := map[string]int{}
+	// ts1 simulates an agent whose libprocess ID isn't "slave(1)", so the flags endpoint is only found via "/state"
+	ts1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/state":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"pid": "slave(2)@127.0.0.1:5051"}`))
+		case "/slave(2)/flags":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"flags": {"isolation": "cgroups/cpu,cgroups/mem"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts1.Close()
+
+	// ts2 simulates an agent that doesn't provide any flags endpoint
+	ts2 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/state":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"pid": "slave(1)@127.0.0.1:5051"}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts2.Close()
+
+	// ts3 simulates a Mesos 0.28 agent that provides the flags under its libprocess ID as well as at "/flags"
+	ts3Requests := map[string]int{}
+	ts3 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts3Requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "0.28.1"}`))
+		case "/flags", "/slave(1)/flags":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"flags": {"isolation": "posix/cpu,posix/mem"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts3.Close()
+
+	Convey("When the flags endpoint has to be discovered", t, func() {
+		Convey("Should find the flags endpoint using the PID reported by the agent's state", func() {
+			host, err := extractHostFromURL(ts1.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			flags, err := GetFlags(host)
+			So(err, ShouldBeNil)
+			So(flags["isolation"], ShouldEqual, "cgroups/cpu,cgroups/mem")
+
+			Convey("Should remember the flags endpoint for the host", func() {
+				_, err := GetFlags(host)
+				So(err, ShouldBeNil)
+				So(requests["/flags"], ShouldEqual, 1)
+				So(requests["/state"], ShouldEqual, 1)
+				So(requests["/slave(2)/flags"], ShouldEqual, 2)
+			})
+		})
+
+		Convey("Should try the flags endpoint most likely for the version of the agent first", func() {
+			host, err := extractHostFromURL(ts3.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			flags, err := GetFlags(host)
+			So(err, ShouldBeNil)
+			So(flags["isolation"], ShouldEqual, "posix/cpu,posix/mem")
+			So(ts3Requests["/slave(1)/flags"], ShouldEqual, 1)
+			So(ts3Requests["/flags"], ShouldEqual, 0)
+		})
+
+		Convey("Should return ErrFlagsNotFound when no flags endpoint exists", func() {
+			host, err := extractHostFromURL(ts2.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			flags, err := GetFlags(host)
+			So(flags, ShouldBeNil)
+			So(err, ShouldEqual, ErrFlagsNotFound)
+
+			Convey("And the metric types should not depend on optional isolators", func() {
+				namespaces, err := GetMonitoringStatisticsMetricTypes(host)
+				So(err, ShouldBeNil)
+				So(namespaces, ShouldContain, "cpus_limit")
+				So(namespaces, ShouldNotContain, "disk_used_bytes")
+			})
+		})
+	})
+}
+
 func TestGetMetricsSnapshot(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		td, err := json.Marshal(map[string]float64{
@@ -159,18 +268,300 @@ func TestGetMonitoringStatistics(t *testing.T) {
 			for _, exec := range execs {
 				switch exec.ID {
 				case "id1":
-					So(*exec.Statistics.CpusLimit, ShouldEqual, 1.1)
-					So(*exec.Statistics.MemTotalBytes, ShouldEqual, 1000)
-					So(*exec.Statistics.Perf.ContextSwitches, ShouldEqual, 10)
+					So(exec.Statistics.GetCpusLimit(), ShouldEqual, 1.1)
+					So(exec.Statistics.GetMemTotalBytes(), ShouldEqual, 1000)
+					So(exec.Statistics.(*mesos_pb2.ResourceStatistics).Perf.GetContextSwitches(), ShouldEqual, 10)
 				case "id2":
-					So(*exec.Statistics.CpusLimit, ShouldEqual, 1.1)
-					So(*exec.Statistics.MemTotalBytes, ShouldEqual, 2000)
+					So(exec.Statistics.GetCpusLimit(), ShouldEqual, 1.1)
+					So(exec.Statistics.GetMemTotalBytes(), ShouldEqual, 2000)
 				}
 			}
 		})
 	})
 }
 
+func TestGetMonitoringStatistics_V1(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "1.4.0"}`))
+		case "/monitor/statistics":
+			w.WriteHeader(200)
+			w.Write([]byte(`[{"executor_id": "id1", "framework_id": "frame1",
+				"statistics": {"cpus_limit": 1.1, "cpus_soft_limit": 0.5, "mem_total_bytes": 1000}}]`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When monitoring statistics are requested from a Mesos 1.x agent", t, func() {
+		execs, err := GetMonitoringStatistics(host)
+		So(err, ShouldBeNil)
+		So(len(execs), ShouldEqual, 1)
+
+		Convey("Then the statistics are decoded using the v1 types", func() {
+			stats, ok := execs[0].Statistics.(*mesos_v1.ResourceStatistics)
+			So(ok, ShouldBeTrue)
+			So(stats.GetCpusLimit(), ShouldEqual, 1.1)
+			So(stats.GetCpusSoftLimit(), ShouldEqual, 0.5)
+			So(stats.GetMemTotalBytes(), ShouldEqual, 1000)
+		})
+	})
+}
+
+func TestGetMonitoringStatisticsWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	var versionRequests int32
+	statistics := []string{}
+	for i := 0; i < 10; i++ {
+		statistics = append(statistics, `{"executor_id": "id", "framework_id": "frame1", "statistics": {"cpus_limit": 1.0}}`)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			atomic.AddInt32(&versionRequests, 1)
+			<-release
+		case "/monitor/statistics":
+			w.WriteHeader(200)
+			w.Write([]byte("[" + strings.Join(statistics, ",") + "]"))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	start := time.Now()
+	execs, err := GetMonitoringStatisticsWithTimeout(host, 200*time.Millisecond)
+	elapsed := time.Since(start)
+
+	Convey("When the version of an agent can't be fetched within the timeout", t, func() {
+		So(err, ShouldBeNil)
+		So(len(execs), ShouldEqual, 10)
+
+		Convey("Then the version should only be requested once, within the timeout", func() {
+			So(atomic.LoadInt32(&versionRequests), ShouldEqual, 1)
+			So(elapsed, ShouldBeLessThan, time.Second)
+		})
+
+		Convey("Then the statistics are decoded using the 0.28 types", func() {
+			_, ok := execs[0].Statistics.(*mesos_pb2.ResourceStatistics)
+			So(ok, ShouldBeTrue)
+		})
+	})
+}
+
+func TestGetContainers(t *testing.T) {
+	containers := `[
+		{"container_id": "cont1", "executor_id": "id1", "framework_id": "frame1",
+		 "status": {"cgroup_info": {}}, "statistics": {"cpus_limit": 1.1, "mem_total_bytes": 1000}},
+		{"container_id": "cont2", "parent_container_id": "cont1", "executor_id": "id1", "framework_id": "frame1",
+		 "statistics": {"cpus_limit": 0.5}}
+	]`
+	executors := `[{"executor_id": "id1", "framework_id": "frame1", "statistics": {"cpus_limit": 1.1}}]`
+
+	// ts1 simulates a newer agent that provides the "/containers" endpoint
+	ts1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/containers":
+			w.WriteHeader(200)
+			w.Write([]byte(containers))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts1.Close()
+
+	// ts2 simulates an older agent that only provides the "/monitor/statistics" endpoint
+	requests := map[string]int{}
+	ts2 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/monitor/statistics":
+			w.WriteHeader(200)
+			w.Write([]byte(executors))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts2.Close()
+
+	Convey("When containers are requested", t, func() {
+		Convey("Then the agent's containers are returned, including nested containers", func() {
+			host, err := extractHostFromURL(ts1.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			execs, err := GetContainers(host)
+			So(err, ShouldBeNil)
+			So(len(execs), ShouldEqual, 2)
+			So(execs[0].ContainerID, ShouldEqual, "cont1")
+			So(execs[0].Status, ShouldNotBeNil)
+			So(execs[0].Statistics.GetMemTotalBytes(), ShouldEqual, 1000)
+			So(execs[1].ContainerID, ShouldEqual, "cont2")
+			So(execs[1].ParentContainerID, ShouldEqual, "cont1")
+		})
+
+		Convey("Then older agents fall back to the monitoring statistics", func() {
+			host, err := extractHostFromURL(ts2.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			execs, err := GetContainers(host)
+			So(err, ShouldBeNil)
+			So(len(execs), ShouldEqual, 1)
+			So(execs[0].ID, ShouldEqual, "id1")
+			So(execs[0].ContainerID, ShouldEqual, "")
+
+			_, err = GetContainers(host)
+			So(err, ShouldBeNil)
+			So(requests["/containers"], ShouldEqual, 1)
+			So(requests["/monitor/statistics"], ShouldEqual, 2)
+		})
+	})
+}
+
+func TestGetContainers_OperatorAPI(t *testing.T) {
+	var call *mesos_v1_agent.Call
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/version":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "1.4.0"}`))
+		case "/api/v1":
+			body, _ := ioutil.ReadAll(r.Body)
+			call = &mesos_v1_agent.Call{}
+			if err := proto.Unmarshal(body, call); err != nil {
+				panic(err)
+			}
+			response := &mesos_v1_agent.Response{}
+			err := json.Unmarshal([]byte(`{"type": "GET_CONTAINERS", "get_containers": {"containers": [
+				{"framework_id": {"value": "frame1"}, "executor_id": {"value": "id1"}, "executor_name": "name1",
+				 "container_id": {"value": "cont1"}, "container_status": {"network_infos": [{"ip_addresses": [{"ip_address": "10.0.0.5"}]}]},
+				 "resource_statistics": {"timestamp": 1, "cpus_limit": 1.1, "mem_total_bytes": 1000}},
+				{"framework_id": {"value": "frame1"}, "executor_id": {"value": "id1"},
+				 "container_id": {"value": "cont2", "parent": {"value": "cont1"}}}
+			]}}`), response)
+			if err != nil {
+				panic(err)
+			}
+			b, _ := proto.Marshal(response)
+			w.Header().Set("Content-Type", r.Header.Get("Accept"))
+			w.WriteHeader(200)
+			w.Write(b)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When containers are requested from an agent that provides the operator API", t, func() {
+		execs, err := GetContainers(host)
+
+		Convey("Then the containers should be returned from GET_CONTAINERS, including nested containers", func() {
+			So(err, ShouldBeNil)
+			So(call.GetType(), ShouldEqual, mesos_v1_agent.Call_GET_CONTAINERS)
+			So(call.GetGetContainers().GetShowNested(), ShouldBeTrue)
+			So(len(execs), ShouldEqual, 2)
+			So(execs[0].ID, ShouldEqual, "id1")
+			So(execs[0].Name, ShouldEqual, "name1")
+			So(execs[0].Framework, ShouldEqual, "frame1")
+			So(execs[0].ContainerID, ShouldEqual, "cont1")
+			So(execs[0].Status.GetNetworkInfos()[0].GetIpAddresses()[0].GetIpAddress(), ShouldEqual, "10.0.0.5")
+			So(execs[0].Statistics.GetMemTotalBytes(), ShouldEqual, 1000)
+			So(execs[1].ParentContainerID, ShouldEqual, "cont1")
+			So(execs[1].Statistics, ShouldBeNil)
+		})
+	})
+}
+
+func TestGetMonitoringStatisticsMetricTypes_Perf(t *testing.T) {
+	executors := `[{"executor_id": "id1", "framework_id": "frame1", "statistics": {
+		"perf": {"timestamp": 1466000000.0, "duration": 10.0, "cycles": 100, "cache_misses": 10}
+	}}]`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/flags":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"flags": {
+				"isolation": "cgroups/cpu,cgroups/mem,cgroups/perf_event",
+				"perf_events": "cycles,Cache-Misses,bogus-event,instructions"
+			}}`))
+		case "/monitor/statistics":
+			w.WriteHeader(200)
+			w.Write([]byte(executors))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the cgroups/perf_event isolator is enabled", t, func() {
+		namespaces, err := GetMonitoringStatisticsMetricTypes(host)
+		So(err, ShouldBeNil)
+
+		Convey("Should return the perf events that are sampled by Mesos", func() {
+			So(namespaces, ShouldContain, "perf/cycles")
+			So(namespaces, ShouldContain, "perf/cache_misses")
+		})
+
+		Convey("Should return the time and duration of the perf sample", func() {
+			So(namespaces, ShouldContain, "perf/timestamp")
+			So(namespaces, ShouldContain, "perf/duration")
+		})
+
+		Convey("Should drop perf events that Mesos doesn't support", func() {
+			So(namespaces, ShouldNotContain, "perf/bogus_event")
+		})
+
+		Convey("Should drop perf events that aren't in the live sample", func() {
+			So(namespaces, ShouldNotContain, "perf/instructions")
+		})
+	})
+
+	Convey("When no perf sample is available", t, func() {
+		executors = `[]`
+		namespaces, err := GetMonitoringStatisticsMetricTypes(host)
+		So(err, ShouldBeNil)
+
+		Convey("Should only drop perf events that Mesos doesn't support", func() {
+			So(namespaces, ShouldContain, "perf/instructions")
+			So(namespaces, ShouldNotContain, "perf/bogus_event")
+		})
+	})
+}
+
 func Test_normalizePerfEventName(t *testing.T) {
 	Convey("When passed a perf_event name containing mixed case and dashes", t, func() {
 		Convey("Should return a normalized perf event name", func() {
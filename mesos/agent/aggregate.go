@@ -0,0 +1,100 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+)
+
+// Aggregate is the sum of the monitoring statistics of a group of executors, either those of a single framework or
+// all of the executors on the agent. It lets capacity dashboards use a handful of series per agent instead of
+// aggregating the series of thousands of executors downstream.
+type Aggregate struct {
+	Executors          uint64  `json:"executors"`
+	CpusLimit          float64 `json:"cpus_limit"`
+	CpusUserTimeSecs   float64 `json:"cpus_user_time_secs"`
+	CpusSystemTimeSecs float64 `json:"cpus_system_time_secs"`
+	MemLimitBytes      uint64  `json:"mem_limit_bytes"`
+	MemRssBytes        uint64  `json:"mem_rss_bytes"`
+	MemTotalBytes      uint64  `json:"mem_total_bytes"`
+	DiskLimitBytes     uint64  `json:"disk_limit_bytes"`
+	DiskUsedBytes      uint64  `json:"disk_used_bytes"`
+	NetRxBytes         uint64  `json:"net_rx_bytes"`
+	NetTxBytes         uint64  `json:"net_tx_bytes"`
+}
+
+// Recursively traverse the Aggregate struct, building "/"-delimited strings that resemble snap metric types.
+func GetAggregateMetricTypes() ([]string, error) {
+	log.Debug("Getting aggregate metric types")
+	namespaces := []string{}
+	if err := ns.FromCompositeObject(Aggregate{}, "", &namespaces); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Sum the statistics of the given executors, both for the whole agent and for each framework (keyed by framework
+// ID). Nested containers are skipped, since their resources are already accounted to their parent container.
+func AggregateStatistics(executors []Executor) (*Aggregate, map[string]*Aggregate) {
+	total := &Aggregate{}
+	frameworks := map[string]*Aggregate{}
+
+	for _, exec := range executors {
+		if exec.ParentContainerID != "" || exec.Statistics == nil {
+			continue
+		}
+		if frameworks[exec.Framework] == nil {
+			frameworks[exec.Framework] = &Aggregate{}
+		}
+		total.add(exec)
+		frameworks[exec.Framework].add(exec)
+	}
+
+	return total, frameworks
+}
+
+func (a *Aggregate) add(exec Executor) {
+	s := exec.Statistics
+	a.Executors++
+	a.CpusLimit += s.GetCpusLimit()
+	a.CpusUserTimeSecs += s.GetCpusUserTimeSecs()
+	a.CpusSystemTimeSecs += s.GetCpusSystemTimeSecs()
+	a.MemLimitBytes += s.GetMemLimitBytes()
+	a.MemRssBytes += s.GetMemRssBytes()
+	a.MemTotalBytes += s.GetMemTotalBytes()
+	a.DiskLimitBytes += s.GetDiskLimitBytes()
+	a.DiskUsedBytes += s.GetDiskUsedBytes()
+	a.NetRxBytes += s.GetNetRxBytes()
+	a.NetTxBytes += s.GetNetTxBytes()
+}
+
+// Add the sums of another aggregate to this one, e.g. to sum the aggregates of the frameworks in a role.
+func (a *Aggregate) Merge(b *Aggregate) {
+	a.Executors += b.Executors
+	a.CpusLimit += b.CpusLimit
+	a.CpusUserTimeSecs += b.CpusUserTimeSecs
+	a.CpusSystemTimeSecs += b.CpusSystemTimeSecs
+	a.MemLimitBytes += b.MemLimitBytes
+	a.MemRssBytes += b.MemRssBytes
+	a.MemTotalBytes += b.MemTotalBytes
+	a.DiskLimitBytes += b.DiskLimitBytes
+	a.DiskUsedBytes += b.DiskUsedBytes
+	a.NetRxBytes += b.NetRxBytes
+	a.NetTxBytes += b.NetTxBytes
+}
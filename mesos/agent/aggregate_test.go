@@ -0,0 +1,72 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetAggregateMetricTypes(t *testing.T) {
+	Convey("When building metric types for the aggregated statistics", t, func() {
+		namespaces, err := GetAggregateMetricTypes()
+		So(err, ShouldBeNil)
+		So(len(namespaces), ShouldEqual, 11)
+		So(namespaces, ShouldContain, "executors")
+		So(namespaces, ShouldContain, "mem_rss_bytes")
+	})
+}
+
+func TestAggregateStatistics(t *testing.T) {
+	executors := []Executor{
+		{ID: "exec1", Framework: "frame1", Statistics: &mesos_pb2.ResourceStatistics{
+			CpusLimit: proto.Float64(1.5), MemRssBytes: proto.Uint64(100), NetRxBytes: proto.Uint64(10),
+		}},
+		{ID: "exec2", Framework: "frame1", Statistics: &mesos_pb2.ResourceStatistics{
+			CpusLimit: proto.Float64(0.5), MemRssBytes: proto.Uint64(200),
+		}},
+		{ID: "exec3", Framework: "frame2", Statistics: &mesos_pb2.ResourceStatistics{
+			CpusLimit: proto.Float64(2.0), MemRssBytes: proto.Uint64(300), NetRxBytes: proto.Uint64(5),
+		}},
+		{ID: "exec3", Framework: "frame2", ContainerID: "nested", ParentContainerID: "parent",
+			Statistics: &mesos_pb2.ResourceStatistics{MemRssBytes: proto.Uint64(1000)}},
+	}
+
+	Convey("When aggregating the statistics of executors", t, func() {
+		total, frameworks := AggregateStatistics(executors)
+
+		Convey("The total should be summed across all executors, except nested containers", func() {
+			So(total.Executors, ShouldEqual, 3)
+			So(total.CpusLimit, ShouldEqual, 4.0)
+			So(total.MemRssBytes, ShouldEqual, 600)
+			So(total.NetRxBytes, ShouldEqual, 15)
+		})
+
+		Convey("Each framework should be summed across its own executors", func() {
+			So(len(frameworks), ShouldEqual, 2)
+			So(frameworks["frame1"].Executors, ShouldEqual, 2)
+			So(frameworks["frame1"].CpusLimit, ShouldEqual, 2.0)
+			So(frameworks["frame1"].MemRssBytes, ShouldEqual, 300)
+			So(frameworks["frame2"].MemRssBytes, ShouldEqual, 300)
+		})
+	})
+}
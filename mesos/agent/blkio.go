@@ -0,0 +1,177 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+	"github.com/intelsdi-x/snap-plugin-utilities/str"
+)
+
+// The device name used for the statistics that Mesos reports without a device, which cover all devices.
+const BlkioTotalDevice = "total"
+
+// Block I/O statistics of a single device, as reported by the "cgroups/blkio" isolator on Mesos 1.x agents. Mesos
+// reports them as lists of devices, each with lists of values per operation; they are flattened here so that every
+// statistic can be addressed by a namespace below the device. For the actual Mesos implementation, see
+// https://github.com/apache/mesos/blob/1.9.0/src/slave/containerizer/mesos/isolators/cgroups/subsystems/blkio.cpp
+type BlkioDevice struct {
+	Cfq          *BlkioCFQ        `json:"cfq"`
+	CfqRecursive *BlkioCFQ        `json:"cfq_recursive"`
+	Throttling   *BlkioThrottling `json:"throttling"`
+}
+
+// Statistics of the CFQ I/O scheduler, from the blkio.* files of the container's cgroup.
+type BlkioCFQ struct {
+	Sectors        *uint64          `json:"sectors"`
+	Time           *uint64          `json:"time"`
+	IoServiced     *BlkioOperations `json:"io_serviced"`
+	IoServiceBytes *BlkioOperations `json:"io_service_bytes"`
+	IoServiceTime  *BlkioOperations `json:"io_service_time"`
+	IoWaitTime     *BlkioOperations `json:"io_wait_time"`
+	IoMerged       *BlkioOperations `json:"io_merged"`
+	IoQueued       *BlkioOperations `json:"io_queued"`
+}
+
+// Statistics of the throttling policy, from the blkio.throttle.* files of the container's cgroup.
+type BlkioThrottling struct {
+	IoServiced     *BlkioOperations `json:"io_serviced"`
+	IoServiceBytes *BlkioOperations `json:"io_service_bytes"`
+}
+
+// A statistic broken down by the type of I/O operation. Operations that the kernel doesn't report are left nil.
+type BlkioOperations struct {
+	Total   *uint64 `json:"total"`
+	Read    *uint64 `json:"read"`
+	Write   *uint64 `json:"write"`
+	Sync    *uint64 `json:"sync"`
+	Async   *uint64 `json:"async"`
+	Discard *uint64 `json:"discard"`
+}
+
+// Recursively traverse the BlkioDevice struct, building "/"-delimited strings that resemble snap metric types. These
+// are relative to the device, e.g. "cfq/io_serviced/read".
+func GetBlkioStatisticsMetricTypes() ([]string, error) {
+	namespaces := []string{}
+	err := ns.FromCompositeObject(&BlkioDevice{}, "", &namespaces)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Return the block I/O metric types for the given agent. Mesos only reports block I/O statistics from version 1.0
+// onwards, and only if the "cgroups/blkio" isolator is enabled; otherwise no metric types are returned.
+func GetBlkioMetricTypes(host string) ([]string, error) {
+	if version, err := client.GetVersion(host); err != nil || !client.VersionAtLeast(version, "1.0") {
+		log.Debug("Block I/O statistics are not available on host ", host)
+		return []string{}, nil
+	}
+
+	flags, err := GetFlags(host)
+	if err != nil {
+		log.Warn("Unable to get flags from host ", host, " (", err, "), assuming cgroups/blkio is not enabled")
+		return []string{}, nil
+	}
+	if !str.Contains(strings.Split(flags["isolation"], ","), "cgroups/blkio") {
+		log.Debug("Isolator cgroups/blkio is not enabled on host ", host)
+		return []string{}, nil
+	}
+
+	return GetBlkioStatisticsMetricTypes()
+}
+
+// Return the block I/O statistics of an executor by device name, e.g. "8:0". Statistics that Mesos reports without a
+// device are returned under BlkioTotalDevice. Returns nil if the statistics don't include block I/O statistics.
+func BlkioDevices(statistics ResourceStatistics) map[string]*BlkioDevice {
+	stats, ok := statistics.(*mesos_v1.ResourceStatistics)
+	if !ok || stats.GetBlkioStatistics() == nil {
+		return nil
+	}
+
+	devices := map[string]*BlkioDevice{}
+	device := func(number *mesos_v1.Device_Number) *BlkioDevice {
+		name := BlkioTotalDevice
+		if number != nil {
+			name = fmt.Sprintf("%d:%d", number.GetMajorNumber(), number.GetMinorNumber())
+		}
+		if _, ok := devices[name]; !ok {
+			devices[name] = &BlkioDevice{}
+		}
+		return devices[name]
+	}
+
+	for _, cfq := range stats.GetBlkioStatistics().GetCfq() {
+		device(cfq.GetDevice()).Cfq = newBlkioCFQ(cfq)
+	}
+	for _, cfq := range stats.GetBlkioStatistics().GetCfqRecursive() {
+		device(cfq.GetDevice()).CfqRecursive = newBlkioCFQ(cfq)
+	}
+	for _, throttling := range stats.GetBlkioStatistics().GetThrottling() {
+		device(throttling.GetDevice()).Throttling = &BlkioThrottling{
+			IoServiced:     newBlkioOperations(throttling.GetIoServiced()),
+			IoServiceBytes: newBlkioOperations(throttling.GetIoServiceBytes()),
+		}
+	}
+
+	return devices
+}
+
+func newBlkioCFQ(cfq *mesos_v1.CgroupInfo_Blkio_CFQ_Statistics) *BlkioCFQ {
+	return &BlkioCFQ{
+		Sectors:        cfq.Sectors,
+		Time:           cfq.Time,
+		IoServiced:     newBlkioOperations(cfq.GetIoServiced()),
+		IoServiceBytes: newBlkioOperations(cfq.GetIoServiceBytes()),
+		IoServiceTime:  newBlkioOperations(cfq.GetIoServiceTime()),
+		IoWaitTime:     newBlkioOperations(cfq.GetIoWaitTime()),
+		IoMerged:       newBlkioOperations(cfq.GetIoMerged()),
+		IoQueued:       newBlkioOperations(cfq.GetIoQueued()),
+	}
+}
+
+func newBlkioOperations(values []*mesos_v1.CgroupInfo_Blkio_Value) *BlkioOperations {
+	if len(values) == 0 {
+		return nil
+	}
+
+	operations := &BlkioOperations{}
+	for _, value := range values {
+		v := value.GetValue()
+		switch value.GetOp() {
+		case mesos_v1.CgroupInfo_Blkio_TOTAL:
+			operations.Total = &v
+		case mesos_v1.CgroupInfo_Blkio_READ:
+			operations.Read = &v
+		case mesos_v1.CgroupInfo_Blkio_WRITE:
+			operations.Write = &v
+		case mesos_v1.CgroupInfo_Blkio_SYNC:
+			operations.Sync = &v
+		case mesos_v1.CgroupInfo_Blkio_ASYNC:
+			operations.Async = &v
+		case mesos_v1.CgroupInfo_Blkio_DISCARD:
+			operations.Discard = &v
+		}
+	}
+	return operations
+}
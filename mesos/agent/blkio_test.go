@@ -0,0 +1,82 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetBlkioStatisticsMetricTypes(t *testing.T) {
+	Convey("When building metric types for the block I/O statistics", t, func() {
+		namespaces, err := GetBlkioStatisticsMetricTypes()
+		So(err, ShouldBeNil)
+		So(len(namespaces), ShouldEqual, 2*(2+6*6)+2*6)
+		So(namespaces, ShouldContain, "cfq/sectors")
+		So(namespaces, ShouldContain, "cfq_recursive/io_wait_time/read")
+		So(namespaces, ShouldContain, "throttling/io_service_bytes/total")
+	})
+}
+
+func TestBlkioDevices(t *testing.T) {
+	statistics := `{"blkio_statistics": {
+		"cfq": [
+			{"device": {"major_number": 8, "minor_number": 0}, "sectors": 100, "time": 20,
+			 "io_serviced": [{"op": "READ", "value": 3}, {"op": "WRITE", "value": 4}, {"op": "TOTAL", "value": 7}]},
+			{"sectors": 100}
+		],
+		"throttling": [
+			{"device": {"major_number": 8, "minor_number": 0}, "io_service_bytes": [{"op": "TOTAL", "value": 4096}]},
+			{"io_service_bytes": [{"op": "TOTAL", "value": 4096}]}
+		]
+	}}`
+
+	Convey("When getting the block I/O statistics of an executor", t, func() {
+		stats := &mesos_v1.ResourceStatistics{}
+		So(json.Unmarshal([]byte(statistics), stats), ShouldBeNil)
+		devices := BlkioDevices(stats)
+
+		Convey("Statistics should be grouped by device, and by total when no device is reported", func() {
+			So(len(devices), ShouldEqual, 2)
+			So(devices, ShouldContainKey, "8:0")
+			So(devices, ShouldContainKey, BlkioTotalDevice)
+			So(*devices[BlkioTotalDevice].Cfq.Sectors, ShouldEqual, 100)
+			So(*devices[BlkioTotalDevice].Throttling.IoServiceBytes.Total, ShouldEqual, 4096)
+		})
+
+		Convey("Values should be broken down by operation", func() {
+			cfq := devices["8:0"].Cfq
+			So(*cfq.IoServiced.Read, ShouldEqual, 3)
+			So(*cfq.IoServiced.Write, ShouldEqual, 4)
+			So(*cfq.IoServiced.Total, ShouldEqual, 7)
+			So(cfq.IoServiced.Discard, ShouldBeNil)
+			So(cfq.IoWaitTime, ShouldBeNil)
+			So(devices["8:0"].CfqRecursive, ShouldBeNil)
+		})
+	})
+
+	Convey("When the statistics don't include block I/O statistics", t, func() {
+		So(BlkioDevices(&mesos_v1.ResourceStatistics{}), ShouldBeNil)
+		So(BlkioDevices(&mesos_pb2.ResourceStatistics{}), ShouldBeNil)
+	})
+}
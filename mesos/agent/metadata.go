@@ -0,0 +1,116 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+)
+
+// Metadata describes how a Mesos agent was built and configured, so that differences in configuration across a
+// cluster can be tracked over time. The attributes and resources of an agent are keyed by their name, which is
+// represented by a dynamic element in the metric namespace, and so aren't part of the static metric types.
+type Metadata struct {
+	Version        string                 `json:"version"`
+	GitSHA         string                 `json:"git_sha"`
+	StartTime      float64                `json:"start_time"`
+	Uptime         float64                `json:"uptime_secs"`
+	Containerizers string                 `json:"containerizers"`
+	Isolation      string                 `json:"isolation"`
+	Attributes     map[string]interface{} `json:"-"`
+	Resources      map[string]float64     `json:"-"`
+}
+
+// Matches the role in a resource declared using the text format, e.g. the "(*)" in "cpus(*):4".
+var resourceRole = regexp.MustCompile(`\(.*\)$`)
+
+// Recursively traverse the Metadata struct, building "/"-delimited strings that resemble snap metric types.
+func GetMetadataMetricTypes() ([]string, error) {
+	log.Debug("Getting agent metadata metric types")
+	namespaces := []string{}
+	if err := ns.FromCompositeObject(Metadata{}, "", &namespaces); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Get the metadata of the Mesos agent from its state. The containerizers, isolators, and resources are taken from
+// the flags that the agent reports in its state, so that they reflect what was declared on the command line.
+func GetMetadata(state *State) *Metadata {
+	m := &Metadata{
+		Version:        state.Version,
+		GitSHA:         state.GitSHA,
+		StartTime:      state.StartTime,
+		Containerizers: state.Flags["containerizers"],
+		Isolation:      state.Flags["isolation"],
+		Attributes:     state.Attributes,
+		Resources:      parseResources(state.Flags["resources"]),
+	}
+	if state.StartTime > 0 {
+		m.Uptime = float64(time.Now().UnixNano())/float64(time.Second) - state.StartTime
+	}
+	return m
+}
+
+// Parse the scalar resources (e.g. cpus, mem, disk) declared using the "--resources" flag on the agent, summing the
+// resources that are declared for more than one role. The flag accepts either a JSON array of Resource objects, or
+// a semicolon-delimited string such as "cpus:4;mem(role1):1024;ports:[31000-32000]". Ranges and sets are ignored.
+// See https://github.com/apache/mesos/blob/0.28.1/docs/attributes-resources.md
+func parseResources(s string) map[string]float64 {
+	resources := map[string]float64{}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return resources
+	}
+
+	if strings.HasPrefix(s, "[") {
+		var declared []*mesos_pb2.Resource
+		if err := json.Unmarshal([]byte(s), &declared); err != nil {
+			log.Warn("Unable to parse the resources declared on the agent: ", err)
+			return resources
+		}
+		for _, r := range declared {
+			if r.GetType() == mesos_pb2.Value_SCALAR && r.Scalar != nil {
+				resources[r.GetName()] += r.Scalar.GetValue()
+			}
+		}
+		return resources
+	}
+
+	for _, r := range strings.Split(s, ";") {
+		kv := strings.SplitN(r, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil {
+			log.Debug("Ignoring non-scalar resource ", r)
+			continue
+		}
+		name := resourceRole.ReplaceAllString(strings.TrimSpace(kv[0]), "")
+		resources[name] += value
+	}
+	return resources
+}
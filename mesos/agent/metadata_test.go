@@ -0,0 +1,92 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetMetadataMetricTypes(t *testing.T) {
+	Convey("When building metric types for the agent metadata", t, func() {
+		namespaces, err := GetMetadataMetricTypes()
+		So(err, ShouldBeNil)
+		So(namespaces, ShouldContain, "version")
+		So(namespaces, ShouldContain, "uptime_secs")
+		So(namespaces, ShouldContain, "isolation")
+
+		Convey("Should not contain the attributes or resources, which are dynamic", func() {
+			So(len(namespaces), ShouldEqual, 6)
+		})
+	})
+}
+
+func TestGetMetadata(t *testing.T) {
+	Convey("When getting the metadata from the agent's state", t, func() {
+		state := &State{
+			Version:    "0.28.1",
+			GitSHA:     "555db235a34afbb9fb49940376cc33a66f1f85f0",
+			StartTime:  float64(time.Now().Add(-time.Hour).Unix()),
+			Attributes: map[string]interface{}{"rack": "r1", "zone": 2.0},
+			Flags: map[string]string{
+				"containerizers": "mesos,docker",
+				"isolation":      "cgroups/cpu,cgroups/mem",
+				"resources":      "cpus:4;mem:2048",
+			},
+		}
+		metadata := GetMetadata(state)
+
+		Convey("Should return the version, build, and configuration of the agent", func() {
+			So(metadata.Version, ShouldEqual, "0.28.1")
+			So(metadata.GitSHA, ShouldEqual, "555db235a34afbb9fb49940376cc33a66f1f85f0")
+			So(metadata.Containerizers, ShouldEqual, "mesos,docker")
+			So(metadata.Isolation, ShouldEqual, "cgroups/cpu,cgroups/mem")
+			So(metadata.Attributes["zone"], ShouldEqual, 2.0)
+			So(metadata.Resources["mem"], ShouldEqual, 2048.0)
+		})
+
+		Convey("Should compute the uptime from the start time", func() {
+			So(metadata.Uptime, ShouldAlmostEqual, 3600.0, 5.0)
+		})
+	})
+}
+
+func Test_parseResources(t *testing.T) {
+	Convey("When parsing the resources declared on the agent", t, func() {
+		Convey("Should parse the text format, summing resources declared for several roles", func() {
+			resources := parseResources("cpus:4;mem(*):1024;mem(role1):1024;ports:[31000-32000];disks:{a,b}")
+			So(resources, ShouldResemble, map[string]float64{"cpus": 4.0, "mem": 2048.0})
+		})
+
+		Convey("Should parse the JSON format", func() {
+			resources := parseResources(`[
+				{"name": "cpus", "type": "SCALAR", "scalar": {"value": 8}},
+				{"name": "ports", "type": "RANGES", "ranges": {"range": [{"begin": 31000, "end": 32000}]}}
+			]`)
+			So(resources, ShouldResemble, map[string]float64{"cpus": 8.0})
+		})
+
+		Convey("Should return no resources when none are declared", func() {
+			So(parseResources(""), ShouldBeEmpty)
+			So(parseResources("[not json"), ShouldBeEmpty)
+		})
+	})
+}
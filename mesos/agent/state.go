@@ -0,0 +1,97 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+)
+
+// The "/state" endpoint on a Mesos agent returns a large JSON object describing the agent itself, along with the
+// frameworks, executors, and tasks running on it. Only the parts of this object that are used by the plugin are
+// decoded here. For the actual Mesos implementation, see
+// https://github.com/apache/mesos/blob/0.28.1/src/slave/http.cpp
+type State struct {
+	PID        string                 `json:"pid"`
+	Version    string                 `json:"version"`
+	GitSHA     string                 `json:"git_sha"`
+	StartTime  float64                `json:"start_time"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Flags      map[string]string      `json:"flags"`
+	Frameworks []*StateFramework      `json:"frameworks"`
+}
+
+type StateFramework struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Executors []*StateExecutor `json:"executors"`
+}
+
+type StateExecutor struct {
+	ID    string       `json:"id"`
+	Name  string       `json:"name"`
+	Tasks []*StateTask `json:"tasks"`
+}
+
+type StateTask struct {
+	ID     string             `json:"id"`
+	Name   string             `json:"name"`
+	Labels []*mesos_pb2.Label `json:"labels"`
+}
+
+// Get the state of the Mesos agent, including the frameworks, executors, and tasks running on it.
+func GetState(host string) (*State, error) {
+	log.Debug("Getting state from host ", host)
+	state := &State{}
+
+	c := client.NewClient(host, "/state", 10*time.Second)
+	if err := c.Fetch(&state); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Return the labels for each executor on the agent, keyed by framework ID and then by executor ID. As of Mesos 0.28.x,
+// ExecutorInfo doesn't carry any labels of its own, so the labels of an executor are the labels of the tasks it runs.
+// If two tasks define the same key, the task listed last wins.
+func (s *State) ExecutorLabels() map[string]map[string][]*mesos_pb2.Label {
+	labels := map[string]map[string][]*mesos_pb2.Label{}
+	for _, framework := range s.Frameworks {
+		executors := map[string][]*mesos_pb2.Label{}
+		for _, executor := range framework.Executors {
+			for _, task := range executor.Tasks {
+				executors[executor.ID] = append(executors[executor.ID], task.Labels...)
+			}
+		}
+		labels[framework.ID] = executors
+	}
+	return labels
+}
+
+// Return the names of the frameworks that have executors on the agent, keyed by framework ID.
+func (s *State) FrameworkNames() map[string]string {
+	names := map[string]string{}
+	for _, framework := range s.Frameworks {
+		names[framework.ID] = framework.Name
+	}
+	return names
+}
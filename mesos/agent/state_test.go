@@ -0,0 +1,79 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetState(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{
+			"frameworks": [{
+				"id": "frame1",
+				"name": "marathon",
+				"executors": [{
+					"id": "exec1",
+					"name": "Command Executor",
+					"tasks": [
+						{"id": "task1", "labels": [{"key": "app", "value": "web"}, {"key": "team", "value": "infra"}]},
+						{"id": "task2", "labels": [{"key": "env", "value": "prod"}]}
+					]
+				}, {
+					"id": "exec2",
+					"tasks": [{"id": "task3"}]
+				}]
+			}]
+		}`))
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When getting the state of the Mesos agent", t, func() {
+		state, err := GetState(host)
+
+		Convey("Then no error should be reported", func() {
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Then the frameworks and executors on the agent are returned", func() {
+			So(len(state.Frameworks), ShouldEqual, 1)
+			So(state.Frameworks[0].Name, ShouldEqual, "marathon")
+			So(len(state.Frameworks[0].Executors), ShouldEqual, 2)
+		})
+
+		Convey("Then the labels of each executor are the labels of its tasks", func() {
+			labels := state.ExecutorLabels()
+			So(len(labels["frame1"]["exec1"]), ShouldEqual, 3)
+			So(labels["frame1"]["exec1"][0].GetKey(), ShouldEqual, "app")
+			So(labels["frame1"]["exec1"][2].GetValue(), ShouldEqual, "prod")
+			So(labels["frame1"]["exec2"], ShouldBeEmpty)
+		})
+	})
+}
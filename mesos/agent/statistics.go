@@ -0,0 +1,121 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+)
+
+// ResourceStatistics is implemented by the ResourceStatistics messages generated from both the Mesos 0.28 protobuf
+// (mesos_pb2) and the Mesos 1.x v1 protobuf (mesos_v1). Agents running Mesos 1.0 or newer are decoded using the v1
+// message, so that the statistics added since 0.28 (e.g. blkio and disk statistics) aren't dropped.
+type ResourceStatistics interface {
+	proto.Message
+	GetCpusLimit() float64
+	GetCpusUserTimeSecs() float64
+	GetCpusSystemTimeSecs() float64
+	GetMemLimitBytes() uint64
+	GetMemRssBytes() uint64
+	GetMemTotalBytes() uint64
+	GetDiskLimitBytes() uint64
+	GetDiskUsedBytes() uint64
+	GetNetRxBytes() uint64
+	GetNetTxBytes() uint64
+}
+
+// Return an empty ResourceStatistics message of the kind that matches the version of Mesos running on the agent. If
+// the version can't be detected, the Mesos 0.28 message is used.
+func NewResourceStatistics(host string) ResourceStatistics {
+	version, _ := client.GetVersion(host)
+	return newResourceStatisticsForVersion(version)
+}
+
+// Return an empty ResourceStatistics message of the kind that matches a version of Mesos, e.g. "1.4.0". An empty or
+// unparsable version selects the Mesos 0.28 message.
+func newResourceStatisticsForVersion(version string) ResourceStatistics {
+	if client.VersionAtLeast(version, "1.0") {
+		return &mesos_v1.ResourceStatistics{}
+	}
+	return &mesos_pb2.ResourceStatistics{}
+}
+
+// The JSON representation of an executor (or container), whose statistics are decoded once the version of Mesos
+// running on the agent is known.
+type executorJSON struct {
+	Executor
+	Statistics json.RawMessage `json:"statistics"`
+}
+
+// Fetch the executors (or containers) from an endpoint on the agent, decoding their statistics into the
+// ResourceStatistics message that matches the version of Mesos running on the agent. The version is looked up once,
+// within the same timeout as the endpoint itself.
+func fetchExecutors(host string, path string, timeout time.Duration) ([]Executor, error) {
+	var raw []executorJSON
+
+	c := client.NewClient(host, path, timeout)
+	if err := c.Fetch(&raw); err != nil {
+		return nil, err
+	}
+
+	version := ""
+	if len(raw) > 0 {
+		var err error
+		if version, err = client.GetVersionWithTimeout(host, timeout); err != nil {
+			log.Warn("Unable to get the Mesos version of host ", host, " (", err, "), assuming Mesos 0.28")
+		}
+	}
+
+	executors := make([]Executor, 0, len(raw))
+	for _, r := range raw {
+		exec := r.Executor
+		if len(r.Statistics) > 0 && string(r.Statistics) != "null" {
+			statistics := newResourceStatisticsForVersion(version)
+			if err := json.Unmarshal(r.Statistics, statistics); err != nil {
+				e := fmt.Errorf("unmarshal error: statistics of executor %s: %v", exec.ID, err)
+				log.Error(e)
+				return nil, e
+			}
+			exec.Statistics = statistics
+		}
+		executors = append(executors, exec)
+	}
+
+	return executors, nil
+}
+
+// Return the perf statistics of an executor, or nil if it doesn't have any.
+func perfStatistics(statistics ResourceStatistics) interface{} {
+	switch s := statistics.(type) {
+	case *mesos_pb2.ResourceStatistics:
+		if s.Perf != nil {
+			return s.Perf
+		}
+	case *mesos_v1.ResourceStatistics:
+		if s.Perf != nil {
+			return s.Perf
+		}
+	}
+	return nil
+}
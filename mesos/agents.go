@@ -0,0 +1,136 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+const (
+	defaultAgentFlappingWindow    = 600
+	defaultAgentFlappingThreshold = 2
+)
+
+// agentFlappingConfig controls the tracking of the agents registered with the leading master (see
+// master.TrackAgents), which detects agents that are repeatedly deactivated or reregistered.
+type agentFlappingConfig struct {
+	enabled   bool
+	window    time.Duration
+	threshold int
+}
+
+// Build an agentFlappingConfig from the global config. The following (optional) config items are supported:
+//
+//   "agent_flapping":           true
+//   "agent_flapping_window":    600
+//   "agent_flapping_threshold": 2
+//
+// An agent flapped if it was deactivated or reregistered at least threshold times within the window, in seconds.
+func getAgentFlappingConfig(cfg interface{}) (*agentFlappingConfig, error) {
+	fc := &agentFlappingConfig{
+		window:    time.Duration(defaultAgentFlappingWindow) * time.Second,
+		threshold: defaultAgentFlappingThreshold,
+	}
+
+	fc.enabled, _ = getConfigBool(cfg, "agent_flapping")
+
+	if window, ok := getConfigInt(cfg, "agent_flapping_window"); ok {
+		if window < 1 {
+			e := fmt.Errorf("error: 'agent_flapping_window' must be at least 1, got %d", window)
+			log.Error(e)
+			return nil, e
+		}
+		fc.window = time.Duration(window) * time.Second
+	}
+
+	if threshold, ok := getConfigInt(cfg, "agent_flapping_threshold"); ok {
+		if threshold < 1 {
+			e := fmt.Errorf("error: 'agent_flapping_threshold' must be at least 1, got %d", threshold)
+			log.Error(e)
+			return nil, e
+		}
+		fc.threshold = threshold
+	}
+
+	return fc, nil
+}
+
+// Build the metric types for the history of each agent registered with the master, and the number of agents that
+// flapped.
+func agentHistoryMetricTypes() ([]plugin.MetricType, error) {
+	metricTypes := []plugin.MetricType{}
+
+	history_mts, err := master.GetAgentHistoryMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range history_mts {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master", "agents").
+			AddDynamicElement("agent_id", "Agent ID").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	namespace := core.NewNamespace(pluginVendor, pluginName, "master", "agents", "flapping")
+	log.Debug("Adding metric to catalog: ", namespace.String())
+	metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+
+	return metricTypes, nil
+}
+
+// Collect either the number of agents that flapped, or a metric from the history of each agent that matches the
+// requested namespace. The metrics of each agent are tagged with its hostname.
+func collectAgentHistory(requested core.Namespace, histories map[string]*master.AgentHistory, flapping uint64, agents []*master.Agent, now time.Time, tags map[string]string) []plugin.MetricType {
+	if len(requested) == 5 && requested[4].Value == "flapping" {
+		return []plugin.MetricType{newMetric(requested, now, tags, flapping)}
+	}
+
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[5:]
+
+	for _, agent := range agents {
+		if !matchesElement(requested[4], agent.ID) {
+			continue
+		}
+		val := ns.GetValueByNamespace(histories[agent.ID], n)
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			continue
+		}
+		agentTags := make(map[string]string, len(tags)+1)
+		for k, v := range tags {
+			agentTags[k] = v
+		}
+		agentTags["hostname"] = agent.Hostname
+
+		// substituting the "agent" wildcard with the agent ID
+		rendered := cloneNamespace(requested)
+		rendered[4].Value = agent.ID
+		metrics = append(metrics, newMetric(rendered, now, agentTags, val))
+	}
+	return metrics
+}
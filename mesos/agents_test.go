@@ -0,0 +1,83 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+	"github.com/intelsdi-x/snap/core/cdata"
+	"github.com/intelsdi-x/snap/core/ctypes"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_getAgentFlappingConfig(t *testing.T) {
+	Convey("Get the agent flapping config", t, func() {
+		Convey("Should use the defaults when no config items are set", func() {
+			fc, err := getAgentFlappingConfig(plugin.ConfigType{ConfigDataNode: cdata.NewNode()})
+			So(err, ShouldBeNil)
+			So(fc.enabled, ShouldBeFalse)
+			So(fc.window, ShouldEqual, 10*time.Minute)
+			So(fc.threshold, ShouldEqual, defaultAgentFlappingThreshold)
+		})
+
+		Convey("Should reject a threshold below 1", func() {
+			node := cdata.NewNode()
+			node.AddItem("agent_flapping_threshold", ctypes.ConfigValueInt{Value: 0})
+
+			_, err := getAgentFlappingConfig(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMesos_collectAgentHistory(t *testing.T) {
+	histories := map[string]*master.AgentHistory{
+		"agent1": {Deactivations: 3},
+		"agent2": {},
+	}
+	agents := []*master.Agent{
+		{ID: "agent1", Hostname: "agent1.example.com"},
+		{ID: "agent2", Hostname: "agent2.example.com"},
+	}
+
+	Convey("Collect the history of the agents registered with the master", t, func() {
+		Convey("Should report the history of each agent, tagged with its hostname", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "master", "agents").
+				AddDynamicElement("agent_id", "Agent ID").
+				AddStaticElement("deactivations")
+			metrics := collectAgentHistory(requested, histories, 1, agents, time.Now(), map[string]string{"source": "10.0.0.1:5050"})
+			So(len(metrics), ShouldEqual, 2)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/master/agents/agent1/deactivations")
+			So(metrics[0].Data(), ShouldEqual, 3)
+			So(metrics[0].Tags()["hostname"], ShouldEqual, "agent1.example.com")
+			So(metrics[0].Tags()["source"], ShouldEqual, "10.0.0.1:5050")
+		})
+
+		Convey("Should report the number of agents that flapped", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "master", "agents", "flapping")
+			metrics := collectAgentHistory(requested, histories, 1, agents, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, 1)
+		})
+	})
+}
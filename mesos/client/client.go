@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
@@ -32,29 +33,53 @@ type Client struct {
 	httpClient *http.Client
 	host       string
 	path       string
+	query      string
 }
 
-// Return a new instance of Client.
+// Returned by Fetch when the Mesos API responds with anything other than HTTP 200, so that callers can tell an
+// endpoint that doesn't exist on a given version of Mesos apart from other failures.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("fetch error: %s", e.Status)
+}
+
+// Returns true if the error was caused by the Mesos API responding with HTTP 404.
+func IsNotFound(err error) bool {
+	e, ok := err.(*StatusError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// Return a new instance of Client. The path may include a query string, e.g. "/containers?nested=true". The timeout
+// covers the whole request, including reading the response.
 func NewClient(host string, path string, timeout time.Duration) *Client {
 	log.Debug("Creating a new instance of the Mesos plugin HTTP client")
-	return &Client{
+	c := &Client{
 		httpClient: &http.Client{Timeout: timeout},
 		host:       host,
 		path:       path,
 	}
+	if i := strings.Index(path, "?"); i >= 0 {
+		c.path, c.query = path[:i], path[i+1:]
+	}
+	return c
 }
 
 // Return the URL for this client as a string. Note that this isn't specifically required for this client, but might
 // be useful if you want to retrieve the actual URL for logging, etc throughout this plugin.
 func (c *Client) URL() string {
-	u := url.URL{Scheme: "http", Host: c.host, Path: c.path}
+	u := url.URL{Scheme: "http", Host: c.host, Path: c.path, RawQuery: c.query}
 	return u.String()
 }
 
 // Fetch JSON from the API endpoint, unmarshal it, and return it to the provided 'target'.
 func (c *Client) Fetch(target interface{}) error {
 	log.Debug("Fetching data from ", c.URL())
-	resp, err := http.Get(c.URL())
+	resp, err := c.httpClient.Get(c.URL())
 	if err != nil {
 		log.Error(err)
 		return err
@@ -62,7 +87,7 @@ func (c *Client) Fetch(target interface{}) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		e := fmt.Errorf("fetch error: %s", resp.Status)
+		e := &StatusError{URL: c.URL(), StatusCode: resp.StatusCode, Status: resp.Status}
 		log.Error(e)
 		return e
 	}
@@ -31,7 +31,7 @@ import (
 
 func TestNewClient(t *testing.T) {
 	Convey("Should return a new client", t, func() {
-		c := NewClient("foo.example.com", "/bar", time.Duration(1))
+		c := NewClient("foo.example.com", "/bar", time.Second)
 		So(c, ShouldHaveSameTypeAs, &Client{})
 	})
 }
@@ -58,7 +58,7 @@ func TestClient_Fetch(t *testing.T) {
 			panic(err)
 		}
 
-		c := NewClient(host, "/", time.Duration(1))
+		c := NewClient(host, "/", time.Second)
 		err = c.Fetch(&data)
 
 		So(data["foo"], ShouldEqual, "bar")
@@ -66,11 +66,63 @@ func TestClient_Fetch(t *testing.T) {
 	})
 }
 
+func TestClient_FetchNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer ts.Close()
+
+	Convey("Should return a StatusError when the endpoint doesn't exist", t, func() {
+		data := map[string]string{}
+		host, err := extractHostFromURL(ts.URL)
+		if err != nil {
+			panic(err)
+		}
+
+		c := NewClient(host, "/", time.Second)
+		err = c.Fetch(&data)
+
+		So(err, ShouldHaveSameTypeAs, &StatusError{})
+		So(err.Error(), ShouldEqual, "fetch error: 404 Not Found")
+		So(IsNotFound(err), ShouldBeTrue)
+	})
+}
+
+func TestClient_FetchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	Convey("Should return an error when the Mesos API doesn't respond within the timeout", t, func() {
+		data := map[string]string{}
+		host, err := extractHostFromURL(ts.URL)
+		if err != nil {
+			panic(err)
+		}
+
+		c := NewClient(host, "/", 100*time.Millisecond)
+		start := time.Now()
+		err = c.Fetch(&data)
+
+		So(err, ShouldNotBeNil)
+		So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+	})
+}
+
 func TestClient_URL(t *testing.T) {
 	Convey("Should return the URL as a string", t, func() {
-		c := NewClient("foo.example.com", "/bar", time.Duration(1))
+		c := NewClient("foo.example.com", "/bar", time.Second)
 		So(c.URL(), ShouldEqual, "http://foo.example.com/bar")
 	})
+
+	Convey("Should keep the query string separate from the path", t, func() {
+		c := NewClient("foo.example.com", "/bar?baz=true", time.Second)
+		So(c.URL(), ShouldEqual, "http://foo.example.com/bar?baz=true")
+	})
 }
 
 func extractHostFromURL(u string) (string, error) {
@@ -0,0 +1,113 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// The '/version' endpoint on a Mesos master or agent returns an object describing the build of Mesos it runs.
+type Version struct {
+	Version   string `json:"version"`
+	GitSHA    string `json:"git_sha"`
+	GitTag    string `json:"git_tag"`
+	BuildDate string `json:"build_date"`
+}
+
+// How long the version of a host is remembered before it's fetched again, so that upgrades are eventually noticed
+// without querying '/version' on every collection.
+const versionTTL = 5 * time.Minute
+
+type cachedVersion struct {
+	version string
+	fetched time.Time
+}
+
+var versions = struct {
+	sync.Mutex
+	hosts map[string]cachedVersion
+}{hosts: map[string]cachedVersion{}}
+
+// Get the version of Mesos running on a master or agent, e.g. "0.28.1", from its '/version' endpoint. The version of
+// each host is cached for a few minutes.
+func GetVersion(host string) (string, error) {
+	return GetVersionWithTimeout(host, 5*time.Second)
+}
+
+// Same as GetVersion, but with a timeout other than the default, e.g. when the version is needed within the timeout
+// of another request to the same host.
+func GetVersionWithTimeout(host string, timeout time.Duration) (string, error) {
+	versions.Lock()
+	cached, ok := versions.hosts[host]
+	versions.Unlock()
+	if ok && time.Since(cached.fetched) < versionTTL {
+		return cached.version, nil
+	}
+
+	log.Debug("Getting Mesos version from host ", host)
+	var v Version
+
+	c := NewClient(host, "/version", timeout)
+	if err := c.Fetch(&v); err != nil {
+		log.Error(err)
+		return "", err
+	}
+
+	versions.Lock()
+	versions.hosts[host] = cachedVersion{version: v.Version, fetched: time.Now()}
+	versions.Unlock()
+
+	return v.Version, nil
+}
+
+// Returns true if the version is the same as or newer than min, comparing the major, minor, and patch numbers, e.g.
+// VersionAtLeast("1.0.1", "0.28") is true. Pre-release suffixes such as "-rc1" are ignored. An empty or unparsable
+// version is never at least min.
+func VersionAtLeast(version string, min string) bool {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	m, _ := parseVersion(min)
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] > m[i]
+		}
+	}
+	return true
+}
+
+func parseVersion(s string) ([3]int, bool) {
+	var v [3]int
+	s = strings.SplitN(s, "-", 2)[0]
+	if s == "" {
+		return v, false
+	}
+	for i, part := range strings.SplitN(s, ".", 3) {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return v, false
+		}
+		v[i] = n
+	}
+	return v, true
+}
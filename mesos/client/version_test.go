@@ -0,0 +1,66 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetVersion(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"version": "0.28.1", "git_sha": "abc123", "build_date": "2016-04-14"}`))
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("Get the Mesos version of a host", t, func() {
+		version, err := GetVersion(host)
+		So(err, ShouldBeNil)
+		So(version, ShouldEqual, "0.28.1")
+
+		Convey("The version should be cached", func() {
+			_, err := GetVersion(host)
+			So(err, ShouldBeNil)
+			So(requests, ShouldEqual, 1)
+		})
+	})
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	Convey("Compare Mesos versions", t, func() {
+		So(VersionAtLeast("0.28.1", "0.26"), ShouldBeTrue)
+		So(VersionAtLeast("0.28.1", "0.28.1"), ShouldBeTrue)
+		So(VersionAtLeast("1.0.0-rc1", "1.0"), ShouldBeTrue)
+		So(VersionAtLeast("0.9.0", "0.26"), ShouldBeFalse)
+		So(VersionAtLeast("0.28.2", "1.0"), ShouldBeFalse)
+		So(VersionAtLeast("", "0.26"), ShouldBeFalse)
+	})
+}
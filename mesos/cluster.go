@@ -0,0 +1,225 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+const (
+	defaultClusterConcurrency = 10
+	defaultClusterTimeout     = 5
+)
+
+// clusterConfig controls the cluster-wide aggregation mode, in which the collector on the leading master fetches the
+// monitoring statistics of every agent registered with it, and reports the actual resource usage of each framework
+// and role (rather than the allocations reported by "/master/frameworks").
+type clusterConfig struct {
+	enabled     bool
+	concurrency int
+	timeout     time.Duration
+}
+
+// Build a clusterConfig from the global config. The following (optional) config items are supported:
+//
+//   "cluster_aggregation": true
+//   "cluster_concurrency": 10
+//   "cluster_timeout":     5
+//
+// The concurrency is the maximum number of agents queried at once, and the timeout is the number of seconds to wait
+// for each agent before it's counted as failed.
+func getClusterConfig(cfg interface{}) (*clusterConfig, error) {
+	cc := &clusterConfig{concurrency: defaultClusterConcurrency, timeout: time.Duration(defaultClusterTimeout) * time.Second}
+
+	cc.enabled, _ = getConfigBool(cfg, "cluster_aggregation")
+
+	if concurrency, ok := getConfigInt(cfg, "cluster_concurrency"); ok {
+		if concurrency < 1 {
+			e := fmt.Errorf("error: 'cluster_concurrency' must be at least 1, got %d", concurrency)
+			log.Error(e)
+			return nil, e
+		}
+		cc.concurrency = concurrency
+	}
+
+	if timeout, ok := getConfigInt(cfg, "cluster_timeout"); ok {
+		if timeout < 1 {
+			e := fmt.Errorf("error: 'cluster_timeout' must be at least 1, got %d", timeout)
+			log.Error(e)
+			return nil, e
+		}
+		cc.timeout = time.Duration(timeout) * time.Second
+	}
+
+	return cc, nil
+}
+
+// clusterUsage is the actual resource usage of the executors across all agents in the cluster.
+type clusterUsage struct {
+	AgentsQueried uint64                      `json:"agents_queried"`
+	AgentsFailed  uint64                      `json:"agents_failed"`
+	Total         *agent.Aggregate            `json:"-"`
+	Frameworks    map[string]*agent.Aggregate `json:"-"`
+	Roles         map[string]*agent.Aggregate `json:"-"`
+}
+
+// Fetch the monitoring statistics of every active agent, querying at most cc.concurrency agents at once, and
+// aggregate them per framework and per role. Agents that can't be queried are logged and counted, but don't fail the
+// collection, since on a large cluster some agent is likely to be unavailable at any given time.
+func getClusterUsage(agents []*master.Agent, frameworks []*master.Framework, cc *clusterConfig) *clusterUsage {
+	usage := &clusterUsage{}
+	executors := []agent.Executor{}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, cc.concurrency)
+
+	for _, a := range agents {
+		if !a.Active || a.Address() == "" {
+			continue
+		}
+		usage.AgentsQueried++
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(a *master.Agent) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			stats, err := agent.GetMonitoringStatisticsWithTimeout(a.Address(), cc.timeout)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Warn("Unable to get monitoring statistics from agent ", a.ID, " at ", a.Address(), ": ", err)
+				usage.AgentsFailed++
+				return
+			}
+			executors = append(executors, stats...)
+		}(a)
+	}
+	wg.Wait()
+
+	usage.Total, usage.Frameworks = agent.AggregateStatistics(executors)
+
+	roles := map[string]string{}
+	for _, framework := range frameworks {
+		roles[framework.ID] = framework.Role
+	}
+
+	usage.Roles = map[string]*agent.Aggregate{}
+	for id, aggregate := range usage.Frameworks {
+		role, ok := roles[id]
+		if !ok {
+			log.Debug("Framework ", id, " isn't active on the master, so its role is unknown")
+			continue
+		}
+		if usage.Roles[role] == nil {
+			usage.Roles[role] = &agent.Aggregate{}
+		}
+		usage.Roles[role].Merge(aggregate)
+	}
+
+	return usage
+}
+
+// Build the metric types for the cluster-wide aggregation mode.
+func clusterMetricTypes() ([]plugin.MetricType, error) {
+	metricTypes := []plugin.MetricType{}
+
+	for _, key := range []string{"agents_queried", "agents_failed"} {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master", "cluster", key)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	aggregate_mts, err := agent.GetAggregateMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range aggregate_mts {
+		for _, namespace := range []core.Namespace{
+			core.NewNamespace(pluginVendor, pluginName, "master", "cluster", "total").
+				AddStaticElements(strings.Split(key, "/")...),
+			core.NewNamespace(pluginVendor, pluginName, "master", "cluster", "framework").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElements(strings.Split(key, "/")...),
+			core.NewNamespace(pluginVendor, pluginName, "master", "cluster", "role").
+				AddDynamicElement("role", "Role name").
+				AddStaticElements(strings.Split(key, "/")...),
+		} {
+			log.Debug("Adding metric to catalog: ", namespace.String())
+			metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+		}
+	}
+
+	return metricTypes, nil
+}
+
+// Collect a metric from the cluster-wide usage. Per-framework and per-role usage is requested using a dynamic element
+// for the framework ID or role, so a single requested namespace may return more than one metric.
+func collectClusterUsage(requested core.Namespace, usage *clusterUsage, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[4:]
+
+	isDynamic, _ := requested.IsDynamic()
+	if !isDynamic {
+		var val interface{}
+		if n[0] == "total" {
+			val = ns.GetValueByNamespace(usage.Total, n[1:])
+		} else {
+			val = ns.GetValueByNamespace(usage, n)
+		}
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			return metrics
+		}
+		return append(metrics, newMetric(requested, now, tags, val))
+	}
+
+	aggregates := usage.Frameworks
+	if n[0] == "role" {
+		aggregates = usage.Roles
+	}
+
+	for name, aggregate := range aggregates {
+		if !matchesElement(requested[5], name) {
+			continue
+		}
+		val := ns.GetValueByNamespace(aggregate, n[2:])
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			continue
+		}
+		rendered := cloneNamespace(requested)
+		// substituting the framework or role wildcard with its ID or name
+		rendered[5].Value = name
+		metrics = append(metrics, newMetric(rendered, now, tags, val))
+	}
+	return metrics
+}
@@ -0,0 +1,119 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+	"github.com/intelsdi-x/snap/core/cdata"
+	"github.com/intelsdi-x/snap/core/ctypes"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_getClusterConfig(t *testing.T) {
+	Convey("Get the cluster aggregation config from snap global config", t, func() {
+		Convey("When no cluster config is provided, aggregation should be disabled with the default limits", func() {
+			cc, err := getClusterConfig(plugin.ConfigType{ConfigDataNode: cdata.NewNode()})
+			So(err, ShouldBeNil)
+			So(cc.enabled, ShouldBeFalse)
+			So(cc.concurrency, ShouldEqual, defaultClusterConcurrency)
+			So(cc.timeout, ShouldEqual, time.Duration(defaultClusterTimeout)*time.Second)
+		})
+
+		Convey("When the concurrency is invalid, an error should be returned", func() {
+			node := cdata.NewNode()
+			node.AddItem("cluster_aggregation", ctypes.ConfigValueBool{Value: true})
+			node.AddItem("cluster_concurrency", ctypes.ConfigValueInt{Value: 0})
+
+			_, err := getClusterConfig(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMesos_getClusterUsage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`[
+			{"executor_id": "exec1", "framework_id": "frame1", "statistics": {"cpus_limit": 1.0, "mem_rss_bytes": 100}},
+			{"executor_id": "exec2", "framework_id": "frame2", "statistics": {"cpus_limit": 2.0, "mem_rss_bytes": 200}},
+			{"executor_id": "exec3", "framework_id": "frame3", "statistics": {"cpus_limit": 0.5, "mem_rss_bytes": 50}}
+		]`))
+	}))
+	defer ts.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(503)
+	}))
+	defer failing.Close()
+
+	address := func(url string) string {
+		return strings.TrimPrefix(url, "http://")
+	}
+	agents := []*master.Agent{
+		{ID: "agent1", PID: "slave(1)@" + address(ts.URL), Active: true},
+		{ID: "agent2", PID: "slave(1)@" + address(ts.URL), Active: true},
+		{ID: "agent3", PID: "slave(1)@" + address(failing.URL), Active: true},
+		{ID: "agent4", PID: "slave(1)@" + address(failing.URL), Active: false},
+	}
+	frameworks := []*master.Framework{
+		{ID: "frame1", Role: "web"},
+		{ID: "frame2", Role: "web"},
+		{ID: "frame3", Role: "batch"},
+	}
+	cc := &clusterConfig{enabled: true, concurrency: 2, timeout: time.Second}
+
+	Convey("When aggregating the usage of every agent in the cluster", t, func() {
+		usage := getClusterUsage(agents, frameworks, cc)
+
+		Convey("Only active agents should be queried, and failures should be counted", func() {
+			So(usage.AgentsQueried, ShouldEqual, 3)
+			So(usage.AgentsFailed, ShouldEqual, 1)
+		})
+
+		Convey("Usage should be summed for the cluster, per framework, and per role", func() {
+			So(usage.Total.Executors, ShouldEqual, 6)
+			So(usage.Total.CpusLimit, ShouldEqual, 7.0)
+			So(usage.Frameworks["frame1"].MemRssBytes, ShouldEqual, 200)
+			So(usage.Roles["web"].MemRssBytes, ShouldEqual, 600)
+			So(usage.Roles["batch"].CpusLimit, ShouldEqual, 1.0)
+		})
+
+		Convey("Should collect one metric per role", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "master", "cluster", "role").
+				AddDynamicElement("role", "Role name").
+				AddStaticElement("mem_rss_bytes")
+			metrics := collectClusterUsage(requested, usage, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 2)
+
+			requested = core.NewNamespace(pluginVendor, pluginName, "master", "cluster", "agents_failed")
+			metrics = collectClusterUsage(requested, usage, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, 1)
+		})
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+// Build the metric types for the event stream mode, in which the collector subscribes to the event stream of the
+// master (see master.EventStream), enabled with the (optional) config item:
+//
+//   "event_stream": true
+func eventMetricTypes() []plugin.MetricType {
+	metricTypes := []plugin.MetricType{}
+
+	for _, key := range master.GetEventMetricTypes() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master", "events").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	return metricTypes
+}
+
+// Collect a metric from the data returned by master.EventStream.Collect().
+func collectEvents(requested core.Namespace, data map[string]uint64, now time.Time, tags map[string]string) (plugin.MetricType, error) {
+	key := strings.Join(requested.Strings()[4:], "/")
+	val, ok := data[key]
+	if !ok {
+		e := fmt.Errorf("error: requested metric %s not found", requested.String())
+		log.Error(e)
+		return plugin.MetricType{}, e
+	}
+	return newMetric(requested, now, tags, val), nil
+}
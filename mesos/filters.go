@@ -0,0 +1,252 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+)
+
+// snapshotFilter decides which keys from the "/metrics/snapshot" endpoint of a master or agent are added to the
+// metric catalog and collected. Since a master returns hundreds of keys, this lets operators limit the catalog to the
+// families they care about (e.g. "^(master|allocator)/") instead of listing each metric in the task manifest.
+type snapshotFilter struct {
+	include *regexp.Regexp
+	exclude *regexp.Regexp
+}
+
+// Build a snapshotFilter from the global config. The following (optional) config items are supported:
+//
+//   "snapshot_include": "^(master|allocator)/"
+//   "snapshot_exclude": "_ms/p[0-9]+$"
+//
+// Keys are matched as they're returned by Mesos, e.g. "master/tasks_running", without the "/intel/mesos/master"
+// prefix of the namespace. A key must match "snapshot_include" (if set) and must not match "snapshot_exclude".
+func getSnapshotFilter(cfg interface{}) (*snapshotFilter, error) {
+	sf := &snapshotFilter{}
+
+	if include, ok := getConfigString(cfg, "snapshot_include"); ok {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			e := fmt.Errorf("error: invalid regex for 'snapshot_include': %s", err)
+			log.Error(e)
+			return nil, e
+		}
+		sf.include = re
+	}
+
+	if exclude, ok := getConfigString(cfg, "snapshot_exclude"); ok {
+		re, err := regexp.Compile(exclude)
+		if err != nil {
+			e := fmt.Errorf("error: invalid regex for 'snapshot_exclude': %s", err)
+			log.Error(e)
+			return nil, e
+		}
+		sf.exclude = re
+	}
+
+	return sf, nil
+}
+
+// Returns true if the snapshot key passes the filter. A nil filter matches every key.
+func (sf *snapshotFilter) matches(key string) bool {
+	if sf == nil {
+		return true
+	}
+	if sf.include != nil && !sf.include.MatchString(key) {
+		return false
+	}
+	if sf.exclude != nil && sf.exclude.MatchString(key) {
+		return false
+	}
+	return true
+}
+
+// Return a copy of the snapshot with only the keys that pass the filter.
+func (sf *snapshotFilter) apply(snapshot map[string]float64) map[string]float64 {
+	result := make(map[string]float64, len(snapshot))
+	for key, val := range snapshot {
+		if sf.matches(key) {
+			result[key] = val
+		}
+	}
+	log.Debug("Snapshot filter kept ", len(result), " of ", len(snapshot), " metrics")
+	return result
+}
+
+// executorFilter decides which executors on an agent per-executor metrics are collected for. On agents with hundreds
+// of executors, collecting every requested metric for every executor makes the number of metrics explode, so
+// operators may limit collection to the frameworks or executors they care about, and cap the number of executors
+// reported on each collection.
+type executorFilter struct {
+	frameworkIDs   map[string]bool
+	frameworkNames map[string]bool
+	executorID     *regexp.Regexp
+	labels         map[string]string
+	max            int
+}
+
+// Build an executorFilter from the global config. The following (optional) config items are supported:
+//
+//   "executor_framework_ids":   "20160101-000000-1234-5050-0001,20160101-000000-1234-5050-0002"
+//   "executor_framework_names": "marathon,chronos"
+//   "executor_id_include":      "^(web|api)\\."
+//   "executor_label_selector":  "team=infra,env=prod"
+//   "executor_max":             100
+//
+// An executor is collected only if it passes every filter that is set. Framework names and labels are only available
+// from the agent's state, so using either of them means "/state" is fetched on each collection.
+func getExecutorFilter(cfg interface{}) (*executorFilter, error) {
+	ef := &executorFilter{}
+
+	if ids, ok := getConfigString(cfg, "executor_framework_ids"); ok {
+		ef.frameworkIDs = splitList(ids)
+	}
+
+	if names, ok := getConfigString(cfg, "executor_framework_names"); ok {
+		ef.frameworkNames = splitList(names)
+	}
+
+	if include, ok := getConfigString(cfg, "executor_id_include"); ok {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			e := fmt.Errorf("error: invalid regex for 'executor_id_include': %s", err)
+			log.Error(e)
+			return nil, e
+		}
+		ef.executorID = re
+	}
+
+	if selector, ok := getConfigString(cfg, "executor_label_selector"); ok {
+		ef.labels = map[string]string{}
+		for _, pair := range strings.Split(selector, ",") {
+			kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				e := fmt.Errorf("error: invalid entry %q for 'executor_label_selector', expected 'key=value'", pair)
+				log.Error(e)
+				return nil, e
+			}
+			ef.labels[kv[0]] = kv[1]
+		}
+	}
+
+	if max, ok := getConfigInt(cfg, "executor_max"); ok {
+		if max < 0 {
+			e := fmt.Errorf("error: 'executor_max' must not be negative, got %d", max)
+			log.Error(e)
+			return nil, e
+		}
+		ef.max = max
+	}
+
+	return ef, nil
+}
+
+// Returns true if the filter needs the agent's state, i.e. it selects executors by framework name or label.
+func (ef *executorFilter) needsState() bool {
+	return ef != nil && (ef.frameworkNames != nil || ef.labels != nil)
+}
+
+// Return the executors that pass the filter, sorted by framework and executor ID. If there are more of them than
+// "executor_max", the rest are dropped and a warning is logged. The state may be nil unless needsState() is true.
+func (ef *executorFilter) apply(executors []agent.Executor, state *agent.State) []agent.Executor {
+	if ef == nil {
+		return executors
+	}
+
+	var frameworkNames map[string]string
+	var executorLabels map[string]map[string][]*mesos_pb2.Label
+	if state != nil {
+		frameworkNames = state.FrameworkNames()
+		executorLabels = state.ExecutorLabels()
+	}
+
+	result := []agent.Executor{}
+	for _, exec := range executors {
+		if ef.frameworkIDs != nil && !ef.frameworkIDs[exec.Framework] {
+			continue
+		}
+		if ef.frameworkNames != nil && !ef.frameworkNames[frameworkNames[exec.Framework]] {
+			continue
+		}
+		if ef.executorID != nil && !ef.executorID.MatchString(exec.ID) {
+			continue
+		}
+		if ef.labels != nil && !matchLabels(ef.labels, executorLabels[exec.Framework][exec.ID]) {
+			continue
+		}
+		result = append(result, exec)
+	}
+
+	// Sort the executors so that the same ones are reported on each collection when the cap is reached
+	sort.Sort(byExecutor(result))
+
+	if ef.max > 0 && len(result) > ef.max {
+		log.Warn("Collecting metrics for ", ef.max, " of ", len(result), " executors, ",
+			len(result)-ef.max, " executors exceeded 'executor_max' and were skipped")
+		result = result[:ef.max]
+	}
+	return result
+}
+
+// Returns true if the labels contain every key and value in the selector.
+func matchLabels(selector map[string]string, labels []*mesos_pb2.Label) bool {
+	for key, value := range selector {
+		found := false
+		for _, label := range labels {
+			if label.GetKey() == key && label.GetValue() == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Split a comma-separated list into a set, ignoring empty entries.
+func splitList(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
+
+type byExecutor []agent.Executor
+
+func (e byExecutor) Len() int      { return len(e) }
+func (e byExecutor) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e byExecutor) Less(i, j int) bool {
+	if e[i].Framework != e[j].Framework {
+		return e[i].Framework < e[j].Framework
+	}
+	if e[i].ID != e[j].ID {
+		return e[i].ID < e[j].ID
+	}
+	return e[i].ContainerID < e[j].ContainerID
+}
@@ -0,0 +1,159 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core/cdata"
+	"github.com/intelsdi-x/snap/core/ctypes"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_getSnapshotFilter(t *testing.T) {
+	snapshot := map[string]float64{
+		"master/tasks_running":             1.0,
+		"allocator/event_queue_dispatches": 2.0,
+		"registrar/state_store_ms/p99":     3.0,
+		"master/messages_launch_tasks":     4.0,
+		"system/load_1min":                 5.0,
+	}
+
+	Convey("Get the snapshot filter from snap global config", t, func() {
+		Convey("When no filter config is provided, every key should be kept", func() {
+			node := cdata.NewNode()
+			node.AddItem("master", ctypes.ConfigValueStr{Value: "mesos-master.example.com:5050"})
+
+			sf, err := getSnapshotFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(sf.apply(snapshot), ShouldResemble, snapshot)
+		})
+
+		Convey("When include and exclude are provided, only keys matching include but not exclude should be kept", func() {
+			node := cdata.NewNode()
+			node.AddItem("snapshot_include", ctypes.ConfigValueStr{Value: "^(master|allocator)/"})
+			node.AddItem("snapshot_exclude", ctypes.ConfigValueStr{Value: "^master/messages_"})
+
+			sf, err := getSnapshotFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(sf.apply(snapshot), ShouldResemble, map[string]float64{
+				"master/tasks_running":             1.0,
+				"allocator/event_queue_dispatches": 2.0,
+			})
+			So(sf.matches("registrar/state_store_ms/p99"), ShouldBeFalse)
+		})
+
+		Convey("When a regex is invalid, an error should be returned", func() {
+			node := cdata.NewNode()
+			node.AddItem("snapshot_exclude", ctypes.ConfigValueStr{Value: "(master"})
+
+			_, err := getSnapshotFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMesos_getExecutorFilter(t *testing.T) {
+	executors := []agent.Executor{
+		{ID: "web.2", Framework: "frame1"},
+		{ID: "web.1", Framework: "frame1"},
+		{ID: "batch.1", Framework: "frame2"},
+		{ID: "api.1", Framework: "frame3"},
+	}
+	state := &agent.State{
+		Frameworks: []*agent.StateFramework{
+			{ID: "frame1", Name: "marathon", Executors: []*agent.StateExecutor{
+				{ID: "web.1", Tasks: []*agent.StateTask{
+					{Labels: []*mesos_pb2.Label{{Key: proto.String("team"), Value: proto.String("infra")}}},
+				}},
+				{ID: "web.2", Tasks: []*agent.StateTask{
+					{Labels: []*mesos_pb2.Label{{Key: proto.String("team"), Value: proto.String("web")}}},
+				}},
+			}},
+			{ID: "frame2", Name: "chronos"},
+			{ID: "frame3", Name: "marathon"},
+		},
+	}
+
+	ids := func(executors []agent.Executor) []string {
+		result := []string{}
+		for _, exec := range executors {
+			result = append(result, exec.ID)
+		}
+		return result
+	}
+
+	Convey("Get the executor filter from snap global config", t, func() {
+		Convey("When no filter config is provided, every executor should be kept", func() {
+			node := cdata.NewNode()
+
+			ef, err := getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(ef.needsState(), ShouldBeFalse)
+			So(len(ef.apply(executors, nil)), ShouldEqual, 4)
+		})
+
+		Convey("When framework IDs and an executor ID regex are provided, executors should match both", func() {
+			node := cdata.NewNode()
+			node.AddItem("executor_framework_ids", ctypes.ConfigValueStr{Value: "frame1, frame2"})
+			node.AddItem("executor_id_include", ctypes.ConfigValueStr{Value: "^(web|api)\\."})
+
+			ef, err := getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(ef.needsState(), ShouldBeFalse)
+			So(ids(ef.apply(executors, nil)), ShouldResemble, []string{"web.1", "web.2"})
+		})
+
+		Convey("When framework names and a label selector are provided, they should be matched against the state", func() {
+			node := cdata.NewNode()
+			node.AddItem("executor_framework_names", ctypes.ConfigValueStr{Value: "marathon"})
+			node.AddItem("executor_label_selector", ctypes.ConfigValueStr{Value: "team=infra"})
+
+			ef, err := getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(ef.needsState(), ShouldBeTrue)
+			So(ids(ef.apply(executors, state)), ShouldResemble, []string{"web.1"})
+		})
+
+		Convey("When a maximum is provided, only that many executors should be kept", func() {
+			node := cdata.NewNode()
+			node.AddItem("executor_max", ctypes.ConfigValueInt{Value: 2})
+
+			ef, err := getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(ids(ef.apply(executors, nil)), ShouldResemble, []string{"web.1", "web.2"})
+		})
+
+		Convey("When the config is invalid, an error should be returned", func() {
+			node := cdata.NewNode()
+			node.AddItem("executor_label_selector", ctypes.ConfigValueStr{Value: "team"})
+			_, err := getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+
+			node = cdata.NewNode()
+			node.AddItem("executor_max", ctypes.ConfigValueInt{Value: -1})
+			_, err = getExecutorFilter(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
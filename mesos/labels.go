@@ -0,0 +1,118 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+)
+
+// labelMapper decides which Mesos framework and task labels are attached to collected metrics as Snap tags, and
+// under which tag key. Labels are only mapped when "label_include" is set in the global config, so that enabling the
+// plugin on a cluster with many labels doesn't silently add tags to every metric.
+type labelMapper struct {
+	include *regexp.Regexp
+	exclude *regexp.Regexp
+	rename  map[string]string
+}
+
+// Build a labelMapper from the global config. The following (optional) config items are supported:
+//
+//   "label_include": "^(app|team|env)$"
+//   "label_exclude": "^DCOS_"
+//   "label_rename":  "MARATHON_APP_ID=app,team_name=team"
+//
+// Labels are matched against "label_include" and "label_exclude" by their original key, before they are renamed.
+func getLabelMapper(cfg interface{}) (*labelMapper, error) {
+	lm := &labelMapper{rename: map[string]string{}}
+
+	if include, ok := getConfigString(cfg, "label_include"); ok {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			e := fmt.Errorf("error: invalid regex for 'label_include': %s", err)
+			log.Error(e)
+			return nil, e
+		}
+		lm.include = re
+	}
+
+	if exclude, ok := getConfigString(cfg, "label_exclude"); ok {
+		re, err := regexp.Compile(exclude)
+		if err != nil {
+			e := fmt.Errorf("error: invalid regex for 'label_exclude': %s", err)
+			log.Error(e)
+			return nil, e
+		}
+		lm.exclude = re
+	}
+
+	if rename, ok := getConfigString(cfg, "label_rename"); ok {
+		for _, pair := range strings.Split(rename, ",") {
+			kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				e := fmt.Errorf("error: invalid entry %q for 'label_rename', expected 'label=tag'", pair)
+				log.Error(e)
+				return nil, e
+			}
+			lm.rename[kv[0]] = kv[1]
+		}
+	}
+
+	return lm, nil
+}
+
+// Returns true if any labels could be mapped to tags.
+func (lm *labelMapper) enabled() bool {
+	return lm != nil && lm.include != nil
+}
+
+// Return a copy of the given tags with the matching labels added to it. A label never overwrites an existing tag
+// (e.g. "source"), and labels without a key are ignored.
+func (lm *labelMapper) tags(tags map[string]string, labels []*mesos_pb2.Label) map[string]string {
+	result := make(map[string]string, len(tags)+len(labels))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	if !lm.enabled() {
+		return result
+	}
+
+	for _, label := range labels {
+		key := label.GetKey()
+		if key == "" || !lm.include.MatchString(key) {
+			continue
+		}
+		if lm.exclude != nil && lm.exclude.MatchString(key) {
+			continue
+		}
+		if renamed, ok := lm.rename[key]; ok {
+			key = renamed
+		}
+		if _, exists := tags[key]; exists {
+			log.Debug("Not mapping label ", label.GetKey(), " to tag ", key, " because that tag is already set")
+			continue
+		}
+		result[key] = label.GetValue()
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core/cdata"
+	"github.com/intelsdi-x/snap/core/ctypes"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_getLabelMapper(t *testing.T) {
+	Convey("Get the label mapping from snap global config", t, func() {
+		Convey("When no label config is provided, labels should not be mapped", func() {
+			node := cdata.NewNode()
+			node.AddItem("agent", ctypes.ConfigValueStr{Value: "mesos-agent.example.com:5051"})
+
+			lm, err := getLabelMapper(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(lm.enabled(), ShouldBeFalse)
+		})
+
+		Convey("When include, exclude, and rename are provided, they should all be parsed", func() {
+			node := cdata.NewNode()
+			node.AddItem("label_include", ctypes.ConfigValueStr{Value: "^(app|team|env|DCOS_.*)$"})
+			node.AddItem("label_exclude", ctypes.ConfigValueStr{Value: "^DCOS_"})
+			node.AddItem("label_rename", ctypes.ConfigValueStr{Value: "team=owner, env=environment"})
+
+			lm, err := getLabelMapper(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+			So(lm.enabled(), ShouldBeTrue)
+			So(lm.rename, ShouldResemble, map[string]string{"team": "owner", "env": "environment"})
+		})
+
+		Convey("When a regex is invalid, an error should be returned", func() {
+			node := cdata.NewNode()
+			node.AddItem("label_include", ctypes.ConfigValueStr{Value: "(app"})
+
+			_, err := getLabelMapper(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When a rename entry is malformed, an error should be returned", func() {
+			node := cdata.NewNode()
+			node.AddItem("label_rename", ctypes.ConfigValueStr{Value: "team"})
+
+			_, err := getLabelMapper(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMesos_labelMapperTags(t *testing.T) {
+	labels := []*mesos_pb2.Label{
+		&mesos_pb2.Label{Key: proto.String("app"), Value: proto.String("web")},
+		&mesos_pb2.Label{Key: proto.String("team"), Value: proto.String("infra")},
+		&mesos_pb2.Label{Key: proto.String("DCOS_PACKAGE_NAME"), Value: proto.String("marathon")},
+		&mesos_pb2.Label{Key: proto.String("source"), Value: proto.String("label")},
+		&mesos_pb2.Label{Value: proto.String("no key")},
+	}
+	tags := map[string]string{"source": "mesos-agent.example.com:5051"}
+
+	Convey("Map Mesos labels to Snap tags", t, func() {
+		Convey("When label mapping is disabled, only the original tags should be returned", func() {
+			lm := &labelMapper{}
+			So(lm.tags(tags, labels), ShouldResemble, tags)
+		})
+
+		Convey("When label mapping is enabled, matching labels should be added and renamed", func() {
+			node := cdata.NewNode()
+			node.AddItem("label_include", ctypes.ConfigValueStr{Value: ".*"})
+			node.AddItem("label_exclude", ctypes.ConfigValueStr{Value: "^DCOS_"})
+			node.AddItem("label_rename", ctypes.ConfigValueStr{Value: "team=owner"})
+			lm, err := getLabelMapper(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+
+			result := lm.tags(tags, labels)
+			So(result, ShouldResemble, map[string]string{
+				"source": "mesos-agent.example.com:5051",
+				"app":    "web",
+				"owner":  "infra",
+			})
+
+			Convey("The original tags should not be modified", func() {
+				So(len(tags), ShouldEqual, 1)
+			})
+		})
+	})
+}
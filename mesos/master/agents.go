@@ -0,0 +1,134 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+)
+
+// AgentHistory counts the transitions of an agent that were seen from one call of TrackAgents() to the next. Mesos
+// only reports the current state of an agent, so the counters start from zero when the agent is first seen, and are
+// reset whenever the plugin is restarted.
+type AgentHistory struct {
+	Registrations   uint64  `json:"registrations"`
+	Reregistrations uint64  `json:"reregistrations"`
+	Deactivations   uint64  `json:"deactivations"`
+	InactiveSecs    float64 `json:"inactive_secs"`
+}
+
+// The last known state of an agent, along with its history and the times it was deactivated or reregistered.
+type agentState struct {
+	history      AgentHistory
+	active       bool
+	registered   float64
+	reregistered float64
+	seen         time.Time
+	transitions  []time.Time
+}
+
+// The agents of each master, keyed by agent ID, and the time the master was first tracked. Registrations are only
+// counted if they happened after the master was first tracked.
+type agentTracker struct {
+	started time.Time
+	agents  map[string]*agentState
+}
+
+var agentsSeen = struct {
+	sync.Mutex
+	hosts map[string]*agentTracker
+}{hosts: map[string]*agentTracker{}}
+
+// Recursively traverse the AgentHistory struct, building "/"-delimited strings that resemble snap metric types.
+func GetAgentHistoryMetricTypes() ([]string, error) {
+	log.Debug("Getting agent history metric types")
+	namespaces := []string{}
+	if err := ns.FromCompositeObject(AgentHistory{}, "", &namespaces); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Track the agents registered with the master (see GetAgents), and return the history of each agent (keyed by agent
+// ID), along with the number of agents that flapped: those that were deactivated or reregistered at least threshold
+// times within the window. Agents are remembered from one call to the next, so that their transitions are detected;
+// agents that are no longer registered are forgotten. An agent that became inactive and active again between two
+// calls isn't seen as deactivated, and the time an agent spends inactive is only as precise as the interval between
+// calls.
+func TrackAgents(host string, agents []*Agent, now time.Time, window time.Duration, threshold int) (map[string]*AgentHistory, uint64) {
+	agentsSeen.Lock()
+	defer agentsSeen.Unlock()
+
+	tracker, ok := agentsSeen.hosts[host]
+	if !ok {
+		tracker = &agentTracker{started: now, agents: map[string]*agentState{}}
+		agentsSeen.hosts[host] = tracker
+	}
+	since := float64(tracker.started.UnixNano()) / 1e9
+
+	seen := map[string]*agentState{}
+	histories := map[string]*AgentHistory{}
+	flapping := uint64(0)
+
+	for _, agent := range agents {
+		state, ok := tracker.agents[agent.ID]
+		if !ok {
+			state = &agentState{active: agent.Active, seen: now}
+			if agent.RegisteredTime >= since {
+				state.history.Registrations++
+			}
+		}
+
+		if agent.ReregisteredTime > state.reregistered && agent.ReregisteredTime >= since {
+			state.history.Reregistrations++
+			state.transitions = append(state.transitions, now)
+		}
+		if ok && agent.RegisteredTime > state.registered {
+			state.history.Registrations++
+		}
+		if state.active && !agent.Active {
+			state.history.Deactivations++
+			state.transitions = append(state.transitions, now)
+		}
+		if !state.active {
+			state.history.InactiveSecs += now.Sub(state.seen).Seconds()
+		}
+
+		state.active = agent.Active
+		state.registered = agent.RegisteredTime
+		state.reregistered = agent.ReregisteredTime
+		state.seen = now
+
+		for len(state.transitions) > 0 && now.Sub(state.transitions[0]) > window {
+			state.transitions = state.transitions[1:]
+		}
+		if len(state.transitions) >= threshold {
+			flapping++
+		}
+
+		seen[agent.ID] = state
+		history := state.history
+		histories[agent.ID] = &history
+	}
+
+	tracker.agents = seen
+	return histories, flapping
+}
@@ -0,0 +1,92 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTrackAgents(t *testing.T) {
+	start := time.Unix(1000, 0)
+	at := func(secs int) time.Time {
+		return start.Add(time.Duration(secs) * time.Second)
+	}
+	agent := func(id string, active bool, registered float64, reregistered float64) *Agent {
+		return &Agent{ID: id, Active: active, RegisteredTime: registered, ReregisteredTime: reregistered}
+	}
+	track := func(secs int, agents ...*Agent) (map[string]*AgentHistory, uint64) {
+		return TrackAgents("10.0.0.1:5050", agents, at(secs), 5*time.Minute, 2)
+	}
+
+	// Agents are tracked from one call to the next, so the calls are made once rather than for every Convey block
+	first, firstFlapping := track(0,
+		agent("agent1", true, 500, 0),
+		agent("agent2", false, 900, 990))
+	track(60,
+		agent("agent1", false, 500, 0),
+		agent("agent2", true, 900, 990),
+		agent("agent3", true, 1030, 0))
+	track(120,
+		agent("agent1", true, 500, 1100),
+		agent("agent2", true, 900, 990),
+		agent("agent3", true, 1030, 0))
+	last, lastFlapping := track(600,
+		agent("agent1", false, 500, 1100),
+		agent("agent3", true, 1030, 0))
+
+	Convey("When the agents of a master are tracked", t, func() {
+		Convey("Then transitions before the master was first tracked shouldn't be counted", func() {
+			So(*first["agent1"], ShouldResemble, AgentHistory{})
+			So(*first["agent2"], ShouldResemble, AgentHistory{})
+			So(firstFlapping, ShouldEqual, 0)
+		})
+
+		Convey("Then registrations, reregistrations, and deactivations should be counted", func() {
+			So(last["agent1"].Deactivations, ShouldEqual, 2)
+			So(last["agent1"].Reregistrations, ShouldEqual, 1)
+			So(last["agent3"].Registrations, ShouldEqual, 1)
+		})
+
+		Convey("Then the time agents spent inactive should be accumulated", func() {
+			So(last["agent1"].InactiveSecs, ShouldEqual, 60)
+		})
+
+		Convey("Then agents that are no longer registered should be forgotten", func() {
+			So(last, ShouldNotContainKey, "agent2")
+		})
+
+		Convey("Then only transitions within the window should count towards flapping", func() {
+			So(lastFlapping, ShouldEqual, 0)
+		})
+	})
+
+	flapping := uint64(0)
+	for i, active := range []bool{true, false, true, false} {
+		_, flapping = TrackAgents("10.0.0.2:5050", []*Agent{agent("agent1", active, 500, 0)}, at(i*30), 5*time.Minute, 2)
+	}
+
+	Convey("When an agent is repeatedly deactivated within the window", t, func() {
+		Convey("Then it should be counted as flapping", func() {
+			So(flapping, ShouldEqual, 1)
+		})
+	})
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/operator"
+)
+
+// How long to wait before subscribing again after the event stream was lost. The wait doubles after every failed
+// attempt, up to the maximum, and is reset once a subscription succeeds.
+const (
+	minResubscribeBackoff = 1 * time.Second
+	maxResubscribeBackoff = 60 * time.Second
+)
+
+// An event stream that hasn't been requested by any collection for this long is released, so that streams aren't kept
+// for masters, or tasks, that are gone.
+const eventStreamIdleTimeout = 5 * time.Minute
+
+// The events that are counted, in addition to TASK_UPDATED which is counted by the state of the task.
+var countedEvents = []mesos_v1_master.Event_Type{
+	mesos_v1_master.Event_TASK_ADDED,
+	mesos_v1_master.Event_AGENT_ADDED,
+	mesos_v1_master.Event_AGENT_REMOVED,
+	mesos_v1_master.Event_FRAMEWORK_ADDED,
+	mesos_v1_master.Event_FRAMEWORK_UPDATED,
+	mesos_v1_master.Event_FRAMEWORK_REMOVED,
+}
+
+// Terminal task states, after which a task is dropped from the live model, and which are counted by
+// TerminalTasks(). See https://github.com/apache/mesos/blob/1.9.0/src/common/protobuf_utils.cpp#L103-L121
+var terminalTaskStates = map[mesos_v1.TaskState]bool{
+	mesos_v1.TaskState_TASK_FINISHED:         true,
+	mesos_v1.TaskState_TASK_FAILED:           true,
+	mesos_v1.TaskState_TASK_KILLED:           true,
+	mesos_v1.TaskState_TASK_LOST:             true,
+	mesos_v1.TaskState_TASK_ERROR:            true,
+	mesos_v1.TaskState_TASK_DROPPED:          true,
+	mesos_v1.TaskState_TASK_GONE:             true,
+	mesos_v1.TaskState_TASK_GONE_BY_OPERATOR: true,
+}
+
+// EventStream keeps a live model of the tasks, agents, and frameworks known to a master, along with the number of
+// events received since the stream was first requested, by subscribing to the master's event stream. Unlike polling,
+// this sees every task, no matter how short-lived.
+type EventStream struct {
+	host string
+	stop chan struct{}
+
+	sync.Mutex
+	connected  bool
+	used       time.Time
+	tasks      map[string]mesos_v1.TaskState
+	agents     map[string]bool
+	frameworks map[string]bool
+	counts     map[string]uint64
+}
+
+// The event stream of each master, so that each is only subscribed to once for the lifetime of the plugin.
+var eventStreams = struct {
+	sync.Mutex
+	hosts map[string]*EventStream
+}{hosts: map[string]*EventStream{}}
+
+// Return the event stream of the master, subscribing to it in the background the first time it's requested for the
+// host. The subscription is renewed whenever it's lost, so the returned EventStream is the same for a host until it's
+// released, either by ReleaseEventStream() or once it hasn't been requested for eventStreamIdleTimeout.
+func GetEventStream(host string) *EventStream {
+	eventStreams.Lock()
+	defer eventStreams.Unlock()
+
+	stream, ok := eventStreams.hosts[host]
+	if !ok {
+		stream = newEventStream(host)
+		eventStreams.hosts[host] = stream
+		go stream.run()
+		go stream.expire()
+	}
+
+	stream.Lock()
+	stream.used = time.Now()
+	stream.Unlock()
+	return stream
+}
+
+// Stop the event stream of the master, if any, e.g. once the master is no longer the leader. Its event counts are
+// lost, so they start from zero if the stream is requested again.
+func ReleaseEventStream(host string) {
+	eventStreams.Lock()
+	defer eventStreams.Unlock()
+
+	if stream, ok := eventStreams.hosts[host]; ok {
+		stream.release()
+	}
+}
+
+// Stop the stream and forget it, so that the next request for its host subscribes again. The caller must hold the
+// lock of eventStreams.
+func (s *EventStream) release() {
+	log.Info("Releasing the event stream of master ", s.host)
+	delete(eventStreams.hosts, s.host)
+	close(s.stop)
+}
+
+func newEventStream(host string) *EventStream {
+	return &EventStream{
+		host:       host,
+		stop:       make(chan struct{}),
+		used:       time.Now(),
+		tasks:      map[string]mesos_v1.TaskState{},
+		agents:     map[string]bool{},
+		frameworks: map[string]bool{},
+		counts:     map[string]uint64{},
+	}
+}
+
+// Subscribe to the event stream of the master, and subscribe again with a backoff whenever the stream is lost, until
+// the stream is released.
+func (s *EventStream) run() {
+	backoff := minResubscribeBackoff
+	for {
+		err := operator.Subscribe(s.host, s.handle, s.stop)
+
+		s.Lock()
+		subscribed := s.connected
+		s.connected = false
+		s.Unlock()
+
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		if subscribed {
+			backoff = minResubscribeBackoff
+		}
+		log.Warn("Lost the event stream of master ", s.host, " (", err, "), subscribing again in ", backoff)
+		select {
+		case <-s.stop:
+			return
+		case <-time.After(backoff):
+		}
+		if !subscribed && backoff < maxResubscribeBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+// Release the event stream once it hasn't been requested for eventStreamIdleTimeout.
+func (s *EventStream) expire() {
+	ticker := time.NewTicker(eventStreamIdleTimeout / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if s.idle(time.Now()) {
+				log.Info("The event stream of master ", s.host, " hasn't been collected in ", eventStreamIdleTimeout)
+				eventStreams.Lock()
+				// The stream may have been released, and replaced by another one, in the meantime
+				if eventStreams.hosts[s.host] == s {
+					s.release()
+				}
+				eventStreams.Unlock()
+				return
+			}
+		}
+	}
+}
+
+func (s *EventStream) idle(now time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+	return now.Sub(s.used) > eventStreamIdleTimeout
+}
+
+// Update the live model and the event counts with an event from the master.
+func (s *EventStream) handle(event *mesos_v1_master.Event) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch event.GetType() {
+	case mesos_v1_master.Event_SUBSCRIBED:
+		// The state of the cluster is reported in full when subscribing, so the model is rebuilt from scratch, while
+		// events counted before the subscription was lost are kept
+		state := event.GetSubscribed().GetGetState()
+		s.connected = true
+		s.tasks = map[string]mesos_v1.TaskState{}
+		for _, tasks := range [][]*mesos_v1.Task{
+			state.GetGetTasks().GetPendingTasks(),
+			state.GetGetTasks().GetTasks(),
+			state.GetGetTasks().GetUnreachableTasks(),
+		} {
+			for _, task := range tasks {
+				s.tasks[taskKey(task.GetFrameworkId(), task.GetTaskId())] = task.GetState()
+			}
+		}
+		s.agents = map[string]bool{}
+		for _, agent := range state.GetGetAgents().GetAgents() {
+			s.agents[agent.GetAgentInfo().GetId().GetValue()] = true
+		}
+		s.frameworks = map[string]bool{}
+		for _, framework := range state.GetGetFrameworks().GetFrameworks() {
+			s.frameworks[framework.GetFrameworkInfo().GetId().GetValue()] = true
+		}
+		return
+
+	case mesos_v1_master.Event_TASK_ADDED:
+		task := event.GetTaskAdded().GetTask()
+		s.tasks[taskKey(task.GetFrameworkId(), task.GetTaskId())] = task.GetState()
+
+	case mesos_v1_master.Event_TASK_UPDATED:
+		update := event.GetTaskUpdated()
+		key := taskKey(update.GetFrameworkId(), update.GetStatus().GetTaskId())
+		if terminalTaskStates[update.GetState()] {
+			delete(s.tasks, key)
+		} else {
+			s.tasks[key] = update.GetState()
+		}
+		s.counts["task_updated/"+stateName(update.GetState())]++
+		return
+
+	case mesos_v1_master.Event_AGENT_ADDED:
+		s.agents[event.GetAgentAdded().GetAgent().GetAgentInfo().GetId().GetValue()] = true
+
+	case mesos_v1_master.Event_AGENT_REMOVED:
+		delete(s.agents, event.GetAgentRemoved().GetAgentId().GetValue())
+
+	case mesos_v1_master.Event_FRAMEWORK_ADDED:
+		s.frameworks[event.GetFrameworkAdded().GetFramework().GetFrameworkInfo().GetId().GetValue()] = true
+
+	case mesos_v1_master.Event_FRAMEWORK_UPDATED:
+		s.frameworks[event.GetFrameworkUpdated().GetFramework().GetFrameworkInfo().GetId().GetValue()] = true
+
+	case mesos_v1_master.Event_FRAMEWORK_REMOVED:
+		delete(s.frameworks, event.GetFrameworkRemoved().GetFrameworkInfo().GetId().GetValue())
+
+	default:
+		return
+	}
+
+	s.counts[eventName(event.GetType())]++
+}
+
+// Return the metrics of the event stream, keyed by the names returned by GetEventMetricTypes(), e.g. "task_added" or
+// "tasks/task_running". Event counts are counters of the events received since the stream was first requested, so
+// that any number of collections can read them; the rest describe the live model at the time of the call.
+func (s *EventStream) Collect() map[string]uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	data := map[string]uint64{}
+	for _, name := range GetEventMetricTypes() {
+		data[name] = 0
+	}
+
+	if s.connected {
+		data["connected"] = 1
+	}
+	data["agents"] = uint64(len(s.agents))
+	data["frameworks"] = uint64(len(s.frameworks))
+	for _, state := range s.tasks {
+		data["tasks/"+stateName(state)]++
+	}
+
+	for name, count := range s.counts {
+		data[name] = count
+	}
+
+	return data
+}
+
+// Return the names of the metrics reported by EventStream.Collect(), which resemble snap metric types.
+func GetEventMetricTypes() []string {
+	namespaces := []string{"connected", "agents", "frameworks"}
+	for _, event := range countedEvents {
+		namespaces = append(namespaces, eventName(event))
+	}
+	for _, state := range mesos_v1.TaskState_name {
+		namespaces = append(namespaces, "tasks/"+strings.ToLower(state), "task_updated/"+strings.ToLower(state))
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func taskKey(frameworkID *mesos_v1.FrameworkID, taskID *mesos_v1.TaskID) string {
+	return frameworkID.GetValue() + "/" + taskID.GetValue()
+}
+
+// Return the name of an event or task state as used in metric names, e.g. "task_added" or "task_running".
+func eventName(event mesos_v1_master.Event_Type) string {
+	return strings.ToLower(event.String())
+}
+
+func stateName(state mesos_v1.TaskState) string {
+	return strings.ToLower(state.String())
+}
@@ -0,0 +1,157 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventStream(t *testing.T) {
+	task := func(id string, state mesos_v1.TaskState) *mesos_v1.Task {
+		return &mesos_v1.Task{
+			TaskId:      &mesos_v1.TaskID{Value: proto.String(id)},
+			FrameworkId: &mesos_v1.FrameworkID{Value: proto.String("framework-1")},
+			State:       state.Enum(),
+		}
+	}
+	taskUpdated := func(id string, state mesos_v1.TaskState) *mesos_v1_master.Event {
+		return &mesos_v1_master.Event{
+			Type: mesos_v1_master.Event_TASK_UPDATED.Enum(),
+			TaskUpdated: &mesos_v1_master.Event_TaskUpdated{
+				FrameworkId: &mesos_v1.FrameworkID{Value: proto.String("framework-1")},
+				Status: &mesos_v1.TaskStatus{
+					TaskId: &mesos_v1.TaskID{Value: proto.String(id)},
+					State:  state.Enum(),
+				},
+				State: state.Enum(),
+			},
+		}
+	}
+
+	Convey("When events are received from the master", t, func() {
+		stream := newEventStream("localhost:5050")
+		stream.handle(&mesos_v1_master.Event{
+			Type: mesos_v1_master.Event_SUBSCRIBED.Enum(),
+			Subscribed: &mesos_v1_master.Event_Subscribed{GetState: &mesos_v1_master.Response_GetState{
+				GetTasks: &mesos_v1_master.Response_GetTasks{
+					Tasks:        []*mesos_v1.Task{task("task-1", mesos_v1.TaskState_TASK_RUNNING)},
+					PendingTasks: []*mesos_v1.Task{task("task-2", mesos_v1.TaskState_TASK_STAGING)},
+				},
+				GetAgents: &mesos_v1_master.Response_GetAgents{Agents: []*mesos_v1_master.Response_GetAgents_Agent{
+					{AgentInfo: &mesos_v1.AgentInfo{
+						Id:       &mesos_v1.AgentID{Value: proto.String("agent-1")},
+						Hostname: proto.String("agent-1.example.com"),
+					}},
+				}},
+				GetFrameworks: &mesos_v1_master.Response_GetFrameworks{},
+			}},
+		})
+
+		Convey("Then the model should be built from the state of the cluster", func() {
+			data := stream.Collect()
+			So(data["connected"], ShouldEqual, 1)
+			So(data["agents"], ShouldEqual, 1)
+			So(data["frameworks"], ShouldEqual, 0)
+			So(data["tasks/task_running"], ShouldEqual, 1)
+			So(data["tasks/task_staging"], ShouldEqual, 1)
+			So(data["task_added"], ShouldEqual, 0)
+		})
+
+		Convey("Then events should be counted, no matter how often they're collected", func() {
+			stream.handle(&mesos_v1_master.Event{
+				Type:      mesos_v1_master.Event_TASK_ADDED.Enum(),
+				TaskAdded: &mesos_v1_master.Event_TaskAdded{Task: task("task-3", mesos_v1.TaskState_TASK_STAGING)},
+			})
+			stream.handle(taskUpdated("task-2", mesos_v1.TaskState_TASK_RUNNING))
+			stream.handle(taskUpdated("task-3", mesos_v1.TaskState_TASK_RUNNING))
+			stream.handle(taskUpdated("task-1", mesos_v1.TaskState_TASK_FINISHED))
+			stream.handle(&mesos_v1_master.Event{
+				Type: mesos_v1_master.Event_AGENT_REMOVED.Enum(),
+				AgentRemoved: &mesos_v1_master.Event_AgentRemoved{
+					AgentId: &mesos_v1.AgentID{Value: proto.String("agent-1")},
+				},
+			})
+			stream.handle(&mesos_v1_master.Event{Type: mesos_v1_master.Event_HEARTBEAT.Enum()})
+
+			data := stream.Collect()
+			So(data["task_added"], ShouldEqual, 1)
+			So(data["task_updated/task_running"], ShouldEqual, 2)
+			So(data["task_updated/task_finished"], ShouldEqual, 1)
+			So(data["agent_removed"], ShouldEqual, 1)
+			So(data["agents"], ShouldEqual, 0)
+			So(data["tasks/task_running"], ShouldEqual, 2)
+			So(data["tasks/task_staging"], ShouldEqual, 0)
+			So(data["tasks/task_finished"], ShouldEqual, 0)
+
+			data = stream.Collect()
+			So(data["task_added"], ShouldEqual, 1)
+			So(data["task_updated/task_running"], ShouldEqual, 2)
+			So(data["tasks/task_running"], ShouldEqual, 2)
+
+			stream.handle(taskUpdated("task-2", mesos_v1.TaskState_TASK_FAILED))
+			data = stream.Collect()
+			So(data["task_updated/task_failed"], ShouldEqual, 1)
+			So(data["task_updated/task_running"], ShouldEqual, 2)
+			So(data["tasks/task_running"], ShouldEqual, 1)
+		})
+
+		Convey("Then every metric type should be collected", func() {
+			data := stream.Collect()
+			for _, name := range GetEventMetricTypes() {
+				_, ok := data[name]
+				So(ok, ShouldBeTrue)
+			}
+			So(len(data), ShouldEqual, len(GetEventMetricTypes()))
+		})
+	})
+}
+
+func TestReleaseEventStream(t *testing.T) {
+	Convey("When the event stream of a master is no longer needed", t, func() {
+		stream := newEventStream("localhost:5051")
+		eventStreams.Lock()
+		eventStreams.hosts[stream.host] = stream
+		eventStreams.Unlock()
+
+		Convey("Then it should be idle once it hasn't been requested for a while", func() {
+			So(stream.idle(time.Now()), ShouldBeFalse)
+			So(stream.idle(time.Now().Add(eventStreamIdleTimeout+time.Second)), ShouldBeTrue)
+		})
+
+		Convey("Then releasing it should stop it and forget it", func() {
+			ReleaseEventStream(stream.host)
+			eventStreams.Lock()
+			_, ok := eventStreams.hosts[stream.host]
+			eventStreams.Unlock()
+			So(ok, ShouldBeFalse)
+
+			_, open := <-stream.stop
+			So(open, ShouldBeFalse)
+
+			// Releasing a stream that doesn't exist does nothing
+			ReleaseEventStream(stream.host)
+		})
+	})
+}
@@ -24,6 +24,8 @@ import (
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/operator"
 	"github.com/intelsdi-x/snap-plugin-utilities/ns"
 )
 
@@ -32,10 +34,13 @@ type Frameworks struct {
 }
 
 type Framework struct {
-	ID               string     `json:"id"`
-	OfferedResources *Resources `json:"offered_resources"`
-	Resources        *Resources `json:"resources"`
-	UsedResources    *Resources `json:"used_resources"`
+	ID               string             `json:"id"`
+	Role             string             `json:"role"`
+	Labels           []*mesos_pb2.Label `json:"labels"`
+	OfferedResources *Resources         `json:"offered_resources"`
+	Resources        *Resources         `json:"resources"`
+	UsedResources    *Resources         `json:"used_resources"`
+	Offers           []*Offer           `json:"offers"`
 }
 
 type Resources struct {
@@ -52,10 +57,13 @@ func GetFrameworksMetricTypes() ([]string, error) {
 		log.Error(err)
 		return nil, err
 	}
+	// None of the framework ID, role, or labels are metrics; they're used for dynamic namespace elements and tags.
+	// Outstanding offers are summarized by GetOfferStats() instead.
 	for i := 0; i < len(namespaces); i++ {
-		if namespaces[i] == "id" {
+		if namespaces[i] == "id" || namespaces[i] == "role" || strings.HasPrefix(namespaces[i], "labels") ||
+			strings.HasPrefix(namespaces[i], "offers") {
 			namespaces = append(namespaces[:i], namespaces[i+1:]...)
-			break
+			i--
 		}
 	}
 	return namespaces, nil
@@ -63,12 +71,19 @@ func GetFrameworksMetricTypes() ([]string, error) {
 }
 
 // Get metrics from the '/master/frameworks' endpoint on the master. This endpoint returns JSON about the overall
-// state and resource utilization of the frameworks running on the cluster.
+// state and resource utilization of the frameworks running on the cluster. Mesos versions before 0.26 don't provide
+// this endpoint, so the frameworks are taken from the master's state instead, which has the same shape. Note that
+// GET_FRAMEWORKS in the operator API isn't used, because it doesn't report the resources used by each framework.
 func GetFrameworks(host string) ([]*Framework, error) {
 	log.Debug("Getting active frameworks resource utilization from master ", host)
 	var frameworks Frameworks
 
-	c := client.NewClient(host, "/master/frameworks", time.Duration(10))
+	path := "/master/frameworks"
+	if version, err := client.GetVersion(host); err == nil && !client.VersionAtLeast(version, "0.26") {
+		path = "/master/state.json"
+	}
+
+	c := client.NewClient(host, path, 10*time.Second)
 	if err := c.Fetch(&frameworks); err != nil {
 		log.Error(err)
 		return nil, err
@@ -77,6 +92,73 @@ func GetFrameworks(host string) ([]*Framework, error) {
 	return frameworks.ActiveFrameworks, nil
 }
 
+type Agents struct {
+	Agents []*Agent `json:"slaves"`
+}
+
+type Agent struct {
+	ID       string `json:"id"`
+	PID      string `json:"pid"`
+	Hostname string `json:"hostname"`
+	Active   bool   `json:"active"`
+
+	// When the agent registered and last reregistered with the master, in seconds since the epoch. The reregistration
+	// time is 0 if the agent hasn't reregistered.
+	RegisteredTime   float64 `json:"registered_time"`
+	ReregisteredTime float64 `json:"reregistered_time"`
+}
+
+// Return the "host:port" address of the agent's HTTP API, taken from its PID, e.g. "slave(1)@10.0.0.1:5051".
+func (a *Agent) Address() string {
+	if i := strings.LastIndex(a.PID, "@"); i >= 0 {
+		return a.PID[i+1:]
+	}
+	return ""
+}
+
+// Get the agents registered with the master, using GET_AGENTS if the master provides the operator API, or the
+// '/master/slaves' endpoint otherwise.
+func GetAgents(host string) ([]*Agent, error) {
+	if operator.Supported(host) {
+		agents, err := getAgentsFromOperator(host)
+		if err == nil || !client.IsNotFound(err) {
+			return agents, err
+		}
+	}
+
+	log.Debug("Getting registered agents from master ", host)
+	var agents Agents
+
+	c := client.NewClient(host, "/master/slaves", 10*time.Second)
+	if err := c.Fetch(&agents); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return agents.Agents, nil
+}
+
+func getAgentsFromOperator(host string) ([]*Agent, error) {
+	response, err := operator.GetAgents(host)
+	if err != nil {
+		return nil, err
+	}
+
+	agents := []*Agent{}
+	for _, agent := range response {
+		agents = append(agents, &Agent{
+			ID:       agent.GetAgentInfo().GetId().GetValue(),
+			PID:      agent.GetPid(),
+			Hostname: agent.GetAgentInfo().GetHostname(),
+			Active:   agent.GetActive(),
+
+			RegisteredTime:   float64(agent.GetRegisteredTime().GetNanoseconds()) / 1e9,
+			ReregisteredTime: float64(agent.GetReregisteredTime().GetNanoseconds()) / 1e9,
+		})
+	}
+	return agents, nil
+}
+
 // Collect metrics from the '/metrics/snapshot' endpoint on the master.  The '/metrics/snapshot' endpoint returns JSON,
 // and all metrics contained in the endpoint use a string as the key, and a double (float64) for the value. For example:
 //
@@ -84,11 +166,19 @@ func GetFrameworks(host string) ([]*Framework, error) {
 //     "master/cpus_total": 2.0
 //   }
 //
+// If the master provides the operator API, the same metrics are collected using GET_METRICS instead.
 func GetMetricsSnapshot(host string) (map[string]float64, error) {
+	if operator.Supported(host) {
+		data, err := operator.GetMasterMetrics(host)
+		if err == nil || !client.IsNotFound(err) {
+			return data, err
+		}
+	}
+
 	log.Debug("Getting metrics snapshot for host ", host)
 	data := map[string]float64{}
 
-	c := client.NewClient(host, "/metrics/snapshot", time.Duration(5))
+	c := client.NewClient(host, "/metrics/snapshot", 5*time.Second)
 	if err := c.Fetch(&data); err != nil {
 		log.Error(err)
 		return nil, err
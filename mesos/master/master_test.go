@@ -25,6 +25,9 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/operator"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -99,6 +102,39 @@ func TestGetFrameworks(t *testing.T) {
 	})
 }
 
+func TestGetFrameworks_BeforeMesos026(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "0.25.0"}`))
+		case "/master/state.json":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"frameworks": [{"id": "id1", "role": "*", "resources": {"cpus": 1.0}}]}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When frameworks are requested from a master that doesn't provide '/master/frameworks'", t, func() {
+		frameworks, err := GetFrameworks(host)
+
+		Convey("Then the frameworks should be taken from the master's state", func() {
+			So(err, ShouldBeNil)
+			So(len(frameworks), ShouldEqual, 1)
+			So(frameworks[0].Role, ShouldEqual, "*")
+			So(frameworks[0].Resources.CPUs, ShouldEqual, 1.0)
+		})
+	})
+}
+
 func TestGetFrameworksMetricTypes(t *testing.T) {
 	Convey("When building metric types for Frameworks on the master", t, func() {
 		namespaces, err := GetFrameworksMetricTypes()
@@ -112,6 +148,87 @@ func TestGetFrameworksMetricTypes(t *testing.T) {
 		})
 		Convey("Should not contain non-metrics namespaces, e.g. 'id'", func() {
 			So(namespaces, ShouldNotContain, "id")
+			So(namespaces, ShouldNotContain, "role")
+		})
+		Convey("Should not contain framework labels or offers", func() {
+			for _, namespace := range namespaces {
+				So(namespace, ShouldNotStartWith, "labels")
+				So(namespace, ShouldNotStartWith, "offers")
+			}
+		})
+	})
+}
+
+func TestGetAgents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/master/slaves" {
+			w.WriteHeader(404)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"slaves": [
+			{"id": "agent1", "pid": "slave(1)@10.0.0.1:5051", "hostname": "agent1.example.com", "active": true},
+			{"id": "agent2", "pid": "slave(1)@10.0.0.2:5051", "hostname": "agent2.example.com", "active": false}
+		]}`))
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the agents registered with the master are requested", t, func() {
+		agents, err := GetAgents(host)
+
+		Convey("Then the agents and their addresses should be returned", func() {
+			So(err, ShouldBeNil)
+			So(len(agents), ShouldEqual, 2)
+			So(agents[0].Address(), ShouldEqual, "10.0.0.1:5051")
+			So(agents[1].Active, ShouldBeFalse)
+		})
+	})
+}
+
+func TestGetAgents_OperatorAPI(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "1.4.0"}`))
+		case "/api/v1":
+			w.Header().Set("Content-Type", r.Header.Get("Accept"))
+			w.WriteHeader(200)
+			w.Write(operatorResponse(r, `{"type": "GET_AGENTS", "get_agents": {"agents": [
+				{"agent_info": {"id": {"value": "agent1"}, "hostname": "agent1.example.com"},
+				 "pid": "slave(1)@10.0.0.1:5051", "active": true, "version": "1.4.0",
+				 "registered_time": {"nanoseconds": 1500000000500000000}}
+			]}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the agents are requested from a master that provides the operator API", t, func() {
+		agents, err := GetAgents(host)
+
+		Convey("Then the agents should be returned from GET_AGENTS", func() {
+			So(err, ShouldBeNil)
+			So(len(agents), ShouldEqual, 1)
+			So(agents[0].ID, ShouldEqual, "agent1")
+			So(agents[0].Hostname, ShouldEqual, "agent1.example.com")
+			So(agents[0].Address(), ShouldEqual, "10.0.0.1:5051")
+			So(agents[0].Active, ShouldBeTrue)
+			So(agents[0].RegisteredTime, ShouldEqual, 1500000000.5)
+			So(agents[0].ReregisteredTime, ShouldEqual, 0)
 		})
 	})
 }
@@ -197,6 +314,22 @@ func TestIsLeader(t *testing.T) {
 	})
 }
 
+// Encode a JSON response of the operator API in the content type the client accepts.
+func operatorResponse(r *http.Request, response string) []byte {
+	if r.Header.Get("Accept") != operator.ContentTypeProtobuf {
+		return []byte(response)
+	}
+	message := &mesos_v1_master.Response{}
+	if err := json.Unmarshal([]byte(response), message); err != nil {
+		panic(err)
+	}
+	b, err := proto.Marshal(message)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 func extractHostFromURL(u string) (string, error) {
 	parsed, err := url.Parse(u)
 	if err != nil {
@@ -0,0 +1,90 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+)
+
+// An outstanding offer of a framework, as reported by '/master/frameworks'.
+type Offer struct {
+	ID        string     `json:"id"`
+	AgentID   string     `json:"slave_id"`
+	Resources *Resources `json:"resources"`
+}
+
+// OfferStats summarizes the outstanding offers of a framework. The resources they hold are reported by the
+// framework's "offered_resources". Declined offers and suppressed roles are reported by the master's metrics snapshot
+// since Mesos 1.8, and offer filters aren't exposed by Mesos, so they aren't included.
+type OfferStats struct {
+	Outstanding   uint64  `json:"outstanding"`
+	OldestAgeSecs float64 `json:"oldest_age_secs"`
+}
+
+// The time each outstanding offer was first seen, keyed by master and offer ID. Mesos doesn't report when an offer was
+// made, so its age is measured from the first collection that saw it.
+var offersSeen = struct {
+	sync.Mutex
+	hosts map[string]map[string]time.Time
+}{hosts: map[string]map[string]time.Time{}}
+
+// Recursively traverse the OfferStats struct, building "/"-delimited strings that resemble snap metric types.
+func GetOfferMetricTypes() ([]string, error) {
+	log.Debug("Getting offer metric types")
+	namespaces := []string{}
+	if err := ns.FromCompositeObject(OfferStats{}, "", &namespaces); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Summarize the outstanding offers of each framework (keyed by framework ID) of the master. Offers are remembered from
+// one call to the next, so that the age of the oldest offer of a framework can be reported; offers that are no longer
+// outstanding are forgotten.
+func GetOfferStats(host string, frameworks []*Framework, now time.Time) map[string]*OfferStats {
+	offersSeen.Lock()
+	defer offersSeen.Unlock()
+
+	previous := offersSeen.hosts[host]
+	seen := map[string]time.Time{}
+	stats := map[string]*OfferStats{}
+
+	for _, framework := range frameworks {
+		s := &OfferStats{}
+		for _, offer := range framework.Offers {
+			first, ok := previous[offer.ID]
+			if !ok {
+				first = now
+			}
+			seen[offer.ID] = first
+
+			s.Outstanding++
+			if age := now.Sub(first).Seconds(); age > s.OldestAgeSecs {
+				s.OldestAgeSecs = age
+			}
+		}
+		stats[framework.ID] = s
+	}
+
+	offersSeen.hosts[host] = seen
+	return stats
+}
@@ -0,0 +1,61 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOfferStats(t *testing.T) {
+	framework := func(id string, offers ...string) *Framework {
+		f := &Framework{ID: id}
+		for _, offer := range offers {
+			f.Offers = append(f.Offers, &Offer{ID: offer})
+		}
+		return f
+	}
+
+	// The offers seen are remembered across calls, so the calls are made once rather than for each Convey block
+	start := time.Now()
+	GetOfferStats("offers-test:5050", []*Framework{framework("frame1", "O1", "O2"), framework("frame2")}, start)
+	stats := GetOfferStats("offers-test:5050", []*Framework{
+		framework("frame1", "O2", "O3"),
+		framework("frame2", "O4"),
+	}, start.Add(30*time.Second))
+	later := GetOfferStats("offers-test:5050", []*Framework{framework("frame1", "O1")}, start.Add(60*time.Second))
+
+	Convey("When the outstanding offers of each framework are summarized", t, func() {
+		Convey("Then the number of outstanding offers should be reported", func() {
+			So(stats["frame1"].Outstanding, ShouldEqual, 2)
+			So(stats["frame2"].Outstanding, ShouldEqual, 1)
+		})
+
+		Convey("Then the age of the oldest offer should be measured from when it was first seen", func() {
+			So(stats["frame1"].OldestAgeSecs, ShouldEqual, 30.0)
+			So(stats["frame2"].OldestAgeSecs, ShouldEqual, 0.0)
+		})
+
+		Convey("Then offers that are no longer outstanding should be forgotten", func() {
+			So(later["frame1"].OldestAgeSecs, ShouldEqual, 0.0)
+		})
+	})
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_quota"
+)
+
+// The resources that a quota may be set for. Mesos only supports quotas of scalar resources, and only these are
+// reported by '/master/roles'.
+var quotaResources = []string{"cpus", "gpus", "mem", "disk"}
+
+// The metrics that are derived for each resource of a role with a quota.
+var quotaStatistics = []string{"guarantee", "limit", "allocated", "headroom", "over_guarantee", "over_limit"}
+
+type Roles struct {
+	Roles []*Role `json:"roles"`
+}
+
+type Role struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+
+	// The resources allocated to the frameworks in the role. Non-scalar resources (e.g. ports) are reported as
+	// ranges, so they're decoded as they are and ignored.
+	Resources map[string]interface{} `json:"resources"`
+}
+
+// RoleQuota is the weight and quota of a role, along with the resources allocated to it. Resources are keyed by name
+// (e.g. "cpus"), and only those that the quota sets a guarantee or limit for are included in Guarantee and Limit.
+type RoleQuota struct {
+	Weight    float64
+	Guarantee map[string]float64
+	Limit     map[string]float64
+	Allocated map[string]float64
+}
+
+// Return the names of the metrics reported for each role by RoleQuota.Metrics(), which resemble snap metric types,
+// e.g. "weight" or "quota/cpus/headroom".
+func GetRoleQuotaMetricTypes() []string {
+	namespaces := []string{"weight"}
+	for _, resource := range quotaResources {
+		for _, statistic := range quotaStatistics {
+			namespaces = append(namespaces, "quota/"+resource+"/"+statistic)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Get the weight, quota, and allocated resources of every role known to the master, keyed by role name, from the
+// '/master/roles' and '/master/quota' endpoints. Roles with a quota are included even if nothing is allocated to
+// them, so that their headroom is reported.
+func GetRoleQuotas(host string) (map[string]*RoleQuota, error) {
+	log.Debug("Getting roles and quotas from master ", host)
+	var roles Roles
+	c := client.NewClient(host, "/master/roles", 10*time.Second)
+	if err := c.Fetch(&roles); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var status mesos_v1_quota.QuotaStatus
+	c = client.NewClient(host, "/master/quota", 10*time.Second)
+	if err := c.Fetch(&status); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	quotas := map[string]*RoleQuota{}
+	quota := func(role string) *RoleQuota {
+		if quotas[role] == nil {
+			quotas[role] = &RoleQuota{
+				Weight:    1.0,
+				Guarantee: map[string]float64{},
+				Limit:     map[string]float64{},
+				Allocated: map[string]float64{},
+			}
+		}
+		return quotas[role]
+	}
+
+	for _, role := range roles.Roles {
+		q := quota(role.Name)
+		q.Weight = role.Weight
+		for _, resource := range quotaResources {
+			if val, ok := role.Resources[resource].(float64); ok {
+				q.Allocated[resource] = val
+			}
+		}
+	}
+
+	// Mesos 1.9 and later report quota configs, which include limits, and keep reporting quota infos with the
+	// guarantees alone for backwards compatibility
+	if len(status.GetConfigs()) > 0 {
+		for _, config := range status.GetConfigs() {
+			q := quota(config.GetRole())
+			for name, scalar := range config.GetGuarantees() {
+				q.Guarantee[name] = scalar.GetValue()
+			}
+			for name, scalar := range config.GetLimits() {
+				q.Limit[name] = scalar.GetValue()
+			}
+		}
+	} else {
+		for _, info := range status.GetInfos() {
+			q := quota(info.GetRole())
+			for _, resource := range info.GetGuarantee() {
+				q.Guarantee[resource.GetName()] += resource.GetScalar().GetValue()
+			}
+		}
+	}
+
+	return quotas, nil
+}
+
+// Return the metrics of the role, keyed by the names returned by GetRoleQuotaMetricTypes(). For each resource with a
+// guarantee, the headroom is the guarantee minus the allocated resources (negative once the guarantee is exceeded),
+// and over_guarantee is 1 if more than the guarantee is allocated. For each resource with a limit, over_limit is 1 if
+// more than the limit is allocated. Metrics of resources without a guarantee or limit are left out, apart from the
+// allocated resources of a role with a quota.
+func (q *RoleQuota) Metrics() map[string]float64 {
+	data := map[string]float64{"weight": q.Weight}
+	if len(q.Guarantee) == 0 && len(q.Limit) == 0 {
+		return data
+	}
+
+	for _, resource := range quotaResources {
+		prefix := "quota/" + resource + "/"
+		allocated := q.Allocated[resource]
+		data[prefix+"allocated"] = allocated
+
+		if guarantee, ok := q.Guarantee[resource]; ok {
+			data[prefix+"guarantee"] = guarantee
+			data[prefix+"headroom"] = guarantee - allocated
+			data[prefix+"over_guarantee"] = boolToFloat(allocated > guarantee)
+		}
+		if limit, ok := q.Limit[resource]; ok {
+			data[prefix+"limit"] = limit
+			data[prefix+"over_limit"] = boolToFloat(allocated > limit)
+		}
+	}
+	return data
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetRoleQuotas(t *testing.T) {
+	quota := `{"infos": [
+		{"role": "dev", "guarantee": [{"name": "cpus", "type": "SCALAR", "scalar": {"value": 2.0}}]},
+		{"role": "batch", "guarantee": [{"name": "mem", "type": "SCALAR", "scalar": {"value": 1024.0}}]}
+	]}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/master/roles":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"roles": [
+				{"name": "*", "weight": 1.0, "resources": {"cpus": 0.5, "mem": 128.0, "disk": 0, "gpus": 0}},
+				{"name": "dev", "weight": 2.5, "resources": {"cpus": 3.0, "mem": 512.0, "disk": 0, "gpus": 0,
+					"ports": "[31000-31001]"}}
+			]}`))
+		case "/master/quota":
+			w.WriteHeader(200)
+			w.Write([]byte(quota))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the quotas of the roles are requested", t, func() {
+		quotas, err := GetRoleQuotas(host)
+		So(err, ShouldBeNil)
+
+		Convey("Then every role should be returned with its weight", func() {
+			So(len(quotas), ShouldEqual, 3)
+			So(quotas["dev"].Weight, ShouldEqual, 2.5)
+			So(quotas["batch"].Weight, ShouldEqual, 1.0)
+			So(quotas["*"].Metrics(), ShouldResemble, map[string]float64{"weight": 1.0})
+		})
+
+		Convey("Then the headroom and violations should be derived for the resources with a quota", func() {
+			data := quotas["dev"].Metrics()
+			So(data["quota/cpus/guarantee"], ShouldEqual, 2.0)
+			So(data["quota/cpus/allocated"], ShouldEqual, 3.0)
+			So(data["quota/cpus/headroom"], ShouldEqual, -1.0)
+			So(data["quota/cpus/over_guarantee"], ShouldEqual, 1.0)
+			So(data["quota/mem/allocated"], ShouldEqual, 512.0)
+			So(data, ShouldNotContainKey, "quota/mem/headroom")
+			So(data, ShouldNotContainKey, "quota/cpus/limit")
+
+			data = quotas["batch"].Metrics()
+			So(data["quota/mem/headroom"], ShouldEqual, 1024.0)
+			So(data["quota/mem/over_guarantee"], ShouldEqual, 0.0)
+		})
+	})
+
+	quota = `{
+		"infos": [{"role": "dev", "guarantee": [{"name": "cpus", "type": "SCALAR", "scalar": {"value": 2.0}}]}],
+		"configs": [{"role": "dev", "guarantees": {"cpus": {"value": 2.0}}, "limits": {"cpus": {"value": 2.5}}}]
+	}`
+
+	Convey("When the quotas of the roles are requested from a master that reports limits", t, func() {
+		quotas, err := GetRoleQuotas(host)
+		So(err, ShouldBeNil)
+
+		Convey("Then the limits should be taken from the quota configs", func() {
+			data := quotas["dev"].Metrics()
+			So(data["quota/cpus/guarantee"], ShouldEqual, 2.0)
+			So(data["quota/cpus/limit"], ShouldEqual, 2.5)
+			So(data["quota/cpus/over_limit"], ShouldEqual, 1.0)
+		})
+	})
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/operator"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+)
+
+// The '/master/tasks' endpoint returns 100 tasks unless asked for more. Mesos keeps up to 1000 completed tasks per
+// framework by default (--max_completed_tasks_per_framework), so this covers a few busy frameworks.
+const maxTasks = 10000
+
+type Tasks struct {
+	Tasks []*Task `json:"tasks"`
+}
+
+type Task struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	FrameworkID string                  `json:"framework_id"`
+	State       mesos_pb2.TaskState     `json:"state"`
+	Statuses    []*mesos_pb2.TaskStatus `json:"statuses"`
+}
+
+// TaskLifecycle describes the distribution of how long the tasks of a framework take to start running, how long they
+// run before they fail or are killed, and how often tasks with the same name fail after running and are restarted.
+type TaskLifecycle struct {
+	StagingToRunning Distribution `json:"staging_to_running_secs"`
+	RunningToFailure Distribution `json:"running_to_failure_secs"`
+	Restarts         Distribution `json:"restarts"`
+}
+
+// Distribution summarizes a set of samples by their number and percentiles.
+type Distribution struct {
+	Count uint64  `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+// Recursively traverse the TaskLifecycle struct, building "/"-delimited strings that resemble snap metric types.
+func GetTaskLifecycleMetricTypes() ([]string, error) {
+	log.Debug("Getting task lifecycle metric types")
+	namespaces := []string{}
+	if err := ns.FromCompositeObject(TaskLifecycle{}, "", &namespaces); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Get the tasks known to the master, including completed and unreachable tasks, using GET_TASKS if the master provides
+// the operator API, or the '/master/tasks' endpoint otherwise.
+func GetTasks(host string) ([]*Task, error) {
+	if operator.Supported(host) {
+		tasks, err := getTasksFromOperator(host)
+		if err == nil || !client.IsNotFound(err) {
+			return tasks, err
+		}
+	}
+
+	log.Debug("Getting tasks from master ", host)
+	var tasks Tasks
+
+	c := client.NewClient(host, fmt.Sprintf("/master/tasks?limit=%d", maxTasks), 30*time.Second)
+	if err := c.Fetch(&tasks); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return tasks.Tasks, nil
+}
+
+func getTasksFromOperator(host string) ([]*Task, error) {
+	response, err := operator.GetTasks(host)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*Task{}
+	for _, list := range [][]*mesos_v1.Task{
+		response.GetPendingTasks(),
+		response.GetTasks(),
+		response.GetUnreachableTasks(),
+		response.GetCompletedTasks(),
+	} {
+		for _, task := range list {
+			t := &Task{
+				ID:          task.GetTaskId().GetValue(),
+				Name:        task.GetName(),
+				FrameworkID: task.GetFrameworkId().GetValue(),
+				State:       mesos_pb2.TaskState(task.GetState()),
+			}
+			for _, status := range task.GetStatuses() {
+				s := &mesos_pb2.TaskStatus{}
+				if err := operator.Devolve(status, s); err != nil {
+					log.Error(err)
+					return nil, err
+				}
+				t.Statuses = append(t.Statuses, s)
+			}
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks, nil
+}
+
+// Compute the task lifecycle of each framework (keyed by framework ID) from the status updates of its tasks:
+//
+//   * staging_to_running_secs: from launching a task to it running. Mesos doesn't record a status update for
+//     TASK_STAGING, so this is measured from the earliest TASK_STAGING or TASK_STARTING update, and tasks that went
+//     straight to TASK_RUNNING aren't counted.
+//   * running_to_failure_secs: from a task running to it failing or being killed (TASK_FAILED or TASK_KILLED).
+//   * restarts: for each task name, the number of tasks that failed (TASK_FAILED) after running. Schedulers such as
+//     Marathon give every task of an app the same name, and replace a task that failed with a new one, so a
+//     crash-looping app has many failures under its name. Instances running side by side, and tasks that were killed
+//     (e.g. when an app is scaled down or redeployed) aren't counted.
+func TaskLifecycles(tasks []*Task) map[string]*TaskLifecycle {
+	startup := map[string][]float64{}
+	failure := map[string][]float64{}
+	names := map[string]map[string]int{}
+
+	for _, task := range tasks {
+		if names[task.FrameworkID] == nil {
+			names[task.FrameworkID] = map[string]int{}
+		}
+		if _, ok := names[task.FrameworkID][task.Name]; !ok {
+			names[task.FrameworkID][task.Name] = 0
+		}
+
+		staging, running, failed := taskTimestamps(task.Statuses)
+		if staging > 0 && running > 0 {
+			startup[task.FrameworkID] = append(startup[task.FrameworkID], running-staging)
+		}
+		if running > 0 && failed > 0 {
+			failure[task.FrameworkID] = append(failure[task.FrameworkID], failed-running)
+			if task.Statuses[len(task.Statuses)-1].GetState() == mesos_pb2.TaskState_TASK_FAILED {
+				names[task.FrameworkID][task.Name]++
+			}
+		}
+	}
+
+	lifecycles := map[string]*TaskLifecycle{}
+	for framework, counts := range names {
+		restarts := []float64{}
+		for _, count := range counts {
+			restarts = append(restarts, float64(count))
+		}
+		lifecycles[framework] = &TaskLifecycle{
+			StagingToRunning: newDistribution(startup[framework]),
+			RunningToFailure: newDistribution(failure[framework]),
+			Restarts:         newDistribution(restarts),
+		}
+	}
+	return lifecycles
+}
+
+// Return the timestamps of the earliest TASK_STAGING or TASK_STARTING update before the task was running, the earliest
+// TASK_RUNNING update, and the TASK_FAILED or TASK_KILLED update after it was running. Timestamps that aren't known
+// are returned as 0.
+func taskTimestamps(statuses []*mesos_pb2.TaskStatus) (staging float64, running float64, failed float64) {
+	for _, status := range statuses {
+		timestamp := status.GetTimestamp()
+		switch status.GetState() {
+		case mesos_pb2.TaskState_TASK_RUNNING:
+			if running == 0 || timestamp < running {
+				running = timestamp
+			}
+		case mesos_pb2.TaskState_TASK_FAILED, mesos_pb2.TaskState_TASK_KILLED:
+			failed = timestamp
+		}
+	}
+
+	for _, status := range statuses {
+		timestamp := status.GetTimestamp()
+		switch status.GetState() {
+		case mesos_pb2.TaskState_TASK_STAGING, mesos_pb2.TaskState_TASK_STARTING:
+			if timestamp > 0 && timestamp < running && (staging == 0 || timestamp < staging) {
+				staging = timestamp
+			}
+		}
+	}
+
+	if failed < running {
+		failed = 0
+	}
+	return staging, running, failed
+}
+
+// Summarize the samples using the nearest-rank method, which always reports an actual sample.
+func newDistribution(samples []float64) Distribution {
+	d := Distribution{Count: uint64(len(samples))}
+	if len(samples) == 0 {
+		return d
+	}
+
+	sort.Float64s(samples)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p / 100 * float64(len(samples))))
+		if rank < 1 {
+			rank = 1
+		}
+		return samples[rank-1]
+	}
+	d.P50, d.P90, d.P99 = percentile(50), percentile(90), percentile(99)
+	return d
+}
+
+// Return the names of the metrics reported for each framework by TerminalTasks(), which resemble snap metric types,
+// e.g. "reason/container_limitation_memory" or "source/agent". Reasons and sources are named after the v1 API, which
+// also covers those added since the protobuf in mesos_pb2 (e.g. "reason/agent_removed" rather than "slave_removed").
+func GetTerminalTaskMetricTypes() []string {
+	namespaces := []string{}
+	for _, reason := range mesos_v1.TaskStatus_Reason_name {
+		namespaces = append(namespaces, "reason/"+strings.ToLower(strings.TrimPrefix(reason, "REASON_")))
+	}
+	for _, source := range mesos_v1.TaskStatus_Source_name {
+		namespaces = append(namespaces, "source/"+strings.ToLower(strings.TrimPrefix(source, "SOURCE_")))
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Count the terminal tasks of each framework (keyed by framework ID) by the reason and source of their last status
+// update, keyed by the names returned by GetTerminalTaskMetricTypes(). Every framework with a task is included, so
+// that its counts are reported as zero rather than missing. Status updates only include their reason and source when
+// the tasks are fetched using the operator API, so tasks fetched from '/master/tasks' aren't counted.
+//
+// The counts only cover the completed tasks that the master retains (--max_completed_tasks_per_framework), so they
+// are gauges over that window rather than counters, and go down when the master drops old tasks.
+func TerminalTasks(tasks []*Task) map[string]map[string]uint64 {
+	frameworks := map[string]map[string]uint64{}
+
+	for _, task := range tasks {
+		if frameworks[task.FrameworkID] == nil {
+			frameworks[task.FrameworkID] = map[string]uint64{}
+		}
+		if !terminalTaskStates[mesos_v1.TaskState(task.State)] || len(task.Statuses) == 0 {
+			continue
+		}
+
+		status := task.Statuses[len(task.Statuses)-1]
+		if status.Reason != nil {
+			reason := mesos_v1.TaskStatus_Reason(status.GetReason()).String()
+			frameworks[task.FrameworkID]["reason/"+strings.ToLower(strings.TrimPrefix(reason, "REASON_"))]++
+		}
+		if status.Source != nil {
+			source := mesos_v1.TaskStatus_Source(status.GetSource()).String()
+			frameworks[task.FrameworkID]["source/"+strings.ToLower(strings.TrimPrefix(source, "SOURCE_"))]++
+		}
+	}
+
+	return frameworks
+}
+
+// TaskHealth is the health of the tasks of a framework, as reported by their health checks. Only tasks that haven't
+// terminated are counted.
+type TaskHealth struct {
+	Healthy   uint64 `json:"healthy"`
+	Unhealthy uint64 `json:"unhealthy"`
+	Unknown   uint64 `json:"unknown"`
+
+	// The health of each task with a health check, keyed by task ID
+	Tasks map[string]bool `json:"tasks"`
+}
+
+// Return the health of the tasks of each framework (keyed by framework ID), taken from the last status update of each
+// task. Tasks without a health check, or whose health hasn't been checked yet, are counted as unknown.
+func TasksHealth(tasks []*Task) map[string]*TaskHealth {
+	frameworks := map[string]*TaskHealth{}
+
+	for _, task := range tasks {
+		if frameworks[task.FrameworkID] == nil {
+			frameworks[task.FrameworkID] = &TaskHealth{Tasks: map[string]bool{}}
+		}
+		if terminalTaskStates[mesos_v1.TaskState(task.State)] {
+			continue
+		}
+
+		health := frameworks[task.FrameworkID]
+		var status *mesos_pb2.TaskStatus
+		if len(task.Statuses) > 0 {
+			status = task.Statuses[len(task.Statuses)-1]
+		}
+		switch {
+		case status == nil || status.Healthy == nil:
+			health.Unknown++
+		case status.GetHealthy():
+			health.Healthy++
+			health.Tasks[task.ID] = true
+		default:
+			health.Unhealthy++
+			health.Tasks[task.ID] = false
+		}
+	}
+
+	return frameworks
+}
@@ -0,0 +1,241 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetTasks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/master/tasks" || r.URL.Query().Get("limit") != "10000" {
+			w.WriteHeader(404)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"tasks": [
+			{"id": "task1", "name": "web", "framework_id": "frame1", "state": "TASK_FAILED", "statuses": [
+				{"state": "TASK_STARTING", "timestamp": 1000.0},
+				{"state": "TASK_RUNNING", "timestamp": 1002.5},
+				{"state": "TASK_FAILED", "timestamp": 1062.5, "container_status": {"executor_pid": 1234}}
+			]}
+		]}`))
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the tasks known to the master are requested", t, func() {
+		tasks, err := GetTasks(host)
+
+		Convey("Then the tasks and their status updates should be returned", func() {
+			So(err, ShouldBeNil)
+			So(len(tasks), ShouldEqual, 1)
+			So(tasks[0].FrameworkID, ShouldEqual, "frame1")
+			So(tasks[0].State, ShouldEqual, mesos_pb2.TaskState_TASK_FAILED)
+			So(len(tasks[0].Statuses), ShouldEqual, 3)
+			So(tasks[0].Statuses[1].GetState(), ShouldEqual, mesos_pb2.TaskState_TASK_RUNNING)
+			So(tasks[0].Statuses[1].GetTimestamp(), ShouldEqual, 1002.5)
+		})
+	})
+}
+
+func TestGetTasks_OperatorAPI(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"version": "1.4.0"}`))
+		case "/api/v1":
+			w.Header().Set("Content-Type", r.Header.Get("Accept"))
+			w.WriteHeader(200)
+			w.Write(operatorResponse(r, `{"type": "GET_TASKS", "get_tasks": {
+				"tasks": [{"name": "web", "task_id": {"value": "task2"}, "framework_id": {"value": "frame1"},
+					"agent_id": {"value": "agent1"}, "state": "TASK_RUNNING", "statuses": [
+						{"task_id": {"value": "task2"}, "state": "TASK_RUNNING", "timestamp": 2000.0}
+					]}],
+				"completed_tasks": [{"name": "web", "task_id": {"value": "task1"}, "framework_id": {"value": "frame1"},
+					"agent_id": {"value": "agent1"}, "state": "TASK_KILLED"}]
+			}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When the tasks are requested from a master that provides the operator API", t, func() {
+		tasks, err := GetTasks(host)
+
+		Convey("Then both active and completed tasks should be returned from GET_TASKS", func() {
+			So(err, ShouldBeNil)
+			So(len(tasks), ShouldEqual, 2)
+			So(tasks[0].ID, ShouldEqual, "task2")
+			So(tasks[0].Statuses[0].GetState(), ShouldEqual, mesos_pb2.TaskState_TASK_RUNNING)
+			So(tasks[0].Statuses[0].GetTimestamp(), ShouldEqual, 2000.0)
+			So(tasks[1].ID, ShouldEqual, "task1")
+			So(tasks[1].State, ShouldEqual, mesos_pb2.TaskState_TASK_KILLED)
+		})
+	})
+}
+
+func TestTaskLifecycles(t *testing.T) {
+	task := func(framework string, name string, statuses ...interface{}) *Task {
+		t := &Task{Name: name, FrameworkID: framework}
+		for i := 0; i < len(statuses); i += 2 {
+			t.Statuses = append(t.Statuses, &mesos_pb2.TaskStatus{
+				State:     statuses[i].(mesos_pb2.TaskState).Enum(),
+				Timestamp: proto.Float64(statuses[i+1].(float64)),
+			})
+		}
+		return t
+	}
+
+	Convey("When the task lifecycle of each framework is computed", t, func() {
+		lifecycles := TaskLifecycles([]*Task{
+			task("frame1", "web",
+				mesos_pb2.TaskState_TASK_STARTING, 100.0,
+				mesos_pb2.TaskState_TASK_RUNNING, 101.0,
+				mesos_pb2.TaskState_TASK_FAILED, 111.0),
+			task("frame1", "web",
+				mesos_pb2.TaskState_TASK_STAGING, 200.0,
+				mesos_pb2.TaskState_TASK_STARTING, 202.0,
+				mesos_pb2.TaskState_TASK_RUNNING, 204.0,
+				mesos_pb2.TaskState_TASK_KILLED, 304.0),
+			task("frame1", "web",
+				mesos_pb2.TaskState_TASK_RUNNING, 400.0),
+			task("frame1", "db",
+				mesos_pb2.TaskState_TASK_STARTING, 100.0,
+				mesos_pb2.TaskState_TASK_FAILED, 101.0),
+			task("frame2", "batch"),
+			task("frame3", "api", mesos_pb2.TaskState_TASK_RUNNING, 100.0),
+			task("frame3", "api", mesos_pb2.TaskState_TASK_RUNNING, 100.0),
+			task("frame3", "api", mesos_pb2.TaskState_TASK_RUNNING, 100.0),
+		})
+
+		Convey("Then the time to running should only count tasks with a status update before running", func() {
+			So(lifecycles["frame1"].StagingToRunning, ShouldResemble, Distribution{Count: 2, P50: 1.0, P90: 4.0, P99: 4.0})
+		})
+
+		Convey("Then the time before failure should only count tasks that were running", func() {
+			So(lifecycles["frame1"].RunningToFailure, ShouldResemble, Distribution{Count: 2, P50: 10.0, P90: 100.0, P99: 100.0})
+		})
+
+		Convey("Then frameworks without any samples should be included", func() {
+			So(lifecycles, ShouldContainKey, "frame2")
+			So(lifecycles["frame2"].StagingToRunning.Count, ShouldEqual, 0)
+		})
+
+		Convey("Then restarts should count the tasks with each name that failed after running", func() {
+			So(lifecycles["frame1"].Restarts, ShouldResemble, Distribution{Count: 2, P50: 0.0, P90: 1.0, P99: 1.0})
+			So(lifecycles["frame2"].Restarts, ShouldResemble, Distribution{Count: 1})
+		})
+
+		Convey("Then instances that share a task name shouldn't be reported as restarts", func() {
+			So(lifecycles["frame3"].Restarts, ShouldResemble, Distribution{Count: 1})
+		})
+	})
+}
+
+func TestTerminalTasks(t *testing.T) {
+	task := func(framework string, state mesos_pb2.TaskState, reason *mesos_pb2.TaskStatus_Reason, source *mesos_pb2.TaskStatus_Source) *Task {
+		return &Task{FrameworkID: framework, State: state, Statuses: []*mesos_pb2.TaskStatus{
+			{State: mesos_pb2.TaskState_TASK_RUNNING.Enum()},
+			{State: state.Enum(), Reason: reason, Source: source},
+		}}
+	}
+
+	Convey("When the terminal tasks of each framework are counted", t, func() {
+		terminal := TerminalTasks([]*Task{
+			task("frame1", mesos_pb2.TaskState_TASK_FAILED,
+				mesos_pb2.TaskStatus_REASON_CONTAINER_LIMITATION_MEMORY.Enum(), mesos_pb2.TaskStatus_SOURCE_SLAVE.Enum()),
+			task("frame1", mesos_pb2.TaskState_TASK_FAILED,
+				mesos_pb2.TaskStatus_REASON_CONTAINER_LIMITATION_MEMORY.Enum(), mesos_pb2.TaskStatus_SOURCE_SLAVE.Enum()),
+			task("frame1", mesos_pb2.TaskState_TASK_LOST,
+				mesos_pb2.TaskStatus_REASON_SLAVE_REMOVED.Enum(), mesos_pb2.TaskStatus_SOURCE_MASTER.Enum()),
+			task("frame1", mesos_pb2.TaskState_TASK_FINISHED, nil, nil),
+			task("frame2", mesos_pb2.TaskState_TASK_RUNNING,
+				mesos_pb2.TaskStatus_REASON_COMMAND_EXECUTOR_FAILED.Enum(), nil),
+		})
+
+		Convey("Then tasks should be counted by the reason and source of their last status update", func() {
+			So(terminal["frame1"], ShouldResemble, map[string]uint64{
+				"reason/container_limitation_memory": 2,
+				"reason/agent_removed":               1,
+				"source/agent":                       2,
+				"source/master":                      1,
+			})
+		})
+
+		Convey("Then frameworks without terminal tasks should be included", func() {
+			So(terminal["frame2"], ShouldBeEmpty)
+			So(terminal, ShouldContainKey, "frame2")
+		})
+
+		Convey("Then every reason and source should be a metric type", func() {
+			types := GetTerminalTaskMetricTypes()
+			So(types, ShouldContain, "reason/agent_removed")
+			So(types, ShouldContain, "reason/executor_terminated")
+			So(types, ShouldContain, "source/executor")
+		})
+	})
+}
+
+func TestTasksHealth(t *testing.T) {
+	task := func(id string, state mesos_pb2.TaskState, healthy *bool) *Task {
+		return &Task{ID: id, FrameworkID: "frame1", State: state, Statuses: []*mesos_pb2.TaskStatus{
+			{State: state.Enum(), Healthy: healthy},
+		}}
+	}
+
+	Convey("When the health of the tasks of each framework is requested", t, func() {
+		health := TasksHealth([]*Task{
+			task("task1", mesos_pb2.TaskState_TASK_RUNNING, proto.Bool(true)),
+			task("task2", mesos_pb2.TaskState_TASK_RUNNING, proto.Bool(false)),
+			task("task3", mesos_pb2.TaskState_TASK_RUNNING, nil),
+			task("task4", mesos_pb2.TaskState_TASK_FAILED, proto.Bool(false)),
+			{ID: "task5", FrameworkID: "frame1", State: mesos_pb2.TaskState_TASK_STAGING},
+		})
+
+		Convey("Then tasks that haven't terminated should be counted by their health", func() {
+			So(health["frame1"].Healthy, ShouldEqual, 1)
+			So(health["frame1"].Unhealthy, ShouldEqual, 1)
+			So(health["frame1"].Unknown, ShouldEqual, 2)
+		})
+
+		Convey("Then only tasks with a health check should be reported individually", func() {
+			So(health["frame1"].Tasks, ShouldResemble, map[string]bool{"task1": true, "task2": false})
+		})
+	})
+}
@@ -23,7 +23,9 @@ import (
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
 	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
 	"github.com/intelsdi-x/snap-plugin-utilities/config"
 	"github.com/intelsdi-x/snap-plugin-utilities/ns"
 	"github.com/intelsdi-x/snap/control/plugin"
@@ -66,69 +68,237 @@ func (m *Mesos) GetMetricTypes(cfg plugin.ConfigType) ([]plugin.MetricType, erro
 		return nil, err
 	}
 
+	filter, err := getSnapshotFilter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := getClusterConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	agentFlapping, err := getAgentFlappingConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	metricTypes := []plugin.MetricType{}
 
 	if configItems["master"] != "" {
 		log.Info("Getting metric types for the Mesos master at ", configItems["master"])
-		master_mts, err := master.GetMetricsSnapshot(configItems["master"])
+		snapshot, err := master.GetMetricsSnapshot(configItems["master"])
 		if err != nil {
 			log.Error(err)
 			return nil, err
 		}
 
-		for key, _ := range master_mts {
-			namespace := core.NewNamespace(pluginVendor, pluginName, "master").
-				AddStaticElements(strings.Split(key, "/")...)
-			log.Debug("Adding metric to catalog: ", namespace.String())
-			metricTypes = append(metricTypes, plugin.MetricType{Namespace_: namespace})
-		}
-
-		framework_mts, err := master.GetFrameworksMetricTypes()
+		master_mts, err := masterMetricTypes(filter.apply(expandTimers(snapshot)))
 		if err != nil {
 			log.Error(err)
 			return nil, err
 		}
+		metricTypes = append(metricTypes, master_mts...)
 
-		for _, key := range framework_mts {
-			namespace := core.NewNamespace(pluginVendor, pluginName, "master").
-				AddDynamicElement("framework_id", "Framework ID").
-				AddStaticElements(strings.Split(key, "/")...)
-			log.Debug("Adding metric to catalog: ", namespace.String())
-			metricTypes = append(metricTypes, plugin.MetricType{Namespace_: namespace})
+		if cluster.enabled {
+			cluster_mts, err := clusterMetricTypes()
+			if err != nil {
+				log.Error(err)
+				return nil, err
+			}
+			metricTypes = append(metricTypes, cluster_mts...)
+		}
+
+		if events, _ := getConfigBool(cfg, "event_stream"); events {
+			metricTypes = append(metricTypes, eventMetricTypes()...)
+		}
+
+		if lifecycle, _ := getConfigBool(cfg, "task_lifecycle"); lifecycle {
+			lifecycle_mts, err := taskLifecycleMetricTypes()
+			if err != nil {
+				log.Error(err)
+				return nil, err
+			}
+			metricTypes = append(metricTypes, lifecycle_mts...)
+		}
+
+		if terminal, _ := getConfigBool(cfg, "terminal_tasks"); terminal {
+			metricTypes = append(metricTypes, terminalTaskMetricTypes()...)
+		}
+
+		if health, _ := getConfigBool(cfg, "task_health"); health {
+			metricTypes = append(metricTypes, taskHealthMetricTypes()...)
+		}
+
+		if quota, _ := getConfigBool(cfg, "role_quota"); quota {
+			metricTypes = append(metricTypes, roleQuotaMetricTypes()...)
+		}
+
+		if agentFlapping.enabled {
+			history_mts, err := agentHistoryMetricTypes()
+			if err != nil {
+				log.Error(err)
+				return nil, err
+			}
+			metricTypes = append(metricTypes, history_mts...)
 		}
 	}
 
 	if configItems["agent"] != "" {
 		log.Info("Getting metric types for the Mesos agent at ", configItems["agent"])
-		agent_mts, err := agent.GetMetricsSnapshot(configItems["agent"])
+		snapshot, err := agent.GetMetricsSnapshot(configItems["agent"])
 		if err != nil {
 			log.Error(err)
 			return nil, err
 		}
 
-		for key, _ := range agent_mts {
-			namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
-				AddStaticElements(strings.Split(key, "/")...)
-			log.Debug("Adding metric to catalog: ", namespace.String())
-			metricTypes = append(metricTypes, plugin.MetricType{Namespace_: namespace})
+		agent_stats, err := agent.GetMonitoringStatisticsMetricTypes(configItems["agent"])
+		if err != nil {
+			log.Error(err)
+			return nil, err
 		}
 
-		agent_stats, err := agent.GetMonitoringStatisticsMetricTypes(configItems["agent"])
+		blkio_stats, err := agent.GetBlkioMetricTypes(configItems["agent"])
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
+
+		normalize, _ := getConfigBool(cfg, "normalize_agent_names")
+		if normalize {
+			snapshot = normalizeAgentSnapshot(snapshot)
+		}
+		snapshot = filter.apply(expandTimers(snapshot))
+		if normalize {
+			snapshot = withLegacyAgentKeys(snapshot)
+		}
+
+		agent_mts, err := agentMetricTypes(snapshot, agent_stats, blkio_stats)
 		if err != nil {
 			log.Error(err)
 			return nil, err
 		}
+		metricTypes = append(metricTypes, agent_mts...)
+	}
 
-		for _, key := range agent_stats {
-			namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+	return metricTypes, nil
+}
+
+// Build the metric types for a Mesos master from its metrics snapshot.
+func masterMetricTypes(snapshot map[string]float64) ([]plugin.MetricType, error) {
+	metricTypes := []plugin.MetricType{}
+
+	for key, _ := range snapshot {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	framework_mts, err := master.GetFrameworksMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range framework_mts {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	offer_mts, err := master.GetOfferMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range offer_mts {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElement("offers").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	return metricTypes, nil
+}
+
+// Build the metric types for a Mesos agent from its metrics snapshot and the monitoring statistics that are available
+// for its executors, including the block I/O statistics that are reported per device.
+func agentMetricTypes(snapshot map[string]float64, statistics []string, blkio []string) ([]plugin.MetricType, error) {
+	metricTypes := []plugin.MetricType{}
+
+	for key, _ := range snapshot {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	for _, key := range statistics {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddDynamicElement("executor_id", "Executor ID").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	for _, key := range blkio {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddDynamicElement("executor_id", "Executor ID").
+			AddStaticElement("blkio").
+			AddDynamicElement("device", "Block device (major:minor), or \"total\" for all devices").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	metadata_mts, err := agent.GetMetadataMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range metadata_mts {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "agent", "meta").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	aggregate_mts, err := agent.GetAggregateMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range aggregate_mts {
+		for _, namespace := range []core.Namespace{
+			core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "total").
+				AddStaticElements(strings.Split(key, "/")...),
+			core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "framework").
 				AddDynamicElement("framework_id", "Framework ID").
-				AddDynamicElement("executor_id", "Executor ID").
-				AddStaticElements(strings.Split(key, "/")...)
+				AddStaticElements(strings.Split(key, "/")...),
+		} {
 			log.Debug("Adding metric to catalog: ", namespace.String())
-			metricTypes = append(metricTypes, plugin.MetricType{Namespace_: namespace})
+			metricTypes = append(metricTypes, newCatalogMetricType(namespace))
 		}
 	}
 
+	for _, namespace := range []core.Namespace{
+		core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "attributes").
+			AddDynamicElement("attribute", "Attribute name").
+			AddStaticElement("value"),
+		core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "resources").
+			AddDynamicElement("resource", "Resource name").
+			AddStaticElement("declared"),
+	} {
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
 	return metricTypes, nil
 }
 
@@ -138,6 +308,31 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 		return nil, err
 	}
 
+	labels, err := getLabelMapper(mts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := getSnapshotFilter(mts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	executorFilter, err := getExecutorFilter(mts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := getClusterConfig(mts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	agentFlapping, err := getAgentFlappingConfig(mts[0])
+	if err != nil {
+		return nil, err
+	}
+
 	requestedMaster := []core.Namespace{}
 	requestedAgent := []core.Namespace{}
 
@@ -174,16 +369,137 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 				return nil, err
 			}
 
-			tags := map[string]string{"source": configItems["master"]}
+			// Agents are only fetched if any cluster-wide metric or agent history was requested
+			var agents []*master.Agent
+			requestedGroups := map[string]bool{}
+			for _, requested := range requestedMaster {
+				requestedGroups[requested.Strings()[3]] = true
+			}
+			if requestedGroups["cluster"] || requestedGroups["agents"] {
+				agents, err = master.GetAgents(configItems["master"])
+				if err != nil {
+					log.Error(err)
+					return nil, err
+				}
+			}
+
+			// Querying every agent in the cluster is expensive, so only do it if any cluster-wide metric was requested
+			var usage *clusterUsage
+			if requestedGroups["cluster"] {
+				usage = getClusterUsage(agents, frameworks, cluster)
+			}
+
+			// Agents are tracked across collections to detect their transitions, see master.TrackAgents
+			var histories map[string]*master.AgentHistory
+			var flapping uint64
+			if requestedGroups["agents"] {
+				histories, flapping = master.TrackAgents(configItems["master"], agents, now, agentFlapping.window, agentFlapping.threshold)
+			}
+
+			// Collect the event stream once, so that all requested metrics describe the same point in time
+			var events map[string]uint64
+			for _, requested := range requestedMaster {
+				if requested.Strings()[3] == "events" {
+					events = master.GetEventStream(configItems["master"]).Collect()
+					break
+				}
+			}
+
+			// Tasks are only fetched if any metric computed from them was requested, since a busy master may know of many
+			var lifecycles map[string]*master.TaskLifecycle
+			var terminal map[string]map[string]uint64
+			var health map[string]*master.TaskHealth
+			for _, requested := range requestedMaster {
+				if len(requested) > 4 && taskMetricFamilies[requested.Strings()[4]] {
+					tasks, err := master.GetTasks(configItems["master"])
+					if err != nil {
+						log.Error(err)
+						return nil, err
+					}
+					lifecycles = master.TaskLifecycles(tasks)
+					terminal = master.TerminalTasks(tasks)
+					health = master.TasksHealth(tasks)
+					break
+				}
+			}
+
+			var quotas map[string]*master.RoleQuota
+			for _, requested := range requestedMaster {
+				if requested.Strings()[3] == "roles" {
+					quotas, err = master.GetRoleQuotas(configItems["master"])
+					if err != nil {
+						log.Error(err)
+						return nil, err
+					}
+					break
+				}
+			}
+
+			// Offers are remembered from one collection to the next to measure their age, so they're only tracked by
+			// collections that request offer metrics
+			var offers map[string]*master.OfferStats
+			for _, requested := range requestedMaster {
+				if len(requested) > 4 && requested.Strings()[4] == "offers" {
+					offers = master.GetOfferStats(configItems["master"], frameworks, now)
+					break
+				}
+			}
+
+			tags := sourceTags(configItems["master"])
 
 			for _, requested := range requestedMaster {
+				if requested.Strings()[3] == "cluster" {
+					metrics = append(metrics, collectClusterUsage(requested, usage, now, tags)...)
+					continue
+				}
+
+				if requested.Strings()[3] == "events" {
+					metric, err := collectEvents(requested, events, now, tags)
+					if err != nil {
+						return nil, err
+					}
+					metrics = append(metrics, metric)
+					continue
+				}
+
+				if requested.Strings()[3] == "agents" {
+					metrics = append(metrics, collectAgentHistory(requested, histories, flapping, agents, now, tags)...)
+					continue
+				}
+
+				if requested.Strings()[3] == "roles" {
+					metrics = append(metrics, collectRoleQuotas(requested, quotas, now, tags)...)
+					continue
+				}
+
 				isDynamic, _ := requested.IsDynamic()
 				if isDynamic {
 					n := requested.Strings()[4:]
 
+					if n[0] == "task_lifecycle" {
+						metrics = append(metrics, collectTaskLifecycle(requested, lifecycles, frameworks, labels, now, tags)...)
+						continue
+					}
+					if n[0] == "terminal_tasks" {
+						metrics = append(metrics, collectTerminalTasks(requested, terminal, frameworks, labels, now, tags)...)
+						continue
+					}
+					if n[0] == "task_health" {
+						metrics = append(metrics, collectTaskHealth(requested, health, frameworks, labels, now, tags)...)
+						continue
+					}
+
 					// Iterate through the array of frameworks returned by GetFrameworks()
 					for _, framework := range frameworks {
-						val := ns.GetValueByNamespace(framework, n)
+						if !matchesElement(requested[3], framework.ID) {
+							continue
+						}
+						var val interface{}
+						if n[0] == "offers" {
+							val = ns.GetValueByNamespace(offers[framework.ID], n[1:])
+						} else {
+							val = ns.GetValueByNamespace(framework, n)
+						}
 						if val == nil {
 							log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
 							continue
@@ -191,25 +507,33 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 						// substituting "framework" wildcard with particular framework id
 						rendered := cloneNamespace(requested)
 						rendered[3].Value = framework.ID
-						// TODO(roger): units
-						metrics = append(metrics, *plugin.NewMetricType(rendered, now, tags, "", val))
+						metrics = append(metrics, newMetric(rendered, now, labels.tags(tags, framework.Labels), val))
 
 					}
 				} else {
-					n := requested.Strings()[3:]
-					val, ok := snapshot[strings.Join(n, "/")]
+					key := strings.Join(requested.Strings()[3:], "/")
+					if !filter.matches(key) {
+						log.Debug("Skipping metric ", requested.String(), " excluded by the snapshot filter")
+						continue
+					}
+					val, ok := snapshot[key]
+					if !ok && timerFamily(key) != "" {
+						// Timers without any samples in their window don't report their statistics
+						log.Debug("Skipping metric ", requested.String(), " which hasn't been sampled")
+						continue
+					}
 					if !ok {
 						e := fmt.Errorf("error: requested metric %s not found", requested.String())
 						log.Error(e)
 						return nil, e
 					}
-					//TODO(kromar): is it possible to provide unit NewMetricType(ns, time, tags, unit, value)?
-					// I'm leaving empty string for now...
-					metrics = append(metrics, *plugin.NewMetricType(requested, now, tags, "", val))
+					metrics = append(metrics, newMetric(requested, now, snapshotTags(key, tags), val))
 				}
 			}
 		} else {
 			log.Info("Attempted CollectMetrics() on ", configItems["master"], "but it isn't the leader. Skipping...")
+			// Only the leader reports events, so stop listening to a master that lost the leadership
+			master.ReleaseEventStream(configItems["master"])
 		}
 	}
 
@@ -221,23 +545,73 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 			return nil, err
 		}
 
-		executors, err := agent.GetMonitoringStatistics(configItems["agent"])
+		// When names are normalized, both the legacy and canonical names of a metric are looked up and reported by the
+		// canonical name, so that tasks keep working when an agent is upgraded and its metrics are renamed
+		normalize, _ := getConfigBool(mts[0], "normalize_agent_names")
+		if normalize {
+			snapshot = normalizeAgentSnapshot(snapshot)
+		}
+
+		var executors []agent.Executor
+		if useContainers, _ := getConfigBool(mts[0], "use_containers_endpoint"); useContainers {
+			executors, err = agent.GetContainers(configItems["agent"])
+		} else {
+			executors, err = agent.GetMonitoringStatistics(configItems["agent"])
+		}
 		if err != nil {
 			log.Error(err)
 			return nil, err
 		}
 
-		tags := map[string]string{"source": configItems["agent"]}
+		requestedMetadata := false
+		for _, requested := range requestedAgent {
+			if requested.Strings()[3] == "meta" {
+				requestedMetadata = true
+				break
+			}
+		}
+
+		// Executor labels, framework names, and agent metadata are only available from the agent's state, so avoid
+		// fetching it unless any of them is needed
+		executorLabels := map[string]map[string][]*mesos_pb2.Label{}
+		var state *agent.State
+		var metadata *agent.Metadata
+		if labels.enabled() || requestedMetadata || executorFilter.needsState() {
+			state, err = agent.GetState(configItems["agent"])
+			if err != nil {
+				log.Error(err)
+				return nil, err
+			}
+			executorLabels = state.ExecutorLabels()
+			metadata = agent.GetMetadata(state)
+		}
+
+		// Aggregates are computed before the executor filter is applied, so that they always cover the whole agent
+		total, frameworkAggregates := agent.AggregateStatistics(executors)
+		executors = executorFilter.apply(executors, state)
+
+		tags := sourceTags(configItems["agent"])
+
+		// A task may request a metric by both its legacy and canonical names (e.g. using a wildcard), which are
+		// reported under the same namespace when names are normalized, so each namespace is only reported once
+		reported := map[string]bool{}
 
 		for _, requested := range requestedAgent {
+			if requested.Strings()[3] == "meta" {
+				metrics = append(metrics, collectAgentMetadata(requested, metadata, now, tags)...)
+				continue
+			}
+			if requested.Strings()[3] == "aggregate" {
+				metrics = append(metrics, collectAgentAggregate(requested, total, frameworkAggregates, now, tags)...)
+				continue
+			}
+
 			n := requested.Strings()[5:]
 			isDynamic, _ := requested.IsDynamic()
 			if isDynamic {
 				// Iterate through the array of executors returned by GetMonitoringStatistics()
 				for _, exec := range executors {
-					val := ns.GetValueByNamespace(exec.Statistics, n)
-					if val == nil {
-						log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+					if !matchesElement(requested[3], exec.Framework) || !matchesElement(requested[4], exec.ID) {
 						continue
 					}
 					rendered := cloneNamespace(requested)
@@ -245,22 +619,58 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 					rendered[3].Value = exec.Framework
 					// substituting "executor" wildcard with particular executor id
 					rendered[4].Value = exec.ID
-					// TODO(roger): units
-					metrics = append(metrics, *plugin.NewMetricType(rendered, now, tags, "", val))
+					execTags := labels.tags(tags, executorLabels[exec.Framework][exec.ID])
+					// pods and nested containers share an executor, so the container ID tells them apart
+					if exec.ContainerID != "" {
+						execTags["container_id"] = exec.ContainerID
+					}
+					if exec.ParentContainerID != "" {
+						execTags["parent_container_id"] = exec.ParentContainerID
+					}
+
+					if n[0] == "blkio" {
+						metrics = append(metrics, collectExecutorBlkio(rendered, agent.BlkioDevices(exec.Statistics), now, execTags)...)
+						continue
+					}
+
+					val := ns.GetValueByNamespace(exec.Statistics, n)
+					if val == nil {
+						log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+						continue
+					}
+					metrics = append(metrics, newMetric(rendered, now, execTags, val))
 
 				}
 			} else {
 				// Get requested metrics from the snapshot map
-				n := requested.Strings()[3:]
-				val, ok := snapshot[strings.Join(n, "/")]
+				key := strings.Join(requested.Strings()[3:], "/")
+				rendered := requested
+				if normalize {
+					key = canonicalAgentKey(key)
+					rendered = canonicalAgentNamespace(requested)
+				}
+				if reported[rendered.String()] {
+					log.Debug("Skipping metric ", requested.String(), " already reported as ", rendered.String())
+					continue
+				}
+				if !filter.matches(key) {
+					log.Debug("Skipping metric ", requested.String(), " excluded by the snapshot filter")
+					continue
+				}
+				val, ok := snapshot[key]
+				if !ok && timerFamily(key) != "" {
+					// Timers without any samples in their window don't report their statistics
+					log.Debug("Skipping metric ", requested.String(), " which hasn't been sampled")
+					continue
+				}
 				if !ok {
 					e := fmt.Errorf("error: requested metric %v not found", requested.String())
 					log.Error(e)
 					return nil, e
 				}
 
-				//TODO(kromar): units here also?
-				metrics = append(metrics, *plugin.NewMetricType(requested, now, tags, "", val))
+				metrics = append(metrics, newMetric(rendered, now, snapshotTags(key, tags), val))
+				reported[rendered.String()] = true
 			}
 		}
 	}
@@ -269,6 +679,114 @@ func (m *Mesos) CollectMetrics(mts []plugin.MetricType) ([]plugin.MetricType, er
 	return metrics, nil
 }
 
+// Collect a metric from the agent's metadata. The attributes and resources of the agent are requested using a
+// dynamic element for their name, so a single requested namespace may return more than one metric.
+func collectAgentMetadata(requested core.Namespace, metadata *agent.Metadata, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[4:]
+
+	isDynamic, _ := requested.IsDynamic()
+	if !isDynamic {
+		val := ns.GetValueByNamespace(metadata, n)
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			return metrics
+		}
+		return append(metrics, newMetric(requested, now, tags, val))
+	}
+
+	// Each value is also tagged with the name of its attribute or resource, so that it can be grouped by name
+	values := map[string]interface{}{}
+	var tag string
+	switch n[0] {
+	case "attributes":
+		values = metadata.Attributes
+		tag = "attribute"
+	case "resources":
+		for name, value := range metadata.Resources {
+			values[name] = value
+		}
+		tag = "resource"
+	}
+
+	for name, val := range values {
+		if !matchesElement(requested[5], name) {
+			continue
+		}
+		rendered := cloneNamespace(requested)
+		// substituting the attribute or resource wildcard with its name
+		rendered[5].Value = name
+
+		nameTags := make(map[string]string, len(tags)+1)
+		for k, v := range tags {
+			nameTags[k] = v
+		}
+		nameTags[tag] = name
+		metrics = append(metrics, newMetric(rendered, now, nameTags, val))
+	}
+	return metrics
+}
+
+// Collect a metric from the statistics aggregated across the executors on the agent. Per-framework aggregates are
+// requested using a dynamic element for the framework ID, so a single requested namespace may return more than one
+// metric.
+func collectAgentAggregate(requested core.Namespace, total *agent.Aggregate, frameworks map[string]*agent.Aggregate,
+	now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+
+	isDynamic, _ := requested.IsDynamic()
+	if !isDynamic {
+		val := ns.GetValueByNamespace(total, requested.Strings()[5:])
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			return metrics
+		}
+		return append(metrics, newMetric(requested, now, tags, val))
+	}
+
+	n := requested.Strings()[6:]
+	for id, aggregate := range frameworks {
+		if !matchesElement(requested[5], id) {
+			continue
+		}
+		val := ns.GetValueByNamespace(aggregate, n)
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			continue
+		}
+		rendered := cloneNamespace(requested)
+		// substituting "framework" wildcard with particular framework id
+		rendered[5].Value = id
+		metrics = append(metrics, newMetric(rendered, now, tags, val))
+	}
+	return metrics
+}
+
+// Collect a block I/O metric of an executor. Devices are requested using a dynamic element, so a single requested
+// namespace may return more than one metric. Not every device reports every statistic (e.g. the "discard" operation
+// depends on the kernel), so missing statistics are skipped quietly.
+func collectExecutorBlkio(requested core.Namespace, devices map[string]*agent.BlkioDevice, now time.Time,
+	tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[7:]
+
+	for name, device := range devices {
+		if !matchesElement(requested[6], name) {
+			continue
+		}
+		val := ns.GetValueByNamespace(device, n)
+		if val == nil {
+			log.Debug("Block I/O statistic ", strings.Join(n, "/"), " is not reported for device ", name)
+			continue
+		}
+		rendered := cloneNamespace(requested)
+		// substituting "device" wildcard with particular device
+		rendered[6].Value = name
+		metrics = append(metrics, newMetric(rendered, now, tags, val))
+	}
+	return metrics
+}
+
 func getConfig(cfg interface{}) (map[string]string, error) {
 	items := make(map[string]string)
 	var ok bool
@@ -303,6 +821,67 @@ func getConfig(cfg interface{}) (map[string]string, error) {
 	return items, nil
 }
 
+// Get an optional string item from the global config. Returns false if the item is missing, empty, or not a string.
+func getConfigString(cfg interface{}, name string) (string, bool) {
+	item, err := config.GetConfigItem(cfg, name)
+	if err != nil {
+		return "", false
+	}
+	s, ok := item.(string)
+	if !ok || s == "" {
+		return "", false
+	}
+	return s, true
+}
+
+// Get an optional boolean item from the global config. Returns false for the value if the item is missing or not a
+// boolean, and false for ok if the item is missing.
+func getConfigBool(cfg interface{}, name string) (bool, bool) {
+	item, err := config.GetConfigItem(cfg, name)
+	if err != nil {
+		return false, false
+	}
+	b, ok := item.(bool)
+	if !ok {
+		log.Warn("Expected a boolean for config item '", name, "', ignoring it")
+		return false, false
+	}
+	return b, true
+}
+
+// Get an optional integer item from the global config. Returns false for ok if the item is missing or not an integer.
+func getConfigInt(cfg interface{}, name string) (int, bool) {
+	item, err := config.GetConfigItem(cfg, name)
+	if err != nil {
+		return 0, false
+	}
+	i, ok := item.(int)
+	if !ok {
+		log.Warn("Expected an integer for config item '", name, "', ignoring it")
+		return 0, false
+	}
+	return i, true
+}
+
+// Build the tags that are common to every metric collected from a Mesos master or agent: its address and, if it can be
+// detected, the version of Mesos it runs.
+func sourceTags(host string) map[string]string {
+	tags := map[string]string{"source": host}
+	version, err := client.GetVersion(host)
+	if err != nil || version == "" {
+		log.Warn("Unable to detect the Mesos version of ", host, ", metrics won't be tagged with it")
+		return tags
+	}
+	tags["mesos_version"] = version
+	return tags
+}
+
+// Returns true if a dynamic element of a requested namespace matches the given value, i.e. if the task requested it
+// using a wildcard or by that particular value (e.g. a framework ID).
+func matchesElement(element core.NamespaceElement, value string) bool {
+	return element.Value == "*" || element.Value == "" || element.Value == value
+}
+
 func cloneNamespace(ns core.Namespace) core.Namespace {
 	nsCopy := make(core.Namespace, len(ns))
 	copy(nsCopy, ns)
@@ -257,7 +257,7 @@ func launchTasks(masterHost string, agentHost string) {
 		}
 		for _, exec := range executors {
 			if done[exec.ID] != true {
-				if exec.Statistics.DiskUsedBytes != nil {
+				if exec.Statistics != nil && exec.Statistics.GetDiskUsedBytes() > 0 {
 					done[exec.ID] = true
 				} else {
 					time.Sleep(1)
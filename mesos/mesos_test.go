@@ -20,9 +20,12 @@ package mesos
 
 import (
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
 	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
 	"github.com/intelsdi-x/snap/core/cdata"
 	"github.com/intelsdi-x/snap/core/ctypes"
 	. "github.com/smartystreets/goconvey/convey"
@@ -99,3 +102,151 @@ func TestMesos_getConfig(t *testing.T) {
 		})
 	})
 }
+
+func TestMesos_collectAgentMetadata(t *testing.T) {
+	metadata := &agent.Metadata{
+		Version:    "0.28.1",
+		Attributes: map[string]interface{}{"rack": "r1", "zone": 2.0},
+		Resources:  map[string]float64{"cpus": 4.0},
+	}
+	tags := map[string]string{"source": "mesos-agent.example.com:5051"}
+
+	Convey("Collect metrics from the agent metadata", t, func() {
+		Convey("Should collect a static metric", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "version")
+			metrics := collectAgentMetadata(requested, metadata, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, "0.28.1")
+		})
+
+		Convey("Should collect one metric per attribute", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "attributes").
+				AddDynamicElement("attribute", "Attribute name").
+				AddStaticElement("value")
+			metrics := collectAgentMetadata(requested, metadata, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 2)
+			for _, m := range metrics {
+				switch m.Namespace().Strings()[5] {
+				case "rack":
+					So(m.Data(), ShouldEqual, "r1")
+					So(m.Tags()["attribute"], ShouldEqual, "rack")
+				case "zone":
+					So(m.Data(), ShouldEqual, 2.0)
+				default:
+					t.Errorf("unexpected attribute %s", m.Namespace().String())
+				}
+			}
+		})
+
+		Convey("Should collect only the attribute that was requested by name", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "attributes").
+				AddDynamicElement("attribute", "Attribute name").
+				AddStaticElement("value")
+			requested[5].Value = "rack"
+			metrics := collectAgentMetadata(requested, metadata, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, "r1")
+		})
+
+		Convey("Should collect one metric per declared resource", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "meta", "resources").
+				AddDynamicElement("resource", "Resource name").
+				AddStaticElement("declared")
+			metrics := collectAgentMetadata(requested, metadata, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/agent/meta/resources/cpus/declared")
+			So(metrics[0].Data(), ShouldEqual, 4.0)
+			So(metrics[0].Tags(), ShouldResemble, map[string]string{
+				"source":   "mesos-agent.example.com:5051",
+				"resource": "cpus",
+			})
+			So(tags, ShouldNotContainKey, "resource")
+		})
+	})
+}
+
+func TestMesos_collectAgentAggregate(t *testing.T) {
+	total := &agent.Aggregate{Executors: 3, MemRssBytes: 600}
+	frameworks := map[string]*agent.Aggregate{
+		"frame1": {Executors: 2, MemRssBytes: 300},
+		"frame2": {Executors: 1, MemRssBytes: 300},
+	}
+	tags := map[string]string{"source": "mesos-agent.example.com:5051"}
+
+	Convey("Collect metrics from the aggregated statistics", t, func() {
+		Convey("Should collect the total for the agent", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "total", "executors")
+			metrics := collectAgentAggregate(requested, total, frameworks, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, 3)
+		})
+
+		Convey("Should collect one metric per framework", func() {
+			requested := core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "framework").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElement("mem_rss_bytes")
+			metrics := collectAgentAggregate(requested, total, frameworks, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 2)
+
+			requested[5].Value = "frame1"
+			metrics = collectAgentAggregate(requested, total, frameworks, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/agent/aggregate/framework/frame1/mem_rss_bytes")
+			So(metrics[0].Data(), ShouldEqual, 300)
+		})
+	})
+}
+
+func TestMesos_collectExecutorBlkio(t *testing.T) {
+	read := uint64(3)
+	devices := map[string]*agent.BlkioDevice{
+		"8:0":   {Cfq: &agent.BlkioCFQ{IoServiced: &agent.BlkioOperations{Read: &read}}},
+		"total": {Cfq: &agent.BlkioCFQ{}},
+	}
+	tags := map[string]string{"source": "mesos-agent.example.com:5051"}
+
+	Convey("Collect block I/O metrics of an executor", t, func() {
+		requested := core.NewNamespace(pluginVendor, pluginName, "agent").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddDynamicElement("executor_id", "Executor ID").
+			AddStaticElement("blkio").
+			AddDynamicElement("device", "Block device").
+			AddStaticElements("cfq", "io_serviced", "read")
+		requested[3].Value = "frame1"
+		requested[4].Value = "exec1"
+
+		Convey("Should only collect the devices that report the statistic", func() {
+			metrics := collectExecutorBlkio(requested, devices, time.Now(), tags)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/agent/frame1/exec1/blkio/8:0/cfq/io_serviced/read")
+			So(metrics[0].Data(), ShouldEqual, 3)
+		})
+
+		Convey("Should honor a specific device", func() {
+			requested[6].Value = "total"
+			So(len(collectExecutorBlkio(requested, devices, time.Now(), tags)), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestMesos_matchesElement(t *testing.T) {
+	Convey("Match a dynamic element of a requested namespace against an ID", t, func() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddDynamicElement("executor_id", "Executor ID").
+			AddStaticElement("mem_rss_bytes")
+
+		Convey("A wildcard should match any ID", func() {
+			So(matchesElement(namespace[3], "frame1"), ShouldBeTrue)
+			So(matchesElement(namespace[4], "exec1"), ShouldBeTrue)
+		})
+
+		Convey("A specific ID should only match itself", func() {
+			requested := cloneNamespace(namespace)
+			requested[3].Value = "frame1"
+			So(matchesElement(requested[3], "frame1"), ShouldBeTrue)
+			So(matchesElement(requested[3], "frame2"), ShouldBeFalse)
+			So(matchesElement(requested[4], "exec1"), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,11200 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1.proto
+
+It has these top-level messages:
+	FrameworkID
+	OfferID
+	AgentID
+	TaskID
+	ExecutorID
+	ContainerID
+	ResourceProviderID
+	OperationID
+	TimeInfo
+	DurationInfo
+	Address
+	URL
+	Unavailability
+	MachineID
+	MachineInfo
+	FrameworkInfo
+	CheckInfo
+	HealthCheck
+	KillPolicy
+	CommandInfo
+	ExecutorInfo
+	DomainInfo
+	MasterInfo
+	AgentInfo
+	CSIPluginContainerInfo
+	CSIPluginInfo
+	ResourceProviderInfo
+	Value
+	Attribute
+	Resource
+	TrafficControlStatistics
+	IpStatistics
+	IcmpStatistics
+	TcpStatistics
+	UdpStatistics
+	SNMPStatistics
+	DiskStatistics
+	ResourceStatistics
+	ResourceUsage
+	PerfStatistics
+	OfferFilters
+	Request
+	Offer
+	InverseOffer
+	TaskInfo
+	TaskGroupInfo
+	Task
+	TaskResourceLimitation
+	UUID
+	Operation
+	OperationStatus
+	CheckStatusInfo
+	TaskStatus
+	Filters
+	Environment
+	Parameter
+	Parameters
+	Credential
+	Credentials
+	Secret
+	RateLimit
+	RateLimits
+	Image
+	MountPropagation
+	Volume
+	NetworkInfo
+	CapabilityInfo
+	SeccompInfo
+	LinuxInfo
+	RLimitInfo
+	TTYInfo
+	ContainerInfo
+	ContainerStatus
+	CgroupInfo
+	Labels
+	Label
+	Port
+	Ports
+	DiscoveryInfo
+	WeightInfo
+	VersionInfo
+	Flag
+	Role
+	Metric
+	FileInfo
+	Device
+	DeviceAccess
+	DeviceWhitelist
+	DrainConfig
+	DrainInfo
+*/
+package mesos_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+// Status is used to indicate the state of the scheduler and executor
+// driver after function calls.
+type Status int32
+
+const (
+	Status_DRIVER_NOT_STARTED Status = 1
+	Status_DRIVER_RUNNING     Status = 2
+	Status_DRIVER_ABORTED     Status = 3
+	Status_DRIVER_STOPPED     Status = 4
+)
+
+var Status_name = map[int32]string{
+	1: "DRIVER_NOT_STARTED",
+	2: "DRIVER_RUNNING",
+	3: "DRIVER_ABORTED",
+	4: "DRIVER_STOPPED",
+}
+var Status_value = map[string]int32{
+	"DRIVER_NOT_STARTED": 1,
+	"DRIVER_RUNNING":     2,
+	"DRIVER_ABORTED":     3,
+	"DRIVER_STOPPED":     4,
+}
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+func (x *Status) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Status_value, data, "Status")
+	if err != nil {
+		return err
+	}
+	*x = Status(value)
+	return nil
+}
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Describes possible task states. IMPORTANT: Mesos assumes tasks that
+// enter terminal states (see below) imply the task is no longer
+// running and thus clean up any thing associated with the task
+// (ultimately offering any resources being consumed by that task to
+// another task).
+type TaskState int32
+
+const (
+	TaskState_TASK_STAGING  TaskState = 6
+	TaskState_TASK_STARTING TaskState = 0
+	TaskState_TASK_RUNNING  TaskState = 1
+	// NOTE: This should only be sent when the framework has
+	// the TASK_KILLING_STATE capability.
+	TaskState_TASK_KILLING TaskState = 8
+	// The task finished successfully on its own without external interference.
+	TaskState_TASK_FINISHED TaskState = 2
+	TaskState_TASK_FAILED   TaskState = 3
+	TaskState_TASK_KILLED   TaskState = 4
+	TaskState_TASK_ERROR    TaskState = 7
+	// In Mesos 1.3, this will only be sent when the framework does NOT
+	// opt-in to the PARTITION_AWARE capability.
+	//
+	// NOTE: This state is not always terminal. For example, tasks might
+	// transition from TASK_LOST to TASK_RUNNING or other states when a
+	// partitioned agent reregisters.
+	TaskState_TASK_LOST TaskState = 5
+	// The task failed to launch because of a transient error. The
+	// task's executor never started running. Unlike TASK_ERROR, the
+	// task description is valid -- attempting to launch the task again
+	// may be successful.
+	TaskState_TASK_DROPPED TaskState = 9
+	// The task was running on an agent that has lost contact with the
+	// master, typically due to a network failure or partition. The task
+	// may or may not still be running.
+	TaskState_TASK_UNREACHABLE TaskState = 10
+	// The task is no longer running. This can occur if the agent has
+	// been terminated along with all of its tasks (e.g., the host that
+	// was running the agent was rebooted). It might also occur if the
+	// task was terminated due to an agent or containerizer error, or if
+	// the task was preempted by the QoS controller in an
+	// oversubscription scenario.
+	TaskState_TASK_GONE TaskState = 11
+	// The task was running on an agent that the master cannot contact;
+	// the operator has asserted that the agent has been shutdown, but
+	// this has not been directly confirmed by the master. If the
+	// operator is correct, the task is not running and this is a
+	// terminal state; if the operator is mistaken, the task may still
+	// be running and might return to RUNNING in the future.
+	TaskState_TASK_GONE_BY_OPERATOR TaskState = 12
+	// The master has no knowledge of the task. This is typically
+	// because either (a) the master never had knowledge of the task, or
+	// (b) the master forgot about the task because it garbage collected
+	// its metadata about the task. The task may or may not still be
+	// running.
+	TaskState_TASK_UNKNOWN TaskState = 13
+)
+
+var TaskState_name = map[int32]string{
+	6:  "TASK_STAGING",
+	0:  "TASK_STARTING",
+	1:  "TASK_RUNNING",
+	8:  "TASK_KILLING",
+	2:  "TASK_FINISHED",
+	3:  "TASK_FAILED",
+	4:  "TASK_KILLED",
+	7:  "TASK_ERROR",
+	5:  "TASK_LOST",
+	9:  "TASK_DROPPED",
+	10: "TASK_UNREACHABLE",
+	11: "TASK_GONE",
+	12: "TASK_GONE_BY_OPERATOR",
+	13: "TASK_UNKNOWN",
+}
+var TaskState_value = map[string]int32{
+	"TASK_STAGING":          6,
+	"TASK_STARTING":         0,
+	"TASK_RUNNING":          1,
+	"TASK_KILLING":          8,
+	"TASK_FINISHED":         2,
+	"TASK_FAILED":           3,
+	"TASK_KILLED":           4,
+	"TASK_ERROR":            7,
+	"TASK_LOST":             5,
+	"TASK_DROPPED":          9,
+	"TASK_UNREACHABLE":      10,
+	"TASK_GONE":             11,
+	"TASK_GONE_BY_OPERATOR": 12,
+	"TASK_UNKNOWN":          13,
+}
+
+func (x TaskState) Enum() *TaskState {
+	p := new(TaskState)
+	*p = x
+	return p
+}
+func (x TaskState) String() string {
+	return proto.EnumName(TaskState_name, int32(x))
+}
+func (x *TaskState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TaskState_value, data, "TaskState")
+	if err != nil {
+		return err
+	}
+	*x = TaskState(value)
+	return nil
+}
+func (TaskState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// Describes possible operation states.
+type OperationState int32
+
+const (
+	// Default value if the enum is not set. See MESOS-4997.
+	OperationState_OPERATION_UNSUPPORTED OperationState = 0
+	// Initial state.
+	OperationState_OPERATION_PENDING OperationState = 1
+	// TERMINAL: The operation was successfully applied.
+	OperationState_OPERATION_FINISHED OperationState = 2
+	// TERMINAL: The operation failed to apply.
+	OperationState_OPERATION_FAILED OperationState = 3
+	// TERMINAL: The operation description contains an error.
+	OperationState_OPERATION_ERROR OperationState = 4
+	// TERMINAL: The operation was dropped due to a transient error.
+	OperationState_OPERATION_DROPPED OperationState = 5
+	// The operation affects an agent that has lost contact with the master,
+	// typically due to a network failure or partition. The operation may or may
+	// not still be pending.
+	OperationState_OPERATION_UNREACHABLE OperationState = 6
+	// The operation affected an agent that the master cannot contact;
+	// the operator has asserted that the agent has been shutdown, but this has
+	// not been directly confirmed by the master.
+	//
+	// If the operator is correct, the operation is not pending and this is a
+	// terminal state; if the operator is mistaken, the operation may still be
+	// pending and might return to a different state in the future.
+	OperationState_OPERATION_GONE_BY_OPERATOR OperationState = 7
+	// The operation affects an agent that the master recovered from its
+	// state, but that agent has not yet re-registered.
+	//
+	// The operation can transition to `OPERATION_UNREACHABLE` if the
+	// corresponding agent is marked as unreachable, and will transition to
+	// another status if the agent re-registers.
+	OperationState_OPERATION_RECOVERING OperationState = 8
+	// The master has no knowledge of the operation. This is typically
+	// because either (a) the master never had knowledge of the operation, or
+	// (b) the master forgot about the operation because it garbage collected
+	// its metadata about the operation. The operation may or may not still be
+	// pending.
+	OperationState_OPERATION_UNKNOWN OperationState = 9
+)
+
+var OperationState_name = map[int32]string{
+	0: "OPERATION_UNSUPPORTED",
+	1: "OPERATION_PENDING",
+	2: "OPERATION_FINISHED",
+	3: "OPERATION_FAILED",
+	4: "OPERATION_ERROR",
+	5: "OPERATION_DROPPED",
+	6: "OPERATION_UNREACHABLE",
+	7: "OPERATION_GONE_BY_OPERATOR",
+	8: "OPERATION_RECOVERING",
+	9: "OPERATION_UNKNOWN",
+}
+var OperationState_value = map[string]int32{
+	"OPERATION_UNSUPPORTED":      0,
+	"OPERATION_PENDING":          1,
+	"OPERATION_FINISHED":         2,
+	"OPERATION_FAILED":           3,
+	"OPERATION_ERROR":            4,
+	"OPERATION_DROPPED":          5,
+	"OPERATION_UNREACHABLE":      6,
+	"OPERATION_GONE_BY_OPERATOR": 7,
+	"OPERATION_RECOVERING":       8,
+	"OPERATION_UNKNOWN":          9,
+}
+
+func (x OperationState) Enum() *OperationState {
+	p := new(OperationState)
+	*p = x
+	return p
+}
+func (x OperationState) String() string {
+	return proto.EnumName(OperationState_name, int32(x))
+}
+func (x *OperationState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(OperationState_value, data, "OperationState")
+	if err != nil {
+		return err
+	}
+	*x = OperationState(value)
+	return nil
+}
+func (OperationState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type DrainState int32
+
+const (
+	DrainState_UNKNOWN DrainState = 0
+	// The agent is currently draining.
+	DrainState_DRAINING DrainState = 1
+	// The agent has been drained: all tasks have terminated, all terminal
+	// task status updates have been acknowledged by the frameworks, and all
+	// operations have finished and had their terminal updates acknowledged.
+	DrainState_DRAINED DrainState = 2
+)
+
+var DrainState_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DRAINING",
+	2: "DRAINED",
+}
+var DrainState_value = map[string]int32{
+	"UNKNOWN":  0,
+	"DRAINING": 1,
+	"DRAINED":  2,
+}
+
+func (x DrainState) Enum() *DrainState {
+	p := new(DrainState)
+	*p = x
+	return p
+}
+func (x DrainState) String() string {
+	return proto.EnumName(DrainState_name, int32(x))
+}
+func (x *DrainState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(DrainState_value, data, "DrainState")
+	if err != nil {
+		return err
+	}
+	*x = DrainState(value)
+	return nil
+}
+func (DrainState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+// Describes the several states that a machine can be in.  A `Mode`
+// applies to a machine and to all associated agents on the machine.
+type MachineInfo_Mode int32
+
+const (
+	// In this mode, a machine is behaving normally;
+	// offering resources, executing tasks, etc.
+	MachineInfo_UP MachineInfo_Mode = 1
+	// In this mode, all agents on the machine are expected to cooperate with
+	// frameworks to drain resources.  In general, draining is done ahead of
+	// a pending `unavailability`.  The resources should be drained so as to
+	// maximize utilization prior to the maintenance but without knowingly
+	// violating the frameworks' requirements.
+	MachineInfo_DRAINING MachineInfo_Mode = 2
+	// In this mode, a machine is not running any tasks and will not offer
+	// any of its resources.  Agents on the machine will not be allowed to
+	// register with the master.
+	MachineInfo_DOWN MachineInfo_Mode = 3
+)
+
+var MachineInfo_Mode_name = map[int32]string{
+	1: "UP",
+	2: "DRAINING",
+	3: "DOWN",
+}
+var MachineInfo_Mode_value = map[string]int32{
+	"UP":       1,
+	"DRAINING": 2,
+	"DOWN":     3,
+}
+
+func (x MachineInfo_Mode) Enum() *MachineInfo_Mode {
+	p := new(MachineInfo_Mode)
+	*p = x
+	return p
+}
+func (x MachineInfo_Mode) String() string {
+	return proto.EnumName(MachineInfo_Mode_name, int32(x))
+}
+func (x *MachineInfo_Mode) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(MachineInfo_Mode_value, data, "MachineInfo_Mode")
+	if err != nil {
+		return err
+	}
+	*x = MachineInfo_Mode(value)
+	return nil
+}
+func (MachineInfo_Mode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{14, 0} }
+
+type FrameworkInfo_Capability_Type int32
+
+const (
+	// This must be the first enum value in this list, to
+	// ensure that if 'type' is not set, the default value
+	// is UNKNOWN. This enables enum values to be added
+	// in a backwards-compatible way. See: MESOS-4997.
+	FrameworkInfo_Capability_UNKNOWN FrameworkInfo_Capability_Type = 0
+	// Receive offers with revocable resources. See 'Resource'
+	// message for details.
+	FrameworkInfo_Capability_REVOCABLE_RESOURCES FrameworkInfo_Capability_Type = 1
+	// Receive the TASK_KILLING TaskState when a task is being
+	// killed by an executor. The executor will examine this
+	// capability to determine whether it can send TASK_KILLING.
+	FrameworkInfo_Capability_TASK_KILLING_STATE FrameworkInfo_Capability_Type = 2
+	// Indicates whether the framework is aware of GPU resources.
+	// Frameworks that are aware of GPU resources are expected to
+	// avoid placing non-GPU workloads on GPU agents, in order
+	// to avoid occupying a GPU agent and preventing GPU workloads
+	// from running! Currently, if a framework is unaware of GPU
+	// resources, it will not be offered *any* of the resources on
+	// an agent with GPUs. This restriction is in place because we
+	// do not have a revocation mechanism that ensures GPU workloads
+	// can evict GPU agent occupants if necessary.
+	//
+	// TODO(bmahler): As we add revocation we can relax the
+	// restriction here. See MESOS-5634 for more information.
+	FrameworkInfo_Capability_GPU_RESOURCES FrameworkInfo_Capability_Type = 3
+	// Receive offers with resources that are shared.
+	FrameworkInfo_Capability_SHARED_RESOURCES FrameworkInfo_Capability_Type = 4
+	// Indicates that (1) the framework is prepared to handle the
+	// following TaskStates: TASK_UNREACHABLE, TASK_DROPPED,
+	// TASK_GONE, TASK_GONE_BY_OPERATOR, and TASK_UNKNOWN, and (2)
+	// the framework will assume responsibility for managing
+	// partitioned tasks that reregister with the master.
+	//
+	// Frameworks that enable this capability can define how they
+	// would like to handle partitioned tasks. Frameworks will
+	// receive TASK_UNREACHABLE for tasks on agents that are
+	// partitioned from the master.
+	//
+	// Without this capability, frameworks will receive TASK_LOST
+	// for tasks on partitioned agents.
+	// NOTE: Prior to Mesos 1.5, such tasks will be killed by Mesos
+	// when the agent reregisters (unless the master has failed over).
+	// However due to the lack of benefit in maintaining different
+	// behaviors depending on whether the master has failed over
+	// (see MESOS-7215), as of 1.5, Mesos will not kill these
+	// tasks in either case.
+	FrameworkInfo_Capability_PARTITION_AWARE FrameworkInfo_Capability_Type = 5
+	// This expresses the ability for the framework to be
+	// "multi-tenant" via using the newly introduced `roles`
+	// field, and examining `Offer.allocation_info` to determine
+	// which role the offers are being made to. We also
+	// expect that "single-tenant" schedulers eventually
+	// provide this and move away from the deprecated
+	// `role` field.
+	FrameworkInfo_Capability_MULTI_ROLE FrameworkInfo_Capability_Type = 6
+	// This capability has two effects for a framework.
+	//
+	// (1) The framework is offered resources in a new format.
+	//
+	//     The offered resources have the `Resource.reservations` field set
+	//     rather than `Resource.role` and `Resource.reservation`. In short,
+	//     an empty `reservations` field denotes unreserved resources, and
+	//     each `ReservationInfo` in the `reservations` field denotes a
+	//     reservation that refines the previous one.
+	//
+	//     See the 'Resource Format' section for more details.
+	//
+	// (2) The framework can create refined reservations.
+	//
+	//     A framework can refine an existing reservation via the
+	//     `Resource.reservations` field. For example, a reservation for role
+	//     `eng` can be refined to `eng/front_end`.
+	//
+	//     See `ReservationInfo.reservations` for more details.
+	//
+	// NOTE: Without this capability, a framework is not offered resources
+	// that have refined reservations. A resource is said to have refined
+	// reservations if it uses the `Resource.reservations` field, and
+	// `Resource.reservations_size() > 1`.
+	FrameworkInfo_Capability_RESERVATION_REFINEMENT FrameworkInfo_Capability_Type = 7
+	// Indicates that the framework is prepared to receive offers
+	// for agents whose region is different from the master's
+	// region. Network links between hosts in different regions
+	// typically have higher latency and lower bandwidth than
+	// network links within a region, so frameworks should be
+	// careful to only place suitable workloads in remote regions.
+	// Frameworks that are not region-aware will never receive
+	// offers for remote agents; region-aware frameworks are assumed
+	// to implement their own logic to decide which workloads (if
+	// any) are suitable for placement on remote agents.
+	FrameworkInfo_Capability_REGION_AWARE FrameworkInfo_Capability_Type = 8
+)
+
+var FrameworkInfo_Capability_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "REVOCABLE_RESOURCES",
+	2: "TASK_KILLING_STATE",
+	3: "GPU_RESOURCES",
+	4: "SHARED_RESOURCES",
+	5: "PARTITION_AWARE",
+	6: "MULTI_ROLE",
+	7: "RESERVATION_REFINEMENT",
+	8: "REGION_AWARE",
+}
+var FrameworkInfo_Capability_Type_value = map[string]int32{
+	"UNKNOWN":                0,
+	"REVOCABLE_RESOURCES":    1,
+	"TASK_KILLING_STATE":     2,
+	"GPU_RESOURCES":          3,
+	"SHARED_RESOURCES":       4,
+	"PARTITION_AWARE":        5,
+	"MULTI_ROLE":             6,
+	"RESERVATION_REFINEMENT": 7,
+	"REGION_AWARE":           8,
+}
+
+func (x FrameworkInfo_Capability_Type) Enum() *FrameworkInfo_Capability_Type {
+	p := new(FrameworkInfo_Capability_Type)
+	*p = x
+	return p
+}
+func (x FrameworkInfo_Capability_Type) String() string {
+	return proto.EnumName(FrameworkInfo_Capability_Type_name, int32(x))
+}
+func (x *FrameworkInfo_Capability_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FrameworkInfo_Capability_Type_value, data, "FrameworkInfo_Capability_Type")
+	if err != nil {
+		return err
+	}
+	*x = FrameworkInfo_Capability_Type(value)
+	return nil
+}
+func (FrameworkInfo_Capability_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{15, 0, 0}
+}
+
+type CheckInfo_Type int32
+
+const (
+	CheckInfo_UNKNOWN CheckInfo_Type = 0
+	CheckInfo_COMMAND CheckInfo_Type = 1
+	CheckInfo_HTTP    CheckInfo_Type = 2
+	CheckInfo_TCP     CheckInfo_Type = 3
+)
+
+var CheckInfo_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "COMMAND",
+	2: "HTTP",
+	3: "TCP",
+}
+var CheckInfo_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"COMMAND": 1,
+	"HTTP":    2,
+	"TCP":     3,
+}
+
+func (x CheckInfo_Type) Enum() *CheckInfo_Type {
+	p := new(CheckInfo_Type)
+	*p = x
+	return p
+}
+func (x CheckInfo_Type) String() string {
+	return proto.EnumName(CheckInfo_Type_name, int32(x))
+}
+func (x *CheckInfo_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CheckInfo_Type_value, data, "CheckInfo_Type")
+	if err != nil {
+		return err
+	}
+	*x = CheckInfo_Type(value)
+	return nil
+}
+func (CheckInfo_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 0} }
+
+type HealthCheck_Type int32
+
+const (
+	HealthCheck_UNKNOWN HealthCheck_Type = 0
+	HealthCheck_COMMAND HealthCheck_Type = 1
+	HealthCheck_HTTP    HealthCheck_Type = 2
+	HealthCheck_TCP     HealthCheck_Type = 3
+)
+
+var HealthCheck_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "COMMAND",
+	2: "HTTP",
+	3: "TCP",
+}
+var HealthCheck_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"COMMAND": 1,
+	"HTTP":    2,
+	"TCP":     3,
+}
+
+func (x HealthCheck_Type) Enum() *HealthCheck_Type {
+	p := new(HealthCheck_Type)
+	*p = x
+	return p
+}
+func (x HealthCheck_Type) String() string {
+	return proto.EnumName(HealthCheck_Type_name, int32(x))
+}
+func (x *HealthCheck_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(HealthCheck_Type_value, data, "HealthCheck_Type")
+	if err != nil {
+		return err
+	}
+	*x = HealthCheck_Type(value)
+	return nil
+}
+func (HealthCheck_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 0} }
+
+type ExecutorInfo_Type int32
+
+const (
+	ExecutorInfo_UNKNOWN ExecutorInfo_Type = 0
+	// Mesos provides a simple built-in default executor that frameworks can
+	// leverage to run shell commands and containers.
+	//
+	// NOTES:
+	//
+	// 1) `command` must not be set when using a default executor.
+	//
+	// 2) Default executor only accepts a *single* `LAUNCH` or `LAUNCH_GROUP`
+	//    operation.
+	//
+	// 3) If `container` is set, `container.type` must be `MESOS`
+	//    and `container.mesos.image` must not be set.
+	ExecutorInfo_DEFAULT ExecutorInfo_Type = 1
+	// For frameworks that need custom functionality to run tasks, a `CUSTOM`
+	// executor can be used. Note that `command` must be set when using a
+	// `CUSTOM` executor.
+	ExecutorInfo_CUSTOM ExecutorInfo_Type = 2
+)
+
+var ExecutorInfo_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DEFAULT",
+	2: "CUSTOM",
+}
+var ExecutorInfo_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"DEFAULT": 1,
+	"CUSTOM":  2,
+}
+
+func (x ExecutorInfo_Type) Enum() *ExecutorInfo_Type {
+	p := new(ExecutorInfo_Type)
+	*p = x
+	return p
+}
+func (x ExecutorInfo_Type) String() string {
+	return proto.EnumName(ExecutorInfo_Type_name, int32(x))
+}
+func (x *ExecutorInfo_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ExecutorInfo_Type_value, data, "ExecutorInfo_Type")
+	if err != nil {
+		return err
+	}
+	*x = ExecutorInfo_Type(value)
+	return nil
+}
+func (ExecutorInfo_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{20, 0} }
+
+type MasterInfo_Capability_Type int32
+
+const (
+	MasterInfo_Capability_UNKNOWN MasterInfo_Capability_Type = 0
+	// The master can handle slaves whose state
+	// changes after reregistering.
+	MasterInfo_Capability_AGENT_UPDATE MasterInfo_Capability_Type = 1
+	// The master can drain or deactivate agents when requested
+	// via operator APIs.
+	MasterInfo_Capability_AGENT_DRAINING MasterInfo_Capability_Type = 2
+)
+
+var MasterInfo_Capability_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "AGENT_UPDATE",
+	2: "AGENT_DRAINING",
+}
+var MasterInfo_Capability_Type_value = map[string]int32{
+	"UNKNOWN":        0,
+	"AGENT_UPDATE":   1,
+	"AGENT_DRAINING": 2,
+}
+
+func (x MasterInfo_Capability_Type) Enum() *MasterInfo_Capability_Type {
+	p := new(MasterInfo_Capability_Type)
+	*p = x
+	return p
+}
+func (x MasterInfo_Capability_Type) String() string {
+	return proto.EnumName(MasterInfo_Capability_Type_name, int32(x))
+}
+func (x *MasterInfo_Capability_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(MasterInfo_Capability_Type_value, data, "MasterInfo_Capability_Type")
+	if err != nil {
+		return err
+	}
+	*x = MasterInfo_Capability_Type(value)
+	return nil
+}
+func (MasterInfo_Capability_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{22, 0, 0}
+}
+
+type AgentInfo_Capability_Type int32
+
+const (
+	// This must be the first enum value in this list, to
+	// ensure that if 'type' is not set, the default value
+	// is UNKNOWN. This enables enum values to be added
+	// in a backwards-compatible way. See: MESOS-4997.
+	AgentInfo_Capability_UNKNOWN AgentInfo_Capability_Type = 0
+	// This expresses the ability for the agent to be able
+	// to launch tasks of a 'multi-role' framework.
+	AgentInfo_Capability_MULTI_ROLE AgentInfo_Capability_Type = 1
+	// This expresses the ability for the agent to be able to launch
+	// tasks, reserve resources, and create volumes using resources
+	// allocated to a 'hierarchical-role'.
+	// NOTE: This capability is required specifically for creating
+	// volumes because a hierchical role includes '/' (slashes) in them.
+	// Agents with this capability know to transform the '/' (slashes)
+	// into ' ' (spaces).
+	AgentInfo_Capability_HIERARCHICAL_ROLE AgentInfo_Capability_Type = 2
+	// This capability has three effects for an agent.
+	//
+	// (1) The format of the checkpointed resources, and
+	//     the resources reported to master.
+	//
+	//     These resources are reported in the "pre-reservation-refinement"
+	//     format if none of the resources have refined reservations. If any
+	//     of the resources have refined reservations, they are reported in
+	//     the "post-reservation-refinement" format. The purpose is to allow
+	//     downgrading of an agent as well as communication with a pre-1.4.0
+	//     master until the reservation refinement feature is actually used.
+	//
+	//     See the 'Resource Format' section for more details.
+	//
+	// (2) The format of the resources reported by the HTTP endpoints.
+	//
+	//     For resources reported by agent endpoints, the
+	//     "pre-reservation-refinement" format is "injected" if possible.
+	//     That is, resources without refined reservations will have the
+	//     `Resource.role` and `Resource.reservation` set, whereas
+	//     resources with refined reservations will not.
+	//
+	//     See the 'Resource Format' section for more details.
+	//
+	// (3) The ability for the agent to launch tasks, reserve resources, and
+	//     create volumes using resources that have refined reservations.
+	//
+	//     See `ReservationInfo.reservations` section for more details.
+	//
+	// NOTE: Resources are said to have refined reservations if it uses the
+	// `Resource.reservations` field, and `Resource.reservations_size() > 1`.
+	AgentInfo_Capability_RESERVATION_REFINEMENT AgentInfo_Capability_Type = 3
+	// This expresses the ability for the agent to handle resource
+	// provider related operations. This includes the following:
+	//
+	// (1) The ability to report resources that are provided by some
+	//     local resource providers through the resource provider API.
+	//
+	// (2) The ability to provide operation feedback. This also means
+	//     that this capability is a prerequisite for full support of
+	//     feedback for operations on agent default resources. If an
+	//     agent has the mandatory AGENT_OPERATION_FEEDBACK capability
+	//     set but not the RESOURCE_PROVIDER capability, then
+	//     operations on agent default resources which request feedback
+	//     will not be allowed.
+	AgentInfo_Capability_RESOURCE_PROVIDER AgentInfo_Capability_Type = 4
+	// This expresses the capability for the agent to handle persistent volume
+	// resize operations safely. This capability is turned on by default.
+	AgentInfo_Capability_RESIZE_VOLUME AgentInfo_Capability_Type = 5
+	// This expresses the ability of the agent to handle operation feedback
+	// for operations on agent default resources.
+	//
+	// Note that full support for this feature also requires the
+	// RESOURCE_PROVIDER capability; if you would like the agent to
+	// handle feedback for operations on agent default resources, the
+	// RESOURCE_PROVIDER capability should be set as well.
+	AgentInfo_Capability_AGENT_OPERATION_FEEDBACK AgentInfo_Capability_Type = 6
+	// This expresses the ability for the agent to automatically drain tasks
+	// in preparation for operator maintenance. This capability is required.
+	AgentInfo_Capability_AGENT_DRAINING AgentInfo_Capability_Type = 7
+	// This expresses the ability for the agent to launch tasks which specify
+	// resource limits for CPU and/or memory.
+	AgentInfo_Capability_TASK_RESOURCE_LIMITS AgentInfo_Capability_Type = 8
+)
+
+var AgentInfo_Capability_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "MULTI_ROLE",
+	2: "HIERARCHICAL_ROLE",
+	3: "RESERVATION_REFINEMENT",
+	4: "RESOURCE_PROVIDER",
+	5: "RESIZE_VOLUME",
+	6: "AGENT_OPERATION_FEEDBACK",
+	7: "AGENT_DRAINING",
+	8: "TASK_RESOURCE_LIMITS",
+}
+var AgentInfo_Capability_Type_value = map[string]int32{
+	"UNKNOWN":                  0,
+	"MULTI_ROLE":               1,
+	"HIERARCHICAL_ROLE":        2,
+	"RESERVATION_REFINEMENT":   3,
+	"RESOURCE_PROVIDER":        4,
+	"RESIZE_VOLUME":            5,
+	"AGENT_OPERATION_FEEDBACK": 6,
+	"AGENT_DRAINING":           7,
+	"TASK_RESOURCE_LIMITS":     8,
+}
+
+func (x AgentInfo_Capability_Type) Enum() *AgentInfo_Capability_Type {
+	p := new(AgentInfo_Capability_Type)
+	*p = x
+	return p
+}
+func (x AgentInfo_Capability_Type) String() string {
+	return proto.EnumName(AgentInfo_Capability_Type_name, int32(x))
+}
+func (x *AgentInfo_Capability_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(AgentInfo_Capability_Type_value, data, "AgentInfo_Capability_Type")
+	if err != nil {
+		return err
+	}
+	*x = AgentInfo_Capability_Type(value)
+	return nil
+}
+func (AgentInfo_Capability_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{23, 0, 0}
+}
+
+type CSIPluginContainerInfo_Service int32
+
+const (
+	CSIPluginContainerInfo_UNKNOWN            CSIPluginContainerInfo_Service = 0
+	CSIPluginContainerInfo_CONTROLLER_SERVICE CSIPluginContainerInfo_Service = 1
+	CSIPluginContainerInfo_NODE_SERVICE       CSIPluginContainerInfo_Service = 2
+)
+
+var CSIPluginContainerInfo_Service_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CONTROLLER_SERVICE",
+	2: "NODE_SERVICE",
+}
+var CSIPluginContainerInfo_Service_value = map[string]int32{
+	"UNKNOWN":            0,
+	"CONTROLLER_SERVICE": 1,
+	"NODE_SERVICE":       2,
+}
+
+func (x CSIPluginContainerInfo_Service) Enum() *CSIPluginContainerInfo_Service {
+	p := new(CSIPluginContainerInfo_Service)
+	*p = x
+	return p
+}
+func (x CSIPluginContainerInfo_Service) String() string {
+	return proto.EnumName(CSIPluginContainerInfo_Service_name, int32(x))
+}
+func (x *CSIPluginContainerInfo_Service) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CSIPluginContainerInfo_Service_value, data, "CSIPluginContainerInfo_Service")
+	if err != nil {
+		return err
+	}
+	*x = CSIPluginContainerInfo_Service(value)
+	return nil
+}
+func (CSIPluginContainerInfo_Service) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{24, 0}
+}
+
+type Value_Type int32
+
+const (
+	Value_SCALAR Value_Type = 0
+	Value_RANGES Value_Type = 1
+	Value_SET    Value_Type = 2
+	Value_TEXT   Value_Type = 3
+)
+
+var Value_Type_name = map[int32]string{
+	0: "SCALAR",
+	1: "RANGES",
+	2: "SET",
+	3: "TEXT",
+}
+var Value_Type_value = map[string]int32{
+	"SCALAR": 0,
+	"RANGES": 1,
+	"SET":    2,
+	"TEXT":   3,
+}
+
+func (x Value_Type) Enum() *Value_Type {
+	p := new(Value_Type)
+	*p = x
+	return p
+}
+func (x Value_Type) String() string {
+	return proto.EnumName(Value_Type_name, int32(x))
+}
+func (x *Value_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Value_Type_value, data, "Value_Type")
+	if err != nil {
+		return err
+	}
+	*x = Value_Type(value)
+	return nil
+}
+func (Value_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 0} }
+
+type Resource_ReservationInfo_Type int32
+
+const (
+	Resource_ReservationInfo_UNKNOWN Resource_ReservationInfo_Type = 0
+	Resource_ReservationInfo_STATIC  Resource_ReservationInfo_Type = 1
+	Resource_ReservationInfo_DYNAMIC Resource_ReservationInfo_Type = 2
+)
+
+var Resource_ReservationInfo_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "STATIC",
+	2: "DYNAMIC",
+}
+var Resource_ReservationInfo_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"STATIC":  1,
+	"DYNAMIC": 2,
+}
+
+func (x Resource_ReservationInfo_Type) Enum() *Resource_ReservationInfo_Type {
+	p := new(Resource_ReservationInfo_Type)
+	*p = x
+	return p
+}
+func (x Resource_ReservationInfo_Type) String() string {
+	return proto.EnumName(Resource_ReservationInfo_Type_name, int32(x))
+}
+func (x *Resource_ReservationInfo_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Resource_ReservationInfo_Type_value, data, "Resource_ReservationInfo_Type")
+	if err != nil {
+		return err
+	}
+	*x = Resource_ReservationInfo_Type(value)
+	return nil
+}
+func (Resource_ReservationInfo_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 1, 0}
+}
+
+type Resource_DiskInfo_Source_Type int32
+
+const (
+	Resource_DiskInfo_Source_UNKNOWN Resource_DiskInfo_Source_Type = 0
+	Resource_DiskInfo_Source_PATH    Resource_DiskInfo_Source_Type = 1
+	Resource_DiskInfo_Source_MOUNT   Resource_DiskInfo_Source_Type = 2
+	Resource_DiskInfo_Source_BLOCK   Resource_DiskInfo_Source_Type = 3
+	Resource_DiskInfo_Source_RAW     Resource_DiskInfo_Source_Type = 4
+)
+
+var Resource_DiskInfo_Source_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PATH",
+	2: "MOUNT",
+	3: "BLOCK",
+	4: "RAW",
+}
+var Resource_DiskInfo_Source_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"PATH":    1,
+	"MOUNT":   2,
+	"BLOCK":   3,
+	"RAW":     4,
+}
+
+func (x Resource_DiskInfo_Source_Type) Enum() *Resource_DiskInfo_Source_Type {
+	p := new(Resource_DiskInfo_Source_Type)
+	*p = x
+	return p
+}
+func (x Resource_DiskInfo_Source_Type) String() string {
+	return proto.EnumName(Resource_DiskInfo_Source_Type_name, int32(x))
+}
+func (x *Resource_DiskInfo_Source_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Resource_DiskInfo_Source_Type_value, data, "Resource_DiskInfo_Source_Type")
+	if err != nil {
+		return err
+	}
+	*x = Resource_DiskInfo_Source_Type(value)
+	return nil
+}
+func (Resource_DiskInfo_Source_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 2, 1, 0}
+}
+
+type Offer_Operation_Type int32
+
+const (
+	Offer_Operation_UNKNOWN       Offer_Operation_Type = 0
+	Offer_Operation_LAUNCH        Offer_Operation_Type = 1
+	Offer_Operation_LAUNCH_GROUP  Offer_Operation_Type = 6
+	Offer_Operation_RESERVE       Offer_Operation_Type = 2
+	Offer_Operation_UNRESERVE     Offer_Operation_Type = 3
+	Offer_Operation_CREATE        Offer_Operation_Type = 4
+	Offer_Operation_DESTROY       Offer_Operation_Type = 5
+	Offer_Operation_GROW_VOLUME   Offer_Operation_Type = 11
+	Offer_Operation_SHRINK_VOLUME Offer_Operation_Type = 12
+	Offer_Operation_CREATE_DISK   Offer_Operation_Type = 13
+	Offer_Operation_DESTROY_DISK  Offer_Operation_Type = 14
+)
+
+var Offer_Operation_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "LAUNCH",
+	6:  "LAUNCH_GROUP",
+	2:  "RESERVE",
+	3:  "UNRESERVE",
+	4:  "CREATE",
+	5:  "DESTROY",
+	11: "GROW_VOLUME",
+	12: "SHRINK_VOLUME",
+	13: "CREATE_DISK",
+	14: "DESTROY_DISK",
+}
+var Offer_Operation_Type_value = map[string]int32{
+	"UNKNOWN":       0,
+	"LAUNCH":        1,
+	"LAUNCH_GROUP":  6,
+	"RESERVE":       2,
+	"UNRESERVE":     3,
+	"CREATE":        4,
+	"DESTROY":       5,
+	"GROW_VOLUME":   11,
+	"SHRINK_VOLUME": 12,
+	"CREATE_DISK":   13,
+	"DESTROY_DISK":  14,
+}
+
+func (x Offer_Operation_Type) Enum() *Offer_Operation_Type {
+	p := new(Offer_Operation_Type)
+	*p = x
+	return p
+}
+func (x Offer_Operation_Type) String() string {
+	return proto.EnumName(Offer_Operation_Type_name, int32(x))
+}
+func (x *Offer_Operation_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Offer_Operation_Type_value, data, "Offer_Operation_Type")
+	if err != nil {
+		return err
+	}
+	*x = Offer_Operation_Type(value)
+	return nil
+}
+func (Offer_Operation_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0, 0} }
+
+// Describes the source of the task status update.
+type TaskStatus_Source int32
+
+const (
+	TaskStatus_SOURCE_MASTER   TaskStatus_Source = 0
+	TaskStatus_SOURCE_AGENT    TaskStatus_Source = 1
+	TaskStatus_SOURCE_EXECUTOR TaskStatus_Source = 2
+)
+
+var TaskStatus_Source_name = map[int32]string{
+	0: "SOURCE_MASTER",
+	1: "SOURCE_AGENT",
+	2: "SOURCE_EXECUTOR",
+}
+var TaskStatus_Source_value = map[string]int32{
+	"SOURCE_MASTER":   0,
+	"SOURCE_AGENT":    1,
+	"SOURCE_EXECUTOR": 2,
+}
+
+func (x TaskStatus_Source) Enum() *TaskStatus_Source {
+	p := new(TaskStatus_Source)
+	*p = x
+	return p
+}
+func (x TaskStatus_Source) String() string {
+	return proto.EnumName(TaskStatus_Source_name, int32(x))
+}
+func (x *TaskStatus_Source) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TaskStatus_Source_value, data, "TaskStatus_Source")
+	if err != nil {
+		return err
+	}
+	*x = TaskStatus_Source(value)
+	return nil
+}
+func (TaskStatus_Source) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{52, 0} }
+
+// Detailed reason for the task status update.
+// Refer to docs/task-state-reasons.md for additional explanation.
+type TaskStatus_Reason int32
+
+const (
+	// TODO(jieyu): The default value when a caller doesn't check for
+	// presence is 0 and so ideally the 0 reason is not a valid one.
+	// Since this is not used anywhere, consider removing this reason.
+	TaskStatus_REASON_COMMAND_EXECUTOR_FAILED          TaskStatus_Reason = 0
+	TaskStatus_REASON_CONTAINER_LAUNCH_FAILED          TaskStatus_Reason = 21
+	TaskStatus_REASON_CONTAINER_LIMITATION             TaskStatus_Reason = 19
+	TaskStatus_REASON_CONTAINER_LIMITATION_DISK        TaskStatus_Reason = 20
+	TaskStatus_REASON_CONTAINER_LIMITATION_MEMORY      TaskStatus_Reason = 8
+	TaskStatus_REASON_CONTAINER_PREEMPTED              TaskStatus_Reason = 17
+	TaskStatus_REASON_CONTAINER_UPDATE_FAILED          TaskStatus_Reason = 22
+	TaskStatus_REASON_MAX_COMPLETION_TIME_REACHED      TaskStatus_Reason = 33
+	TaskStatus_REASON_EXECUTOR_REGISTRATION_TIMEOUT    TaskStatus_Reason = 23
+	TaskStatus_REASON_EXECUTOR_REREGISTRATION_TIMEOUT  TaskStatus_Reason = 24
+	TaskStatus_REASON_EXECUTOR_TERMINATED              TaskStatus_Reason = 1
+	TaskStatus_REASON_EXECUTOR_UNREGISTERED            TaskStatus_Reason = 2
+	TaskStatus_REASON_FRAMEWORK_REMOVED                TaskStatus_Reason = 3
+	TaskStatus_REASON_GC_ERROR                         TaskStatus_Reason = 4
+	TaskStatus_REASON_INVALID_FRAMEWORKID              TaskStatus_Reason = 5
+	TaskStatus_REASON_INVALID_OFFERS                   TaskStatus_Reason = 6
+	TaskStatus_REASON_IO_SWITCHBOARD_EXITED            TaskStatus_Reason = 27
+	TaskStatus_REASON_MASTER_DISCONNECTED              TaskStatus_Reason = 7
+	TaskStatus_REASON_RECONCILIATION                   TaskStatus_Reason = 9
+	TaskStatus_REASON_RESOURCES_UNKNOWN                TaskStatus_Reason = 18
+	TaskStatus_REASON_AGENT_DISCONNECTED               TaskStatus_Reason = 10
+	TaskStatus_REASON_AGENT_DRAINING                   TaskStatus_Reason = 34
+	TaskStatus_REASON_AGENT_REMOVED                    TaskStatus_Reason = 11
+	TaskStatus_REASON_AGENT_REMOVED_BY_OPERATOR        TaskStatus_Reason = 31
+	TaskStatus_REASON_AGENT_REREGISTERED               TaskStatus_Reason = 32
+	TaskStatus_REASON_AGENT_RESTARTED                  TaskStatus_Reason = 12
+	TaskStatus_REASON_AGENT_UNKNOWN                    TaskStatus_Reason = 13
+	TaskStatus_REASON_TASK_KILLED_DURING_LAUNCH        TaskStatus_Reason = 30
+	TaskStatus_REASON_TASK_CHECK_STATUS_UPDATED        TaskStatus_Reason = 28
+	TaskStatus_REASON_TASK_HEALTH_CHECK_STATUS_UPDATED TaskStatus_Reason = 29
+	TaskStatus_REASON_TASK_GROUP_INVALID               TaskStatus_Reason = 25
+	TaskStatus_REASON_TASK_GROUP_UNAUTHORIZED          TaskStatus_Reason = 26
+	TaskStatus_REASON_TASK_INVALID                     TaskStatus_Reason = 14
+	TaskStatus_REASON_TASK_UNAUTHORIZED                TaskStatus_Reason = 15
+	TaskStatus_REASON_TASK_UNKNOWN                     TaskStatus_Reason = 16
+)
+
+var TaskStatus_Reason_name = map[int32]string{
+	0:  "REASON_COMMAND_EXECUTOR_FAILED",
+	21: "REASON_CONTAINER_LAUNCH_FAILED",
+	19: "REASON_CONTAINER_LIMITATION",
+	20: "REASON_CONTAINER_LIMITATION_DISK",
+	8:  "REASON_CONTAINER_LIMITATION_MEMORY",
+	17: "REASON_CONTAINER_PREEMPTED",
+	22: "REASON_CONTAINER_UPDATE_FAILED",
+	33: "REASON_MAX_COMPLETION_TIME_REACHED",
+	23: "REASON_EXECUTOR_REGISTRATION_TIMEOUT",
+	24: "REASON_EXECUTOR_REREGISTRATION_TIMEOUT",
+	1:  "REASON_EXECUTOR_TERMINATED",
+	2:  "REASON_EXECUTOR_UNREGISTERED",
+	3:  "REASON_FRAMEWORK_REMOVED",
+	4:  "REASON_GC_ERROR",
+	5:  "REASON_INVALID_FRAMEWORKID",
+	6:  "REASON_INVALID_OFFERS",
+	27: "REASON_IO_SWITCHBOARD_EXITED",
+	7:  "REASON_MASTER_DISCONNECTED",
+	9:  "REASON_RECONCILIATION",
+	18: "REASON_RESOURCES_UNKNOWN",
+	10: "REASON_AGENT_DISCONNECTED",
+	34: "REASON_AGENT_DRAINING",
+	11: "REASON_AGENT_REMOVED",
+	31: "REASON_AGENT_REMOVED_BY_OPERATOR",
+	32: "REASON_AGENT_REREGISTERED",
+	12: "REASON_AGENT_RESTARTED",
+	13: "REASON_AGENT_UNKNOWN",
+	30: "REASON_TASK_KILLED_DURING_LAUNCH",
+	28: "REASON_TASK_CHECK_STATUS_UPDATED",
+	29: "REASON_TASK_HEALTH_CHECK_STATUS_UPDATED",
+	25: "REASON_TASK_GROUP_INVALID",
+	26: "REASON_TASK_GROUP_UNAUTHORIZED",
+	14: "REASON_TASK_INVALID",
+	15: "REASON_TASK_UNAUTHORIZED",
+	16: "REASON_TASK_UNKNOWN",
+}
+var TaskStatus_Reason_value = map[string]int32{
+	"REASON_COMMAND_EXECUTOR_FAILED":          0,
+	"REASON_CONTAINER_LAUNCH_FAILED":          21,
+	"REASON_CONTAINER_LIMITATION":             19,
+	"REASON_CONTAINER_LIMITATION_DISK":        20,
+	"REASON_CONTAINER_LIMITATION_MEMORY":      8,
+	"REASON_CONTAINER_PREEMPTED":              17,
+	"REASON_CONTAINER_UPDATE_FAILED":          22,
+	"REASON_MAX_COMPLETION_TIME_REACHED":      33,
+	"REASON_EXECUTOR_REGISTRATION_TIMEOUT":    23,
+	"REASON_EXECUTOR_REREGISTRATION_TIMEOUT":  24,
+	"REASON_EXECUTOR_TERMINATED":              1,
+	"REASON_EXECUTOR_UNREGISTERED":            2,
+	"REASON_FRAMEWORK_REMOVED":                3,
+	"REASON_GC_ERROR":                         4,
+	"REASON_INVALID_FRAMEWORKID":              5,
+	"REASON_INVALID_OFFERS":                   6,
+	"REASON_IO_SWITCHBOARD_EXITED":            27,
+	"REASON_MASTER_DISCONNECTED":              7,
+	"REASON_RECONCILIATION":                   9,
+	"REASON_RESOURCES_UNKNOWN":                18,
+	"REASON_AGENT_DISCONNECTED":               10,
+	"REASON_AGENT_DRAINING":                   34,
+	"REASON_AGENT_REMOVED":                    11,
+	"REASON_AGENT_REMOVED_BY_OPERATOR":        31,
+	"REASON_AGENT_REREGISTERED":               32,
+	"REASON_AGENT_RESTARTED":                  12,
+	"REASON_AGENT_UNKNOWN":                    13,
+	"REASON_TASK_KILLED_DURING_LAUNCH":        30,
+	"REASON_TASK_CHECK_STATUS_UPDATED":        28,
+	"REASON_TASK_HEALTH_CHECK_STATUS_UPDATED": 29,
+	"REASON_TASK_GROUP_INVALID":               25,
+	"REASON_TASK_GROUP_UNAUTHORIZED":          26,
+	"REASON_TASK_INVALID":                     14,
+	"REASON_TASK_UNAUTHORIZED":                15,
+	"REASON_TASK_UNKNOWN":                     16,
+}
+
+func (x TaskStatus_Reason) Enum() *TaskStatus_Reason {
+	p := new(TaskStatus_Reason)
+	*p = x
+	return p
+}
+func (x TaskStatus_Reason) String() string {
+	return proto.EnumName(TaskStatus_Reason_name, int32(x))
+}
+func (x *TaskStatus_Reason) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TaskStatus_Reason_value, data, "TaskStatus_Reason")
+	if err != nil {
+		return err
+	}
+	*x = TaskStatus_Reason(value)
+	return nil
+}
+func (TaskStatus_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{52, 1} }
+
+type Environment_Variable_Type int32
+
+const (
+	Environment_Variable_UNKNOWN Environment_Variable_Type = 0
+	Environment_Variable_VALUE   Environment_Variable_Type = 1
+	Environment_Variable_SECRET  Environment_Variable_Type = 2
+)
+
+var Environment_Variable_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "VALUE",
+	2: "SECRET",
+}
+var Environment_Variable_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"VALUE":   1,
+	"SECRET":  2,
+}
+
+func (x Environment_Variable_Type) Enum() *Environment_Variable_Type {
+	p := new(Environment_Variable_Type)
+	*p = x
+	return p
+}
+func (x Environment_Variable_Type) String() string {
+	return proto.EnumName(Environment_Variable_Type_name, int32(x))
+}
+func (x *Environment_Variable_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Environment_Variable_Type_value, data, "Environment_Variable_Type")
+	if err != nil {
+		return err
+	}
+	*x = Environment_Variable_Type(value)
+	return nil
+}
+func (Environment_Variable_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{54, 0, 0}
+}
+
+type Secret_Type int32
+
+const (
+	Secret_UNKNOWN   Secret_Type = 0
+	Secret_REFERENCE Secret_Type = 1
+	Secret_VALUE     Secret_Type = 2
+)
+
+var Secret_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "REFERENCE",
+	2: "VALUE",
+}
+var Secret_Type_value = map[string]int32{
+	"UNKNOWN":   0,
+	"REFERENCE": 1,
+	"VALUE":     2,
+}
+
+func (x Secret_Type) Enum() *Secret_Type {
+	p := new(Secret_Type)
+	*p = x
+	return p
+}
+func (x Secret_Type) String() string {
+	return proto.EnumName(Secret_Type_name, int32(x))
+}
+func (x *Secret_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Secret_Type_value, data, "Secret_Type")
+	if err != nil {
+		return err
+	}
+	*x = Secret_Type(value)
+	return nil
+}
+func (Secret_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{59, 0} }
+
+type Image_Type int32
+
+const (
+	Image_APPC   Image_Type = 1
+	Image_DOCKER Image_Type = 2
+)
+
+var Image_Type_name = map[int32]string{
+	1: "APPC",
+	2: "DOCKER",
+}
+var Image_Type_value = map[string]int32{
+	"APPC":   1,
+	"DOCKER": 2,
+}
+
+func (x Image_Type) Enum() *Image_Type {
+	p := new(Image_Type)
+	*p = x
+	return p
+}
+func (x Image_Type) String() string {
+	return proto.EnumName(Image_Type_name, int32(x))
+}
+func (x *Image_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Image_Type_value, data, "Image_Type")
+	if err != nil {
+		return err
+	}
+	*x = Image_Type(value)
+	return nil
+}
+func (Image_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{62, 0} }
+
+type MountPropagation_Mode int32
+
+const (
+	MountPropagation_UNKNOWN MountPropagation_Mode = 0
+	// The volume in a container will receive new mounts from the host
+	// or other containers, but filesystems mounted inside the
+	// container won't be propagated to the host or other containers.
+	// This is currently the default behavior for all volumes.
+	MountPropagation_HOST_TO_CONTAINER MountPropagation_Mode = 1
+	// The volume in a container will receive new mounts from the host
+	// or other containers, and its own mounts will be propagated from
+	// the container to the host or other containers.
+	MountPropagation_BIDIRECTIONAL MountPropagation_Mode = 2
+)
+
+var MountPropagation_Mode_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "HOST_TO_CONTAINER",
+	2: "BIDIRECTIONAL",
+}
+var MountPropagation_Mode_value = map[string]int32{
+	"UNKNOWN":           0,
+	"HOST_TO_CONTAINER": 1,
+	"BIDIRECTIONAL":     2,
+}
+
+func (x MountPropagation_Mode) Enum() *MountPropagation_Mode {
+	p := new(MountPropagation_Mode)
+	*p = x
+	return p
+}
+func (x MountPropagation_Mode) String() string {
+	return proto.EnumName(MountPropagation_Mode_name, int32(x))
+}
+func (x *MountPropagation_Mode) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(MountPropagation_Mode_value, data, "MountPropagation_Mode")
+	if err != nil {
+		return err
+	}
+	*x = MountPropagation_Mode(value)
+	return nil
+}
+func (MountPropagation_Mode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{63, 0} }
+
+type Volume_Mode int32
+
+const (
+	Volume_RW Volume_Mode = 1
+	Volume_RO Volume_Mode = 2
+)
+
+var Volume_Mode_name = map[int32]string{
+	1: "RW",
+	2: "RO",
+}
+var Volume_Mode_value = map[string]int32{
+	"RW": 1,
+	"RO": 2,
+}
+
+func (x Volume_Mode) Enum() *Volume_Mode {
+	p := new(Volume_Mode)
+	*p = x
+	return p
+}
+func (x Volume_Mode) String() string {
+	return proto.EnumName(Volume_Mode_name, int32(x))
+}
+func (x *Volume_Mode) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Volume_Mode_value, data, "Volume_Mode")
+	if err != nil {
+		return err
+	}
+	*x = Volume_Mode(value)
+	return nil
+}
+func (Volume_Mode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 0} }
+
+type Volume_Source_Type int32
+
+const (
+	// This must be the first enum value in this list, to
+	// ensure that if 'type' is not set, the default value
+	// is UNKNOWN. This enables enum values to be added
+	// in a backwards-compatible way. See: MESOS-4997.
+	Volume_Source_UNKNOWN Volume_Source_Type = 0
+	// TODO(gyliu513): Add IMAGE as volume source type.
+	Volume_Source_DOCKER_VOLUME Volume_Source_Type = 1
+	Volume_Source_HOST_PATH     Volume_Source_Type = 4
+	Volume_Source_SANDBOX_PATH  Volume_Source_Type = 2
+	Volume_Source_SECRET        Volume_Source_Type = 3
+)
+
+var Volume_Source_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DOCKER_VOLUME",
+	4: "HOST_PATH",
+	2: "SANDBOX_PATH",
+	3: "SECRET",
+}
+var Volume_Source_Type_value = map[string]int32{
+	"UNKNOWN":       0,
+	"DOCKER_VOLUME": 1,
+	"HOST_PATH":     4,
+	"SANDBOX_PATH":  2,
+	"SECRET":        3,
+}
+
+func (x Volume_Source_Type) Enum() *Volume_Source_Type {
+	p := new(Volume_Source_Type)
+	*p = x
+	return p
+}
+func (x Volume_Source_Type) String() string {
+	return proto.EnumName(Volume_Source_Type_name, int32(x))
+}
+func (x *Volume_Source_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Volume_Source_Type_value, data, "Volume_Source_Type")
+	if err != nil {
+		return err
+	}
+	*x = Volume_Source_Type(value)
+	return nil
+}
+func (Volume_Source_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 0, 0} }
+
+type Volume_Source_SandboxPath_Type int32
+
+const (
+	Volume_Source_SandboxPath_UNKNOWN Volume_Source_SandboxPath_Type = 0
+	Volume_Source_SandboxPath_SELF    Volume_Source_SandboxPath_Type = 1
+	Volume_Source_SandboxPath_PARENT  Volume_Source_SandboxPath_Type = 2
+)
+
+var Volume_Source_SandboxPath_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SELF",
+	2: "PARENT",
+}
+var Volume_Source_SandboxPath_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SELF":    1,
+	"PARENT":  2,
+}
+
+func (x Volume_Source_SandboxPath_Type) Enum() *Volume_Source_SandboxPath_Type {
+	p := new(Volume_Source_SandboxPath_Type)
+	*p = x
+	return p
+}
+func (x Volume_Source_SandboxPath_Type) String() string {
+	return proto.EnumName(Volume_Source_SandboxPath_Type_name, int32(x))
+}
+func (x *Volume_Source_SandboxPath_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Volume_Source_SandboxPath_Type_value, data, "Volume_Source_SandboxPath_Type")
+	if err != nil {
+		return err
+	}
+	*x = Volume_Source_SandboxPath_Type(value)
+	return nil
+}
+func (Volume_Source_SandboxPath_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{64, 0, 2, 0}
+}
+
+type NetworkInfo_Protocol int32
+
+const (
+	NetworkInfo_IPv4 NetworkInfo_Protocol = 1
+	NetworkInfo_IPv6 NetworkInfo_Protocol = 2
+)
+
+var NetworkInfo_Protocol_name = map[int32]string{
+	1: "IPv4",
+	2: "IPv6",
+}
+var NetworkInfo_Protocol_value = map[string]int32{
+	"IPv4": 1,
+	"IPv6": 2,
+}
+
+func (x NetworkInfo_Protocol) Enum() *NetworkInfo_Protocol {
+	p := new(NetworkInfo_Protocol)
+	*p = x
+	return p
+}
+func (x NetworkInfo_Protocol) String() string {
+	return proto.EnumName(NetworkInfo_Protocol_name, int32(x))
+}
+func (x *NetworkInfo_Protocol) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(NetworkInfo_Protocol_value, data, "NetworkInfo_Protocol")
+	if err != nil {
+		return err
+	}
+	*x = NetworkInfo_Protocol(value)
+	return nil
+}
+func (NetworkInfo_Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
+
+// We start the actual values at an offset(1000) because Protobuf 2
+// uses the first value as the default one. Separating the default
+// value from the real first value helps to disambiguate them. This
+// is especially valuable for backward compatibility.
+// See: MESOS-4997.
+type CapabilityInfo_Capability int32
+
+const (
+	CapabilityInfo_UNKNOWN          CapabilityInfo_Capability = 0
+	CapabilityInfo_CHOWN            CapabilityInfo_Capability = 1000
+	CapabilityInfo_DAC_OVERRIDE     CapabilityInfo_Capability = 1001
+	CapabilityInfo_DAC_READ_SEARCH  CapabilityInfo_Capability = 1002
+	CapabilityInfo_FOWNER           CapabilityInfo_Capability = 1003
+	CapabilityInfo_FSETID           CapabilityInfo_Capability = 1004
+	CapabilityInfo_KILL             CapabilityInfo_Capability = 1005
+	CapabilityInfo_SETGID           CapabilityInfo_Capability = 1006
+	CapabilityInfo_SETUID           CapabilityInfo_Capability = 1007
+	CapabilityInfo_SETPCAP          CapabilityInfo_Capability = 1008
+	CapabilityInfo_LINUX_IMMUTABLE  CapabilityInfo_Capability = 1009
+	CapabilityInfo_NET_BIND_SERVICE CapabilityInfo_Capability = 1010
+	CapabilityInfo_NET_BROADCAST    CapabilityInfo_Capability = 1011
+	CapabilityInfo_NET_ADMIN        CapabilityInfo_Capability = 1012
+	CapabilityInfo_NET_RAW          CapabilityInfo_Capability = 1013
+	CapabilityInfo_IPC_LOCK         CapabilityInfo_Capability = 1014
+	CapabilityInfo_IPC_OWNER        CapabilityInfo_Capability = 1015
+	CapabilityInfo_SYS_MODULE       CapabilityInfo_Capability = 1016
+	CapabilityInfo_SYS_RAWIO        CapabilityInfo_Capability = 1017
+	CapabilityInfo_SYS_CHROOT       CapabilityInfo_Capability = 1018
+	CapabilityInfo_SYS_PTRACE       CapabilityInfo_Capability = 1019
+	CapabilityInfo_SYS_PACCT        CapabilityInfo_Capability = 1020
+	CapabilityInfo_SYS_ADMIN        CapabilityInfo_Capability = 1021
+	CapabilityInfo_SYS_BOOT         CapabilityInfo_Capability = 1022
+	CapabilityInfo_SYS_NICE         CapabilityInfo_Capability = 1023
+	CapabilityInfo_SYS_RESOURCE     CapabilityInfo_Capability = 1024
+	CapabilityInfo_SYS_TIME         CapabilityInfo_Capability = 1025
+	CapabilityInfo_SYS_TTY_CONFIG   CapabilityInfo_Capability = 1026
+	CapabilityInfo_MKNOD            CapabilityInfo_Capability = 1027
+	CapabilityInfo_LEASE            CapabilityInfo_Capability = 1028
+	CapabilityInfo_AUDIT_WRITE      CapabilityInfo_Capability = 1029
+	CapabilityInfo_AUDIT_CONTROL    CapabilityInfo_Capability = 1030
+	CapabilityInfo_SETFCAP          CapabilityInfo_Capability = 1031
+	CapabilityInfo_MAC_OVERRIDE     CapabilityInfo_Capability = 1032
+	CapabilityInfo_MAC_ADMIN        CapabilityInfo_Capability = 1033
+	CapabilityInfo_SYSLOG           CapabilityInfo_Capability = 1034
+	CapabilityInfo_WAKE_ALARM       CapabilityInfo_Capability = 1035
+	CapabilityInfo_BLOCK_SUSPEND    CapabilityInfo_Capability = 1036
+	CapabilityInfo_AUDIT_READ       CapabilityInfo_Capability = 1037
+)
+
+var CapabilityInfo_Capability_name = map[int32]string{
+	0:    "UNKNOWN",
+	1000: "CHOWN",
+	1001: "DAC_OVERRIDE",
+	1002: "DAC_READ_SEARCH",
+	1003: "FOWNER",
+	1004: "FSETID",
+	1005: "KILL",
+	1006: "SETGID",
+	1007: "SETUID",
+	1008: "SETPCAP",
+	1009: "LINUX_IMMUTABLE",
+	1010: "NET_BIND_SERVICE",
+	1011: "NET_BROADCAST",
+	1012: "NET_ADMIN",
+	1013: "NET_RAW",
+	1014: "IPC_LOCK",
+	1015: "IPC_OWNER",
+	1016: "SYS_MODULE",
+	1017: "SYS_RAWIO",
+	1018: "SYS_CHROOT",
+	1019: "SYS_PTRACE",
+	1020: "SYS_PACCT",
+	1021: "SYS_ADMIN",
+	1022: "SYS_BOOT",
+	1023: "SYS_NICE",
+	1024: "SYS_RESOURCE",
+	1025: "SYS_TIME",
+	1026: "SYS_TTY_CONFIG",
+	1027: "MKNOD",
+	1028: "LEASE",
+	1029: "AUDIT_WRITE",
+	1030: "AUDIT_CONTROL",
+	1031: "SETFCAP",
+	1032: "MAC_OVERRIDE",
+	1033: "MAC_ADMIN",
+	1034: "SYSLOG",
+	1035: "WAKE_ALARM",
+	1036: "BLOCK_SUSPEND",
+	1037: "AUDIT_READ",
+}
+var CapabilityInfo_Capability_value = map[string]int32{
+	"UNKNOWN":          0,
+	"CHOWN":            1000,
+	"DAC_OVERRIDE":     1001,
+	"DAC_READ_SEARCH":  1002,
+	"FOWNER":           1003,
+	"FSETID":           1004,
+	"KILL":             1005,
+	"SETGID":           1006,
+	"SETUID":           1007,
+	"SETPCAP":          1008,
+	"LINUX_IMMUTABLE":  1009,
+	"NET_BIND_SERVICE": 1010,
+	"NET_BROADCAST":    1011,
+	"NET_ADMIN":        1012,
+	"NET_RAW":          1013,
+	"IPC_LOCK":         1014,
+	"IPC_OWNER":        1015,
+	"SYS_MODULE":       1016,
+	"SYS_RAWIO":        1017,
+	"SYS_CHROOT":       1018,
+	"SYS_PTRACE":       1019,
+	"SYS_PACCT":        1020,
+	"SYS_ADMIN":        1021,
+	"SYS_BOOT":         1022,
+	"SYS_NICE":         1023,
+	"SYS_RESOURCE":     1024,
+	"SYS_TIME":         1025,
+	"SYS_TTY_CONFIG":   1026,
+	"MKNOD":            1027,
+	"LEASE":            1028,
+	"AUDIT_WRITE":      1029,
+	"AUDIT_CONTROL":    1030,
+	"SETFCAP":          1031,
+	"MAC_OVERRIDE":     1032,
+	"MAC_ADMIN":        1033,
+	"SYSLOG":           1034,
+	"WAKE_ALARM":       1035,
+	"BLOCK_SUSPEND":    1036,
+	"AUDIT_READ":       1037,
+}
+
+func (x CapabilityInfo_Capability) Enum() *CapabilityInfo_Capability {
+	p := new(CapabilityInfo_Capability)
+	*p = x
+	return p
+}
+func (x CapabilityInfo_Capability) String() string {
+	return proto.EnumName(CapabilityInfo_Capability_name, int32(x))
+}
+func (x *CapabilityInfo_Capability) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CapabilityInfo_Capability_value, data, "CapabilityInfo_Capability")
+	if err != nil {
+		return err
+	}
+	*x = CapabilityInfo_Capability(value)
+	return nil
+}
+func (CapabilityInfo_Capability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{66, 0}
+}
+
+type LinuxInfo_IpcMode int32
+
+const (
+	LinuxInfo_UNKNOWN LinuxInfo_IpcMode = 0
+	// The container will have its own IPC namespace and /dev/shm, with a
+	// possibility to share them with its child containers.
+	LinuxInfo_PRIVATE LinuxInfo_IpcMode = 1
+	// The container will share the IPC namespace and /dev/shm from its
+	// parent. If the container is a top level container, it will share
+	// the IPC namespace and /dev/shm from the agent host, if the container
+	// is a nested container, it will share the IPC namespace and /dev/shm
+	// from its parent container. The implication is if a nested container
+	// wants to share the IPC namespace and /dev/shm from the agent host,
+	// its parent container has to do it first.
+	LinuxInfo_SHARE_PARENT LinuxInfo_IpcMode = 2
+)
+
+var LinuxInfo_IpcMode_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PRIVATE",
+	2: "SHARE_PARENT",
+}
+var LinuxInfo_IpcMode_value = map[string]int32{
+	"UNKNOWN":      0,
+	"PRIVATE":      1,
+	"SHARE_PARENT": 2,
+}
+
+func (x LinuxInfo_IpcMode) Enum() *LinuxInfo_IpcMode {
+	p := new(LinuxInfo_IpcMode)
+	*p = x
+	return p
+}
+func (x LinuxInfo_IpcMode) String() string {
+	return proto.EnumName(LinuxInfo_IpcMode_name, int32(x))
+}
+func (x *LinuxInfo_IpcMode) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(LinuxInfo_IpcMode_value, data, "LinuxInfo_IpcMode")
+	if err != nil {
+		return err
+	}
+	*x = LinuxInfo_IpcMode(value)
+	return nil
+}
+func (LinuxInfo_IpcMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{68, 0} }
+
+type RLimitInfo_RLimit_Type int32
+
+const (
+	RLimitInfo_RLimit_UNKNOWN         RLimitInfo_RLimit_Type = 0
+	RLimitInfo_RLimit_RLMT_AS         RLimitInfo_RLimit_Type = 1
+	RLimitInfo_RLimit_RLMT_CORE       RLimitInfo_RLimit_Type = 2
+	RLimitInfo_RLimit_RLMT_CPU        RLimitInfo_RLimit_Type = 3
+	RLimitInfo_RLimit_RLMT_DATA       RLimitInfo_RLimit_Type = 4
+	RLimitInfo_RLimit_RLMT_FSIZE      RLimitInfo_RLimit_Type = 5
+	RLimitInfo_RLimit_RLMT_LOCKS      RLimitInfo_RLimit_Type = 6
+	RLimitInfo_RLimit_RLMT_MEMLOCK    RLimitInfo_RLimit_Type = 7
+	RLimitInfo_RLimit_RLMT_MSGQUEUE   RLimitInfo_RLimit_Type = 8
+	RLimitInfo_RLimit_RLMT_NICE       RLimitInfo_RLimit_Type = 9
+	RLimitInfo_RLimit_RLMT_NOFILE     RLimitInfo_RLimit_Type = 10
+	RLimitInfo_RLimit_RLMT_NPROC      RLimitInfo_RLimit_Type = 11
+	RLimitInfo_RLimit_RLMT_RSS        RLimitInfo_RLimit_Type = 12
+	RLimitInfo_RLimit_RLMT_RTPRIO     RLimitInfo_RLimit_Type = 13
+	RLimitInfo_RLimit_RLMT_RTTIME     RLimitInfo_RLimit_Type = 14
+	RLimitInfo_RLimit_RLMT_SIGPENDING RLimitInfo_RLimit_Type = 15
+	RLimitInfo_RLimit_RLMT_STACK      RLimitInfo_RLimit_Type = 16
+)
+
+var RLimitInfo_RLimit_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "RLMT_AS",
+	2:  "RLMT_CORE",
+	3:  "RLMT_CPU",
+	4:  "RLMT_DATA",
+	5:  "RLMT_FSIZE",
+	6:  "RLMT_LOCKS",
+	7:  "RLMT_MEMLOCK",
+	8:  "RLMT_MSGQUEUE",
+	9:  "RLMT_NICE",
+	10: "RLMT_NOFILE",
+	11: "RLMT_NPROC",
+	12: "RLMT_RSS",
+	13: "RLMT_RTPRIO",
+	14: "RLMT_RTTIME",
+	15: "RLMT_SIGPENDING",
+	16: "RLMT_STACK",
+}
+var RLimitInfo_RLimit_Type_value = map[string]int32{
+	"UNKNOWN":         0,
+	"RLMT_AS":         1,
+	"RLMT_CORE":       2,
+	"RLMT_CPU":        3,
+	"RLMT_DATA":       4,
+	"RLMT_FSIZE":      5,
+	"RLMT_LOCKS":      6,
+	"RLMT_MEMLOCK":    7,
+	"RLMT_MSGQUEUE":   8,
+	"RLMT_NICE":       9,
+	"RLMT_NOFILE":     10,
+	"RLMT_NPROC":      11,
+	"RLMT_RSS":        12,
+	"RLMT_RTPRIO":     13,
+	"RLMT_RTTIME":     14,
+	"RLMT_SIGPENDING": 15,
+	"RLMT_STACK":      16,
+}
+
+func (x RLimitInfo_RLimit_Type) Enum() *RLimitInfo_RLimit_Type {
+	p := new(RLimitInfo_RLimit_Type)
+	*p = x
+	return p
+}
+func (x RLimitInfo_RLimit_Type) String() string {
+	return proto.EnumName(RLimitInfo_RLimit_Type_name, int32(x))
+}
+func (x *RLimitInfo_RLimit_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(RLimitInfo_RLimit_Type_value, data, "RLimitInfo_RLimit_Type")
+	if err != nil {
+		return err
+	}
+	*x = RLimitInfo_RLimit_Type(value)
+	return nil
+}
+func (RLimitInfo_RLimit_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{69, 0, 0}
+}
+
+// All container implementation types.
+// For each type there should be a field in the ContainerInfo itself
+// with exactly matching name in lowercase.
+type ContainerInfo_Type int32
+
+const (
+	ContainerInfo_DOCKER ContainerInfo_Type = 1
+	ContainerInfo_MESOS  ContainerInfo_Type = 2
+)
+
+var ContainerInfo_Type_name = map[int32]string{
+	1: "DOCKER",
+	2: "MESOS",
+}
+var ContainerInfo_Type_value = map[string]int32{
+	"DOCKER": 1,
+	"MESOS":  2,
+}
+
+func (x ContainerInfo_Type) Enum() *ContainerInfo_Type {
+	p := new(ContainerInfo_Type)
+	*p = x
+	return p
+}
+func (x ContainerInfo_Type) String() string {
+	return proto.EnumName(ContainerInfo_Type_name, int32(x))
+}
+func (x *ContainerInfo_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ContainerInfo_Type_value, data, "ContainerInfo_Type")
+	if err != nil {
+		return err
+	}
+	*x = ContainerInfo_Type(value)
+	return nil
+}
+func (ContainerInfo_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{71, 0} }
+
+// Network options.
+type ContainerInfo_DockerInfo_Network int32
+
+const (
+	ContainerInfo_DockerInfo_HOST   ContainerInfo_DockerInfo_Network = 1
+	ContainerInfo_DockerInfo_BRIDGE ContainerInfo_DockerInfo_Network = 2
+	ContainerInfo_DockerInfo_NONE   ContainerInfo_DockerInfo_Network = 3
+	ContainerInfo_DockerInfo_USER   ContainerInfo_DockerInfo_Network = 4
+)
+
+var ContainerInfo_DockerInfo_Network_name = map[int32]string{
+	1: "HOST",
+	2: "BRIDGE",
+	3: "NONE",
+	4: "USER",
+}
+var ContainerInfo_DockerInfo_Network_value = map[string]int32{
+	"HOST":   1,
+	"BRIDGE": 2,
+	"NONE":   3,
+	"USER":   4,
+}
+
+func (x ContainerInfo_DockerInfo_Network) Enum() *ContainerInfo_DockerInfo_Network {
+	p := new(ContainerInfo_DockerInfo_Network)
+	*p = x
+	return p
+}
+func (x ContainerInfo_DockerInfo_Network) String() string {
+	return proto.EnumName(ContainerInfo_DockerInfo_Network_name, int32(x))
+}
+func (x *ContainerInfo_DockerInfo_Network) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ContainerInfo_DockerInfo_Network_value, data, "ContainerInfo_DockerInfo_Network")
+	if err != nil {
+		return err
+	}
+	*x = ContainerInfo_DockerInfo_Network(value)
+	return nil
+}
+func (ContainerInfo_DockerInfo_Network) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{71, 0, 0}
+}
+
+type CgroupInfo_Blkio_Operation int32
+
+const (
+	CgroupInfo_Blkio_UNKNOWN CgroupInfo_Blkio_Operation = 0
+	CgroupInfo_Blkio_TOTAL   CgroupInfo_Blkio_Operation = 1
+	CgroupInfo_Blkio_READ    CgroupInfo_Blkio_Operation = 2
+	CgroupInfo_Blkio_WRITE   CgroupInfo_Blkio_Operation = 3
+	CgroupInfo_Blkio_SYNC    CgroupInfo_Blkio_Operation = 4
+	CgroupInfo_Blkio_ASYNC   CgroupInfo_Blkio_Operation = 5
+	CgroupInfo_Blkio_DISCARD CgroupInfo_Blkio_Operation = 6
+)
+
+var CgroupInfo_Blkio_Operation_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "TOTAL",
+	2: "READ",
+	3: "WRITE",
+	4: "SYNC",
+	5: "ASYNC",
+	6: "DISCARD",
+}
+var CgroupInfo_Blkio_Operation_value = map[string]int32{
+	"UNKNOWN": 0,
+	"TOTAL":   1,
+	"READ":    2,
+	"WRITE":   3,
+	"SYNC":    4,
+	"ASYNC":   5,
+	"DISCARD": 6,
+}
+
+func (x CgroupInfo_Blkio_Operation) Enum() *CgroupInfo_Blkio_Operation {
+	p := new(CgroupInfo_Blkio_Operation)
+	*p = x
+	return p
+}
+func (x CgroupInfo_Blkio_Operation) String() string {
+	return proto.EnumName(CgroupInfo_Blkio_Operation_name, int32(x))
+}
+func (x *CgroupInfo_Blkio_Operation) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CgroupInfo_Blkio_Operation_value, data, "CgroupInfo_Blkio_Operation")
+	if err != nil {
+		return err
+	}
+	*x = CgroupInfo_Blkio_Operation(value)
+	return nil
+}
+func (CgroupInfo_Blkio_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{73, 0, 0}
+}
+
+type DiscoveryInfo_Visibility int32
+
+const (
+	DiscoveryInfo_FRAMEWORK DiscoveryInfo_Visibility = 0
+	DiscoveryInfo_CLUSTER   DiscoveryInfo_Visibility = 1
+	DiscoveryInfo_EXTERNAL  DiscoveryInfo_Visibility = 2
+)
+
+var DiscoveryInfo_Visibility_name = map[int32]string{
+	0: "FRAMEWORK",
+	1: "CLUSTER",
+	2: "EXTERNAL",
+}
+var DiscoveryInfo_Visibility_value = map[string]int32{
+	"FRAMEWORK": 0,
+	"CLUSTER":   1,
+	"EXTERNAL":  2,
+}
+
+func (x DiscoveryInfo_Visibility) Enum() *DiscoveryInfo_Visibility {
+	p := new(DiscoveryInfo_Visibility)
+	*p = x
+	return p
+}
+func (x DiscoveryInfo_Visibility) String() string {
+	return proto.EnumName(DiscoveryInfo_Visibility_name, int32(x))
+}
+func (x *DiscoveryInfo_Visibility) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(DiscoveryInfo_Visibility_value, data, "DiscoveryInfo_Visibility")
+	if err != nil {
+		return err
+	}
+	*x = DiscoveryInfo_Visibility(value)
+	return nil
+}
+func (DiscoveryInfo_Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{78, 0}
+}
+
+// A unique ID assigned to a framework. A framework can reuse this ID
+// in order to do failover (see MesosSchedulerDriver).
+type FrameworkID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *FrameworkID) Reset()                    { *m = FrameworkID{} }
+func (m *FrameworkID) String() string            { return proto.CompactTextString(m) }
+func (*FrameworkID) ProtoMessage()               {}
+func (*FrameworkID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *FrameworkID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// A unique ID assigned to an offer.
+type OfferID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *OfferID) Reset()                    { *m = OfferID{} }
+func (m *OfferID) String() string            { return proto.CompactTextString(m) }
+func (*OfferID) ProtoMessage()               {}
+func (*OfferID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *OfferID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// A unique ID assigned to an agent. Currently, an agent gets a new ID
+// whenever it (re)registers with Mesos. Framework writers shouldn't
+// assume any binding between an agent ID and and a hostname.
+type AgentID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *AgentID) Reset()                    { *m = AgentID{} }
+func (m *AgentID) String() string            { return proto.CompactTextString(m) }
+func (*AgentID) ProtoMessage()               {}
+func (*AgentID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *AgentID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// A framework-generated ID to distinguish a task. The ID must remain
+// unique while the task is active. A framework can reuse an ID _only_
+// if the previous task with the same ID has reached a terminal state
+// (e.g., TASK_FINISHED, TASK_KILLED, etc.). However, reusing task IDs
+// is strongly discouraged (MESOS-2198).
+type TaskID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TaskID) Reset()                    { *m = TaskID{} }
+func (m *TaskID) String() string            { return proto.CompactTextString(m) }
+func (*TaskID) ProtoMessage()               {}
+func (*TaskID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *TaskID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// A framework-generated ID to distinguish an executor. Only one
+// executor with the same ID can be active on the same agent at a
+// time. However, reusing executor IDs is discouraged.
+type ExecutorID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ExecutorID) Reset()                    { *m = ExecutorID{} }
+func (m *ExecutorID) String() string            { return proto.CompactTextString(m) }
+func (*ExecutorID) ProtoMessage()               {}
+func (*ExecutorID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ExecutorID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// ID used to uniquely identify a container. If the `parent` is not
+// specified, the ID is a UUID generated by the agent to uniquely
+// identify the container of an executor run. If the `parent` field is
+// specified, it represents a nested container.
+type ContainerID struct {
+	Value            *string      `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	Parent           *ContainerID `protobuf:"bytes,2,opt,name=parent" json:"parent,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *ContainerID) Reset()                    { *m = ContainerID{} }
+func (m *ContainerID) String() string            { return proto.CompactTextString(m) }
+func (*ContainerID) ProtoMessage()               {}
+func (*ContainerID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ContainerID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+func (m *ContainerID) GetParent() *ContainerID {
+	if m != nil {
+		return m.Parent
+	}
+	return nil
+}
+
+// A unique ID assigned to a resource provider. Currently, a resource
+// provider gets a new ID whenever it (re)registers with Mesos.
+type ResourceProviderID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ResourceProviderID) Reset()                    { *m = ResourceProviderID{} }
+func (m *ResourceProviderID) String() string            { return proto.CompactTextString(m) }
+func (*ResourceProviderID) ProtoMessage()               {}
+func (*ResourceProviderID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ResourceProviderID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// A framework-generated ID to distinguish an operation. The ID
+// must be unique within the framework.
+type OperationID struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *OperationID) Reset()                    { *m = OperationID{} }
+func (m *OperationID) String() string            { return proto.CompactTextString(m) }
+func (*OperationID) ProtoMessage()               {}
+func (*OperationID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *OperationID) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Represents time since the epoch, in nanoseconds.
+type TimeInfo struct {
+	Nanoseconds      *int64 `protobuf:"varint,1,req,name=nanoseconds" json:"nanoseconds,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *TimeInfo) Reset()                    { *m = TimeInfo{} }
+func (m *TimeInfo) String() string            { return proto.CompactTextString(m) }
+func (*TimeInfo) ProtoMessage()               {}
+func (*TimeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *TimeInfo) GetNanoseconds() int64 {
+	if m != nil && m.Nanoseconds != nil {
+		return *m.Nanoseconds
+	}
+	return 0
+}
+
+// Represents duration in nanoseconds.
+type DurationInfo struct {
+	Nanoseconds      *int64 `protobuf:"varint,1,req,name=nanoseconds" json:"nanoseconds,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DurationInfo) Reset()                    { *m = DurationInfo{} }
+func (m *DurationInfo) String() string            { return proto.CompactTextString(m) }
+func (*DurationInfo) ProtoMessage()               {}
+func (*DurationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *DurationInfo) GetNanoseconds() int64 {
+	if m != nil && m.Nanoseconds != nil {
+		return *m.Nanoseconds
+	}
+	return 0
+}
+
+// A network address.
+//
+// TODO(bmahler): Use this more widely.
+type Address struct {
+	// May contain a hostname, IP address, or both.
+	Hostname         *string `protobuf:"bytes,1,opt,name=hostname" json:"hostname,omitempty"`
+	Ip               *string `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+	Port             *int32  `protobuf:"varint,3,req,name=port" json:"port,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Address) Reset()                    { *m = Address{} }
+func (m *Address) String() string            { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()               {}
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *Address) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *Address) GetIp() string {
+	if m != nil && m.Ip != nil {
+		return *m.Ip
+	}
+	return ""
+}
+
+func (m *Address) GetPort() int32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return 0
+}
+
+// Represents a URL.
+type URL struct {
+	Scheme           *string      `protobuf:"bytes,1,req,name=scheme" json:"scheme,omitempty"`
+	Address          *Address     `protobuf:"bytes,2,req,name=address" json:"address,omitempty"`
+	Path             *string      `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	Query            []*Parameter `protobuf:"bytes,4,rep,name=query" json:"query,omitempty"`
+	Fragment         *string      `protobuf:"bytes,5,opt,name=fragment" json:"fragment,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *URL) Reset()                    { *m = URL{} }
+func (m *URL) String() string            { return proto.CompactTextString(m) }
+func (*URL) ProtoMessage()               {}
+func (*URL) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *URL) GetScheme() string {
+	if m != nil && m.Scheme != nil {
+		return *m.Scheme
+	}
+	return ""
+}
+
+func (m *URL) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *URL) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *URL) GetQuery() []*Parameter {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *URL) GetFragment() string {
+	if m != nil && m.Fragment != nil {
+		return *m.Fragment
+	}
+	return ""
+}
+
+// Represents an interval, from a given start time over a given duration.
+// This interval pertains to an unavailability event, such as maintenance,
+// and is not a generic interval.
+type Unavailability struct {
+	Start *TimeInfo `protobuf:"bytes,1,req,name=start" json:"start,omitempty"`
+	// When added to `start`, this represents the end of the interval.
+	// If unspecified, the duration is assumed to be infinite.
+	Duration         *DurationInfo `protobuf:"bytes,2,opt,name=duration" json:"duration,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Unavailability) Reset()                    { *m = Unavailability{} }
+func (m *Unavailability) String() string            { return proto.CompactTextString(m) }
+func (*Unavailability) ProtoMessage()               {}
+func (*Unavailability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *Unavailability) GetStart() *TimeInfo {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Unavailability) GetDuration() *DurationInfo {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+// Represents a single machine, which may hold one or more agents.
+//
+// NOTE: In order to match an agent to a machine, both the `hostname` and
+// `ip` must match the values advertised by the agent to the master.
+// Hostname is not case-sensitive.
+type MachineID struct {
+	Hostname         *string `protobuf:"bytes,1,opt,name=hostname" json:"hostname,omitempty"`
+	Ip               *string `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MachineID) Reset()                    { *m = MachineID{} }
+func (m *MachineID) String() string            { return proto.CompactTextString(m) }
+func (*MachineID) ProtoMessage()               {}
+func (*MachineID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *MachineID) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *MachineID) GetIp() string {
+	if m != nil && m.Ip != nil {
+		return *m.Ip
+	}
+	return ""
+}
+
+// Holds information about a single machine, its `mode`, and any other
+// relevant information which may affect the behavior of the machine.
+type MachineInfo struct {
+	Id   *MachineID        `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Mode *MachineInfo_Mode `protobuf:"varint,2,opt,name=mode,enum=mesos.v1.MachineInfo_Mode" json:"mode,omitempty"`
+	// Signifies that the machine may be unavailable during the given interval.
+	// See comments in `Unavailability` and for the `unavailability` fields
+	// in `Offer` and `InverseOffer` for more information.
+	Unavailability   *Unavailability `protobuf:"bytes,3,opt,name=unavailability" json:"unavailability,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *MachineInfo) Reset()                    { *m = MachineInfo{} }
+func (m *MachineInfo) String() string            { return proto.CompactTextString(m) }
+func (*MachineInfo) ProtoMessage()               {}
+func (*MachineInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *MachineInfo) GetId() *MachineID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *MachineInfo) GetMode() MachineInfo_Mode {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return MachineInfo_UP
+}
+
+func (m *MachineInfo) GetUnavailability() *Unavailability {
+	if m != nil {
+		return m.Unavailability
+	}
+	return nil
+}
+
+// Describes a framework.
+type FrameworkInfo struct {
+	// Used to determine the Unix user that an executor or task should be
+	// launched as.
+	//
+	// When using the MesosSchedulerDriver, if the field is set to an
+	// empty string, it will automagically set it to the current user.
+	//
+	// When using the HTTP Scheduler API, the user has to be set
+	// explicitly.
+	User *string `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	// Name of the framework that shows up in the Mesos Web UI.
+	Name *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	// Used to uniquely identify the framework.
+	//
+	// This field must be unset when the framework subscribes for the
+	// first time upon which the master will assign a new ID. To
+	// resubscribe after scheduler failover the framework should set
+	// 'id' to the ID assigned by the master.  Setting 'id' to values
+	// not assigned by Mesos masters is unsupported.
+	Id *FrameworkID `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	// The amount of time (in seconds) that the master will wait for the
+	// scheduler to failover before it tears down the framework by
+	// killing all its tasks/executors. This should be non-zero if a
+	// framework expects to reconnect after a failure and not lose its
+	// tasks/executors.
+	//
+	// NOTE: To avoid accidental destruction of tasks, production
+	// frameworks typically set this to a large value (e.g., 1 week).
+	FailoverTimeout *float64 `protobuf:"fixed64,4,opt,name=failover_timeout,json=failoverTimeout,def=0" json:"failover_timeout,omitempty"`
+	// If set, agents running tasks started by this framework will write
+	// the framework pid, executor pids and status updates to disk. If
+	// the agent exits (e.g., due to a crash or as part of upgrading
+	// Mesos), this checkpointed data allows the restarted agent to
+	// reconnect to executors that were started by the old instance of
+	// the agent. Enabling checkpointing improves fault tolerance, at
+	// the cost of a (usually small) increase in disk I/O.
+	Checkpoint *bool `protobuf:"varint,5,opt,name=checkpoint,def=0" json:"checkpoint,omitempty"`
+	// Roles are the entities to which allocations are made.
+	// The framework must have at least one role in order to
+	// be offered resources. Note that `role` is deprecated
+	// in favor of `roles` and only one of these fields must
+	// be used. Since we cannot distinguish between empty
+	// `roles` and the default unset `role`, we require that
+	// frameworks set the `MULTI_ROLE` capability if
+	// setting the `roles` field.
+	Role  *string  `protobuf:"bytes,6,opt,name=role,def=*" json:"role,omitempty"`
+	Roles []string `protobuf:"bytes,12,rep,name=roles" json:"roles,omitempty"`
+	// Used to indicate the current host from which the scheduler is
+	// registered in the Mesos Web UI. If set to an empty string Mesos
+	// will automagically set it to the current hostname if one is
+	// available.
+	Hostname *string `protobuf:"bytes,7,opt,name=hostname" json:"hostname,omitempty"`
+	// This field should match the credential's principal the framework
+	// uses for authentication. This field is used for framework API
+	// rate limiting and dynamic reservations. It should be set even
+	// if authentication is not enabled if these features are desired.
+	Principal *string `protobuf:"bytes,8,opt,name=principal" json:"principal,omitempty"`
+	// This field allows a framework to advertise its web UI, so that
+	// the Mesos web UI can link to it. It is expected to be a full URL,
+	// for example http://my-scheduler.example.com:8080/.
+	WebuiUrl *string `protobuf:"bytes,9,opt,name=webui_url,json=webuiUrl" json:"webui_url,omitempty"`
+	// This field allows a framework to advertise its set of
+	// capabilities (e.g., ability to receive offers for revocable
+	// resources).
+	Capabilities []*FrameworkInfo_Capability `protobuf:"bytes,10,rep,name=capabilities" json:"capabilities,omitempty"`
+	// Labels are free-form key value pairs supplied by the framework
+	// scheduler (e.g., to describe additional functionality offered by
+	// the framework). These labels are not interpreted by Mesos itself.
+	// Labels should not contain duplicate key-value pairs.
+	Labels *Labels `protobuf:"bytes,11,opt,name=labels" json:"labels,omitempty"`
+	// Specifc resource requirements for each of the framework's roles. This field
+	// is used by e.g., the default allocator to decide whether a framework is
+	// interested in seeing a resource of a certain shape.
+	OfferFilters     map[string]*OfferFilters `protobuf:"bytes,13,rep,name=offer_filters,json=offerFilters" json:"offer_filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *FrameworkInfo) Reset()                    { *m = FrameworkInfo{} }
+func (m *FrameworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*FrameworkInfo) ProtoMessage()               {}
+func (*FrameworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+const Default_FrameworkInfo_FailoverTimeout float64 = 0
+const Default_FrameworkInfo_Checkpoint bool = false
+const Default_FrameworkInfo_Role string = "*"
+
+func (m *FrameworkInfo) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *FrameworkInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *FrameworkInfo) GetId() *FrameworkID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *FrameworkInfo) GetFailoverTimeout() float64 {
+	if m != nil && m.FailoverTimeout != nil {
+		return *m.FailoverTimeout
+	}
+	return Default_FrameworkInfo_FailoverTimeout
+}
+
+func (m *FrameworkInfo) GetCheckpoint() bool {
+	if m != nil && m.Checkpoint != nil {
+		return *m.Checkpoint
+	}
+	return Default_FrameworkInfo_Checkpoint
+}
+
+func (m *FrameworkInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return Default_FrameworkInfo_Role
+}
+
+func (m *FrameworkInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *FrameworkInfo) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *FrameworkInfo) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *FrameworkInfo) GetWebuiUrl() string {
+	if m != nil && m.WebuiUrl != nil {
+		return *m.WebuiUrl
+	}
+	return ""
+}
+
+func (m *FrameworkInfo) GetCapabilities() []*FrameworkInfo_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *FrameworkInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *FrameworkInfo) GetOfferFilters() map[string]*OfferFilters {
+	if m != nil {
+		return m.OfferFilters
+	}
+	return nil
+}
+
+type FrameworkInfo_Capability struct {
+	// Enum fields should be optional, see: MESOS-4997.
+	Type             *FrameworkInfo_Capability_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.FrameworkInfo_Capability_Type" json:"type,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *FrameworkInfo_Capability) Reset()                    { *m = FrameworkInfo_Capability{} }
+func (m *FrameworkInfo_Capability) String() string            { return proto.CompactTextString(m) }
+func (*FrameworkInfo_Capability) ProtoMessage()               {}
+func (*FrameworkInfo_Capability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15, 0} }
+
+func (m *FrameworkInfo_Capability) GetType() FrameworkInfo_Capability_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return FrameworkInfo_Capability_UNKNOWN
+}
+
+// Describes a general non-interpreting non-killing check for a task or
+// executor (or any arbitrary process/command). A type is picked by
+// specifying one of the optional fields. Specifying more than one type
+// is an error.
+//
+// NOTE: This API is subject to change and the related feature is experimental.
+type CheckInfo struct {
+	// The type of the check.
+	Type *CheckInfo_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.CheckInfo_Type" json:"type,omitempty"`
+	// Command check.
+	Command *CheckInfo_Command `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	// HTTP check.
+	Http *CheckInfo_Http `protobuf:"bytes,3,opt,name=http" json:"http,omitempty"`
+	// TCP check.
+	Tcp *CheckInfo_Tcp `protobuf:"bytes,7,opt,name=tcp" json:"tcp,omitempty"`
+	// Amount of time to wait to start checking the task after it
+	// transitions to `TASK_RUNNING` or `TASK_STARTING` if the latter
+	// is used by the executor.
+	DelaySeconds *float64 `protobuf:"fixed64,4,opt,name=delay_seconds,json=delaySeconds,def=15" json:"delay_seconds,omitempty"`
+	// Interval between check attempts, i.e., amount of time to wait after
+	// the previous check finished or timed out to start the next check.
+	IntervalSeconds *float64 `protobuf:"fixed64,5,opt,name=interval_seconds,json=intervalSeconds,def=10" json:"interval_seconds,omitempty"`
+	// Amount of time to wait for the check to complete. Zero means infinite
+	// timeout.
+	//
+	// After this timeout, the check attempt is aborted and no result is
+	// reported. Note that this may be considered a state change and hence
+	// may trigger a check status change delivery to the corresponding
+	// scheduler. See `CheckStatusInfo` for more details.
+	TimeoutSeconds   *float64 `protobuf:"fixed64,6,opt,name=timeout_seconds,json=timeoutSeconds,def=20" json:"timeout_seconds,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *CheckInfo) Reset()                    { *m = CheckInfo{} }
+func (m *CheckInfo) String() string            { return proto.CompactTextString(m) }
+func (*CheckInfo) ProtoMessage()               {}
+func (*CheckInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+const Default_CheckInfo_DelaySeconds float64 = 15
+const Default_CheckInfo_IntervalSeconds float64 = 10
+const Default_CheckInfo_TimeoutSeconds float64 = 20
+
+func (m *CheckInfo) GetType() CheckInfo_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return CheckInfo_UNKNOWN
+}
+
+func (m *CheckInfo) GetCommand() *CheckInfo_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *CheckInfo) GetHttp() *CheckInfo_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *CheckInfo) GetTcp() *CheckInfo_Tcp {
+	if m != nil {
+		return m.Tcp
+	}
+	return nil
+}
+
+func (m *CheckInfo) GetDelaySeconds() float64 {
+	if m != nil && m.DelaySeconds != nil {
+		return *m.DelaySeconds
+	}
+	return Default_CheckInfo_DelaySeconds
+}
+
+func (m *CheckInfo) GetIntervalSeconds() float64 {
+	if m != nil && m.IntervalSeconds != nil {
+		return *m.IntervalSeconds
+	}
+	return Default_CheckInfo_IntervalSeconds
+}
+
+func (m *CheckInfo) GetTimeoutSeconds() float64 {
+	if m != nil && m.TimeoutSeconds != nil {
+		return *m.TimeoutSeconds
+	}
+	return Default_CheckInfo_TimeoutSeconds
+}
+
+// Describes a command check. If applicable, enters mount and/or network
+// namespaces of the task.
+type CheckInfo_Command struct {
+	Command          *CommandInfo `protobuf:"bytes,1,req,name=command" json:"command,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *CheckInfo_Command) Reset()                    { *m = CheckInfo_Command{} }
+func (m *CheckInfo_Command) String() string            { return proto.CompactTextString(m) }
+func (*CheckInfo_Command) ProtoMessage()               {}
+func (*CheckInfo_Command) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 0} }
+
+func (m *CheckInfo_Command) GetCommand() *CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+// Describes an HTTP check. Sends a GET request to
+// http://<host>:port/path. Note that <host> is not configurable and is
+// resolved automatically to 127.0.0.1.
+type CheckInfo_Http struct {
+	// Port to send the HTTP request.
+	Port *uint32 `protobuf:"varint,1,req,name=port" json:"port,omitempty"`
+	// HTTP request path.
+	Path             *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CheckInfo_Http) Reset()                    { *m = CheckInfo_Http{} }
+func (m *CheckInfo_Http) String() string            { return proto.CompactTextString(m) }
+func (*CheckInfo_Http) ProtoMessage()               {}
+func (*CheckInfo_Http) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 1} }
+
+func (m *CheckInfo_Http) GetPort() uint32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return 0
+}
+
+func (m *CheckInfo_Http) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+// Describes a TCP check, i.e. based on establishing a TCP connection to
+// the specified port. Note that <host> is not configurable and is resolved
+// automatically to 127.0.0.1.
+type CheckInfo_Tcp struct {
+	Port             *uint32 `protobuf:"varint,1,req,name=port" json:"port,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CheckInfo_Tcp) Reset()                    { *m = CheckInfo_Tcp{} }
+func (m *CheckInfo_Tcp) String() string            { return proto.CompactTextString(m) }
+func (*CheckInfo_Tcp) ProtoMessage()               {}
+func (*CheckInfo_Tcp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 2} }
+
+func (m *CheckInfo_Tcp) GetPort() uint32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return 0
+}
+
+// Describes a health check for a task or executor (or any arbitrary
+// process/command). A type is picked by specifying one of the
+// optional fields. Specifying more than one type is an error.
+type HealthCheck struct {
+	// Amount of time to wait to start health checking the task after it
+	// transitions to `TASK_RUNNING` or `TASK_STATING` if the latter is
+	// used by the executor.
+	DelaySeconds *float64 `protobuf:"fixed64,2,opt,name=delay_seconds,json=delaySeconds,def=15" json:"delay_seconds,omitempty"`
+	// Interval between health checks, i.e., amount of time to wait after
+	// the previous health check finished or timed out to start the next
+	// health check.
+	IntervalSeconds *float64 `protobuf:"fixed64,3,opt,name=interval_seconds,json=intervalSeconds,def=10" json:"interval_seconds,omitempty"`
+	// Amount of time to wait for the health check to complete. After this
+	// timeout, the health check is aborted and treated as a failure. Zero
+	// means infinite timeout.
+	TimeoutSeconds *float64 `protobuf:"fixed64,4,opt,name=timeout_seconds,json=timeoutSeconds,def=20" json:"timeout_seconds,omitempty"`
+	// Number of consecutive failures until the task is killed by the executor.
+	ConsecutiveFailures *uint32 `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,def=3" json:"consecutive_failures,omitempty"`
+	// Amount of time after the task is launched during which health check
+	// failures are ignored. Once a check succeeds for the first time,
+	// the grace period does not apply anymore. Note that it includes
+	// `delay_seconds`, i.e., setting `grace_period_seconds` < `delay_seconds`
+	// has no effect.
+	GracePeriodSeconds *float64 `protobuf:"fixed64,6,opt,name=grace_period_seconds,json=gracePeriodSeconds,def=10" json:"grace_period_seconds,omitempty"`
+	// The type of health check.
+	Type *HealthCheck_Type `protobuf:"varint,8,opt,name=type,enum=mesos.v1.HealthCheck_Type" json:"type,omitempty"`
+	// Command health check.
+	Command *CommandInfo `protobuf:"bytes,7,opt,name=command" json:"command,omitempty"`
+	// HTTP health check.
+	Http *HealthCheck_HTTPCheckInfo `protobuf:"bytes,1,opt,name=http" json:"http,omitempty"`
+	// TCP health check.
+	Tcp              *HealthCheck_TCPCheckInfo `protobuf:"bytes,9,opt,name=tcp" json:"tcp,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *HealthCheck) Reset()                    { *m = HealthCheck{} }
+func (m *HealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()               {}
+func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+const Default_HealthCheck_DelaySeconds float64 = 15
+const Default_HealthCheck_IntervalSeconds float64 = 10
+const Default_HealthCheck_TimeoutSeconds float64 = 20
+const Default_HealthCheck_ConsecutiveFailures uint32 = 3
+const Default_HealthCheck_GracePeriodSeconds float64 = 10
+
+func (m *HealthCheck) GetDelaySeconds() float64 {
+	if m != nil && m.DelaySeconds != nil {
+		return *m.DelaySeconds
+	}
+	return Default_HealthCheck_DelaySeconds
+}
+
+func (m *HealthCheck) GetIntervalSeconds() float64 {
+	if m != nil && m.IntervalSeconds != nil {
+		return *m.IntervalSeconds
+	}
+	return Default_HealthCheck_IntervalSeconds
+}
+
+func (m *HealthCheck) GetTimeoutSeconds() float64 {
+	if m != nil && m.TimeoutSeconds != nil {
+		return *m.TimeoutSeconds
+	}
+	return Default_HealthCheck_TimeoutSeconds
+}
+
+func (m *HealthCheck) GetConsecutiveFailures() uint32 {
+	if m != nil && m.ConsecutiveFailures != nil {
+		return *m.ConsecutiveFailures
+	}
+	return Default_HealthCheck_ConsecutiveFailures
+}
+
+func (m *HealthCheck) GetGracePeriodSeconds() float64 {
+	if m != nil && m.GracePeriodSeconds != nil {
+		return *m.GracePeriodSeconds
+	}
+	return Default_HealthCheck_GracePeriodSeconds
+}
+
+func (m *HealthCheck) GetType() HealthCheck_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return HealthCheck_UNKNOWN
+}
+
+func (m *HealthCheck) GetCommand() *CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetHttp() *HealthCheck_HTTPCheckInfo {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetTcp() *HealthCheck_TCPCheckInfo {
+	if m != nil {
+		return m.Tcp
+	}
+	return nil
+}
+
+// Describes an HTTP health check. Sends a GET request to
+// scheme://<host>:port/path. Note that <host> is not configurable and is
+// resolved automatically, in most cases to 127.0.0.1. Default executors
+// treat return codes between 200 and 399 as success; custom executors
+// may employ a different strategy, e.g. leveraging the `statuses` field.
+type HealthCheck_HTTPCheckInfo struct {
+	Protocol *NetworkInfo_Protocol `protobuf:"varint,5,opt,name=protocol,enum=mesos.v1.NetworkInfo_Protocol,def=1" json:"protocol,omitempty"`
+	// Currently "http" and "https" are supported.
+	Scheme *string `protobuf:"bytes,3,opt,name=scheme" json:"scheme,omitempty"`
+	// Port to send the HTTP request.
+	Port *uint32 `protobuf:"varint,1,req,name=port" json:"port,omitempty"`
+	// HTTP request path.
+	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// NOTE: It is up to the custom executor to interpret and act on this
+	// field. Setting this field has no effect on the default executors.
+	//
+	// TODO(haosdent): Deprecate this field when we add better support for
+	// success and possibly failure statuses, e.g. ranges of success and
+	// failure statuses.
+	Statuses         []uint32 `protobuf:"varint,4,rep,name=statuses" json:"statuses,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *HealthCheck_HTTPCheckInfo) Reset()                    { *m = HealthCheck_HTTPCheckInfo{} }
+func (m *HealthCheck_HTTPCheckInfo) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheck_HTTPCheckInfo) ProtoMessage()               {}
+func (*HealthCheck_HTTPCheckInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 0} }
+
+const Default_HealthCheck_HTTPCheckInfo_Protocol NetworkInfo_Protocol = NetworkInfo_IPv4
+
+func (m *HealthCheck_HTTPCheckInfo) GetProtocol() NetworkInfo_Protocol {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return Default_HealthCheck_HTTPCheckInfo_Protocol
+}
+
+func (m *HealthCheck_HTTPCheckInfo) GetScheme() string {
+	if m != nil && m.Scheme != nil {
+		return *m.Scheme
+	}
+	return ""
+}
+
+func (m *HealthCheck_HTTPCheckInfo) GetPort() uint32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return 0
+}
+
+func (m *HealthCheck_HTTPCheckInfo) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *HealthCheck_HTTPCheckInfo) GetStatuses() []uint32 {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// Describes a TCP health check, i.e. based on establishing
+// a TCP connection to the specified port.
+type HealthCheck_TCPCheckInfo struct {
+	Protocol *NetworkInfo_Protocol `protobuf:"varint,2,opt,name=protocol,enum=mesos.v1.NetworkInfo_Protocol,def=1" json:"protocol,omitempty"`
+	// Port expected to be open.
+	Port             *uint32 `protobuf:"varint,1,req,name=port" json:"port,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *HealthCheck_TCPCheckInfo) Reset()                    { *m = HealthCheck_TCPCheckInfo{} }
+func (m *HealthCheck_TCPCheckInfo) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheck_TCPCheckInfo) ProtoMessage()               {}
+func (*HealthCheck_TCPCheckInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 1} }
+
+const Default_HealthCheck_TCPCheckInfo_Protocol NetworkInfo_Protocol = NetworkInfo_IPv4
+
+func (m *HealthCheck_TCPCheckInfo) GetProtocol() NetworkInfo_Protocol {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return Default_HealthCheck_TCPCheckInfo_Protocol
+}
+
+func (m *HealthCheck_TCPCheckInfo) GetPort() uint32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return 0
+}
+
+// Describes a kill policy for a task. Currently does not express
+// different policies (e.g. hitting HTTP endpoints), only controls
+// how long to wait between graceful and forcible task kill:
+//
+//	graceful kill --------------> forcible kill
+//	               grace_period
+//
+// Kill policies are best-effort, because machine failures / forcible
+// terminations may occur.
+//
+// NOTE: For executor-less command-based tasks, the kill is performed
+// via sending a signal to the task process: SIGTERM for the graceful
+// kill and SIGKILL for the forcible kill. For the docker executor-less
+// tasks the grace period is passed to 'docker stop --time'.
+type KillPolicy struct {
+	// The grace period specifies how long to wait before forcibly
+	// killing the task. It is recommended to attempt to gracefully
+	// kill the task (and send TASK_KILLING) to indicate that the
+	// graceful kill is in progress. Once the grace period elapses,
+	// if the task has not terminated, a forcible kill should occur.
+	// The task should not assume that it will always be allotted
+	// the full grace period. For example, the executor may be
+	// shutdown more quickly by the agent, or failures / forcible
+	// terminations may occur.
+	GracePeriod      *DurationInfo `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod" json:"grace_period,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *KillPolicy) Reset()                    { *m = KillPolicy{} }
+func (m *KillPolicy) String() string            { return proto.CompactTextString(m) }
+func (*KillPolicy) ProtoMessage()               {}
+func (*KillPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *KillPolicy) GetGracePeriod() *DurationInfo {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return nil
+}
+
+// Describes a command, executed via: '/bin/sh -c value'. Any URIs specified
+// are fetched before executing the command.  If the executable field for an
+// uri is set, executable file permission is set on the downloaded file.
+// Otherwise, if the downloaded file has a recognized archive extension
+// (currently [compressed] tar and zip) it is extracted into the executor's
+// working directory. This extraction can be disabled by setting `extract` to
+// false. In addition, any environment variables are set before executing
+// the command (so they can be used to "parameterize" your command).
+type CommandInfo struct {
+	Uris        []*CommandInfo_URI `protobuf:"bytes,1,rep,name=uris" json:"uris,omitempty"`
+	Environment *Environment       `protobuf:"bytes,2,opt,name=environment" json:"environment,omitempty"`
+	// There are two ways to specify the command:
+	// 1) If 'shell == true', the command will be launched via shell
+	// 		(i.e., /bin/sh -c 'value'). The 'value' specified will be
+	// 		treated as the shell command. The 'arguments' will be ignored.
+	// 2) If 'shell == false', the command will be launched by passing
+	// 		arguments to an executable. The 'value' specified will be
+	// 		treated as the filename of the executable. The 'arguments'
+	// 		will be treated as the arguments to the executable. This is
+	// 		similar to how POSIX exec families launch processes (i.e.,
+	// 		execlp(value, arguments(0), arguments(1), ...)).
+	// NOTE: The field 'value' is changed from 'required' to 'optional'
+	// in 0.20.0. It will only cause issues if a new framework is
+	// connecting to an old master.
+	Shell     *bool    `protobuf:"varint,6,opt,name=shell,def=1" json:"shell,omitempty"`
+	Value     *string  `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+	Arguments []string `protobuf:"bytes,7,rep,name=arguments" json:"arguments,omitempty"`
+	// Enables executor and tasks to run as a specific user. If the user
+	// field is present both in FrameworkInfo and here, the CommandInfo
+	// user value takes precedence.
+	User             *string `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CommandInfo) Reset()                    { *m = CommandInfo{} }
+func (m *CommandInfo) String() string            { return proto.CompactTextString(m) }
+func (*CommandInfo) ProtoMessage()               {}
+func (*CommandInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+const Default_CommandInfo_Shell bool = true
+
+func (m *CommandInfo) GetUris() []*CommandInfo_URI {
+	if m != nil {
+		return m.Uris
+	}
+	return nil
+}
+
+func (m *CommandInfo) GetEnvironment() *Environment {
+	if m != nil {
+		return m.Environment
+	}
+	return nil
+}
+
+func (m *CommandInfo) GetShell() bool {
+	if m != nil && m.Shell != nil {
+		return *m.Shell
+	}
+	return Default_CommandInfo_Shell
+}
+
+func (m *CommandInfo) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+func (m *CommandInfo) GetArguments() []string {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *CommandInfo) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+type CommandInfo_URI struct {
+	Value      *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	Executable *bool   `protobuf:"varint,2,opt,name=executable" json:"executable,omitempty"`
+	// In case the fetched file is recognized as an archive, extract
+	// its contents into the sandbox. Note that a cached archive is
+	// not copied from the cache to the sandbox in case extraction
+	// originates from an archive in the cache.
+	Extract *bool `protobuf:"varint,3,opt,name=extract,def=1" json:"extract,omitempty"`
+	// If this field is "true", the fetcher cache will be used. If not,
+	// fetching bypasses the cache and downloads directly into the
+	// sandbox directory, no matter whether a suitable cache file is
+	// available or not. The former directs the fetcher to download to
+	// the file cache, then copy from there to the sandbox. Subsequent
+	// fetch attempts with the same URI will omit downloading and copy
+	// from the cache as long as the file is resident there. Cache files
+	// may get evicted at any time, which then leads to renewed
+	// downloading. See also "docs/fetcher.md" and
+	// "docs/fetcher-cache-internals.md".
+	Cache *bool `protobuf:"varint,4,opt,name=cache" json:"cache,omitempty"`
+	// The fetcher's default behavior is to use the URI string's basename to
+	// name the local copy. If this field is provided, the local copy will be
+	// named with its value instead. If there is a directory component (which
+	// must be a relative path), the local copy will be stored in that
+	// subdirectory inside the sandbox.
+	OutputFile       *string `protobuf:"bytes,5,opt,name=output_file,json=outputFile" json:"output_file,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CommandInfo_URI) Reset()                    { *m = CommandInfo_URI{} }
+func (m *CommandInfo_URI) String() string            { return proto.CompactTextString(m) }
+func (*CommandInfo_URI) ProtoMessage()               {}
+func (*CommandInfo_URI) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19, 0} }
+
+const Default_CommandInfo_URI_Extract bool = true
+
+func (m *CommandInfo_URI) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+func (m *CommandInfo_URI) GetExecutable() bool {
+	if m != nil && m.Executable != nil {
+		return *m.Executable
+	}
+	return false
+}
+
+func (m *CommandInfo_URI) GetExtract() bool {
+	if m != nil && m.Extract != nil {
+		return *m.Extract
+	}
+	return Default_CommandInfo_URI_Extract
+}
+
+func (m *CommandInfo_URI) GetCache() bool {
+	if m != nil && m.Cache != nil {
+		return *m.Cache
+	}
+	return false
+}
+
+func (m *CommandInfo_URI) GetOutputFile() string {
+	if m != nil && m.OutputFile != nil {
+		return *m.OutputFile
+	}
+	return ""
+}
+
+// Describes information about an executor.
+type ExecutorInfo struct {
+	// For backwards compatibility, if this field is not set when using `LAUNCH`
+	// operation, Mesos will infer the type by checking if `command` is set
+	// (`CUSTOM`) or unset (`DEFAULT`). `type` must be set when using
+	// `LAUNCH_GROUP` operation.
+	//
+	// TODO(vinod): Add support for explicitly setting `type` to `DEFAULT` in
+	// `LAUNCH` operation.
+	Type        *ExecutorInfo_Type `protobuf:"varint,15,opt,name=type,enum=mesos.v1.ExecutorInfo_Type" json:"type,omitempty"`
+	ExecutorId  *ExecutorID        `protobuf:"bytes,1,req,name=executor_id,json=executorId" json:"executor_id,omitempty"`
+	FrameworkId *FrameworkID       `protobuf:"bytes,8,opt,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	Command     *CommandInfo       `protobuf:"bytes,7,opt,name=command" json:"command,omitempty"`
+	// Executor provided with a container will launch the container
+	// with the executor's CommandInfo and we expect the container to
+	// act as a Mesos executor.
+	Container *ContainerInfo `protobuf:"bytes,11,opt,name=container" json:"container,omitempty"`
+	Resources []*Resource    `protobuf:"bytes,5,rep,name=resources" json:"resources,omitempty"`
+	Name      *string        `protobuf:"bytes,9,opt,name=name" json:"name,omitempty"`
+	// 'source' is an identifier style string used by frameworks to
+	// track the source of an executor. This is useful when it's
+	// possible for different executor ids to be related semantically.
+	//
+	// NOTE: 'source' is exposed alongside the resource usage of the
+	// executor via JSON on the agent. This allows users to import usage
+	// information into a time series database for monitoring.
+	//
+	// This field is deprecated since 1.0. Please use labels for
+	// free-form metadata instead.
+	Source *string `protobuf:"bytes,10,opt,name=source" json:"source,omitempty"`
+	// This field can be used to pass arbitrary bytes to an executor.
+	Data []byte `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	// Service discovery information for the executor. It is not
+	// interpreted or acted upon by Mesos. It is up to a service
+	// discovery system to use this information as needed and to handle
+	// executors without service discovery information.
+	Discovery *DiscoveryInfo `protobuf:"bytes,12,opt,name=discovery" json:"discovery,omitempty"`
+	// When shutting down an executor the agent will wait in a
+	// best-effort manner for the grace period specified here
+	// before forcibly destroying the container. The executor
+	// must not assume that it will always be allotted the full
+	// grace period, as the agent may decide to allot a shorter
+	// period and failures / forcible terminations may occur.
+	ShutdownGracePeriod *DurationInfo `protobuf:"bytes,13,opt,name=shutdown_grace_period,json=shutdownGracePeriod" json:"shutdown_grace_period,omitempty"`
+	// Labels are free-form key value pairs which are exposed through
+	// master and agent endpoints. Labels will not be interpreted or
+	// acted upon by Mesos itself. As opposed to the data field, labels
+	// will be kept in memory on master and agent processes. Therefore,
+	// labels should be used to tag executors with lightweight metadata.
+	// Labels should not contain duplicate key-value pairs.
+	Labels           *Labels `protobuf:"bytes,14,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ExecutorInfo) Reset()                    { *m = ExecutorInfo{} }
+func (m *ExecutorInfo) String() string            { return proto.CompactTextString(m) }
+func (*ExecutorInfo) ProtoMessage()               {}
+func (*ExecutorInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *ExecutorInfo) GetType() ExecutorInfo_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ExecutorInfo_UNKNOWN
+}
+
+func (m *ExecutorInfo) GetExecutorId() *ExecutorID {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetFrameworkId() *FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetCommand() *CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetContainer() *ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ExecutorInfo) GetSource() string {
+	if m != nil && m.Source != nil {
+		return *m.Source
+	}
+	return ""
+}
+
+func (m *ExecutorInfo) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetDiscovery() *DiscoveryInfo {
+	if m != nil {
+		return m.Discovery
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetShutdownGracePeriod() *DurationInfo {
+	if m != nil {
+		return m.ShutdownGracePeriod
+	}
+	return nil
+}
+
+func (m *ExecutorInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Describes a domain. A domain is a collection of hosts that have
+// similar characteristics. Mesos currently only supports "fault
+// domains", which identify groups of hosts with similar failure
+// characteristics.
+//
+// Frameworks can generally assume that network links between hosts in
+// the same fault domain have lower latency, higher bandwidth, and better
+// availability than network links between hosts in different domains.
+// Schedulers may prefer to place network-intensive workloads in the
+// same domain, as this may improve performance. Conversely, a single
+// failure that affects a host in a domain may be more likely to
+// affect other hosts in the same domain; hence, schedulers may prefer
+// to place workloads that require high availability in multiple
+// domains. (For example, all the hosts in a single rack might lose
+// power or network connectivity simultaneously.)
+//
+// There are two kinds of fault domains: regions and zones. Regions
+// offer the highest degree of fault isolation, but network latency
+// between regions is typically high (typically >50 ms). Zones offer a
+// modest degree of fault isolation along with reasonably low network
+// latency (typically <10 ms).
+//
+// The mapping from fault domains to physical infrastructure is up to
+// the operator to configure. In cloud environments, regions and zones
+// can be mapped to the "region" and "availability zone" concepts
+// exposed by most cloud providers, respectively. In on-premise
+// deployments, regions and zones can be mapped to data centers and
+// racks, respectively.
+//
+// Both masters and agents can be configured with domains. Frameworks
+// can compare the domains of two hosts to determine if the hosts are
+// in the same zone, in different zones in the same region, or in
+// different regions. Note that all masters in a given Mesos cluster
+// must be in the same region.
+//
+// Complex deployments may have additional levels of hierarchy: for example,
+// multiple racks might be grouped together into "halls" and multiple DCs in
+// the same geographical vicinity might be grouped together. As a convention,
+// the recommended way to represent additional levels of hierarchy is via dot-
+// separated labels in the existing zone and region fields. For example, the
+// fact that racks "abc" and "def" are in the same hall might be represented
+// using the zone names "rack-abc.hall-1" and "rack-def.hall-1", for example.
+// Software that is not aware of this additional structure will compare the
+// zone names for equality- hence, the two zones will be treated as different
+// (unrelated) zones. Software that is "hall-aware" can inspect the zone names
+// and make use of the additional hierarchy.
+type DomainInfo struct {
+	FaultDomain      *DomainInfo_FaultDomain `protobuf:"bytes,1,opt,name=fault_domain,json=faultDomain" json:"fault_domain,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *DomainInfo) Reset()                    { *m = DomainInfo{} }
+func (m *DomainInfo) String() string            { return proto.CompactTextString(m) }
+func (*DomainInfo) ProtoMessage()               {}
+func (*DomainInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *DomainInfo) GetFaultDomain() *DomainInfo_FaultDomain {
+	if m != nil {
+		return m.FaultDomain
+	}
+	return nil
+}
+
+type DomainInfo_FaultDomain struct {
+	Region           *DomainInfo_FaultDomain_RegionInfo `protobuf:"bytes,1,req,name=region" json:"region,omitempty"`
+	Zone             *DomainInfo_FaultDomain_ZoneInfo   `protobuf:"bytes,2,req,name=zone" json:"zone,omitempty"`
+	XXX_unrecognized []byte                             `json:"-"`
+}
+
+func (m *DomainInfo_FaultDomain) Reset()                    { *m = DomainInfo_FaultDomain{} }
+func (m *DomainInfo_FaultDomain) String() string            { return proto.CompactTextString(m) }
+func (*DomainInfo_FaultDomain) ProtoMessage()               {}
+func (*DomainInfo_FaultDomain) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21, 0} }
+
+func (m *DomainInfo_FaultDomain) GetRegion() *DomainInfo_FaultDomain_RegionInfo {
+	if m != nil {
+		return m.Region
+	}
+	return nil
+}
+
+func (m *DomainInfo_FaultDomain) GetZone() *DomainInfo_FaultDomain_ZoneInfo {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type DomainInfo_FaultDomain_RegionInfo struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *DomainInfo_FaultDomain_RegionInfo) Reset()         { *m = DomainInfo_FaultDomain_RegionInfo{} }
+func (m *DomainInfo_FaultDomain_RegionInfo) String() string { return proto.CompactTextString(m) }
+func (*DomainInfo_FaultDomain_RegionInfo) ProtoMessage()    {}
+func (*DomainInfo_FaultDomain_RegionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{21, 0, 0}
+}
+
+func (m *DomainInfo_FaultDomain_RegionInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+type DomainInfo_FaultDomain_ZoneInfo struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *DomainInfo_FaultDomain_ZoneInfo) Reset()         { *m = DomainInfo_FaultDomain_ZoneInfo{} }
+func (m *DomainInfo_FaultDomain_ZoneInfo) String() string { return proto.CompactTextString(m) }
+func (*DomainInfo_FaultDomain_ZoneInfo) ProtoMessage()    {}
+func (*DomainInfo_FaultDomain_ZoneInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{21, 0, 1}
+}
+
+func (m *DomainInfo_FaultDomain_ZoneInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+// Describes a master. This will probably have more fields in the
+// future which might be used, for example, to link a framework webui
+// to a master webui.
+type MasterInfo struct {
+	Id *string `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	// The IP address (only IPv4) as a packed 4-bytes integer,
+	// stored in network order.  Deprecated, use `address.ip` instead.
+	Ip *uint32 `protobuf:"varint,2,req,name=ip" json:"ip,omitempty"`
+	// The TCP port the Master is listening on for incoming
+	// HTTP requests; deprecated, use `address.port` instead.
+	Port *uint32 `protobuf:"varint,3,req,name=port,def=5050" json:"port,omitempty"`
+	// In the default implementation, this will contain information
+	// about both the IP address, port and Master name; it should really
+	// not be relied upon by external tooling/frameworks and be
+	// considered an "internal" implementation field.
+	Pid *string `protobuf:"bytes,4,opt,name=pid" json:"pid,omitempty"`
+	// The server's hostname, if available; it may be unreliable
+	// in environments where the DNS configuration does not resolve
+	// internal hostnames (eg, some public cloud providers).
+	// Deprecated, use `address.hostname` instead.
+	Hostname *string `protobuf:"bytes,5,opt,name=hostname" json:"hostname,omitempty"`
+	// The running Master version, as a string; taken from the
+	// generated "master/version.hpp".
+	Version *string `protobuf:"bytes,6,opt,name=version" json:"version,omitempty"`
+	// The full IP address (supports both IPv4 and IPv6 formats)
+	// and supersedes the use of `ip`, `port` and `hostname`.
+	// Since Mesos 0.24.
+	Address *Address `protobuf:"bytes,7,opt,name=address" json:"address,omitempty"`
+	// The domain that this master belongs to. All masters in a Mesos
+	// cluster should belong to the same region.
+	Domain           *DomainInfo              `protobuf:"bytes,8,opt,name=domain" json:"domain,omitempty"`
+	Capabilities     []*MasterInfo_Capability `protobuf:"bytes,9,rep,name=capabilities" json:"capabilities,omitempty"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *MasterInfo) Reset()                    { *m = MasterInfo{} }
+func (m *MasterInfo) String() string            { return proto.CompactTextString(m) }
+func (*MasterInfo) ProtoMessage()               {}
+func (*MasterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+const Default_MasterInfo_Port uint32 = 5050
+
+func (m *MasterInfo) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetIp() uint32 {
+	if m != nil && m.Ip != nil {
+		return *m.Ip
+	}
+	return 0
+}
+
+func (m *MasterInfo) GetPort() uint32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return Default_MasterInfo_Port
+}
+
+func (m *MasterInfo) GetPid() string {
+	if m != nil && m.Pid != nil {
+		return *m.Pid
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MasterInfo) GetDomain() *DomainInfo {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+func (m *MasterInfo) GetCapabilities() []*MasterInfo_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type MasterInfo_Capability struct {
+	Type             *MasterInfo_Capability_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.MasterInfo_Capability_Type" json:"type,omitempty"`
+	XXX_unrecognized []byte                      `json:"-"`
+}
+
+func (m *MasterInfo_Capability) Reset()                    { *m = MasterInfo_Capability{} }
+func (m *MasterInfo_Capability) String() string            { return proto.CompactTextString(m) }
+func (*MasterInfo_Capability) ProtoMessage()               {}
+func (*MasterInfo_Capability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 0} }
+
+func (m *MasterInfo_Capability) GetType() MasterInfo_Capability_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return MasterInfo_Capability_UNKNOWN
+}
+
+// Describes an agent. Note that the 'id' field is only available
+// after an agent is registered with the master, and is made available
+// here to facilitate re-registration.
+type AgentInfo struct {
+	Hostname *string `protobuf:"bytes,1,req,name=hostname" json:"hostname,omitempty"`
+	Port     *int32  `protobuf:"varint,8,opt,name=port,def=5051" json:"port,omitempty"`
+	// The configured resources at the agent. This does not include any
+	// dynamic reservations or persistent volumes that may currently
+	// exist at the agent.
+	Resources  []*Resource  `protobuf:"bytes,3,rep,name=resources" json:"resources,omitempty"`
+	Attributes []*Attribute `protobuf:"bytes,5,rep,name=attributes" json:"attributes,omitempty"`
+	Id         *AgentID     `protobuf:"bytes,6,opt,name=id" json:"id,omitempty"`
+	// The domain that this agent belongs to. If the agent's region
+	// differs from the master's region, it will not appear in resource
+	// offers to frameworks that have not enabled the REGION_AWARE
+	// capability.
+	Domain           *DomainInfo `protobuf:"bytes,10,opt,name=domain" json:"domain,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *AgentInfo) Reset()                    { *m = AgentInfo{} }
+func (m *AgentInfo) String() string            { return proto.CompactTextString(m) }
+func (*AgentInfo) ProtoMessage()               {}
+func (*AgentInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+const Default_AgentInfo_Port int32 = 5051
+
+func (m *AgentInfo) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetPort() int32 {
+	if m != nil && m.Port != nil {
+		return *m.Port
+	}
+	return Default_AgentInfo_Port
+}
+
+func (m *AgentInfo) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *AgentInfo) GetAttributes() []*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *AgentInfo) GetId() *AgentID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *AgentInfo) GetDomain() *DomainInfo {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+type AgentInfo_Capability struct {
+	// Enum fields should be optional, see: MESOS-4997.
+	Type             *AgentInfo_Capability_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.AgentInfo_Capability_Type" json:"type,omitempty"`
+	XXX_unrecognized []byte                     `json:"-"`
+}
+
+func (m *AgentInfo_Capability) Reset()                    { *m = AgentInfo_Capability{} }
+func (m *AgentInfo_Capability) String() string            { return proto.CompactTextString(m) }
+func (*AgentInfo_Capability) ProtoMessage()               {}
+func (*AgentInfo_Capability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23, 0} }
+
+func (m *AgentInfo_Capability) GetType() AgentInfo_Capability_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return AgentInfo_Capability_UNKNOWN
+}
+
+// Describes the container configuration to run a CSI plugin component.
+type CSIPluginContainerInfo struct {
+	Services         []CSIPluginContainerInfo_Service `protobuf:"varint,1,rep,name=services,enum=mesos.v1.CSIPluginContainerInfo_Service" json:"services,omitempty"`
+	Command          *CommandInfo                     `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Resources        []*Resource                      `protobuf:"bytes,3,rep,name=resources" json:"resources,omitempty"`
+	Container        *ContainerInfo                   `protobuf:"bytes,4,opt,name=container" json:"container,omitempty"`
+	XXX_unrecognized []byte                           `json:"-"`
+}
+
+func (m *CSIPluginContainerInfo) Reset()                    { *m = CSIPluginContainerInfo{} }
+func (m *CSIPluginContainerInfo) String() string            { return proto.CompactTextString(m) }
+func (*CSIPluginContainerInfo) ProtoMessage()               {}
+func (*CSIPluginContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *CSIPluginContainerInfo) GetServices() []CSIPluginContainerInfo_Service {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *CSIPluginContainerInfo) GetCommand() *CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *CSIPluginContainerInfo) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *CSIPluginContainerInfo) GetContainer() *ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+// Describes a CSI plugin.
+type CSIPluginInfo struct {
+	// The type of the CSI plugin. This uniquely identifies a CSI
+	// implementation. For instance:
+	//     org.apache.mesos.csi.test
+	//
+	// Please follow to Java package naming convention
+	// (https://en.wikipedia.org/wiki/Java_package#Package_naming_conventions)
+	// to avoid conflicts on type names.
+	Type *string `protobuf:"bytes,1,req,name=type" json:"type,omitempty"`
+	// The name of the CSI plugin. There could be multiple instances of a
+	// type of CSI plugin within a Mesos cluster. The name field is used to
+	// distinguish these instances. It should be a legal Java identifier
+	// (https://docs.oracle.com/javase/tutorial/java/nutsandbolts/variables.html)
+	// to avoid conflicts on concatenation of type and name.
+	//
+	// The type and name together provide the means to uniquely identify a storage
+	// backend and its resources in the cluster, so the operator should ensure
+	// that the concatenation of type and name is unique in the cluster, and it
+	// remains the same if the instance is migrated to another agent (e.g., there
+	// is a change in the agent ID).
+	Name *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	// A list of container configurations to run CSI plugin components.
+	// The controller service will be served by the first configuration
+	// that contains `CONTROLLER_SERVICE`, and the node service will be
+	// served by the first configuration that contains `NODE_SERVICE`.
+	Containers       []*CSIPluginContainerInfo `protobuf:"bytes,3,rep,name=containers" json:"containers,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *CSIPluginInfo) Reset()                    { *m = CSIPluginInfo{} }
+func (m *CSIPluginInfo) String() string            { return proto.CompactTextString(m) }
+func (*CSIPluginInfo) ProtoMessage()               {}
+func (*CSIPluginInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *CSIPluginInfo) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *CSIPluginInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *CSIPluginInfo) GetContainers() []*CSIPluginContainerInfo {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+// Describes a resource provider. Note that the 'id' field is only available
+// after a resource provider is registered with the master, and is made
+// available here to facilitate re-registration.
+type ResourceProviderInfo struct {
+	Id         *ResourceProviderID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Attributes []*Attribute        `protobuf:"bytes,2,rep,name=attributes" json:"attributes,omitempty"`
+	// The type of the resource provider. This uniquely identifies a
+	// resource provider implementation. For instance:
+	//     org.apache.mesos.rp.local.storage
+	//
+	// Please follow to Java package naming convention
+	// (https://en.wikipedia.org/wiki/Java_package#Package_naming_conventions)
+	// to avoid conflicts on type names.
+	Type *string `protobuf:"bytes,3,req,name=type" json:"type,omitempty"`
+	// The name of the resource provider. There could be multiple
+	// instances of a type of resource provider. The name field is used
+	// to distinguish these instances. It should be a legal Java identifier
+	// (https://docs.oracle.com/javase/tutorial/java/nutsandbolts/variables.html)
+	// to avoid conflicts on concatenation of type and name.
+	Name *string `protobuf:"bytes,4,req,name=name" json:"name,omitempty"`
+	// The stack of default reservations. If this field is not empty, it
+	// indicates that resources from this resource provider are reserved
+	// by default, except for the resources that have been reserved or
+	// unreserved through operations. The first `ReservationInfo`
+	// may have type `STATIC` or `DYNAMIC`, but the rest must have
+	// `DYNAMIC`. One can create a new reservation on top of an existing
+	// one by pushing a new `ReservationInfo` to the back. The last
+	// `ReservationInfo` in this stack is the "current" reservation. The
+	// new reservation's role must be a child of the current one.
+	DefaultReservations []*Resource_ReservationInfo   `protobuf:"bytes,5,rep,name=default_reservations,json=defaultReservations" json:"default_reservations,omitempty"`
+	Storage             *ResourceProviderInfo_Storage `protobuf:"bytes,6,opt,name=storage" json:"storage,omitempty"`
+	XXX_unrecognized    []byte                        `json:"-"`
+}
+
+func (m *ResourceProviderInfo) Reset()                    { *m = ResourceProviderInfo{} }
+func (m *ResourceProviderInfo) String() string            { return proto.CompactTextString(m) }
+func (*ResourceProviderInfo) ProtoMessage()               {}
+func (*ResourceProviderInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *ResourceProviderInfo) GetId() *ResourceProviderID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ResourceProviderInfo) GetAttributes() []*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *ResourceProviderInfo) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *ResourceProviderInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ResourceProviderInfo) GetDefaultReservations() []*Resource_ReservationInfo {
+	if m != nil {
+		return m.DefaultReservations
+	}
+	return nil
+}
+
+func (m *ResourceProviderInfo) GetStorage() *ResourceProviderInfo_Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+// Storage resource provider related information.
+type ResourceProviderInfo_Storage struct {
+	Plugin *CSIPluginInfo `protobuf:"bytes,1,req,name=plugin" json:"plugin,omitempty"`
+	// Amount of time to wait after the resource provider finishes reconciling
+	// existing volumes and storage pools against the CSI plugin to start the
+	// next reconciliation. A non-positive value means that no reconciliation
+	// will happen after startup.
+	ReconciliationIntervalSeconds *float64 `protobuf:"fixed64,2,opt,name=reconciliation_interval_seconds,json=reconciliationIntervalSeconds" json:"reconciliation_interval_seconds,omitempty"`
+	XXX_unrecognized              []byte   `json:"-"`
+}
+
+func (m *ResourceProviderInfo_Storage) Reset()         { *m = ResourceProviderInfo_Storage{} }
+func (m *ResourceProviderInfo_Storage) String() string { return proto.CompactTextString(m) }
+func (*ResourceProviderInfo_Storage) ProtoMessage()    {}
+func (*ResourceProviderInfo_Storage) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{26, 0}
+}
+
+func (m *ResourceProviderInfo_Storage) GetPlugin() *CSIPluginInfo {
+	if m != nil {
+		return m.Plugin
+	}
+	return nil
+}
+
+func (m *ResourceProviderInfo_Storage) GetReconciliationIntervalSeconds() float64 {
+	if m != nil && m.ReconciliationIntervalSeconds != nil {
+		return *m.ReconciliationIntervalSeconds
+	}
+	return 0
+}
+
+// Describes an Attribute or Resource "value". A value is described
+// using the standard protocol buffer "union" trick.
+type Value struct {
+	Type             *Value_Type   `protobuf:"varint,1,req,name=type,enum=mesos.v1.Value_Type" json:"type,omitempty"`
+	Scalar           *Value_Scalar `protobuf:"bytes,2,opt,name=scalar" json:"scalar,omitempty"`
+	Ranges           *Value_Ranges `protobuf:"bytes,3,opt,name=ranges" json:"ranges,omitempty"`
+	Set              *Value_Set    `protobuf:"bytes,4,opt,name=set" json:"set,omitempty"`
+	Text             *Value_Text   `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Value) Reset()                    { *m = Value{} }
+func (m *Value) String() string            { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()               {}
+func (*Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *Value) GetType() Value_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Value_SCALAR
+}
+
+func (m *Value) GetScalar() *Value_Scalar {
+	if m != nil {
+		return m.Scalar
+	}
+	return nil
+}
+
+func (m *Value) GetRanges() *Value_Ranges {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *Value) GetSet() *Value_Set {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *Value) GetText() *Value_Text {
+	if m != nil {
+		return m.Text
+	}
+	return nil
+}
+
+type Value_Scalar struct {
+	// Scalar values are represented using floating point. To reduce
+	// the chance of unpredictable floating point behavior due to
+	// roundoff error, Mesos only supports three decimal digits of
+	// precision for scalar resource values. That is, floating point
+	// values are converted to a fixed point format that supports
+	// three decimal digits of precision, and then converted back to
+	// floating point on output. Any additional precision in scalar
+	// resource values is discarded (via rounding).
+	Value            *float64 `protobuf:"fixed64,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Value_Scalar) Reset()                    { *m = Value_Scalar{} }
+func (m *Value_Scalar) String() string            { return proto.CompactTextString(m) }
+func (*Value_Scalar) ProtoMessage()               {}
+func (*Value_Scalar) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 0} }
+
+func (m *Value_Scalar) GetValue() float64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+type Value_Range struct {
+	Begin            *uint64 `protobuf:"varint,1,req,name=begin" json:"begin,omitempty"`
+	End              *uint64 `protobuf:"varint,2,req,name=end" json:"end,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Value_Range) Reset()                    { *m = Value_Range{} }
+func (m *Value_Range) String() string            { return proto.CompactTextString(m) }
+func (*Value_Range) ProtoMessage()               {}
+func (*Value_Range) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 1} }
+
+func (m *Value_Range) GetBegin() uint64 {
+	if m != nil && m.Begin != nil {
+		return *m.Begin
+	}
+	return 0
+}
+
+func (m *Value_Range) GetEnd() uint64 {
+	if m != nil && m.End != nil {
+		return *m.End
+	}
+	return 0
+}
+
+type Value_Ranges struct {
+	Range            []*Value_Range `protobuf:"bytes,1,rep,name=range" json:"range,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Value_Ranges) Reset()                    { *m = Value_Ranges{} }
+func (m *Value_Ranges) String() string            { return proto.CompactTextString(m) }
+func (*Value_Ranges) ProtoMessage()               {}
+func (*Value_Ranges) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 2} }
+
+func (m *Value_Ranges) GetRange() []*Value_Range {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+type Value_Set struct {
+	Item             []string `protobuf:"bytes,1,rep,name=item" json:"item,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Value_Set) Reset()                    { *m = Value_Set{} }
+func (m *Value_Set) String() string            { return proto.CompactTextString(m) }
+func (*Value_Set) ProtoMessage()               {}
+func (*Value_Set) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 3} }
+
+func (m *Value_Set) GetItem() []string {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type Value_Text struct {
+	Value            *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Value_Text) Reset()                    { *m = Value_Text{} }
+func (m *Value_Text) String() string            { return proto.CompactTextString(m) }
+func (*Value_Text) ProtoMessage()               {}
+func (*Value_Text) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 4} }
+
+func (m *Value_Text) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Describes an attribute that can be set on a machine. For now,
+// attributes and resources share the same "value" type, but this may
+// change in the future and attributes may only be string based.
+type Attribute struct {
+	Name             *string       `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Type             *Value_Type   `protobuf:"varint,2,req,name=type,enum=mesos.v1.Value_Type" json:"type,omitempty"`
+	Scalar           *Value_Scalar `protobuf:"bytes,3,opt,name=scalar" json:"scalar,omitempty"`
+	Ranges           *Value_Ranges `protobuf:"bytes,4,opt,name=ranges" json:"ranges,omitempty"`
+	Set              *Value_Set    `protobuf:"bytes,6,opt,name=set" json:"set,omitempty"`
+	Text             *Value_Text   `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Attribute) Reset()                    { *m = Attribute{} }
+func (m *Attribute) String() string            { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()               {}
+func (*Attribute) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *Attribute) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Attribute) GetType() Value_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Value_SCALAR
+}
+
+func (m *Attribute) GetScalar() *Value_Scalar {
+	if m != nil {
+		return m.Scalar
+	}
+	return nil
+}
+
+func (m *Attribute) GetRanges() *Value_Ranges {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *Attribute) GetSet() *Value_Set {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *Attribute) GetText() *Value_Text {
+	if m != nil {
+		return m.Text
+	}
+	return nil
+}
+
+// Describes a resource from a resource provider. The `name` field is
+// a string like "cpus" or "mem" that indicates which kind of resource
+// this is; the rest of the fields describe the properties of the
+// resource. A resource can take on one of three types: scalar
+// (double), a list of finite and discrete ranges (e.g., [1-10,
+// 20-30]), or a set of items. A resource is described using the
+// standard protocol buffer "union" trick.
+//
+// Note that "disk" and "mem" resources are scalar values expressed in
+// megabytes. Fractional "cpus" values are allowed (e.g., "0.5"),
+// which correspond to partial shares of a CPU.
+type Resource struct {
+	// Specified if the resource comes from a particular resource provider.
+	ProviderId *ResourceProviderID `protobuf:"bytes,12,opt,name=provider_id,json=providerId" json:"provider_id,omitempty"`
+	Name       *string             `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Type       *Value_Type         `protobuf:"varint,2,req,name=type,enum=mesos.v1.Value_Type" json:"type,omitempty"`
+	Scalar     *Value_Scalar       `protobuf:"bytes,3,opt,name=scalar" json:"scalar,omitempty"`
+	Ranges     *Value_Ranges       `protobuf:"bytes,4,opt,name=ranges" json:"ranges,omitempty"`
+	Set        *Value_Set          `protobuf:"bytes,5,opt,name=set" json:"set,omitempty"`
+	// The role that this resource is reserved for. If "*", this indicates
+	// that the resource is unreserved. Otherwise, the resource will only
+	// be offered to frameworks that belong to this role.
+	//
+	// NOTE: Frameworks must not set this field if `reservations` is set.
+	//       See the 'Resource Format' section for more details.
+	//
+	// TODO(mpark): Deprecate once `reservations` is no longer experimental.
+	Role           *string                  `protobuf:"bytes,6,opt,name=role,def=*" json:"role,omitempty"`
+	AllocationInfo *Resource_AllocationInfo `protobuf:"bytes,11,opt,name=allocation_info,json=allocationInfo" json:"allocation_info,omitempty"`
+	// If this is set, this resource was dynamically reserved by an
+	// operator or a framework. Otherwise, this resource is either unreserved
+	// or statically reserved by an operator via the --resources flag.
+	//
+	// NOTE: Frameworks must not set this field if `reservations` is set.
+	//       See the 'Resource Format' section for more details.
+	//
+	// TODO(mpark): Deprecate once `reservations` is no longer experimental.
+	Reservation *Resource_ReservationInfo `protobuf:"bytes,8,opt,name=reservation" json:"reservation,omitempty"`
+	// The stack of reservations. If this field is empty, it indicates that this
+	// resource is unreserved. Otherwise, the resource is reserved. The first
+	// `ReservationInfo` may have type `STATIC` or `DYNAMIC`, but the rest must
+	// have `DYNAMIC`. One can create a new reservation on top of an existing
+	// one by pushing a new `ReservationInfo` to the back. The last
+	// `ReservationInfo` in this stack is the "current" reservation. The new
+	// reservation's role must be a child of the current reservation's role.
+	//
+	// NOTE: Frameworks must not set this field if `reservation` is set.
+	//       See the 'Resource Format' section for more details.
+	//
+	// TODO(mpark): Deprecate `role` and `reservation` once this is stable.
+	Reservations []*Resource_ReservationInfo `protobuf:"bytes,13,rep,name=reservations" json:"reservations,omitempty"`
+	Disk         *Resource_DiskInfo          `protobuf:"bytes,7,opt,name=disk" json:"disk,omitempty"`
+	// If this is set, the resources are revocable, i.e., any tasks or
+	// executors launched using these resources could get preempted or
+	// throttled at any time. This could be used by frameworks to run
+	// best effort tasks that do not need strict uptime or performance
+	// guarantees. Note that if this is set, 'disk' or 'reservation'
+	// cannot be set.
+	Revocable *Resource_RevocableInfo `protobuf:"bytes,9,opt,name=revocable" json:"revocable,omitempty"`
+	// If this is set, the resources are shared, i.e. multiple tasks
+	// can be launched using this resource and all of them shall refer
+	// to the same physical resource on the cluster. Note that only
+	// persistent volumes can be shared currently.
+	//
+	// NOTE: Different shared resources must be uniquely identifiable.
+	// This currently holds as persistent volume should have unique `id`
+	// (this is not validated for enforced though).
+	Shared           *Resource_SharedInfo `protobuf:"bytes,10,opt,name=shared" json:"shared,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Resource) Reset()                    { *m = Resource{} }
+func (m *Resource) String() string            { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()               {}
+func (*Resource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+const Default_Resource_Role string = "*"
+
+func (m *Resource) GetProviderId() *ResourceProviderID {
+	if m != nil {
+		return m.ProviderId
+	}
+	return nil
+}
+
+func (m *Resource) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Resource) GetType() Value_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Value_SCALAR
+}
+
+func (m *Resource) GetScalar() *Value_Scalar {
+	if m != nil {
+		return m.Scalar
+	}
+	return nil
+}
+
+func (m *Resource) GetRanges() *Value_Ranges {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *Resource) GetSet() *Value_Set {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *Resource) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return Default_Resource_Role
+}
+
+func (m *Resource) GetAllocationInfo() *Resource_AllocationInfo {
+	if m != nil {
+		return m.AllocationInfo
+	}
+	return nil
+}
+
+func (m *Resource) GetReservation() *Resource_ReservationInfo {
+	if m != nil {
+		return m.Reservation
+	}
+	return nil
+}
+
+func (m *Resource) GetReservations() []*Resource_ReservationInfo {
+	if m != nil {
+		return m.Reservations
+	}
+	return nil
+}
+
+func (m *Resource) GetDisk() *Resource_DiskInfo {
+	if m != nil {
+		return m.Disk
+	}
+	return nil
+}
+
+func (m *Resource) GetRevocable() *Resource_RevocableInfo {
+	if m != nil {
+		return m.Revocable
+	}
+	return nil
+}
+
+func (m *Resource) GetShared() *Resource_SharedInfo {
+	if m != nil {
+		return m.Shared
+	}
+	return nil
+}
+
+// This was initially introduced to support MULTI_ROLE capable
+// frameworks. Frameworks that are not MULTI_ROLE capable can
+// continue to assume that the offered resources are allocated
+// to their role.
+type Resource_AllocationInfo struct {
+	// If set, this resource is allocated to a role. Note that in the
+	// future, this may be unset and the scheduler may be responsible
+	// for allocating to one of its roles.
+	Role             *string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_AllocationInfo) Reset()                    { *m = Resource_AllocationInfo{} }
+func (m *Resource_AllocationInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_AllocationInfo) ProtoMessage()               {}
+func (*Resource_AllocationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29, 0} }
+
+func (m *Resource_AllocationInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+type Resource_ReservationInfo struct {
+	// The type of this reservation.
+	//
+	// NOTE: This field must not be set for `Resource.reservation`.
+	//       See the 'Resource Format' section for more details.
+	Type *Resource_ReservationInfo_Type `protobuf:"varint,4,opt,name=type,enum=mesos.v1.Resource_ReservationInfo_Type" json:"type,omitempty"`
+	// The role to which this reservation is made for.
+	//
+	// NOTE: This field must not be set for `Resource.reservation`.
+	//       See the 'Resource Format' section for more details.
+	Role *string `protobuf:"bytes,3,opt,name=role" json:"role,omitempty"`
+	// Indicates the principal, if any, of the framework or operator
+	// that reserved this resource. If reserved by a framework, the
+	// field should match the `FrameworkInfo.principal`. It is used in
+	// conjunction with the `UnreserveResources` ACL to determine
+	// whether the entity attempting to unreserve this resource is
+	// permitted to do so.
+	Principal *string `protobuf:"bytes,1,opt,name=principal" json:"principal,omitempty"`
+	// Labels are free-form key value pairs that can be used to
+	// associate arbitrary metadata with a reserved resource.  For
+	// example, frameworks can use labels to identify the intended
+	// purpose for a portion of the resources the framework has
+	// reserved at a given agent. Labels should not contain duplicate
+	// key-value pairs.
+	Labels           *Labels `protobuf:"bytes,2,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_ReservationInfo) Reset()                    { *m = Resource_ReservationInfo{} }
+func (m *Resource_ReservationInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_ReservationInfo) ProtoMessage()               {}
+func (*Resource_ReservationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29, 1} }
+
+func (m *Resource_ReservationInfo) GetType() Resource_ReservationInfo_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Resource_ReservationInfo_UNKNOWN
+}
+
+func (m *Resource_ReservationInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *Resource_ReservationInfo) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *Resource_ReservationInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type Resource_DiskInfo struct {
+	Persistence *Resource_DiskInfo_Persistence `protobuf:"bytes,1,opt,name=persistence" json:"persistence,omitempty"`
+	// Describes how this disk resource will be mounted in the
+	// container. If not set, the disk resource will be used as the
+	// sandbox. Otherwise, it will be mounted according to the
+	// 'container_path' inside 'volume'. The 'host_path' inside
+	// 'volume' is ignored.
+	// NOTE: If 'volume' is set but 'persistence' is not set, the
+	// volume will be automatically garbage collected after
+	// task/executor terminates. Currently, if 'persistence' is set,
+	// 'volume' must be set.
+	Volume           *Volume                   `protobuf:"bytes,2,opt,name=volume" json:"volume,omitempty"`
+	Source           *Resource_DiskInfo_Source `protobuf:"bytes,3,opt,name=source" json:"source,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *Resource_DiskInfo) Reset()                    { *m = Resource_DiskInfo{} }
+func (m *Resource_DiskInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo) ProtoMessage()               {}
+func (*Resource_DiskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29, 2} }
+
+func (m *Resource_DiskInfo) GetPersistence() *Resource_DiskInfo_Persistence {
+	if m != nil {
+		return m.Persistence
+	}
+	return nil
+}
+
+func (m *Resource_DiskInfo) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *Resource_DiskInfo) GetSource() *Resource_DiskInfo_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+// Describes a persistent disk volume.
+//
+// A persistent disk volume will not be automatically garbage
+// collected if the task/executor/agent terminates, but will be
+// re-offered to the framework(s) belonging to the 'role'.
+//
+// NOTE: Currently, we do not allow persistent disk volumes
+// without a reservation (i.e., 'role' cannot be '*').
+type Resource_DiskInfo_Persistence struct {
+	// A unique ID for the persistent disk volume. This ID must be
+	// unique per role on each agent. Although it is possible to use
+	// the same ID on different agents in the cluster and to reuse
+	// IDs after a volume with that ID has been destroyed, both
+	// practices are discouraged.
+	Id *string `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	// This field indicates the principal of the operator or
+	// framework that created this volume. It is used in conjunction
+	// with the "destroy" ACL to determine whether an entity
+	// attempting to destroy the volume is permitted to do so.
+	//
+	// NOTE: This field should match the FrameworkInfo.principal of
+	// the framework that created the volume.
+	Principal        *string `protobuf:"bytes,2,opt,name=principal" json:"principal,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_DiskInfo_Persistence) Reset()         { *m = Resource_DiskInfo_Persistence{} }
+func (m *Resource_DiskInfo_Persistence) String() string { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo_Persistence) ProtoMessage()    {}
+func (*Resource_DiskInfo_Persistence) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 2, 0}
+}
+
+func (m *Resource_DiskInfo_Persistence) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Resource_DiskInfo_Persistence) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+// Describes where a disk originates from.
+type Resource_DiskInfo_Source struct {
+	Type  *Resource_DiskInfo_Source_Type  `protobuf:"varint,1,req,name=type,enum=mesos.v1.Resource_DiskInfo_Source_Type" json:"type,omitempty"`
+	Path  *Resource_DiskInfo_Source_Path  `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Mount *Resource_DiskInfo_Source_Mount `protobuf:"bytes,3,opt,name=mount" json:"mount,omitempty"`
+	// The vendor of this source. If present, this field provides the means to
+	// uniquely identify the storage backend of this source in the cluster.
+	Vendor *string `protobuf:"bytes,7,opt,name=vendor" json:"vendor,omitempty"`
+	// The identifier of this source. This field maps onto CSI volume IDs and
+	// is not expected to be set by frameworks. If both `vendor` and `id` are
+	// present, these two fields together provide the means to uniquely
+	// identify this source in the cluster.
+	Id *string `protobuf:"bytes,4,opt,name=id" json:"id,omitempty"`
+	// Additional metadata for this source. This field maps onto CSI volume
+	// context. Frameworks should neither alter this field, nor expect this
+	// field to remain unchanged.
+	Metadata *Labels `protobuf:"bytes,5,opt,name=metadata" json:"metadata,omitempty"`
+	// This field serves as an indirection to a set of storage
+	// vendor specific disk parameters which describe the properties
+	// of the disk. The operator will setup mappings between a
+	// profile name to a set of vendor specific disk parameters. And
+	// the framework will do disk selection based on profile names,
+	// instead of vendor specific disk parameters.
+	//
+	// Also see the DiskProfileAdaptor module.
+	Profile          *string `protobuf:"bytes,6,opt,name=profile" json:"profile,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_DiskInfo_Source) Reset()         { *m = Resource_DiskInfo_Source{} }
+func (m *Resource_DiskInfo_Source) String() string { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo_Source) ProtoMessage()    {}
+func (*Resource_DiskInfo_Source) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 2, 1}
+}
+
+func (m *Resource_DiskInfo_Source) GetType() Resource_DiskInfo_Source_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Resource_DiskInfo_Source_UNKNOWN
+}
+
+func (m *Resource_DiskInfo_Source) GetPath() *Resource_DiskInfo_Source_Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *Resource_DiskInfo_Source) GetMount() *Resource_DiskInfo_Source_Mount {
+	if m != nil {
+		return m.Mount
+	}
+	return nil
+}
+
+func (m *Resource_DiskInfo_Source) GetVendor() string {
+	if m != nil && m.Vendor != nil {
+		return *m.Vendor
+	}
+	return ""
+}
+
+func (m *Resource_DiskInfo_Source) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Resource_DiskInfo_Source) GetMetadata() *Labels {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Resource_DiskInfo_Source) GetProfile() string {
+	if m != nil && m.Profile != nil {
+		return *m.Profile
+	}
+	return ""
+}
+
+// A folder that can be located on a separate disk device. This
+// can be shared and carved up as necessary between frameworks.
+type Resource_DiskInfo_Source_Path struct {
+	// Path to the folder (e.g., /mnt/raid/disk0). If the path is a
+	// relative path, it is relative to the agent work directory.
+	Root             *string `protobuf:"bytes,1,opt,name=root" json:"root,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_DiskInfo_Source_Path) Reset()         { *m = Resource_DiskInfo_Source_Path{} }
+func (m *Resource_DiskInfo_Source_Path) String() string { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo_Source_Path) ProtoMessage()    {}
+func (*Resource_DiskInfo_Source_Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 2, 1, 0}
+}
+
+func (m *Resource_DiskInfo_Source_Path) GetRoot() string {
+	if m != nil && m.Root != nil {
+		return *m.Root
+	}
+	return ""
+}
+
+// A mounted file-system set up by the Agent administrator. This
+// can only be used exclusively: a framework cannot accept a
+// partial amount of this disk.
+type Resource_DiskInfo_Source_Mount struct {
+	// Path to mount point (e.g., /mnt/raid/disk0). If the path is a
+	// relative path, it is relative to the agent work directory.
+	Root             *string `protobuf:"bytes,1,opt,name=root" json:"root,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_DiskInfo_Source_Mount) Reset()         { *m = Resource_DiskInfo_Source_Mount{} }
+func (m *Resource_DiskInfo_Source_Mount) String() string { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo_Source_Mount) ProtoMessage()    {}
+func (*Resource_DiskInfo_Source_Mount) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 2, 1, 1}
+}
+
+func (m *Resource_DiskInfo_Source_Mount) GetRoot() string {
+	if m != nil && m.Root != nil {
+		return *m.Root
+	}
+	return ""
+}
+
+type Resource_RevocableInfo struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Resource_RevocableInfo) Reset()                    { *m = Resource_RevocableInfo{} }
+func (m *Resource_RevocableInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_RevocableInfo) ProtoMessage()               {}
+func (*Resource_RevocableInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29, 3} }
+
+// Allow the resource to be shared across tasks.
+type Resource_SharedInfo struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Resource_SharedInfo) Reset()                    { *m = Resource_SharedInfo{} }
+func (m *Resource_SharedInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_SharedInfo) ProtoMessage()               {}
+func (*Resource_SharedInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29, 4} }
+
+// When the network bandwidth caps are enabled and the container
+// is over its limit, outbound packets may be either delayed or
+// dropped completely either because it exceeds the maximum bandwidth
+// allocation for a single container (the cap) or because the combined
+// network traffic of multiple containers on the host exceeds the
+// transmit capacity of the host (the share). We can report the
+// following statistics for each of these conditions exported directly
+// from the Linux Traffic Control Queueing Discipline.
+//
+// id         : name of the limiter, e.g. 'tx_bw_cap'
+// backlog    : number of packets currently delayed
+// bytes      : total bytes seen
+// drops      : number of packets dropped in total
+// overlimits : number of packets which exceeded allocation
+// packets    : total packets seen
+// qlen       : number of packets currently queued
+// rate_bps   : throughput in bytes/sec
+// rate_pps   : throughput in packets/sec
+// requeues   : number of times a packet has been delayed due to
+//
+//	locking or device contention issues
+//
+// More information on the operation of Linux Traffic Control can be
+// found at http://www.lartc.org/lartc.html.
+type TrafficControlStatistics struct {
+	Id               *string `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Backlog          *uint64 `protobuf:"varint,2,opt,name=backlog" json:"backlog,omitempty"`
+	Bytes            *uint64 `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	Drops            *uint64 `protobuf:"varint,4,opt,name=drops" json:"drops,omitempty"`
+	Overlimits       *uint64 `protobuf:"varint,5,opt,name=overlimits" json:"overlimits,omitempty"`
+	Packets          *uint64 `protobuf:"varint,6,opt,name=packets" json:"packets,omitempty"`
+	Qlen             *uint64 `protobuf:"varint,7,opt,name=qlen" json:"qlen,omitempty"`
+	Ratebps          *uint64 `protobuf:"varint,8,opt,name=ratebps" json:"ratebps,omitempty"`
+	Ratepps          *uint64 `protobuf:"varint,9,opt,name=ratepps" json:"ratepps,omitempty"`
+	Requeues         *uint64 `protobuf:"varint,10,opt,name=requeues" json:"requeues,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TrafficControlStatistics) Reset()                    { *m = TrafficControlStatistics{} }
+func (m *TrafficControlStatistics) String() string            { return proto.CompactTextString(m) }
+func (*TrafficControlStatistics) ProtoMessage()               {}
+func (*TrafficControlStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *TrafficControlStatistics) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *TrafficControlStatistics) GetBacklog() uint64 {
+	if m != nil && m.Backlog != nil {
+		return *m.Backlog
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetDrops() uint64 {
+	if m != nil && m.Drops != nil {
+		return *m.Drops
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetOverlimits() uint64 {
+	if m != nil && m.Overlimits != nil {
+		return *m.Overlimits
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetPackets() uint64 {
+	if m != nil && m.Packets != nil {
+		return *m.Packets
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetQlen() uint64 {
+	if m != nil && m.Qlen != nil {
+		return *m.Qlen
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetRatebps() uint64 {
+	if m != nil && m.Ratebps != nil {
+		return *m.Ratebps
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetRatepps() uint64 {
+	if m != nil && m.Ratepps != nil {
+		return *m.Ratepps
+	}
+	return 0
+}
+
+func (m *TrafficControlStatistics) GetRequeues() uint64 {
+	if m != nil && m.Requeues != nil {
+		return *m.Requeues
+	}
+	return 0
+}
+
+type IpStatistics struct {
+	Forwarding       *int64 `protobuf:"varint,1,opt,name=Forwarding" json:"Forwarding,omitempty"`
+	DefaultTTL       *int64 `protobuf:"varint,2,opt,name=DefaultTTL" json:"DefaultTTL,omitempty"`
+	InReceives       *int64 `protobuf:"varint,3,opt,name=InReceives" json:"InReceives,omitempty"`
+	InHdrErrors      *int64 `protobuf:"varint,4,opt,name=InHdrErrors" json:"InHdrErrors,omitempty"`
+	InAddrErrors     *int64 `protobuf:"varint,5,opt,name=InAddrErrors" json:"InAddrErrors,omitempty"`
+	ForwDatagrams    *int64 `protobuf:"varint,6,opt,name=ForwDatagrams" json:"ForwDatagrams,omitempty"`
+	InUnknownProtos  *int64 `protobuf:"varint,7,opt,name=InUnknownProtos" json:"InUnknownProtos,omitempty"`
+	InDiscards       *int64 `protobuf:"varint,8,opt,name=InDiscards" json:"InDiscards,omitempty"`
+	InDelivers       *int64 `protobuf:"varint,9,opt,name=InDelivers" json:"InDelivers,omitempty"`
+	OutRequests      *int64 `protobuf:"varint,10,opt,name=OutRequests" json:"OutRequests,omitempty"`
+	OutDiscards      *int64 `protobuf:"varint,11,opt,name=OutDiscards" json:"OutDiscards,omitempty"`
+	OutNoRoutes      *int64 `protobuf:"varint,12,opt,name=OutNoRoutes" json:"OutNoRoutes,omitempty"`
+	ReasmTimeout     *int64 `protobuf:"varint,13,opt,name=ReasmTimeout" json:"ReasmTimeout,omitempty"`
+	ReasmReqds       *int64 `protobuf:"varint,14,opt,name=ReasmReqds" json:"ReasmReqds,omitempty"`
+	ReasmOKs         *int64 `protobuf:"varint,15,opt,name=ReasmOKs" json:"ReasmOKs,omitempty"`
+	ReasmFails       *int64 `protobuf:"varint,16,opt,name=ReasmFails" json:"ReasmFails,omitempty"`
+	FragOKs          *int64 `protobuf:"varint,17,opt,name=FragOKs" json:"FragOKs,omitempty"`
+	FragFails        *int64 `protobuf:"varint,18,opt,name=FragFails" json:"FragFails,omitempty"`
+	FragCreates      *int64 `protobuf:"varint,19,opt,name=FragCreates" json:"FragCreates,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *IpStatistics) Reset()                    { *m = IpStatistics{} }
+func (m *IpStatistics) String() string            { return proto.CompactTextString(m) }
+func (*IpStatistics) ProtoMessage()               {}
+func (*IpStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *IpStatistics) GetForwarding() int64 {
+	if m != nil && m.Forwarding != nil {
+		return *m.Forwarding
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetDefaultTTL() int64 {
+	if m != nil && m.DefaultTTL != nil {
+		return *m.DefaultTTL
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInReceives() int64 {
+	if m != nil && m.InReceives != nil {
+		return *m.InReceives
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInHdrErrors() int64 {
+	if m != nil && m.InHdrErrors != nil {
+		return *m.InHdrErrors
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInAddrErrors() int64 {
+	if m != nil && m.InAddrErrors != nil {
+		return *m.InAddrErrors
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetForwDatagrams() int64 {
+	if m != nil && m.ForwDatagrams != nil {
+		return *m.ForwDatagrams
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInUnknownProtos() int64 {
+	if m != nil && m.InUnknownProtos != nil {
+		return *m.InUnknownProtos
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInDiscards() int64 {
+	if m != nil && m.InDiscards != nil {
+		return *m.InDiscards
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetInDelivers() int64 {
+	if m != nil && m.InDelivers != nil {
+		return *m.InDelivers
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetOutRequests() int64 {
+	if m != nil && m.OutRequests != nil {
+		return *m.OutRequests
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetOutDiscards() int64 {
+	if m != nil && m.OutDiscards != nil {
+		return *m.OutDiscards
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetOutNoRoutes() int64 {
+	if m != nil && m.OutNoRoutes != nil {
+		return *m.OutNoRoutes
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetReasmTimeout() int64 {
+	if m != nil && m.ReasmTimeout != nil {
+		return *m.ReasmTimeout
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetReasmReqds() int64 {
+	if m != nil && m.ReasmReqds != nil {
+		return *m.ReasmReqds
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetReasmOKs() int64 {
+	if m != nil && m.ReasmOKs != nil {
+		return *m.ReasmOKs
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetReasmFails() int64 {
+	if m != nil && m.ReasmFails != nil {
+		return *m.ReasmFails
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetFragOKs() int64 {
+	if m != nil && m.FragOKs != nil {
+		return *m.FragOKs
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetFragFails() int64 {
+	if m != nil && m.FragFails != nil {
+		return *m.FragFails
+	}
+	return 0
+}
+
+func (m *IpStatistics) GetFragCreates() int64 {
+	if m != nil && m.FragCreates != nil {
+		return *m.FragCreates
+	}
+	return 0
+}
+
+type IcmpStatistics struct {
+	InMsgs           *int64 `protobuf:"varint,1,opt,name=InMsgs" json:"InMsgs,omitempty"`
+	InErrors         *int64 `protobuf:"varint,2,opt,name=InErrors" json:"InErrors,omitempty"`
+	InCsumErrors     *int64 `protobuf:"varint,3,opt,name=InCsumErrors" json:"InCsumErrors,omitempty"`
+	InDestUnreachs   *int64 `protobuf:"varint,4,opt,name=InDestUnreachs" json:"InDestUnreachs,omitempty"`
+	InTimeExcds      *int64 `protobuf:"varint,5,opt,name=InTimeExcds" json:"InTimeExcds,omitempty"`
+	InParmProbs      *int64 `protobuf:"varint,6,opt,name=InParmProbs" json:"InParmProbs,omitempty"`
+	InSrcQuenchs     *int64 `protobuf:"varint,7,opt,name=InSrcQuenchs" json:"InSrcQuenchs,omitempty"`
+	InRedirects      *int64 `protobuf:"varint,8,opt,name=InRedirects" json:"InRedirects,omitempty"`
+	InEchos          *int64 `protobuf:"varint,9,opt,name=InEchos" json:"InEchos,omitempty"`
+	InEchoReps       *int64 `protobuf:"varint,10,opt,name=InEchoReps" json:"InEchoReps,omitempty"`
+	InTimestamps     *int64 `protobuf:"varint,11,opt,name=InTimestamps" json:"InTimestamps,omitempty"`
+	InTimestampReps  *int64 `protobuf:"varint,12,opt,name=InTimestampReps" json:"InTimestampReps,omitempty"`
+	InAddrMasks      *int64 `protobuf:"varint,13,opt,name=InAddrMasks" json:"InAddrMasks,omitempty"`
+	InAddrMaskReps   *int64 `protobuf:"varint,14,opt,name=InAddrMaskReps" json:"InAddrMaskReps,omitempty"`
+	OutMsgs          *int64 `protobuf:"varint,15,opt,name=OutMsgs" json:"OutMsgs,omitempty"`
+	OutErrors        *int64 `protobuf:"varint,16,opt,name=OutErrors" json:"OutErrors,omitempty"`
+	OutDestUnreachs  *int64 `protobuf:"varint,17,opt,name=OutDestUnreachs" json:"OutDestUnreachs,omitempty"`
+	OutTimeExcds     *int64 `protobuf:"varint,18,opt,name=OutTimeExcds" json:"OutTimeExcds,omitempty"`
+	OutParmProbs     *int64 `protobuf:"varint,19,opt,name=OutParmProbs" json:"OutParmProbs,omitempty"`
+	OutSrcQuenchs    *int64 `protobuf:"varint,20,opt,name=OutSrcQuenchs" json:"OutSrcQuenchs,omitempty"`
+	OutRedirects     *int64 `protobuf:"varint,21,opt,name=OutRedirects" json:"OutRedirects,omitempty"`
+	OutEchos         *int64 `protobuf:"varint,22,opt,name=OutEchos" json:"OutEchos,omitempty"`
+	OutEchoReps      *int64 `protobuf:"varint,23,opt,name=OutEchoReps" json:"OutEchoReps,omitempty"`
+	OutTimestamps    *int64 `protobuf:"varint,24,opt,name=OutTimestamps" json:"OutTimestamps,omitempty"`
+	OutTimestampReps *int64 `protobuf:"varint,25,opt,name=OutTimestampReps" json:"OutTimestampReps,omitempty"`
+	OutAddrMasks     *int64 `protobuf:"varint,26,opt,name=OutAddrMasks" json:"OutAddrMasks,omitempty"`
+	OutAddrMaskReps  *int64 `protobuf:"varint,27,opt,name=OutAddrMaskReps" json:"OutAddrMaskReps,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *IcmpStatistics) Reset()                    { *m = IcmpStatistics{} }
+func (m *IcmpStatistics) String() string            { return proto.CompactTextString(m) }
+func (*IcmpStatistics) ProtoMessage()               {}
+func (*IcmpStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *IcmpStatistics) GetInMsgs() int64 {
+	if m != nil && m.InMsgs != nil {
+		return *m.InMsgs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInErrors() int64 {
+	if m != nil && m.InErrors != nil {
+		return *m.InErrors
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInCsumErrors() int64 {
+	if m != nil && m.InCsumErrors != nil {
+		return *m.InCsumErrors
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInDestUnreachs() int64 {
+	if m != nil && m.InDestUnreachs != nil {
+		return *m.InDestUnreachs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInTimeExcds() int64 {
+	if m != nil && m.InTimeExcds != nil {
+		return *m.InTimeExcds
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInParmProbs() int64 {
+	if m != nil && m.InParmProbs != nil {
+		return *m.InParmProbs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInSrcQuenchs() int64 {
+	if m != nil && m.InSrcQuenchs != nil {
+		return *m.InSrcQuenchs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInRedirects() int64 {
+	if m != nil && m.InRedirects != nil {
+		return *m.InRedirects
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInEchos() int64 {
+	if m != nil && m.InEchos != nil {
+		return *m.InEchos
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInEchoReps() int64 {
+	if m != nil && m.InEchoReps != nil {
+		return *m.InEchoReps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInTimestamps() int64 {
+	if m != nil && m.InTimestamps != nil {
+		return *m.InTimestamps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInTimestampReps() int64 {
+	if m != nil && m.InTimestampReps != nil {
+		return *m.InTimestampReps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInAddrMasks() int64 {
+	if m != nil && m.InAddrMasks != nil {
+		return *m.InAddrMasks
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetInAddrMaskReps() int64 {
+	if m != nil && m.InAddrMaskReps != nil {
+		return *m.InAddrMaskReps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutMsgs() int64 {
+	if m != nil && m.OutMsgs != nil {
+		return *m.OutMsgs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutErrors() int64 {
+	if m != nil && m.OutErrors != nil {
+		return *m.OutErrors
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutDestUnreachs() int64 {
+	if m != nil && m.OutDestUnreachs != nil {
+		return *m.OutDestUnreachs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutTimeExcds() int64 {
+	if m != nil && m.OutTimeExcds != nil {
+		return *m.OutTimeExcds
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutParmProbs() int64 {
+	if m != nil && m.OutParmProbs != nil {
+		return *m.OutParmProbs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutSrcQuenchs() int64 {
+	if m != nil && m.OutSrcQuenchs != nil {
+		return *m.OutSrcQuenchs
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutRedirects() int64 {
+	if m != nil && m.OutRedirects != nil {
+		return *m.OutRedirects
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutEchos() int64 {
+	if m != nil && m.OutEchos != nil {
+		return *m.OutEchos
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutEchoReps() int64 {
+	if m != nil && m.OutEchoReps != nil {
+		return *m.OutEchoReps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutTimestamps() int64 {
+	if m != nil && m.OutTimestamps != nil {
+		return *m.OutTimestamps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutTimestampReps() int64 {
+	if m != nil && m.OutTimestampReps != nil {
+		return *m.OutTimestampReps
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutAddrMasks() int64 {
+	if m != nil && m.OutAddrMasks != nil {
+		return *m.OutAddrMasks
+	}
+	return 0
+}
+
+func (m *IcmpStatistics) GetOutAddrMaskReps() int64 {
+	if m != nil && m.OutAddrMaskReps != nil {
+		return *m.OutAddrMaskReps
+	}
+	return 0
+}
+
+type TcpStatistics struct {
+	RtoAlgorithm     *int64 `protobuf:"varint,1,opt,name=RtoAlgorithm" json:"RtoAlgorithm,omitempty"`
+	RtoMin           *int64 `protobuf:"varint,2,opt,name=RtoMin" json:"RtoMin,omitempty"`
+	RtoMax           *int64 `protobuf:"varint,3,opt,name=RtoMax" json:"RtoMax,omitempty"`
+	MaxConn          *int64 `protobuf:"varint,4,opt,name=MaxConn" json:"MaxConn,omitempty"`
+	ActiveOpens      *int64 `protobuf:"varint,5,opt,name=ActiveOpens" json:"ActiveOpens,omitempty"`
+	PassiveOpens     *int64 `protobuf:"varint,6,opt,name=PassiveOpens" json:"PassiveOpens,omitempty"`
+	AttemptFails     *int64 `protobuf:"varint,7,opt,name=AttemptFails" json:"AttemptFails,omitempty"`
+	EstabResets      *int64 `protobuf:"varint,8,opt,name=EstabResets" json:"EstabResets,omitempty"`
+	CurrEstab        *int64 `protobuf:"varint,9,opt,name=CurrEstab" json:"CurrEstab,omitempty"`
+	InSegs           *int64 `protobuf:"varint,10,opt,name=InSegs" json:"InSegs,omitempty"`
+	OutSegs          *int64 `protobuf:"varint,11,opt,name=OutSegs" json:"OutSegs,omitempty"`
+	RetransSegs      *int64 `protobuf:"varint,12,opt,name=RetransSegs" json:"RetransSegs,omitempty"`
+	InErrs           *int64 `protobuf:"varint,13,opt,name=InErrs" json:"InErrs,omitempty"`
+	OutRsts          *int64 `protobuf:"varint,14,opt,name=OutRsts" json:"OutRsts,omitempty"`
+	InCsumErrors     *int64 `protobuf:"varint,15,opt,name=InCsumErrors" json:"InCsumErrors,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *TcpStatistics) Reset()                    { *m = TcpStatistics{} }
+func (m *TcpStatistics) String() string            { return proto.CompactTextString(m) }
+func (*TcpStatistics) ProtoMessage()               {}
+func (*TcpStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *TcpStatistics) GetRtoAlgorithm() int64 {
+	if m != nil && m.RtoAlgorithm != nil {
+		return *m.RtoAlgorithm
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetRtoMin() int64 {
+	if m != nil && m.RtoMin != nil {
+		return *m.RtoMin
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetRtoMax() int64 {
+	if m != nil && m.RtoMax != nil {
+		return *m.RtoMax
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetMaxConn() int64 {
+	if m != nil && m.MaxConn != nil {
+		return *m.MaxConn
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetActiveOpens() int64 {
+	if m != nil && m.ActiveOpens != nil {
+		return *m.ActiveOpens
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetPassiveOpens() int64 {
+	if m != nil && m.PassiveOpens != nil {
+		return *m.PassiveOpens
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetAttemptFails() int64 {
+	if m != nil && m.AttemptFails != nil {
+		return *m.AttemptFails
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetEstabResets() int64 {
+	if m != nil && m.EstabResets != nil {
+		return *m.EstabResets
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetCurrEstab() int64 {
+	if m != nil && m.CurrEstab != nil {
+		return *m.CurrEstab
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetInSegs() int64 {
+	if m != nil && m.InSegs != nil {
+		return *m.InSegs
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetOutSegs() int64 {
+	if m != nil && m.OutSegs != nil {
+		return *m.OutSegs
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetRetransSegs() int64 {
+	if m != nil && m.RetransSegs != nil {
+		return *m.RetransSegs
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetInErrs() int64 {
+	if m != nil && m.InErrs != nil {
+		return *m.InErrs
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetOutRsts() int64 {
+	if m != nil && m.OutRsts != nil {
+		return *m.OutRsts
+	}
+	return 0
+}
+
+func (m *TcpStatistics) GetInCsumErrors() int64 {
+	if m != nil && m.InCsumErrors != nil {
+		return *m.InCsumErrors
+	}
+	return 0
+}
+
+type UdpStatistics struct {
+	InDatagrams      *int64 `protobuf:"varint,1,opt,name=InDatagrams" json:"InDatagrams,omitempty"`
+	NoPorts          *int64 `protobuf:"varint,2,opt,name=NoPorts" json:"NoPorts,omitempty"`
+	InErrors         *int64 `protobuf:"varint,3,opt,name=InErrors" json:"InErrors,omitempty"`
+	OutDatagrams     *int64 `protobuf:"varint,4,opt,name=OutDatagrams" json:"OutDatagrams,omitempty"`
+	RcvbufErrors     *int64 `protobuf:"varint,5,opt,name=RcvbufErrors" json:"RcvbufErrors,omitempty"`
+	SndbufErrors     *int64 `protobuf:"varint,6,opt,name=SndbufErrors" json:"SndbufErrors,omitempty"`
+	InCsumErrors     *int64 `protobuf:"varint,7,opt,name=InCsumErrors" json:"InCsumErrors,omitempty"`
+	IgnoredMulti     *int64 `protobuf:"varint,8,opt,name=IgnoredMulti" json:"IgnoredMulti,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UdpStatistics) Reset()                    { *m = UdpStatistics{} }
+func (m *UdpStatistics) String() string            { return proto.CompactTextString(m) }
+func (*UdpStatistics) ProtoMessage()               {}
+func (*UdpStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *UdpStatistics) GetInDatagrams() int64 {
+	if m != nil && m.InDatagrams != nil {
+		return *m.InDatagrams
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetNoPorts() int64 {
+	if m != nil && m.NoPorts != nil {
+		return *m.NoPorts
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetInErrors() int64 {
+	if m != nil && m.InErrors != nil {
+		return *m.InErrors
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetOutDatagrams() int64 {
+	if m != nil && m.OutDatagrams != nil {
+		return *m.OutDatagrams
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetRcvbufErrors() int64 {
+	if m != nil && m.RcvbufErrors != nil {
+		return *m.RcvbufErrors
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetSndbufErrors() int64 {
+	if m != nil && m.SndbufErrors != nil {
+		return *m.SndbufErrors
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetInCsumErrors() int64 {
+	if m != nil && m.InCsumErrors != nil {
+		return *m.InCsumErrors
+	}
+	return 0
+}
+
+func (m *UdpStatistics) GetIgnoredMulti() int64 {
+	if m != nil && m.IgnoredMulti != nil {
+		return *m.IgnoredMulti
+	}
+	return 0
+}
+
+type SNMPStatistics struct {
+	IpStats          *IpStatistics   `protobuf:"bytes,1,opt,name=ip_stats,json=ipStats" json:"ip_stats,omitempty"`
+	IcmpStats        *IcmpStatistics `protobuf:"bytes,2,opt,name=icmp_stats,json=icmpStats" json:"icmp_stats,omitempty"`
+	TcpStats         *TcpStatistics  `protobuf:"bytes,3,opt,name=tcp_stats,json=tcpStats" json:"tcp_stats,omitempty"`
+	UdpStats         *UdpStatistics  `protobuf:"bytes,4,opt,name=udp_stats,json=udpStats" json:"udp_stats,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *SNMPStatistics) Reset()                    { *m = SNMPStatistics{} }
+func (m *SNMPStatistics) String() string            { return proto.CompactTextString(m) }
+func (*SNMPStatistics) ProtoMessage()               {}
+func (*SNMPStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *SNMPStatistics) GetIpStats() *IpStatistics {
+	if m != nil {
+		return m.IpStats
+	}
+	return nil
+}
+
+func (m *SNMPStatistics) GetIcmpStats() *IcmpStatistics {
+	if m != nil {
+		return m.IcmpStats
+	}
+	return nil
+}
+
+func (m *SNMPStatistics) GetTcpStats() *TcpStatistics {
+	if m != nil {
+		return m.TcpStats
+	}
+	return nil
+}
+
+func (m *SNMPStatistics) GetUdpStats() *UdpStatistics {
+	if m != nil {
+		return m.UdpStats
+	}
+	return nil
+}
+
+type DiskStatistics struct {
+	Source           *Resource_DiskInfo_Source      `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	Persistence      *Resource_DiskInfo_Persistence `protobuf:"bytes,2,opt,name=persistence" json:"persistence,omitempty"`
+	LimitBytes       *uint64                        `protobuf:"varint,3,opt,name=limit_bytes,json=limitBytes" json:"limit_bytes,omitempty"`
+	UsedBytes        *uint64                        `protobuf:"varint,4,opt,name=used_bytes,json=usedBytes" json:"used_bytes,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *DiskStatistics) Reset()                    { *m = DiskStatistics{} }
+func (m *DiskStatistics) String() string            { return proto.CompactTextString(m) }
+func (*DiskStatistics) ProtoMessage()               {}
+func (*DiskStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *DiskStatistics) GetSource() *Resource_DiskInfo_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *DiskStatistics) GetPersistence() *Resource_DiskInfo_Persistence {
+	if m != nil {
+		return m.Persistence
+	}
+	return nil
+}
+
+func (m *DiskStatistics) GetLimitBytes() uint64 {
+	if m != nil && m.LimitBytes != nil {
+		return *m.LimitBytes
+	}
+	return 0
+}
+
+func (m *DiskStatistics) GetUsedBytes() uint64 {
+	if m != nil && m.UsedBytes != nil {
+		return *m.UsedBytes
+	}
+	return 0
+}
+
+// A snapshot of resource usage statistics.
+type ResourceStatistics struct {
+	Timestamp *float64 `protobuf:"fixed64,1,req,name=timestamp" json:"timestamp,omitempty"`
+	Processes *uint32  `protobuf:"varint,30,opt,name=processes" json:"processes,omitempty"`
+	Threads   *uint32  `protobuf:"varint,31,opt,name=threads" json:"threads,omitempty"`
+	// CPU Usage Information:
+	// Total CPU time spent in user mode, and kernel mode.
+	CpusUserTimeSecs   *float64 `protobuf:"fixed64,2,opt,name=cpus_user_time_secs,json=cpusUserTimeSecs" json:"cpus_user_time_secs,omitempty"`
+	CpusSystemTimeSecs *float64 `protobuf:"fixed64,3,opt,name=cpus_system_time_secs,json=cpusSystemTimeSecs" json:"cpus_system_time_secs,omitempty"`
+	// Hard CPU limit.
+	CpusLimit *float64 `protobuf:"fixed64,4,opt,name=cpus_limit,json=cpusLimit" json:"cpus_limit,omitempty"`
+	// Soft CPU limit.
+	CpusSoftLimit *float64 `protobuf:"fixed64,45,opt,name=cpus_soft_limit,json=cpusSoftLimit" json:"cpus_soft_limit,omitempty"`
+	// cpu.stat on process throttling (for contention issues).
+	CpusNrPeriods         *uint32  `protobuf:"varint,7,opt,name=cpus_nr_periods,json=cpusNrPeriods" json:"cpus_nr_periods,omitempty"`
+	CpusNrThrottled       *uint32  `protobuf:"varint,8,opt,name=cpus_nr_throttled,json=cpusNrThrottled" json:"cpus_nr_throttled,omitempty"`
+	CpusThrottledTimeSecs *float64 `protobuf:"fixed64,9,opt,name=cpus_throttled_time_secs,json=cpusThrottledTimeSecs" json:"cpus_throttled_time_secs,omitempty"`
+	// mem_total_bytes was added in 0.23.0 to represent the total memory
+	// of a process in RAM (as opposed to in Swap). This was previously
+	// reported as mem_rss_bytes, which was also changed in 0.23.0 to
+	// represent only the anonymous memory usage, to keep in sync with
+	// Linux kernel's (arguably erroneous) use of terminology.
+	MemTotalBytes *uint64 `protobuf:"varint,36,opt,name=mem_total_bytes,json=memTotalBytes" json:"mem_total_bytes,omitempty"`
+	// Total memory + swap usage. This is set if swap is enabled.
+	MemTotalMemswBytes *uint64 `protobuf:"varint,37,opt,name=mem_total_memsw_bytes,json=memTotalMemswBytes" json:"mem_total_memsw_bytes,omitempty"`
+	// Hard memory limit.
+	MemLimitBytes *uint64 `protobuf:"varint,6,opt,name=mem_limit_bytes,json=memLimitBytes" json:"mem_limit_bytes,omitempty"`
+	// Soft memory limit.
+	MemSoftLimitBytes *uint64 `protobuf:"varint,38,opt,name=mem_soft_limit_bytes,json=memSoftLimitBytes" json:"mem_soft_limit_bytes,omitempty"`
+	// TODO(chzhcn) mem_file_bytes and mem_anon_bytes are deprecated in
+	// 0.23.0 and will be removed in 0.24.0.
+	MemFileBytes *uint64 `protobuf:"varint,10,opt,name=mem_file_bytes,json=memFileBytes" json:"mem_file_bytes,omitempty"`
+	MemAnonBytes *uint64 `protobuf:"varint,11,opt,name=mem_anon_bytes,json=memAnonBytes" json:"mem_anon_bytes,omitempty"`
+	// mem_cache_bytes is added in 0.23.0 to represent page cache usage.
+	MemCacheBytes *uint64 `protobuf:"varint,39,opt,name=mem_cache_bytes,json=memCacheBytes" json:"mem_cache_bytes,omitempty"`
+	// Since 0.23.0, mem_rss_bytes is changed to represent only
+	// anonymous memory usage. Note that neither its requiredness, type,
+	// name nor numeric tag has been changed.
+	MemRssBytes        *uint64 `protobuf:"varint,5,opt,name=mem_rss_bytes,json=memRssBytes" json:"mem_rss_bytes,omitempty"`
+	MemMappedFileBytes *uint64 `protobuf:"varint,12,opt,name=mem_mapped_file_bytes,json=memMappedFileBytes" json:"mem_mapped_file_bytes,omitempty"`
+	// This is only set if swap is enabled.
+	MemSwapBytes        *uint64 `protobuf:"varint,40,opt,name=mem_swap_bytes,json=memSwapBytes" json:"mem_swap_bytes,omitempty"`
+	MemUnevictableBytes *uint64 `protobuf:"varint,41,opt,name=mem_unevictable_bytes,json=memUnevictableBytes" json:"mem_unevictable_bytes,omitempty"`
+	// Number of occurrences of different levels of memory pressure
+	// events reported by memory cgroup. Pressure listening (re)starts
+	// with these values set to 0 when agent (re)starts. See
+	// https://www.kernel.org/doc/Documentation/cgroups/memory.txt for
+	// more details.
+	MemLowPressureCounter      *uint64 `protobuf:"varint,32,opt,name=mem_low_pressure_counter,json=memLowPressureCounter" json:"mem_low_pressure_counter,omitempty"`
+	MemMediumPressureCounter   *uint64 `protobuf:"varint,33,opt,name=mem_medium_pressure_counter,json=memMediumPressureCounter" json:"mem_medium_pressure_counter,omitempty"`
+	MemCriticalPressureCounter *uint64 `protobuf:"varint,34,opt,name=mem_critical_pressure_counter,json=memCriticalPressureCounter" json:"mem_critical_pressure_counter,omitempty"`
+	// Disk Usage Information for executor working directory.
+	DiskLimitBytes *uint64 `protobuf:"varint,26,opt,name=disk_limit_bytes,json=diskLimitBytes" json:"disk_limit_bytes,omitempty"`
+	DiskUsedBytes  *uint64 `protobuf:"varint,27,opt,name=disk_used_bytes,json=diskUsedBytes" json:"disk_used_bytes,omitempty"`
+	// Per disk (resource) statistics.
+	DiskStatistics []*DiskStatistics `protobuf:"bytes,43,rep,name=disk_statistics,json=diskStatistics" json:"disk_statistics,omitempty"`
+	// Cgroups blkio statistics.
+	BlkioStatistics *CgroupInfo_Blkio_Statistics `protobuf:"bytes,44,opt,name=blkio_statistics,json=blkioStatistics" json:"blkio_statistics,omitempty"`
+	// Perf statistics.
+	Perf *PerfStatistics `protobuf:"bytes,13,opt,name=perf" json:"perf,omitempty"`
+	// Network Usage Information:
+	NetRxPackets *uint64 `protobuf:"varint,14,opt,name=net_rx_packets,json=netRxPackets" json:"net_rx_packets,omitempty"`
+	NetRxBytes   *uint64 `protobuf:"varint,15,opt,name=net_rx_bytes,json=netRxBytes" json:"net_rx_bytes,omitempty"`
+	NetRxErrors  *uint64 `protobuf:"varint,16,opt,name=net_rx_errors,json=netRxErrors" json:"net_rx_errors,omitempty"`
+	NetRxDropped *uint64 `protobuf:"varint,17,opt,name=net_rx_dropped,json=netRxDropped" json:"net_rx_dropped,omitempty"`
+	NetTxPackets *uint64 `protobuf:"varint,18,opt,name=net_tx_packets,json=netTxPackets" json:"net_tx_packets,omitempty"`
+	NetTxBytes   *uint64 `protobuf:"varint,19,opt,name=net_tx_bytes,json=netTxBytes" json:"net_tx_bytes,omitempty"`
+	NetTxErrors  *uint64 `protobuf:"varint,20,opt,name=net_tx_errors,json=netTxErrors" json:"net_tx_errors,omitempty"`
+	NetTxDropped *uint64 `protobuf:"varint,21,opt,name=net_tx_dropped,json=netTxDropped" json:"net_tx_dropped,omitempty"`
+	// The kernel keeps track of RTT (round-trip time) for its TCP
+	// sockets. RTT is a way to tell the latency of a container.
+	NetTcpRttMicrosecsP50     *float64 `protobuf:"fixed64,22,opt,name=net_tcp_rtt_microsecs_p50,json=netTcpRttMicrosecsP50" json:"net_tcp_rtt_microsecs_p50,omitempty"`
+	NetTcpRttMicrosecsP90     *float64 `protobuf:"fixed64,23,opt,name=net_tcp_rtt_microsecs_p90,json=netTcpRttMicrosecsP90" json:"net_tcp_rtt_microsecs_p90,omitempty"`
+	NetTcpRttMicrosecsP95     *float64 `protobuf:"fixed64,24,opt,name=net_tcp_rtt_microsecs_p95,json=netTcpRttMicrosecsP95" json:"net_tcp_rtt_microsecs_p95,omitempty"`
+	NetTcpRttMicrosecsP99     *float64 `protobuf:"fixed64,25,opt,name=net_tcp_rtt_microsecs_p99,json=netTcpRttMicrosecsP99" json:"net_tcp_rtt_microsecs_p99,omitempty"`
+	NetTcpActiveConnections   *float64 `protobuf:"fixed64,28,opt,name=net_tcp_active_connections,json=netTcpActiveConnections" json:"net_tcp_active_connections,omitempty"`
+	NetTcpTimeWaitConnections *float64 `protobuf:"fixed64,29,opt,name=net_tcp_time_wait_connections,json=netTcpTimeWaitConnections" json:"net_tcp_time_wait_connections,omitempty"`
+	// Network traffic flowing into or out of a container can be delayed
+	// or dropped due to congestion or policy inside and outside the
+	// container.
+	NetTrafficControlStatistics []*TrafficControlStatistics `protobuf:"bytes,35,rep,name=net_traffic_control_statistics,json=netTrafficControlStatistics" json:"net_traffic_control_statistics,omitempty"`
+	// Network SNMP statistics for each container.
+	NetSnmpStatistics *SNMPStatistics `protobuf:"bytes,42,opt,name=net_snmp_statistics,json=netSnmpStatistics" json:"net_snmp_statistics,omitempty"`
+	XXX_unrecognized  []byte          `json:"-"`
+}
+
+func (m *ResourceStatistics) Reset()                    { *m = ResourceStatistics{} }
+func (m *ResourceStatistics) String() string            { return proto.CompactTextString(m) }
+func (*ResourceStatistics) ProtoMessage()               {}
+func (*ResourceStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *ResourceStatistics) GetTimestamp() float64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetProcesses() uint32 {
+	if m != nil && m.Processes != nil {
+		return *m.Processes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetThreads() uint32 {
+	if m != nil && m.Threads != nil {
+		return *m.Threads
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusUserTimeSecs() float64 {
+	if m != nil && m.CpusUserTimeSecs != nil {
+		return *m.CpusUserTimeSecs
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusSystemTimeSecs() float64 {
+	if m != nil && m.CpusSystemTimeSecs != nil {
+		return *m.CpusSystemTimeSecs
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusLimit() float64 {
+	if m != nil && m.CpusLimit != nil {
+		return *m.CpusLimit
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusSoftLimit() float64 {
+	if m != nil && m.CpusSoftLimit != nil {
+		return *m.CpusSoftLimit
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusNrPeriods() uint32 {
+	if m != nil && m.CpusNrPeriods != nil {
+		return *m.CpusNrPeriods
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusNrThrottled() uint32 {
+	if m != nil && m.CpusNrThrottled != nil {
+		return *m.CpusNrThrottled
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetCpusThrottledTimeSecs() float64 {
+	if m != nil && m.CpusThrottledTimeSecs != nil {
+		return *m.CpusThrottledTimeSecs
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemTotalBytes() uint64 {
+	if m != nil && m.MemTotalBytes != nil {
+		return *m.MemTotalBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemTotalMemswBytes() uint64 {
+	if m != nil && m.MemTotalMemswBytes != nil {
+		return *m.MemTotalMemswBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemLimitBytes() uint64 {
+	if m != nil && m.MemLimitBytes != nil {
+		return *m.MemLimitBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemSoftLimitBytes() uint64 {
+	if m != nil && m.MemSoftLimitBytes != nil {
+		return *m.MemSoftLimitBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemFileBytes() uint64 {
+	if m != nil && m.MemFileBytes != nil {
+		return *m.MemFileBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemAnonBytes() uint64 {
+	if m != nil && m.MemAnonBytes != nil {
+		return *m.MemAnonBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemCacheBytes() uint64 {
+	if m != nil && m.MemCacheBytes != nil {
+		return *m.MemCacheBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemRssBytes() uint64 {
+	if m != nil && m.MemRssBytes != nil {
+		return *m.MemRssBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemMappedFileBytes() uint64 {
+	if m != nil && m.MemMappedFileBytes != nil {
+		return *m.MemMappedFileBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemSwapBytes() uint64 {
+	if m != nil && m.MemSwapBytes != nil {
+		return *m.MemSwapBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemUnevictableBytes() uint64 {
+	if m != nil && m.MemUnevictableBytes != nil {
+		return *m.MemUnevictableBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemLowPressureCounter() uint64 {
+	if m != nil && m.MemLowPressureCounter != nil {
+		return *m.MemLowPressureCounter
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemMediumPressureCounter() uint64 {
+	if m != nil && m.MemMediumPressureCounter != nil {
+		return *m.MemMediumPressureCounter
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetMemCriticalPressureCounter() uint64 {
+	if m != nil && m.MemCriticalPressureCounter != nil {
+		return *m.MemCriticalPressureCounter
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetDiskLimitBytes() uint64 {
+	if m != nil && m.DiskLimitBytes != nil {
+		return *m.DiskLimitBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetDiskUsedBytes() uint64 {
+	if m != nil && m.DiskUsedBytes != nil {
+		return *m.DiskUsedBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetDiskStatistics() []*DiskStatistics {
+	if m != nil {
+		return m.DiskStatistics
+	}
+	return nil
+}
+
+func (m *ResourceStatistics) GetBlkioStatistics() *CgroupInfo_Blkio_Statistics {
+	if m != nil {
+		return m.BlkioStatistics
+	}
+	return nil
+}
+
+func (m *ResourceStatistics) GetPerf() *PerfStatistics {
+	if m != nil {
+		return m.Perf
+	}
+	return nil
+}
+
+func (m *ResourceStatistics) GetNetRxPackets() uint64 {
+	if m != nil && m.NetRxPackets != nil {
+		return *m.NetRxPackets
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetRxBytes() uint64 {
+	if m != nil && m.NetRxBytes != nil {
+		return *m.NetRxBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetRxErrors() uint64 {
+	if m != nil && m.NetRxErrors != nil {
+		return *m.NetRxErrors
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetRxDropped() uint64 {
+	if m != nil && m.NetRxDropped != nil {
+		return *m.NetRxDropped
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTxPackets() uint64 {
+	if m != nil && m.NetTxPackets != nil {
+		return *m.NetTxPackets
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTxBytes() uint64 {
+	if m != nil && m.NetTxBytes != nil {
+		return *m.NetTxBytes
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTxErrors() uint64 {
+	if m != nil && m.NetTxErrors != nil {
+		return *m.NetTxErrors
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTxDropped() uint64 {
+	if m != nil && m.NetTxDropped != nil {
+		return *m.NetTxDropped
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpRttMicrosecsP50() float64 {
+	if m != nil && m.NetTcpRttMicrosecsP50 != nil {
+		return *m.NetTcpRttMicrosecsP50
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpRttMicrosecsP90() float64 {
+	if m != nil && m.NetTcpRttMicrosecsP90 != nil {
+		return *m.NetTcpRttMicrosecsP90
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpRttMicrosecsP95() float64 {
+	if m != nil && m.NetTcpRttMicrosecsP95 != nil {
+		return *m.NetTcpRttMicrosecsP95
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpRttMicrosecsP99() float64 {
+	if m != nil && m.NetTcpRttMicrosecsP99 != nil {
+		return *m.NetTcpRttMicrosecsP99
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpActiveConnections() float64 {
+	if m != nil && m.NetTcpActiveConnections != nil {
+		return *m.NetTcpActiveConnections
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTcpTimeWaitConnections() float64 {
+	if m != nil && m.NetTcpTimeWaitConnections != nil {
+		return *m.NetTcpTimeWaitConnections
+	}
+	return 0
+}
+
+func (m *ResourceStatistics) GetNetTrafficControlStatistics() []*TrafficControlStatistics {
+	if m != nil {
+		return m.NetTrafficControlStatistics
+	}
+	return nil
+}
+
+func (m *ResourceStatistics) GetNetSnmpStatistics() *SNMPStatistics {
+	if m != nil {
+		return m.NetSnmpStatistics
+	}
+	return nil
+}
+
+// Describes a snapshot of the resource usage for executors.
+type ResourceUsage struct {
+	Executors []*ResourceUsage_Executor `protobuf:"bytes,1,rep,name=executors" json:"executors,omitempty"`
+	// Agent's total resources including checkpointed dynamic
+	// reservations and persistent volumes.
+	Total            []*Resource `protobuf:"bytes,2,rep,name=total" json:"total,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
+func (m *ResourceUsage) String() string            { return proto.CompactTextString(m) }
+func (*ResourceUsage) ProtoMessage()               {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *ResourceUsage) GetExecutors() []*ResourceUsage_Executor {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *ResourceUsage) GetTotal() []*Resource {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type ResourceUsage_Executor struct {
+	ExecutorInfo *ExecutorInfo `protobuf:"bytes,1,req,name=executor_info,json=executorInfo" json:"executor_info,omitempty"`
+	// This includes resources used by the executor itself
+	// as well as its active tasks.
+	Allocated []*Resource `protobuf:"bytes,2,rep,name=allocated" json:"allocated,omitempty"`
+	// Current resource usage. If absent, the containerizer
+	// cannot provide resource usage.
+	Statistics *ResourceStatistics `protobuf:"bytes,3,opt,name=statistics" json:"statistics,omitempty"`
+	// The container id for the executor specified in the executor_info field.
+	ContainerId *ContainerID `protobuf:"bytes,4,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// Non-terminal tasks.
+	Tasks            []*ResourceUsage_Executor_Task `protobuf:"bytes,5,rep,name=tasks" json:"tasks,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *ResourceUsage_Executor) Reset()                    { *m = ResourceUsage_Executor{} }
+func (m *ResourceUsage_Executor) String() string            { return proto.CompactTextString(m) }
+func (*ResourceUsage_Executor) ProtoMessage()               {}
+func (*ResourceUsage_Executor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38, 0} }
+
+func (m *ResourceUsage_Executor) GetExecutorInfo() *ExecutorInfo {
+	if m != nil {
+		return m.ExecutorInfo
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor) GetAllocated() []*Resource {
+	if m != nil {
+		return m.Allocated
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor) GetStatistics() *ResourceStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor) GetContainerId() *ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor) GetTasks() []*ResourceUsage_Executor_Task {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type ResourceUsage_Executor_Task struct {
+	Name             *string     `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Id               *TaskID     `protobuf:"bytes,2,req,name=id" json:"id,omitempty"`
+	Resources        []*Resource `protobuf:"bytes,3,rep,name=resources" json:"resources,omitempty"`
+	Labels           *Labels     `protobuf:"bytes,4,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *ResourceUsage_Executor_Task) Reset()         { *m = ResourceUsage_Executor_Task{} }
+func (m *ResourceUsage_Executor_Task) String() string { return proto.CompactTextString(m) }
+func (*ResourceUsage_Executor_Task) ProtoMessage()    {}
+func (*ResourceUsage_Executor_Task) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{38, 0, 0}
+}
+
+func (m *ResourceUsage_Executor_Task) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ResourceUsage_Executor_Task) GetId() *TaskID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor_Task) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *ResourceUsage_Executor_Task) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Describes a sample of events from "perf stat". Only available on
+// Linux.
+//
+// NOTE: Each optional field matches the name of a perf event (see
+// "perf list") with the following changes:
+//  1. Names are downcased.
+//  2. Hyphens ('-') are replaced with underscores ('_').
+//  3. Events with alternate names use the name "perf stat" returns,
+//     e.g., for the event "cycles OR cpu-cycles" perf always returns
+//     cycles.
+type PerfStatistics struct {
+	Timestamp *float64 `protobuf:"fixed64,1,req,name=timestamp" json:"timestamp,omitempty"`
+	Duration  *float64 `protobuf:"fixed64,2,req,name=duration" json:"duration,omitempty"`
+	// Hardware event.
+	Cycles                *uint64 `protobuf:"varint,3,opt,name=cycles" json:"cycles,omitempty"`
+	StalledCyclesFrontend *uint64 `protobuf:"varint,4,opt,name=stalled_cycles_frontend,json=stalledCyclesFrontend" json:"stalled_cycles_frontend,omitempty"`
+	StalledCyclesBackend  *uint64 `protobuf:"varint,5,opt,name=stalled_cycles_backend,json=stalledCyclesBackend" json:"stalled_cycles_backend,omitempty"`
+	Instructions          *uint64 `protobuf:"varint,6,opt,name=instructions" json:"instructions,omitempty"`
+	CacheReferences       *uint64 `protobuf:"varint,7,opt,name=cache_references,json=cacheReferences" json:"cache_references,omitempty"`
+	CacheMisses           *uint64 `protobuf:"varint,8,opt,name=cache_misses,json=cacheMisses" json:"cache_misses,omitempty"`
+	Branches              *uint64 `protobuf:"varint,9,opt,name=branches" json:"branches,omitempty"`
+	BranchMisses          *uint64 `protobuf:"varint,10,opt,name=branch_misses,json=branchMisses" json:"branch_misses,omitempty"`
+	BusCycles             *uint64 `protobuf:"varint,11,opt,name=bus_cycles,json=busCycles" json:"bus_cycles,omitempty"`
+	RefCycles             *uint64 `protobuf:"varint,12,opt,name=ref_cycles,json=refCycles" json:"ref_cycles,omitempty"`
+	// Software event.
+	CpuClock        *float64 `protobuf:"fixed64,13,opt,name=cpu_clock,json=cpuClock" json:"cpu_clock,omitempty"`
+	TaskClock       *float64 `protobuf:"fixed64,14,opt,name=task_clock,json=taskClock" json:"task_clock,omitempty"`
+	PageFaults      *uint64  `protobuf:"varint,15,opt,name=page_faults,json=pageFaults" json:"page_faults,omitempty"`
+	MinorFaults     *uint64  `protobuf:"varint,16,opt,name=minor_faults,json=minorFaults" json:"minor_faults,omitempty"`
+	MajorFaults     *uint64  `protobuf:"varint,17,opt,name=major_faults,json=majorFaults" json:"major_faults,omitempty"`
+	ContextSwitches *uint64  `protobuf:"varint,18,opt,name=context_switches,json=contextSwitches" json:"context_switches,omitempty"`
+	CpuMigrations   *uint64  `protobuf:"varint,19,opt,name=cpu_migrations,json=cpuMigrations" json:"cpu_migrations,omitempty"`
+	AlignmentFaults *uint64  `protobuf:"varint,20,opt,name=alignment_faults,json=alignmentFaults" json:"alignment_faults,omitempty"`
+	EmulationFaults *uint64  `protobuf:"varint,21,opt,name=emulation_faults,json=emulationFaults" json:"emulation_faults,omitempty"`
+	// Hardware cache event.
+	L1DcacheLoads          *uint64 `protobuf:"varint,22,opt,name=l1_dcache_loads,json=l1DcacheLoads" json:"l1_dcache_loads,omitempty"`
+	L1DcacheLoadMisses     *uint64 `protobuf:"varint,23,opt,name=l1_dcache_load_misses,json=l1DcacheLoadMisses" json:"l1_dcache_load_misses,omitempty"`
+	L1DcacheStores         *uint64 `protobuf:"varint,24,opt,name=l1_dcache_stores,json=l1DcacheStores" json:"l1_dcache_stores,omitempty"`
+	L1DcacheStoreMisses    *uint64 `protobuf:"varint,25,opt,name=l1_dcache_store_misses,json=l1DcacheStoreMisses" json:"l1_dcache_store_misses,omitempty"`
+	L1DcachePrefetches     *uint64 `protobuf:"varint,26,opt,name=l1_dcache_prefetches,json=l1DcachePrefetches" json:"l1_dcache_prefetches,omitempty"`
+	L1DcachePrefetchMisses *uint64 `protobuf:"varint,27,opt,name=l1_dcache_prefetch_misses,json=l1DcachePrefetchMisses" json:"l1_dcache_prefetch_misses,omitempty"`
+	L1IcacheLoads          *uint64 `protobuf:"varint,28,opt,name=l1_icache_loads,json=l1IcacheLoads" json:"l1_icache_loads,omitempty"`
+	L1IcacheLoadMisses     *uint64 `protobuf:"varint,29,opt,name=l1_icache_load_misses,json=l1IcacheLoadMisses" json:"l1_icache_load_misses,omitempty"`
+	L1IcachePrefetches     *uint64 `protobuf:"varint,30,opt,name=l1_icache_prefetches,json=l1IcachePrefetches" json:"l1_icache_prefetches,omitempty"`
+	L1IcachePrefetchMisses *uint64 `protobuf:"varint,31,opt,name=l1_icache_prefetch_misses,json=l1IcachePrefetchMisses" json:"l1_icache_prefetch_misses,omitempty"`
+	LlcLoads               *uint64 `protobuf:"varint,32,opt,name=llc_loads,json=llcLoads" json:"llc_loads,omitempty"`
+	LlcLoadMisses          *uint64 `protobuf:"varint,33,opt,name=llc_load_misses,json=llcLoadMisses" json:"llc_load_misses,omitempty"`
+	LlcStores              *uint64 `protobuf:"varint,34,opt,name=llc_stores,json=llcStores" json:"llc_stores,omitempty"`
+	LlcStoreMisses         *uint64 `protobuf:"varint,35,opt,name=llc_store_misses,json=llcStoreMisses" json:"llc_store_misses,omitempty"`
+	LlcPrefetches          *uint64 `protobuf:"varint,36,opt,name=llc_prefetches,json=llcPrefetches" json:"llc_prefetches,omitempty"`
+	LlcPrefetchMisses      *uint64 `protobuf:"varint,37,opt,name=llc_prefetch_misses,json=llcPrefetchMisses" json:"llc_prefetch_misses,omitempty"`
+	DtlbLoads              *uint64 `protobuf:"varint,38,opt,name=dtlb_loads,json=dtlbLoads" json:"dtlb_loads,omitempty"`
+	DtlbLoadMisses         *uint64 `protobuf:"varint,39,opt,name=dtlb_load_misses,json=dtlbLoadMisses" json:"dtlb_load_misses,omitempty"`
+	DtlbStores             *uint64 `protobuf:"varint,40,opt,name=dtlb_stores,json=dtlbStores" json:"dtlb_stores,omitempty"`
+	DtlbStoreMisses        *uint64 `protobuf:"varint,41,opt,name=dtlb_store_misses,json=dtlbStoreMisses" json:"dtlb_store_misses,omitempty"`
+	DtlbPrefetches         *uint64 `protobuf:"varint,42,opt,name=dtlb_prefetches,json=dtlbPrefetches" json:"dtlb_prefetches,omitempty"`
+	DtlbPrefetchMisses     *uint64 `protobuf:"varint,43,opt,name=dtlb_prefetch_misses,json=dtlbPrefetchMisses" json:"dtlb_prefetch_misses,omitempty"`
+	ItlbLoads              *uint64 `protobuf:"varint,44,opt,name=itlb_loads,json=itlbLoads" json:"itlb_loads,omitempty"`
+	ItlbLoadMisses         *uint64 `protobuf:"varint,45,opt,name=itlb_load_misses,json=itlbLoadMisses" json:"itlb_load_misses,omitempty"`
+	BranchLoads            *uint64 `protobuf:"varint,46,opt,name=branch_loads,json=branchLoads" json:"branch_loads,omitempty"`
+	BranchLoadMisses       *uint64 `protobuf:"varint,47,opt,name=branch_load_misses,json=branchLoadMisses" json:"branch_load_misses,omitempty"`
+	NodeLoads              *uint64 `protobuf:"varint,48,opt,name=node_loads,json=nodeLoads" json:"node_loads,omitempty"`
+	NodeLoadMisses         *uint64 `protobuf:"varint,49,opt,name=node_load_misses,json=nodeLoadMisses" json:"node_load_misses,omitempty"`
+	NodeStores             *uint64 `protobuf:"varint,50,opt,name=node_stores,json=nodeStores" json:"node_stores,omitempty"`
+	NodeStoreMisses        *uint64 `protobuf:"varint,51,opt,name=node_store_misses,json=nodeStoreMisses" json:"node_store_misses,omitempty"`
+	NodePrefetches         *uint64 `protobuf:"varint,52,opt,name=node_prefetches,json=nodePrefetches" json:"node_prefetches,omitempty"`
+	NodePrefetchMisses     *uint64 `protobuf:"varint,53,opt,name=node_prefetch_misses,json=nodePrefetchMisses" json:"node_prefetch_misses,omitempty"`
+	XXX_unrecognized       []byte  `json:"-"`
+}
+
+func (m *PerfStatistics) Reset()                    { *m = PerfStatistics{} }
+func (m *PerfStatistics) String() string            { return proto.CompactTextString(m) }
+func (*PerfStatistics) ProtoMessage()               {}
+func (*PerfStatistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *PerfStatistics) GetTimestamp() float64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDuration() float64 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetCycles() uint64 {
+	if m != nil && m.Cycles != nil {
+		return *m.Cycles
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetStalledCyclesFrontend() uint64 {
+	if m != nil && m.StalledCyclesFrontend != nil {
+		return *m.StalledCyclesFrontend
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetStalledCyclesBackend() uint64 {
+	if m != nil && m.StalledCyclesBackend != nil {
+		return *m.StalledCyclesBackend
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetInstructions() uint64 {
+	if m != nil && m.Instructions != nil {
+		return *m.Instructions
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetCacheReferences() uint64 {
+	if m != nil && m.CacheReferences != nil {
+		return *m.CacheReferences
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetCacheMisses() uint64 {
+	if m != nil && m.CacheMisses != nil {
+		return *m.CacheMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetBranches() uint64 {
+	if m != nil && m.Branches != nil {
+		return *m.Branches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetBranchMisses() uint64 {
+	if m != nil && m.BranchMisses != nil {
+		return *m.BranchMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetBusCycles() uint64 {
+	if m != nil && m.BusCycles != nil {
+		return *m.BusCycles
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetRefCycles() uint64 {
+	if m != nil && m.RefCycles != nil {
+		return *m.RefCycles
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetCpuClock() float64 {
+	if m != nil && m.CpuClock != nil {
+		return *m.CpuClock
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetTaskClock() float64 {
+	if m != nil && m.TaskClock != nil {
+		return *m.TaskClock
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetPageFaults() uint64 {
+	if m != nil && m.PageFaults != nil {
+		return *m.PageFaults
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetMinorFaults() uint64 {
+	if m != nil && m.MinorFaults != nil {
+		return *m.MinorFaults
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetMajorFaults() uint64 {
+	if m != nil && m.MajorFaults != nil {
+		return *m.MajorFaults
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetContextSwitches() uint64 {
+	if m != nil && m.ContextSwitches != nil {
+		return *m.ContextSwitches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetCpuMigrations() uint64 {
+	if m != nil && m.CpuMigrations != nil {
+		return *m.CpuMigrations
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetAlignmentFaults() uint64 {
+	if m != nil && m.AlignmentFaults != nil {
+		return *m.AlignmentFaults
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetEmulationFaults() uint64 {
+	if m != nil && m.EmulationFaults != nil {
+		return *m.EmulationFaults
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcacheLoads() uint64 {
+	if m != nil && m.L1DcacheLoads != nil {
+		return *m.L1DcacheLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcacheLoadMisses() uint64 {
+	if m != nil && m.L1DcacheLoadMisses != nil {
+		return *m.L1DcacheLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcacheStores() uint64 {
+	if m != nil && m.L1DcacheStores != nil {
+		return *m.L1DcacheStores
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcacheStoreMisses() uint64 {
+	if m != nil && m.L1DcacheStoreMisses != nil {
+		return *m.L1DcacheStoreMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcachePrefetches() uint64 {
+	if m != nil && m.L1DcachePrefetches != nil {
+		return *m.L1DcachePrefetches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1DcachePrefetchMisses() uint64 {
+	if m != nil && m.L1DcachePrefetchMisses != nil {
+		return *m.L1DcachePrefetchMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1IcacheLoads() uint64 {
+	if m != nil && m.L1IcacheLoads != nil {
+		return *m.L1IcacheLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1IcacheLoadMisses() uint64 {
+	if m != nil && m.L1IcacheLoadMisses != nil {
+		return *m.L1IcacheLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1IcachePrefetches() uint64 {
+	if m != nil && m.L1IcachePrefetches != nil {
+		return *m.L1IcachePrefetches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetL1IcachePrefetchMisses() uint64 {
+	if m != nil && m.L1IcachePrefetchMisses != nil {
+		return *m.L1IcachePrefetchMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcLoads() uint64 {
+	if m != nil && m.LlcLoads != nil {
+		return *m.LlcLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcLoadMisses() uint64 {
+	if m != nil && m.LlcLoadMisses != nil {
+		return *m.LlcLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcStores() uint64 {
+	if m != nil && m.LlcStores != nil {
+		return *m.LlcStores
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcStoreMisses() uint64 {
+	if m != nil && m.LlcStoreMisses != nil {
+		return *m.LlcStoreMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcPrefetches() uint64 {
+	if m != nil && m.LlcPrefetches != nil {
+		return *m.LlcPrefetches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetLlcPrefetchMisses() uint64 {
+	if m != nil && m.LlcPrefetchMisses != nil {
+		return *m.LlcPrefetchMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbLoads() uint64 {
+	if m != nil && m.DtlbLoads != nil {
+		return *m.DtlbLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbLoadMisses() uint64 {
+	if m != nil && m.DtlbLoadMisses != nil {
+		return *m.DtlbLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbStores() uint64 {
+	if m != nil && m.DtlbStores != nil {
+		return *m.DtlbStores
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbStoreMisses() uint64 {
+	if m != nil && m.DtlbStoreMisses != nil {
+		return *m.DtlbStoreMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbPrefetches() uint64 {
+	if m != nil && m.DtlbPrefetches != nil {
+		return *m.DtlbPrefetches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetDtlbPrefetchMisses() uint64 {
+	if m != nil && m.DtlbPrefetchMisses != nil {
+		return *m.DtlbPrefetchMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetItlbLoads() uint64 {
+	if m != nil && m.ItlbLoads != nil {
+		return *m.ItlbLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetItlbLoadMisses() uint64 {
+	if m != nil && m.ItlbLoadMisses != nil {
+		return *m.ItlbLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetBranchLoads() uint64 {
+	if m != nil && m.BranchLoads != nil {
+		return *m.BranchLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetBranchLoadMisses() uint64 {
+	if m != nil && m.BranchLoadMisses != nil {
+		return *m.BranchLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodeLoads() uint64 {
+	if m != nil && m.NodeLoads != nil {
+		return *m.NodeLoads
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodeLoadMisses() uint64 {
+	if m != nil && m.NodeLoadMisses != nil {
+		return *m.NodeLoadMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodeStores() uint64 {
+	if m != nil && m.NodeStores != nil {
+		return *m.NodeStores
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodeStoreMisses() uint64 {
+	if m != nil && m.NodeStoreMisses != nil {
+		return *m.NodeStoreMisses
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodePrefetches() uint64 {
+	if m != nil && m.NodePrefetches != nil {
+		return *m.NodePrefetches
+	}
+	return 0
+}
+
+func (m *PerfStatistics) GetNodePrefetchMisses() uint64 {
+	if m != nil && m.NodePrefetchMisses != nil {
+		return *m.NodePrefetchMisses
+	}
+	return 0
+}
+
+// Represents filters that allow a framework to control the shape of
+// offers that will be sent to its role(s). These filters apply
+// globally to any agent (unlike the existing `DECLINE` filter which
+// us a time-based resource subset filter that only applies to the
+// agent that was declined).
+//
+// NOTE: Custom allocators might interpret these fields in a different
+// way, or not at all.
+type OfferFilters struct {
+	MinAllocatableResources *OfferFilters_MinAllocatableResources `protobuf:"bytes,1,opt,name=min_allocatable_resources,json=minAllocatableResources" json:"min_allocatable_resources,omitempty"`
+	XXX_unrecognized        []byte                                `json:"-"`
+}
+
+func (m *OfferFilters) Reset()                    { *m = OfferFilters{} }
+func (m *OfferFilters) String() string            { return proto.CompactTextString(m) }
+func (*OfferFilters) ProtoMessage()               {}
+func (*OfferFilters) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *OfferFilters) GetMinAllocatableResources() *OfferFilters_MinAllocatableResources {
+	if m != nil {
+		return m.MinAllocatableResources
+	}
+	return nil
+}
+
+type OfferFilters_ResourceQuantities struct {
+	// Quantities are pairs of identifiers of scalar resources and
+	// an associated value, e.g., `{"disk": Scalar {"value": 30}}`.
+	Quantities       map[string]*Value_Scalar `protobuf:"bytes,1,rep,name=quantities" json:"quantities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *OfferFilters_ResourceQuantities) Reset()         { *m = OfferFilters_ResourceQuantities{} }
+func (m *OfferFilters_ResourceQuantities) String() string { return proto.CompactTextString(m) }
+func (*OfferFilters_ResourceQuantities) ProtoMessage()    {}
+func (*OfferFilters_ResourceQuantities) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{40, 0}
+}
+
+func (m *OfferFilters_ResourceQuantities) GetQuantities() map[string]*Value_Scalar {
+	if m != nil {
+		return m.Quantities
+	}
+	return nil
+}
+
+type OfferFilters_MinAllocatableResources struct {
+	// A set of resources is considered allocatable if contained in any of
+	// the following quantities. If no quantities are specified any resource
+	// is considered allocatable.
+	Quantities       []*OfferFilters_ResourceQuantities `protobuf:"bytes,1,rep,name=quantities" json:"quantities,omitempty"`
+	XXX_unrecognized []byte                             `json:"-"`
+}
+
+func (m *OfferFilters_MinAllocatableResources) Reset()         { *m = OfferFilters_MinAllocatableResources{} }
+func (m *OfferFilters_MinAllocatableResources) String() string { return proto.CompactTextString(m) }
+func (*OfferFilters_MinAllocatableResources) ProtoMessage()    {}
+func (*OfferFilters_MinAllocatableResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{40, 1}
+}
+
+func (m *OfferFilters_MinAllocatableResources) GetQuantities() []*OfferFilters_ResourceQuantities {
+	if m != nil {
+		return m.Quantities
+	}
+	return nil
+}
+
+// Describes a request for resources that can be used by a framework
+// to proactively influence the allocator.  If 'agent_id' is provided
+// then this request is assumed to only apply to resources on that
+// agent.
+type Request struct {
+	AgentId          *AgentID    `protobuf:"bytes,1,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Resources        []*Resource `protobuf:"bytes,2,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Request) Reset()                    { *m = Request{} }
+func (m *Request) String() string            { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()               {}
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *Request) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Request) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// Describes some resources available on an agent. An offer only
+// contains resources from a single agent.
+type Offer struct {
+	Id          *OfferID     `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	FrameworkId *FrameworkID `protobuf:"bytes,2,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	AgentId     *AgentID     `protobuf:"bytes,3,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Hostname    *string      `protobuf:"bytes,4,req,name=hostname" json:"hostname,omitempty"`
+	// URL for reaching the agent running on the host.
+	Url *URL `protobuf:"bytes,8,opt,name=url" json:"url,omitempty"`
+	// The domain of the agent.
+	Domain     *DomainInfo  `protobuf:"bytes,11,opt,name=domain" json:"domain,omitempty"`
+	Resources  []*Resource  `protobuf:"bytes,5,rep,name=resources" json:"resources,omitempty"`
+	Attributes []*Attribute `protobuf:"bytes,7,rep,name=attributes" json:"attributes,omitempty"`
+	// Executors of the same framework running on this agent.
+	ExecutorIds []*ExecutorID `protobuf:"bytes,6,rep,name=executor_ids,json=executorIds" json:"executor_ids,omitempty"`
+	// Signifies that the resources in this Offer may be unavailable during
+	// the given interval.  Any tasks launched using these resources may be
+	// killed when the interval arrives.  For example, these resources may be
+	// part of a planned maintenance schedule.
+	//
+	// This field only provides information about a planned unavailability.
+	// The unavailability interval may not necessarily start at exactly this
+	// interval, nor last for exactly the duration of this interval.
+	// The unavailability may also be forever!  See comments in
+	// `Unavailability` for more details.
+	Unavailability *Unavailability `protobuf:"bytes,9,opt,name=unavailability" json:"unavailability,omitempty"`
+	// An offer represents resources allocated to *one* of the
+	// roles managed by the scheduler. (Therefore, each
+	// `Offer.resources[i].allocation_info` will match the
+	// top level `Offer.allocation_info`).
+	AllocationInfo   *Resource_AllocationInfo `protobuf:"bytes,10,opt,name=allocation_info,json=allocationInfo" json:"allocation_info,omitempty"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *Offer) Reset()                    { *m = Offer{} }
+func (m *Offer) String() string            { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()               {}
+func (*Offer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *Offer) GetId() *OfferID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Offer) GetFrameworkId() *FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *Offer) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Offer) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *Offer) GetUrl() *URL {
+	if m != nil {
+		return m.Url
+	}
+	return nil
+}
+
+func (m *Offer) GetDomain() *DomainInfo {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+func (m *Offer) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Offer) GetAttributes() []*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Offer) GetExecutorIds() []*ExecutorID {
+	if m != nil {
+		return m.ExecutorIds
+	}
+	return nil
+}
+
+func (m *Offer) GetUnavailability() *Unavailability {
+	if m != nil {
+		return m.Unavailability
+	}
+	return nil
+}
+
+func (m *Offer) GetAllocationInfo() *Resource_AllocationInfo {
+	if m != nil {
+		return m.AllocationInfo
+	}
+	return nil
+}
+
+// Defines an operation that can be performed against offers.
+type Offer_Operation struct {
+	Type *Offer_Operation_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.Offer_Operation_Type" json:"type,omitempty"`
+	// The `id` field allows frameworks to indicate that they wish to receive
+	// feedback about an operation via the UPDATE_OPERATION_STATUS event in the
+	// v1 scheduler API.
+	Id               *OperationID                  `protobuf:"bytes,12,opt,name=id" json:"id,omitempty"`
+	Launch           *Offer_Operation_Launch       `protobuf:"bytes,2,opt,name=launch" json:"launch,omitempty"`
+	LaunchGroup      *Offer_Operation_LaunchGroup  `protobuf:"bytes,7,opt,name=launch_group,json=launchGroup" json:"launch_group,omitempty"`
+	Reserve          *Offer_Operation_Reserve      `protobuf:"bytes,3,opt,name=reserve" json:"reserve,omitempty"`
+	Unreserve        *Offer_Operation_Unreserve    `protobuf:"bytes,4,opt,name=unreserve" json:"unreserve,omitempty"`
+	Create           *Offer_Operation_Create       `protobuf:"bytes,5,opt,name=create" json:"create,omitempty"`
+	Destroy          *Offer_Operation_Destroy      `protobuf:"bytes,6,opt,name=destroy" json:"destroy,omitempty"`
+	GrowVolume       *Offer_Operation_GrowVolume   `protobuf:"bytes,13,opt,name=grow_volume,json=growVolume" json:"grow_volume,omitempty"`
+	ShrinkVolume     *Offer_Operation_ShrinkVolume `protobuf:"bytes,14,opt,name=shrink_volume,json=shrinkVolume" json:"shrink_volume,omitempty"`
+	CreateDisk       *Offer_Operation_CreateDisk   `protobuf:"bytes,15,opt,name=create_disk,json=createDisk" json:"create_disk,omitempty"`
+	DestroyDisk      *Offer_Operation_DestroyDisk  `protobuf:"bytes,16,opt,name=destroy_disk,json=destroyDisk" json:"destroy_disk,omitempty"`
+	XXX_unrecognized []byte                        `json:"-"`
+}
+
+func (m *Offer_Operation) Reset()                    { *m = Offer_Operation{} }
+func (m *Offer_Operation) String() string            { return proto.CompactTextString(m) }
+func (*Offer_Operation) ProtoMessage()               {}
+func (*Offer_Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0} }
+
+func (m *Offer_Operation) GetType() Offer_Operation_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Offer_Operation_UNKNOWN
+}
+
+func (m *Offer_Operation) GetId() *OperationID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetLaunch() *Offer_Operation_Launch {
+	if m != nil {
+		return m.Launch
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetLaunchGroup() *Offer_Operation_LaunchGroup {
+	if m != nil {
+		return m.LaunchGroup
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetReserve() *Offer_Operation_Reserve {
+	if m != nil {
+		return m.Reserve
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetUnreserve() *Offer_Operation_Unreserve {
+	if m != nil {
+		return m.Unreserve
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetCreate() *Offer_Operation_Create {
+	if m != nil {
+		return m.Create
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetDestroy() *Offer_Operation_Destroy {
+	if m != nil {
+		return m.Destroy
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetGrowVolume() *Offer_Operation_GrowVolume {
+	if m != nil {
+		return m.GrowVolume
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetShrinkVolume() *Offer_Operation_ShrinkVolume {
+	if m != nil {
+		return m.ShrinkVolume
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetCreateDisk() *Offer_Operation_CreateDisk {
+	if m != nil {
+		return m.CreateDisk
+	}
+	return nil
+}
+
+func (m *Offer_Operation) GetDestroyDisk() *Offer_Operation_DestroyDisk {
+	if m != nil {
+		return m.DestroyDisk
+	}
+	return nil
+}
+
+// TODO(vinod): Deprecate this in favor of `LaunchGroup` below.
+type Offer_Operation_Launch struct {
+	TaskInfos        []*TaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos" json:"task_infos,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Offer_Operation_Launch) Reset()                    { *m = Offer_Operation_Launch{} }
+func (m *Offer_Operation_Launch) String() string            { return proto.CompactTextString(m) }
+func (*Offer_Operation_Launch) ProtoMessage()               {}
+func (*Offer_Operation_Launch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0, 0} }
+
+func (m *Offer_Operation_Launch) GetTaskInfos() []*TaskInfo {
+	if m != nil {
+		return m.TaskInfos
+	}
+	return nil
+}
+
+// Unlike `Launch` above, all the tasks in a `task_group` are
+// atomically delivered to an executor.
+//
+// `NetworkInfo` set on executor will be shared by all tasks in
+// the task group.
+//
+// TODO(vinod): Any volumes set on executor could be used by a
+// task by explicitly setting `Volume.source` in its resources.
+type Offer_Operation_LaunchGroup struct {
+	Executor         *ExecutorInfo  `protobuf:"bytes,1,req,name=executor" json:"executor,omitempty"`
+	TaskGroup        *TaskGroupInfo `protobuf:"bytes,2,req,name=task_group,json=taskGroup" json:"task_group,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Offer_Operation_LaunchGroup) Reset()         { *m = Offer_Operation_LaunchGroup{} }
+func (m *Offer_Operation_LaunchGroup) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_LaunchGroup) ProtoMessage()    {}
+func (*Offer_Operation_LaunchGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 1}
+}
+
+func (m *Offer_Operation_LaunchGroup) GetExecutor() *ExecutorInfo {
+	if m != nil {
+		return m.Executor
+	}
+	return nil
+}
+
+func (m *Offer_Operation_LaunchGroup) GetTaskGroup() *TaskGroupInfo {
+	if m != nil {
+		return m.TaskGroup
+	}
+	return nil
+}
+
+type Offer_Operation_Reserve struct {
+	Source           []*Resource `protobuf:"bytes,2,rep,name=source" json:"source,omitempty"`
+	Resources        []*Resource `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Offer_Operation_Reserve) Reset()                    { *m = Offer_Operation_Reserve{} }
+func (m *Offer_Operation_Reserve) String() string            { return proto.CompactTextString(m) }
+func (*Offer_Operation_Reserve) ProtoMessage()               {}
+func (*Offer_Operation_Reserve) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0, 2} }
+
+func (m *Offer_Operation_Reserve) GetSource() []*Resource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Offer_Operation_Reserve) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type Offer_Operation_Unreserve struct {
+	Resources        []*Resource `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Offer_Operation_Unreserve) Reset()         { *m = Offer_Operation_Unreserve{} }
+func (m *Offer_Operation_Unreserve) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_Unreserve) ProtoMessage()    {}
+func (*Offer_Operation_Unreserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 3}
+}
+
+func (m *Offer_Operation_Unreserve) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type Offer_Operation_Create struct {
+	Volumes          []*Resource `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Offer_Operation_Create) Reset()                    { *m = Offer_Operation_Create{} }
+func (m *Offer_Operation_Create) String() string            { return proto.CompactTextString(m) }
+func (*Offer_Operation_Create) ProtoMessage()               {}
+func (*Offer_Operation_Create) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0, 4} }
+
+func (m *Offer_Operation_Create) GetVolumes() []*Resource {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type Offer_Operation_Destroy struct {
+	Volumes          []*Resource `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Offer_Operation_Destroy) Reset()                    { *m = Offer_Operation_Destroy{} }
+func (m *Offer_Operation_Destroy) String() string            { return proto.CompactTextString(m) }
+func (*Offer_Operation_Destroy) ProtoMessage()               {}
+func (*Offer_Operation_Destroy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42, 0, 5} }
+
+func (m *Offer_Operation_Destroy) GetVolumes() []*Resource {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+// Grow a volume by an additional disk resource.
+// NOTE: This is currently experimental and only for persistent volumes
+// created on ROOT/PATH disk.
+type Offer_Operation_GrowVolume struct {
+	Volume           *Resource `protobuf:"bytes,1,req,name=volume" json:"volume,omitempty"`
+	Addition         *Resource `protobuf:"bytes,2,req,name=addition" json:"addition,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *Offer_Operation_GrowVolume) Reset()         { *m = Offer_Operation_GrowVolume{} }
+func (m *Offer_Operation_GrowVolume) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_GrowVolume) ProtoMessage()    {}
+func (*Offer_Operation_GrowVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 6}
+}
+
+func (m *Offer_Operation_GrowVolume) GetVolume() *Resource {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *Offer_Operation_GrowVolume) GetAddition() *Resource {
+	if m != nil {
+		return m.Addition
+	}
+	return nil
+}
+
+// Shrink a volume by the size specified in the `subtract` field.
+// NOTE: This is currently experimental and only for persistent volumes
+// created on ROOT/PATH disk.
+type Offer_Operation_ShrinkVolume struct {
+	Volume *Resource `protobuf:"bytes,1,req,name=volume" json:"volume,omitempty"`
+	// See comments in `Value.Scalar` for maximum precision supported.
+	Subtract         *Value_Scalar `protobuf:"bytes,2,req,name=subtract" json:"subtract,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Offer_Operation_ShrinkVolume) Reset()         { *m = Offer_Operation_ShrinkVolume{} }
+func (m *Offer_Operation_ShrinkVolume) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_ShrinkVolume) ProtoMessage()    {}
+func (*Offer_Operation_ShrinkVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 7}
+}
+
+func (m *Offer_Operation_ShrinkVolume) GetVolume() *Resource {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *Offer_Operation_ShrinkVolume) GetSubtract() *Value_Scalar {
+	if m != nil {
+		return m.Subtract
+	}
+	return nil
+}
+
+// Create a `MOUNT` or `BLOCK` disk resource backed by a CSI volume from a
+// `RAW` disk resource.
+//
+// In the typical case where the `RAW` disk resource has a profile and no
+// source ID, a new CSI volume will be provisioned by Mesos to back the
+// returned `MOUNT` or `BLOCK` disk resource. However, the `RAW` disk
+// resource can instead have no profile but a source ID, indicating that
+// it is already backed by a CSI volume in one of the following scenarios:
+//
+// (1) The CSI volume is preprovisioned out-of-band.
+//
+// (2) The CSI volume is provisioned by Mesos, but Mesos has lost the
+//
+//	corresponding `MOUNT` or `BLOCK` resource metadata. This could
+//	happen if there has been a change in the agent ID or resource
+//	provider ID where the volume belongs.
+//
+// In the above cases, Mesos won't provision a new CSI volume, but instead
+// will simply return a `MOUNT` or `BLOCK` disk resource backed by the same
+// CSI volume, with the profile specified in this call.
+//
+// NOTE: For the time being, this API is subject to change and the related
+// feature is experimental.
+type Offer_Operation_CreateDisk struct {
+	Source *Resource `protobuf:"bytes,1,req,name=source" json:"source,omitempty"`
+	// NOTE: Only `MOUNT` or `BLOCK` is allowed in this field.
+	TargetType *Resource_DiskInfo_Source_Type `protobuf:"varint,2,req,name=target_type,json=targetType,enum=mesos.v1.Resource_DiskInfo_Source_Type" json:"target_type,omitempty"`
+	// Apply the specified profile to the created disk. This field must be set
+	// if `source` does not have a profile, and must not be set if it has one.
+	//
+	// NOTE: The operation will fail If the specified profile is unknown to
+	// Mesos, i.e., not reported by the disk profile adaptor.
+	TargetProfile    *string `protobuf:"bytes,3,opt,name=target_profile,json=targetProfile" json:"target_profile,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Offer_Operation_CreateDisk) Reset()         { *m = Offer_Operation_CreateDisk{} }
+func (m *Offer_Operation_CreateDisk) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_CreateDisk) ProtoMessage()    {}
+func (*Offer_Operation_CreateDisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 8}
+}
+
+func (m *Offer_Operation_CreateDisk) GetSource() *Resource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Offer_Operation_CreateDisk) GetTargetType() Resource_DiskInfo_Source_Type {
+	if m != nil && m.TargetType != nil {
+		return *m.TargetType
+	}
+	return Resource_DiskInfo_Source_UNKNOWN
+}
+
+func (m *Offer_Operation_CreateDisk) GetTargetProfile() string {
+	if m != nil && m.TargetProfile != nil {
+		return *m.TargetProfile
+	}
+	return ""
+}
+
+// Destroy a disk resource backed by a CSI volume.
+//
+// In the typical case where the CSI plugin of the volume supports volume
+// deprovisioning and the disk resource is a `MOUNT` or `BLOCK` disk with a
+// profile known to Mesos, the volume will be deprovisioned and a `RAW` disk
+// resource with the same profile but no source ID will be returned.
+// However, the following scenarios could lead to different outcomes:
+//
+// (1) If the CSI plugin supports volume deprovisioning but the profile of
+//
+//	the disk resource is unknown to the disk profile adaptor, or the disk
+//	resource is a `RAW` disk with no profile but a source ID (see above
+//	for possible scenarios), the volume will be deprovisioned but no
+//	resource will be returned.
+//
+// (2) If the CSI plugin does not support volume deprovisioning, the volume
+//
+//	won't be deprovisioned and a `RAW` disk resource with no profile but
+//	the same source ID will be returned.
+//
+// NOTE: For the time being, this API is subject to change and the related
+// feature is experimental.
+type Offer_Operation_DestroyDisk struct {
+	// NOTE: Only a `MOUNT`, `BLOCK` or `RAW` disk is allowed in this field.
+	Source           *Resource `protobuf:"bytes,1,req,name=source" json:"source,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *Offer_Operation_DestroyDisk) Reset()         { *m = Offer_Operation_DestroyDisk{} }
+func (m *Offer_Operation_DestroyDisk) String() string { return proto.CompactTextString(m) }
+func (*Offer_Operation_DestroyDisk) ProtoMessage()    {}
+func (*Offer_Operation_DestroyDisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 0, 9}
+}
+
+func (m *Offer_Operation_DestroyDisk) GetSource() *Resource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+// A request to return some resources occupied by a framework.
+type InverseOffer struct {
+	// This is the same OfferID as found in normal offers, which allows
+	// re-use of some of the OfferID-only messages.
+	Id *OfferID `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	// URL for reaching the agent running on the host.  This enables some
+	// optimizations as described in MESOS-3012, such as allowing the
+	// scheduler driver to bypass the master and talk directly with an agent.
+	Url *URL `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	// The framework that should release its resources.
+	// If no specifics are provided (i.e. which agent), all the framework's
+	// resources are requested back.
+	FrameworkId *FrameworkID `protobuf:"bytes,3,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	// Specified if the resources need to be released from a particular agent.
+	// All the framework's resources on this agent are requested back,
+	// unless further qualified by the `resources` field.
+	AgentId *AgentID `protobuf:"bytes,4,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	// This InverseOffer represents a planned unavailability event in the
+	// specified interval.  Any tasks running on the given framework or agent
+	// may be killed when the interval arrives.  Therefore, frameworks should
+	// aim to gracefully terminate tasks prior to the arrival of the interval.
+	//
+	// For reserved resources, the resources are expected to be returned to the
+	// framework after the unavailability interval.  This is an expectation,
+	// not a guarantee.  For example, if the unavailability duration is not set,
+	// the resources may be removed permanently.
+	//
+	// For other resources, there is no guarantee that requested resources will
+	// be returned after the unavailability interval.  The allocator has no
+	// obligation to re-offer these resources to the prior framework after
+	// the unavailability.
+	Unavailability *Unavailability `protobuf:"bytes,5,req,name=unavailability" json:"unavailability,omitempty"`
+	// A list of resources being requested back from the framework,
+	// on the agent identified by `agent_id`.  If no resources are specified
+	// then all resources are being requested back.  For the purpose of
+	// maintenance, this field is always empty (maintenance always requests
+	// all resources back).
+	Resources        []*Resource `protobuf:"bytes,6,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *InverseOffer) Reset()                    { *m = InverseOffer{} }
+func (m *InverseOffer) String() string            { return proto.CompactTextString(m) }
+func (*InverseOffer) ProtoMessage()               {}
+func (*InverseOffer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *InverseOffer) GetId() *OfferID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *InverseOffer) GetUrl() *URL {
+	if m != nil {
+		return m.Url
+	}
+	return nil
+}
+
+func (m *InverseOffer) GetFrameworkId() *FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *InverseOffer) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *InverseOffer) GetUnavailability() *Unavailability {
+	if m != nil {
+		return m.Unavailability
+	}
+	return nil
+}
+
+func (m *InverseOffer) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// Describes a task. Passed from the scheduler all the way to an
+// executor (see SchedulerDriver::launchTasks and
+// Executor::launchTask). Either ExecutorInfo or CommandInfo should be set.
+// A different executor can be used to launch this task, and subsequent tasks
+// meant for the same executor can reuse the same ExecutorInfo struct.
+type TaskInfo struct {
+	Name      *string       `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	TaskId    *TaskID       `protobuf:"bytes,2,req,name=task_id,json=taskId" json:"task_id,omitempty"`
+	AgentId   *AgentID      `protobuf:"bytes,3,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Resources []*Resource   `protobuf:"bytes,4,rep,name=resources" json:"resources,omitempty"`
+	Executor  *ExecutorInfo `protobuf:"bytes,5,opt,name=executor" json:"executor,omitempty"`
+	Command   *CommandInfo  `protobuf:"bytes,7,opt,name=command" json:"command,omitempty"`
+	// Task provided with a container will launch the container as part
+	// of this task paired with the task's CommandInfo.
+	Container *ContainerInfo `protobuf:"bytes,9,opt,name=container" json:"container,omitempty"`
+	// A health check for the task. Implemented for executor-less
+	// command-based tasks. For tasks that specify an executor, it is
+	// the executor's responsibility to implement the health checking.
+	HealthCheck *HealthCheck `protobuf:"bytes,8,opt,name=health_check,json=healthCheck" json:"health_check,omitempty"`
+	// A general check for the task. Implemented for all built-in executors.
+	// For tasks that specify an executor, it is the executor's responsibility
+	// to implement checking support. Executors should (all built-in executors
+	// will) neither interpret nor act on the check's result.
+	//
+	// NOTE: Check support in built-in executors is experimental.
+	//
+	// TODO(alexr): Consider supporting multiple checks per task.
+	Check *CheckInfo `protobuf:"bytes,13,opt,name=check" json:"check,omitempty"`
+	// A kill policy for the task. Implemented for executor-less
+	// command-based and docker tasks. For tasks that specify an
+	// executor, it is the executor's responsibility to implement
+	// the kill policy.
+	KillPolicy *KillPolicy `protobuf:"bytes,12,opt,name=kill_policy,json=killPolicy" json:"kill_policy,omitempty"`
+	Data       []byte      `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
+	// Labels are free-form key value pairs which are exposed through
+	// master and agent endpoints. Labels will not be interpreted or
+	// acted upon by Mesos itself. As opposed to the data field, labels
+	// will be kept in memory on master and agent processes. Therefore,
+	// labels should be used to tag tasks with light-weight meta-data.
+	// Labels should not contain duplicate key-value pairs.
+	Labels *Labels `protobuf:"bytes,10,opt,name=labels" json:"labels,omitempty"`
+	// Service discovery information for the task. It is not interpreted
+	// or acted upon by Mesos. It is up to a service discovery system
+	// to use this information as needed and to handle tasks without
+	// service discovery information.
+	Discovery *DiscoveryInfo `protobuf:"bytes,11,opt,name=discovery" json:"discovery,omitempty"`
+	// Maximum duration for task completion. If the task is non-terminal at the
+	// end of this duration, it will fail with the reason
+	// `REASON_MAX_COMPLETION_TIME_REACHED`. Mesos supports this field for
+	// executor-less tasks, and tasks that use Docker or default executors.
+	// It is the executor's responsibility to implement this, so it might not be
+	// supported by all custom executors.
+	MaxCompletionTime *DurationInfo `protobuf:"bytes,14,opt,name=max_completion_time,json=maxCompletionTime" json:"max_completion_time,omitempty"`
+	// Resource limits associated with the task.
+	Limits           map[string]*Value_Scalar `protobuf:"bytes,15,rep,name=limits" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *TaskInfo) Reset()                    { *m = TaskInfo{} }
+func (m *TaskInfo) String() string            { return proto.CompactTextString(m) }
+func (*TaskInfo) ProtoMessage()               {}
+func (*TaskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *TaskInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetTaskId() *TaskID {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetExecutor() *ExecutorInfo {
+	if m != nil {
+		return m.Executor
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetCommand() *CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetContainer() *ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetHealthCheck() *HealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetCheck() *CheckInfo {
+	if m != nil {
+		return m.Check
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetKillPolicy() *KillPolicy {
+	if m != nil {
+		return m.KillPolicy
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetDiscovery() *DiscoveryInfo {
+	if m != nil {
+		return m.Discovery
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetMaxCompletionTime() *DurationInfo {
+	if m != nil {
+		return m.MaxCompletionTime
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetLimits() map[string]*Value_Scalar {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// Describes a group of tasks that belong to an executor. The
+// executor will receive the task group in a single message to
+// allow the group to be launched "atomically".
+//
+// NOTES:
+//  1. `NetworkInfo` must not be set inside task's `ContainerInfo`.
+//  2. `TaskInfo.executor` doesn't need to set. If set, it should match
+//     `LaunchGroup.executor`.
+type TaskGroupInfo struct {
+	Tasks            []*TaskInfo `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *TaskGroupInfo) Reset()                    { *m = TaskGroupInfo{} }
+func (m *TaskGroupInfo) String() string            { return proto.CompactTextString(m) }
+func (*TaskGroupInfo) ProtoMessage()               {}
+func (*TaskGroupInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *TaskGroupInfo) GetTasks() []*TaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+// Describes a task, similar to `TaskInfo`.
+//
+// `Task` is used in some of the Mesos messages found below.
+// `Task` is used instead of `TaskInfo` if:
+//  1. we need additional IDs, such as a specific
+//     framework, executor, or agent; or
+//  2. we do not need the additional data, such as the command run by the
+//     task. These additional fields may be large and unnecessary for some
+//     Mesos messages.
+//
+// `Task` is generally constructed from a `TaskInfo`.  See protobuf::createTask.
+type Task struct {
+	Name        *string       `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	TaskId      *TaskID       `protobuf:"bytes,2,req,name=task_id,json=taskId" json:"task_id,omitempty"`
+	FrameworkId *FrameworkID  `protobuf:"bytes,3,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	ExecutorId  *ExecutorID   `protobuf:"bytes,4,opt,name=executor_id,json=executorId" json:"executor_id,omitempty"`
+	AgentId     *AgentID      `protobuf:"bytes,5,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	State       *TaskState    `protobuf:"varint,6,req,name=state,enum=mesos.v1.TaskState" json:"state,omitempty"`
+	Resources   []*Resource   `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
+	Statuses    []*TaskStatus `protobuf:"bytes,8,rep,name=statuses" json:"statuses,omitempty"`
+	// These fields correspond to the state and uuid of the latest
+	// status update forwarded to the master.
+	// NOTE: Either both the fields must be set or both must be unset.
+	StatusUpdateState *TaskState `protobuf:"varint,9,opt,name=status_update_state,json=statusUpdateState,enum=mesos.v1.TaskState" json:"status_update_state,omitempty"`
+	StatusUpdateUuid  []byte     `protobuf:"bytes,10,opt,name=status_update_uuid,json=statusUpdateUuid" json:"status_update_uuid,omitempty"`
+	Labels            *Labels    `protobuf:"bytes,11,opt,name=labels" json:"labels,omitempty"`
+	// Service discovery information for the task. It is not interpreted
+	// or acted upon by Mesos. It is up to a service discovery system
+	// to use this information as needed and to handle tasks without
+	// service discovery information.
+	Discovery *DiscoveryInfo `protobuf:"bytes,12,opt,name=discovery" json:"discovery,omitempty"`
+	// Container information for the task.
+	Container   *ContainerInfo `protobuf:"bytes,13,opt,name=container" json:"container,omitempty"`
+	HealthCheck *HealthCheck   `protobuf:"bytes,15,opt,name=health_check,json=healthCheck" json:"health_check,omitempty"`
+	// The kill policy used for this task when it is killed. It's possible for
+	// this policy to be overridden by the scheduler when killing the task.
+	KillPolicy *KillPolicy `protobuf:"bytes,16,opt,name=kill_policy,json=killPolicy" json:"kill_policy,omitempty"`
+	// Specific user under which task is running.
+	User             *string `protobuf:"bytes,14,opt,name=user" json:"user,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Task) Reset()                    { *m = Task{} }
+func (m *Task) String() string            { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()               {}
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *Task) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Task) GetTaskId() *TaskID {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *Task) GetFrameworkId() *FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *Task) GetExecutorId() *ExecutorID {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return nil
+}
+
+func (m *Task) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Task) GetState() TaskState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return TaskState_TASK_STAGING
+}
+
+func (m *Task) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Task) GetStatuses() []*TaskStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *Task) GetStatusUpdateState() TaskState {
+	if m != nil && m.StatusUpdateState != nil {
+		return *m.StatusUpdateState
+	}
+	return TaskState_TASK_STAGING
+}
+
+func (m *Task) GetStatusUpdateUuid() []byte {
+	if m != nil {
+		return m.StatusUpdateUuid
+	}
+	return nil
+}
+
+func (m *Task) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Task) GetDiscovery() *DiscoveryInfo {
+	if m != nil {
+		return m.Discovery
+	}
+	return nil
+}
+
+func (m *Task) GetContainer() *ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+func (m *Task) GetHealthCheck() *HealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *Task) GetKillPolicy() *KillPolicy {
+	if m != nil {
+		return m.KillPolicy
+	}
+	return nil
+}
+
+func (m *Task) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+// Describes a resource limitation that caused a task failure.
+type TaskResourceLimitation struct {
+	// This field contains the resource whose limits were violated.
+	//
+	// NOTE: 'Resources' is used here because the resource may span
+	// multiple roles (e.g. `"mem(*):1;mem(role):2"`).
+	Resources        []*Resource `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *TaskResourceLimitation) Reset()                    { *m = TaskResourceLimitation{} }
+func (m *TaskResourceLimitation) String() string            { return proto.CompactTextString(m) }
+func (*TaskResourceLimitation) ProtoMessage()               {}
+func (*TaskResourceLimitation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *TaskResourceLimitation) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// A 128 bit (16 byte) UUID, see RFC 4122.
+type UUID struct {
+	Value            []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UUID) Reset()                    { *m = UUID{} }
+func (m *UUID) String() string            { return proto.CompactTextString(m) }
+func (*UUID) ProtoMessage()               {}
+func (*UUID) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *UUID) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// Describes an operation, similar to `Offer.Operation`, with
+// some additional information.
+type Operation struct {
+	FrameworkId  *FrameworkID     `protobuf:"bytes,1,opt,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	AgentId      *AgentID         `protobuf:"bytes,2,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Info         *Offer_Operation `protobuf:"bytes,3,req,name=info" json:"info,omitempty"`
+	LatestStatus *OperationStatus `protobuf:"bytes,4,req,name=latest_status,json=latestStatus" json:"latest_status,omitempty"`
+	// All the statuses known to this operation. Some of the statuses in this
+	// list might not have been acknowledged yet. The statuses are ordered.
+	Statuses []*OperationStatus `protobuf:"bytes,5,rep,name=statuses" json:"statuses,omitempty"`
+	// This is the internal UUID for the operation, which is kept independently
+	// from the framework-specified operation ID, which is optional.
+	Uuid             *UUID  `protobuf:"bytes,6,req,name=uuid" json:"uuid,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Operation) Reset()                    { *m = Operation{} }
+func (m *Operation) String() string            { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()               {}
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *Operation) GetFrameworkId() *FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *Operation) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Operation) GetInfo() *Offer_Operation {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *Operation) GetLatestStatus() *OperationStatus {
+	if m != nil {
+		return m.LatestStatus
+	}
+	return nil
+}
+
+func (m *Operation) GetStatuses() []*OperationStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *Operation) GetUuid() *UUID {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+// Describes the current status of an operation.
+type OperationStatus struct {
+	// While frameworks will only receive status updates for operations on which
+	// they have set an ID, this field is optional because this message is also
+	// used internally by Mesos components when the operation's ID has not been
+	// set.
+	OperationId *OperationID    `protobuf:"bytes,1,opt,name=operation_id,json=operationId" json:"operation_id,omitempty"`
+	State       *OperationState `protobuf:"varint,2,req,name=state,enum=mesos.v1.OperationState" json:"state,omitempty"`
+	Message     *string         `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+	// Converted resources after applying the operation. This only
+	// applies if the `state` is `OPERATION_FINISHED`.
+	ConvertedResources []*Resource `protobuf:"bytes,4,rep,name=converted_resources,json=convertedResources" json:"converted_resources,omitempty"`
+	// Statuses that are delivered reliably to the scheduler will
+	// include a `uuid`. The status is considered delivered once
+	// it is acknowledged by the scheduler.
+	Uuid *UUID `protobuf:"bytes,5,opt,name=uuid" json:"uuid,omitempty"`
+	// If the operation affects resources from a local resource provider,
+	// both `agent_id` and `resource_provider_id` will be set.
+	//
+	// If the operation affects resources that belong to an external
+	// resource provider, only `resource_provider_id` will be set.
+	//
+	// In certain cases, e.g., invalid operations, neither `uuid`,
+	// `slave_id` nor `resource_provider_id` will be set, and the
+	// scheduler does not need to acknowledge this status update.
+	AgentId            *AgentID            `protobuf:"bytes,6,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	ResourceProviderId *ResourceProviderID `protobuf:"bytes,7,opt,name=resource_provider_id,json=resourceProviderId" json:"resource_provider_id,omitempty"`
+	XXX_unrecognized   []byte              `json:"-"`
+}
+
+func (m *OperationStatus) Reset()                    { *m = OperationStatus{} }
+func (m *OperationStatus) String() string            { return proto.CompactTextString(m) }
+func (*OperationStatus) ProtoMessage()               {}
+func (*OperationStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *OperationStatus) GetOperationId() *OperationID {
+	if m != nil {
+		return m.OperationId
+	}
+	return nil
+}
+
+func (m *OperationStatus) GetState() OperationState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return OperationState_OPERATION_UNSUPPORTED
+}
+
+func (m *OperationStatus) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *OperationStatus) GetConvertedResources() []*Resource {
+	if m != nil {
+		return m.ConvertedResources
+	}
+	return nil
+}
+
+func (m *OperationStatus) GetUuid() *UUID {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+func (m *OperationStatus) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *OperationStatus) GetResourceProviderId() *ResourceProviderID {
+	if m != nil {
+		return m.ResourceProviderId
+	}
+	return nil
+}
+
+// Describes the status of a check. Type and the corresponding field, i.e.,
+// `command` or `http` must be set. If the result of the check is not available
+// (e.g., the check timed out), these fields must contain empty messages, i.e.,
+// `exit_code` or `status_code` will be unset.
+//
+// NOTE: This API is subject to change and the related feature is experimental.
+type CheckStatusInfo struct {
+	// The type of the check this status corresponds to.
+	Type *CheckInfo_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.CheckInfo_Type" json:"type,omitempty"`
+	// Status of a command check.
+	Command *CheckStatusInfo_Command `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	// Status of an HTTP check.
+	Http *CheckStatusInfo_Http `protobuf:"bytes,3,opt,name=http" json:"http,omitempty"`
+	// Status of a TCP check.
+	Tcp              *CheckStatusInfo_Tcp `protobuf:"bytes,4,opt,name=tcp" json:"tcp,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *CheckStatusInfo) Reset()                    { *m = CheckStatusInfo{} }
+func (m *CheckStatusInfo) String() string            { return proto.CompactTextString(m) }
+func (*CheckStatusInfo) ProtoMessage()               {}
+func (*CheckStatusInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *CheckStatusInfo) GetType() CheckInfo_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return CheckInfo_UNKNOWN
+}
+
+func (m *CheckStatusInfo) GetCommand() *CheckStatusInfo_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *CheckStatusInfo) GetHttp() *CheckStatusInfo_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *CheckStatusInfo) GetTcp() *CheckStatusInfo_Tcp {
+	if m != nil {
+		return m.Tcp
+	}
+	return nil
+}
+
+type CheckStatusInfo_Command struct {
+	// Exit code of a command check. It is the result of calling
+	// `WEXITSTATUS()` on `waitpid()` termination information on
+	// Posix and calling `GetExitCodeProcess()` on Windows.
+	ExitCode         *int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode" json:"exit_code,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CheckStatusInfo_Command) Reset()                    { *m = CheckStatusInfo_Command{} }
+func (m *CheckStatusInfo_Command) String() string            { return proto.CompactTextString(m) }
+func (*CheckStatusInfo_Command) ProtoMessage()               {}
+func (*CheckStatusInfo_Command) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51, 0} }
+
+func (m *CheckStatusInfo_Command) GetExitCode() int32 {
+	if m != nil && m.ExitCode != nil {
+		return *m.ExitCode
+	}
+	return 0
+}
+
+type CheckStatusInfo_Http struct {
+	// HTTP status code of an HTTP check.
+	StatusCode       *uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode" json:"status_code,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CheckStatusInfo_Http) Reset()                    { *m = CheckStatusInfo_Http{} }
+func (m *CheckStatusInfo_Http) String() string            { return proto.CompactTextString(m) }
+func (*CheckStatusInfo_Http) ProtoMessage()               {}
+func (*CheckStatusInfo_Http) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51, 1} }
+
+func (m *CheckStatusInfo_Http) GetStatusCode() uint32 {
+	if m != nil && m.StatusCode != nil {
+		return *m.StatusCode
+	}
+	return 0
+}
+
+type CheckStatusInfo_Tcp struct {
+	// Whether a TCP connection succeeded.
+	Succeeded        *bool  `protobuf:"varint,1,opt,name=succeeded" json:"succeeded,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CheckStatusInfo_Tcp) Reset()                    { *m = CheckStatusInfo_Tcp{} }
+func (m *CheckStatusInfo_Tcp) String() string            { return proto.CompactTextString(m) }
+func (*CheckStatusInfo_Tcp) ProtoMessage()               {}
+func (*CheckStatusInfo_Tcp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51, 2} }
+
+func (m *CheckStatusInfo_Tcp) GetSucceeded() bool {
+	if m != nil && m.Succeeded != nil {
+		return *m.Succeeded
+	}
+	return false
+}
+
+// Describes the current status of a task.
+type TaskStatus struct {
+	TaskId     *TaskID            `protobuf:"bytes,1,req,name=task_id,json=taskId" json:"task_id,omitempty"`
+	State      *TaskState         `protobuf:"varint,2,req,name=state,enum=mesos.v1.TaskState" json:"state,omitempty"`
+	Message    *string            `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Source     *TaskStatus_Source `protobuf:"varint,9,opt,name=source,enum=mesos.v1.TaskStatus_Source" json:"source,omitempty"`
+	Reason     *TaskStatus_Reason `protobuf:"varint,10,opt,name=reason,enum=mesos.v1.TaskStatus_Reason" json:"reason,omitempty"`
+	Data       []byte             `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	AgentId    *AgentID           `protobuf:"bytes,5,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	ExecutorId *ExecutorID        `protobuf:"bytes,7,opt,name=executor_id,json=executorId" json:"executor_id,omitempty"`
+	Timestamp  *float64           `protobuf:"fixed64,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Statuses that are delivered reliably to the scheduler will
+	// include a 'uuid'. The status is considered delivered once
+	// it is acknowledged by the scheduler. Schedulers can choose
+	// to either explicitly acknowledge statuses or let the scheduler
+	// driver implicitly acknowledge (default).
+	//
+	// TODO(bmahler): This is currently overwritten in the scheduler
+	// driver and executor driver, but executors will need to set this
+	// to a valid RFC-4122 UUID if using the HTTP API.
+	Uuid []byte `protobuf:"bytes,11,opt,name=uuid" json:"uuid,omitempty"`
+	// Describes whether the task has been determined to be healthy (true) or
+	// unhealthy (false) according to the `health_check` field in `TaskInfo`.
+	Healthy *bool `protobuf:"varint,8,opt,name=healthy" json:"healthy,omitempty"`
+	// Contains check status for the check specified in the corresponding
+	// `TaskInfo`. If no check has been specified, this field must be
+	// absent, otherwise it must be present even if the check status is
+	// not available yet. If the status update is triggered for a different
+	// reason than `REASON_TASK_CHECK_STATUS_UPDATED`, this field will contain
+	// the last known value.
+	//
+	// NOTE: A check-related task status update is triggered if and only if
+	// the value or presence of any field in `CheckStatusInfo` changes.
+	//
+	// NOTE: Check support in built-in executors is experimental.
+	CheckStatus *CheckStatusInfo `protobuf:"bytes,15,opt,name=check_status,json=checkStatus" json:"check_status,omitempty"`
+	// Labels are free-form key value pairs which are exposed through
+	// master and agent endpoints. Labels will not be interpreted or
+	// acted upon by Mesos itself. As opposed to the data field, labels
+	// will be kept in memory on master and agent processes. Therefore,
+	// labels should be used to tag TaskStatus message with light-weight
+	// meta-data. Labels should not contain duplicate key-value pairs.
+	Labels *Labels `protobuf:"bytes,12,opt,name=labels" json:"labels,omitempty"`
+	// Container related information that is resolved dynamically such as
+	// network address.
+	ContainerStatus *ContainerStatus `protobuf:"bytes,13,opt,name=container_status,json=containerStatus" json:"container_status,omitempty"`
+	// The time (according to the master's clock) when the agent where
+	// this task was running became unreachable. This is only set on
+	// status updates for tasks running on agents that are unreachable
+	// (e.g., partitioned away from the master).
+	UnreachableTime *TimeInfo `protobuf:"bytes,14,opt,name=unreachable_time,json=unreachableTime" json:"unreachable_time,omitempty"`
+	// If the reason field indicates a container resource limitation,
+	// this field optionally contains additional information.
+	Limitation       *TaskResourceLimitation `protobuf:"bytes,16,opt,name=limitation" json:"limitation,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *TaskStatus) Reset()                    { *m = TaskStatus{} }
+func (m *TaskStatus) String() string            { return proto.CompactTextString(m) }
+func (*TaskStatus) ProtoMessage()               {}
+func (*TaskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *TaskStatus) GetTaskId() *TaskID {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetState() TaskState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return TaskState_TASK_STAGING
+}
+
+func (m *TaskStatus) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *TaskStatus) GetSource() TaskStatus_Source {
+	if m != nil && m.Source != nil {
+		return *m.Source
+	}
+	return TaskStatus_SOURCE_MASTER
+}
+
+func (m *TaskStatus) GetReason() TaskStatus_Reason {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return TaskStatus_REASON_COMMAND_EXECUTOR_FAILED
+}
+
+func (m *TaskStatus) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetAgentId() *AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetExecutorId() *ExecutorID {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetTimestamp() float64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *TaskStatus) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetHealthy() bool {
+	if m != nil && m.Healthy != nil {
+		return *m.Healthy
+	}
+	return false
+}
+
+func (m *TaskStatus) GetCheckStatus() *CheckStatusInfo {
+	if m != nil {
+		return m.CheckStatus
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetContainerStatus() *ContainerStatus {
+	if m != nil {
+		return m.ContainerStatus
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetUnreachableTime() *TimeInfo {
+	if m != nil {
+		return m.UnreachableTime
+	}
+	return nil
+}
+
+func (m *TaskStatus) GetLimitation() *TaskResourceLimitation {
+	if m != nil {
+		return m.Limitation
+	}
+	return nil
+}
+
+// Describes possible filters that can be applied to unused resources
+// (see SchedulerDriver::launchTasks) to influence the allocator.
+type Filters struct {
+	// Time to consider unused resources refused. Note that all unused
+	// resources will be considered refused and use the default value
+	// (below) regardless of whether Filters was passed to
+	// SchedulerDriver::launchTasks. You MUST pass Filters with this
+	// field set to change this behavior (i.e., get another offer which
+	// includes unused resources sooner or later than the default).
+	//
+	// If this field is set to a number of seconds greater than 31536000
+	// (365 days), then the resources will be considered refused for 365
+	// days. If it is set to a negative number, then the default value
+	// will be used.
+	RefuseSeconds    *float64 `protobuf:"fixed64,1,opt,name=refuse_seconds,json=refuseSeconds,def=5" json:"refuse_seconds,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Filters) Reset()                    { *m = Filters{} }
+func (m *Filters) String() string            { return proto.CompactTextString(m) }
+func (*Filters) ProtoMessage()               {}
+func (*Filters) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+const Default_Filters_RefuseSeconds float64 = 5
+
+func (m *Filters) GetRefuseSeconds() float64 {
+	if m != nil && m.RefuseSeconds != nil {
+		return *m.RefuseSeconds
+	}
+	return Default_Filters_RefuseSeconds
+}
+
+// Describes a collection of environment variables. This is used with
+// CommandInfo in order to set environment variables before running a
+// command. The contents of each variable may be specified as a string
+// or a Secret; only one of `value` and `secret` must be set.
+type Environment struct {
+	Variables        []*Environment_Variable `protobuf:"bytes,1,rep,name=variables" json:"variables,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Environment) Reset()                    { *m = Environment{} }
+func (m *Environment) String() string            { return proto.CompactTextString(m) }
+func (*Environment) ProtoMessage()               {}
+func (*Environment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *Environment) GetVariables() []*Environment_Variable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+type Environment_Variable struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// In Mesos 1.2, the `Environment.variables.value` message was made
+	// optional. The default type for `Environment.variables.type` is now VALUE,
+	// which requires `value` to be set, maintaining backward compatibility.
+	//
+	// TODO(greggomann): The default can be removed in Mesos 2.1 (MESOS-7134).
+	Type *Environment_Variable_Type `protobuf:"varint,3,opt,name=type,enum=mesos.v1.Environment_Variable_Type,def=1" json:"type,omitempty"`
+	// Only one of `value` and `secret` must be set.
+	Value            *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	Secret           *Secret `protobuf:"bytes,4,opt,name=secret" json:"secret,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Environment_Variable) Reset()                    { *m = Environment_Variable{} }
+func (m *Environment_Variable) String() string            { return proto.CompactTextString(m) }
+func (*Environment_Variable) ProtoMessage()               {}
+func (*Environment_Variable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54, 0} }
+
+const Default_Environment_Variable_Type Environment_Variable_Type = Environment_Variable_VALUE
+
+func (m *Environment_Variable) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Environment_Variable) GetType() Environment_Variable_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Default_Environment_Variable_Type
+}
+
+func (m *Environment_Variable) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+func (m *Environment_Variable) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+// A generic (key, value) pair used in various places for parameters.
+type Parameter struct {
+	Key              *string `protobuf:"bytes,1,req,name=key" json:"key,omitempty"`
+	Value            *string `protobuf:"bytes,2,req,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Parameter) Reset()                    { *m = Parameter{} }
+func (m *Parameter) String() string            { return proto.CompactTextString(m) }
+func (*Parameter) ProtoMessage()               {}
+func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *Parameter) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *Parameter) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Collection of Parameter.
+type Parameters struct {
+	Parameter        []*Parameter `protobuf:"bytes,1,rep,name=parameter" json:"parameter,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *Parameters) Reset()                    { *m = Parameters{} }
+func (m *Parameters) String() string            { return proto.CompactTextString(m) }
+func (*Parameters) ProtoMessage()               {}
+func (*Parameters) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *Parameters) GetParameter() []*Parameter {
+	if m != nil {
+		return m.Parameter
+	}
+	return nil
+}
+
+// Credential used in various places for authentication and
+// authorization.
+//
+// NOTE: A 'principal' is different from 'FrameworkInfo.user'. The
+// former is used for authentication and authorization while the
+// latter is used to determine the default user under which the
+// framework's executors/tasks are run.
+type Credential struct {
+	Principal        *string `protobuf:"bytes,1,req,name=principal" json:"principal,omitempty"`
+	Secret           *string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Credential) Reset()                    { *m = Credential{} }
+func (m *Credential) String() string            { return proto.CompactTextString(m) }
+func (*Credential) ProtoMessage()               {}
+func (*Credential) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *Credential) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *Credential) GetSecret() string {
+	if m != nil && m.Secret != nil {
+		return *m.Secret
+	}
+	return ""
+}
+
+// Credentials used for framework authentication, HTTP authentication
+// (where the common 'username' and 'password' are captured as
+// 'principal' and 'secret' respectively), etc.
+type Credentials struct {
+	Credentials      []*Credential `protobuf:"bytes,1,rep,name=credentials" json:"credentials,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Credentials) Reset()                    { *m = Credentials{} }
+func (m *Credentials) String() string            { return proto.CompactTextString(m) }
+func (*Credentials) ProtoMessage()               {}
+func (*Credentials) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *Credentials) GetCredentials() []*Credential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+// Secret used to pass privileged information. It is designed to provide
+// pass-by-value or pass-by-reference semantics, where the REFERENCE type can be
+// used by custom modules which interact with a secure back-end.
+type Secret struct {
+	Type *Secret_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.Secret_Type" json:"type,omitempty"`
+	// Only one of `reference` and `value` must be set.
+	Reference        *Secret_Reference `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	Value            *Secret_Value     `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Secret) Reset()                    { *m = Secret{} }
+func (m *Secret) String() string            { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()               {}
+func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *Secret) GetType() Secret_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Secret_UNKNOWN
+}
+
+func (m *Secret) GetReference() *Secret_Reference {
+	if m != nil {
+		return m.Reference
+	}
+	return nil
+}
+
+func (m *Secret) GetValue() *Secret_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// Can be used by modules to refer to a secret stored in a secure back-end.
+// The `key` field is provided to permit reference to a single value within a
+// secret containing arbitrary key-value pairs.
+//
+// For example, given a back-end secret store with a secret named
+// "my-secret" containing the following key-value pairs:
+//
+//	{
+//	  "username": "my-user",
+//	  "password": "my-password
+//	}
+//
+// the username could be referred to in a `Secret` by specifying
+// "my-secret" for the `name` and "username" for the `key`.
+type Secret_Reference struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Key              *string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Secret_Reference) Reset()                    { *m = Secret_Reference{} }
+func (m *Secret_Reference) String() string            { return proto.CompactTextString(m) }
+func (*Secret_Reference) ProtoMessage()               {}
+func (*Secret_Reference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59, 0} }
+
+func (m *Secret_Reference) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Secret_Reference) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+// Used to pass the value of a secret.
+type Secret_Value struct {
+	Data             []byte `protobuf:"bytes,1,req,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Secret_Value) Reset()                    { *m = Secret_Value{} }
+func (m *Secret_Value) String() string            { return proto.CompactTextString(m) }
+func (*Secret_Value) ProtoMessage()               {}
+func (*Secret_Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59, 1} }
+
+func (m *Secret_Value) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Rate (queries per second, QPS) limit for messages from a framework to master.
+// Strictly speaking they are the combined rate from all frameworks of the same
+// principal.
+type RateLimit struct {
+	// Leaving QPS unset gives it unlimited rate (i.e., not throttled),
+	// which also implies unlimited capacity.
+	Qps *float64 `protobuf:"fixed64,1,opt,name=qps" json:"qps,omitempty"`
+	// Principal of framework(s) to be throttled. Should match
+	// FrameworkInfo.principal and Credential.principal (if using authentication).
+	Principal *string `protobuf:"bytes,2,req,name=principal" json:"principal,omitempty"`
+	// Max number of outstanding messages from frameworks of this principal
+	// allowed by master before the next message is dropped and an error is sent
+	// back to the sender. Messages received before the capacity is reached are
+	// still going to be processed after the error is sent.
+	// If unspecified, this principal is assigned unlimited capacity.
+	// NOTE: This value is ignored if 'qps' is not set.
+	Capacity         *uint64 `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RateLimit) Reset()                    { *m = RateLimit{} }
+func (m *RateLimit) String() string            { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()               {}
+func (*RateLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *RateLimit) GetQps() float64 {
+	if m != nil && m.Qps != nil {
+		return *m.Qps
+	}
+	return 0
+}
+
+func (m *RateLimit) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *RateLimit) GetCapacity() uint64 {
+	if m != nil && m.Capacity != nil {
+		return *m.Capacity
+	}
+	return 0
+}
+
+// Collection of RateLimit.
+// Frameworks without rate limits defined here are not throttled unless
+// 'aggregate_default_qps' is specified.
+type RateLimits struct {
+	// Items should have unique principals.
+	Limits []*RateLimit `protobuf:"bytes,1,rep,name=limits" json:"limits,omitempty"`
+	// All the frameworks not specified in 'limits' get this default rate.
+	// This rate is an aggregate rate for all of them, i.e., their combined
+	// traffic is throttled together at this rate.
+	AggregateDefaultQps *float64 `protobuf:"fixed64,2,opt,name=aggregate_default_qps,json=aggregateDefaultQps" json:"aggregate_default_qps,omitempty"`
+	// All the frameworks not specified in 'limits' get this default capacity.
+	// This is an aggregate value similar to 'aggregate_default_qps'.
+	AggregateDefaultCapacity *uint64 `protobuf:"varint,3,opt,name=aggregate_default_capacity,json=aggregateDefaultCapacity" json:"aggregate_default_capacity,omitempty"`
+	XXX_unrecognized         []byte  `json:"-"`
+}
+
+func (m *RateLimits) Reset()                    { *m = RateLimits{} }
+func (m *RateLimits) String() string            { return proto.CompactTextString(m) }
+func (*RateLimits) ProtoMessage()               {}
+func (*RateLimits) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *RateLimits) GetLimits() []*RateLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *RateLimits) GetAggregateDefaultQps() float64 {
+	if m != nil && m.AggregateDefaultQps != nil {
+		return *m.AggregateDefaultQps
+	}
+	return 0
+}
+
+func (m *RateLimits) GetAggregateDefaultCapacity() uint64 {
+	if m != nil && m.AggregateDefaultCapacity != nil {
+		return *m.AggregateDefaultCapacity
+	}
+	return 0
+}
+
+// Describe an image used by tasks or executors. Note that it's only
+// for tasks or executors launched by MesosContainerizer currently.
+type Image struct {
+	Type *Image_Type `protobuf:"varint,1,req,name=type,enum=mesos.v1.Image_Type" json:"type,omitempty"`
+	// Only one of the following image messages should be set to match
+	// the type.
+	Appc   *Image_Appc   `protobuf:"bytes,2,opt,name=appc" json:"appc,omitempty"`
+	Docker *Image_Docker `protobuf:"bytes,3,opt,name=docker" json:"docker,omitempty"`
+	// With this flag set to false, the mesos containerizer will pull
+	// the docker/appc image from the registry even if the image is
+	// already downloaded on the agent.
+	Cached           *bool  `protobuf:"varint,4,opt,name=cached,def=1" json:"cached,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Image) Reset()                    { *m = Image{} }
+func (m *Image) String() string            { return proto.CompactTextString(m) }
+func (*Image) ProtoMessage()               {}
+func (*Image) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+const Default_Image_Cached bool = true
+
+func (m *Image) GetType() Image_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Image_APPC
+}
+
+func (m *Image) GetAppc() *Image_Appc {
+	if m != nil {
+		return m.Appc
+	}
+	return nil
+}
+
+func (m *Image) GetDocker() *Image_Docker {
+	if m != nil {
+		return m.Docker
+	}
+	return nil
+}
+
+func (m *Image) GetCached() bool {
+	if m != nil && m.Cached != nil {
+		return *m.Cached
+	}
+	return Default_Image_Cached
+}
+
+// Protobuf for specifying an Appc container image. See:
+// https://github.com/appc/spec/blob/master/spec/aci.md
+type Image_Appc struct {
+	// The name of the image.
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// An image ID is a string of the format "hash-value", where
+	// "hash" is the hash algorithm used and "value" is the hex
+	// encoded string of the digest. Currently the only permitted
+	// hash algorithm is sha512.
+	Id *string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// Optional labels. Suggested labels: "version", "os", and "arch".
+	Labels           *Labels `protobuf:"bytes,3,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Image_Appc) Reset()                    { *m = Image_Appc{} }
+func (m *Image_Appc) String() string            { return proto.CompactTextString(m) }
+func (*Image_Appc) ProtoMessage()               {}
+func (*Image_Appc) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62, 0} }
+
+func (m *Image_Appc) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Image_Appc) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Image_Appc) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type Image_Docker struct {
+	// The name of the image. Expected format:
+	//   [REGISTRY_HOST[:REGISTRY_PORT]/]REPOSITORY[:TAG|@TYPE:DIGEST]
+	//
+	// See: https://docs.docker.com/reference/commandline/pull/
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// Credential to authenticate with docker registry.
+	// NOTE: This is not encrypted, therefore framework and operators
+	// should enable SSL when passing this information.
+	//
+	// This field has never been used in Mesos before and is
+	// deprecated since Mesos 1.3. Please use `config` below
+	// (see MESOS-7088 for details).
+	Credential *Credential `protobuf:"bytes,2,opt,name=credential" json:"credential,omitempty"`
+	// Docker config containing credentials to authenticate with
+	// docker registry. The secret is expected to be a docker
+	// config file in JSON format with UTF-8 character encoding.
+	Config           *Secret `protobuf:"bytes,3,opt,name=config" json:"config,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Image_Docker) Reset()                    { *m = Image_Docker{} }
+func (m *Image_Docker) String() string            { return proto.CompactTextString(m) }
+func (*Image_Docker) ProtoMessage()               {}
+func (*Image_Docker) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62, 1} }
+
+func (m *Image_Docker) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Image_Docker) GetCredential() *Credential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+func (m *Image_Docker) GetConfig() *Secret {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// Describes how the mount will be propagated for a volume. See the
+// following doc for more details about mount propagation:
+// https://www.kernel.org/doc/Documentation/filesystems/sharedsubtree.txt
+type MountPropagation struct {
+	Mode             *MountPropagation_Mode `protobuf:"varint,1,opt,name=mode,enum=mesos.v1.MountPropagation_Mode" json:"mode,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *MountPropagation) Reset()                    { *m = MountPropagation{} }
+func (m *MountPropagation) String() string            { return proto.CompactTextString(m) }
+func (*MountPropagation) ProtoMessage()               {}
+func (*MountPropagation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *MountPropagation) GetMode() MountPropagation_Mode {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return MountPropagation_UNKNOWN
+}
+
+// Describes a volume mapping either from host to container or vice
+// versa. Both paths can either refer to a directory or a file.
+type Volume struct {
+	// TODO(gyliu513): Make this as `optional` after deprecation cycle of 1.0.
+	Mode *Volume_Mode `protobuf:"varint,3,req,name=mode,enum=mesos.v1.Volume_Mode" json:"mode,omitempty"`
+	// Path pointing to a directory or file in the container. If the
+	// path is a relative path, it is relative to the container work
+	// directory. If the path is an absolute path, that path must
+	// already exist.
+	ContainerPath *string `protobuf:"bytes,1,req,name=container_path,json=containerPath" json:"container_path,omitempty"`
+	// Absolute path pointing to a directory or file on the host or a
+	// path relative to the container work directory.
+	HostPath *string `protobuf:"bytes,2,opt,name=host_path,json=hostPath" json:"host_path,omitempty"`
+	// The source of the volume is an Image which describes a root
+	// filesystem which will be provisioned by Mesos.
+	Image            *Image         `protobuf:"bytes,4,opt,name=image" json:"image,omitempty"`
+	Source           *Volume_Source `protobuf:"bytes,5,opt,name=source" json:"source,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Volume) Reset()                    { *m = Volume{} }
+func (m *Volume) String() string            { return proto.CompactTextString(m) }
+func (*Volume) ProtoMessage()               {}
+func (*Volume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *Volume) GetMode() Volume_Mode {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return Volume_RW
+}
+
+func (m *Volume) GetContainerPath() string {
+	if m != nil && m.ContainerPath != nil {
+		return *m.ContainerPath
+	}
+	return ""
+}
+
+func (m *Volume) GetHostPath() string {
+	if m != nil && m.HostPath != nil {
+		return *m.HostPath
+	}
+	return ""
+}
+
+func (m *Volume) GetImage() *Image {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *Volume) GetSource() *Volume_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+// Describes where a volume originates from.
+type Volume_Source struct {
+	// Enum fields should be optional, see: MESOS-4997.
+	Type *Volume_Source_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.Volume_Source_Type" json:"type,omitempty"`
+	// The source of the volume created by docker volume driver.
+	DockerVolume *Volume_Source_DockerVolume `protobuf:"bytes,2,opt,name=docker_volume,json=dockerVolume" json:"docker_volume,omitempty"`
+	HostPath     *Volume_Source_HostPath     `protobuf:"bytes,5,opt,name=host_path,json=hostPath" json:"host_path,omitempty"`
+	SandboxPath  *Volume_Source_SandboxPath  `protobuf:"bytes,3,opt,name=sandbox_path,json=sandboxPath" json:"sandbox_path,omitempty"`
+	// The volume/secret isolator uses the secret-fetcher module (third-party or
+	// internal) downloads the secret and makes it available at container_path.
+	Secret           *Secret `protobuf:"bytes,4,opt,name=secret" json:"secret,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Volume_Source) Reset()                    { *m = Volume_Source{} }
+func (m *Volume_Source) String() string            { return proto.CompactTextString(m) }
+func (*Volume_Source) ProtoMessage()               {}
+func (*Volume_Source) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 0} }
+
+func (m *Volume_Source) GetType() Volume_Source_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Volume_Source_UNKNOWN
+}
+
+func (m *Volume_Source) GetDockerVolume() *Volume_Source_DockerVolume {
+	if m != nil {
+		return m.DockerVolume
+	}
+	return nil
+}
+
+func (m *Volume_Source) GetHostPath() *Volume_Source_HostPath {
+	if m != nil {
+		return m.HostPath
+	}
+	return nil
+}
+
+func (m *Volume_Source) GetSandboxPath() *Volume_Source_SandboxPath {
+	if m != nil {
+		return m.SandboxPath
+	}
+	return nil
+}
+
+func (m *Volume_Source) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type Volume_Source_DockerVolume struct {
+	// Driver of the volume, it can be flocker, convoy, raxrey etc.
+	Driver *string `protobuf:"bytes,1,opt,name=driver" json:"driver,omitempty"`
+	// Name of the volume.
+	Name *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	// Volume driver specific options.
+	DriverOptions    *Parameters `protobuf:"bytes,3,opt,name=driver_options,json=driverOptions" json:"driver_options,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Volume_Source_DockerVolume) Reset()         { *m = Volume_Source_DockerVolume{} }
+func (m *Volume_Source_DockerVolume) String() string { return proto.CompactTextString(m) }
+func (*Volume_Source_DockerVolume) ProtoMessage()    {}
+func (*Volume_Source_DockerVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{64, 0, 0}
+}
+
+func (m *Volume_Source_DockerVolume) GetDriver() string {
+	if m != nil && m.Driver != nil {
+		return *m.Driver
+	}
+	return ""
+}
+
+func (m *Volume_Source_DockerVolume) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Volume_Source_DockerVolume) GetDriverOptions() *Parameters {
+	if m != nil {
+		return m.DriverOptions
+	}
+	return nil
+}
+
+// Absolute path pointing to a directory or file on the host.
+type Volume_Source_HostPath struct {
+	Path             *string           `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	MountPropagation *MountPropagation `protobuf:"bytes,2,opt,name=mount_propagation,json=mountPropagation" json:"mount_propagation,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Volume_Source_HostPath) Reset()                    { *m = Volume_Source_HostPath{} }
+func (m *Volume_Source_HostPath) String() string            { return proto.CompactTextString(m) }
+func (*Volume_Source_HostPath) ProtoMessage()               {}
+func (*Volume_Source_HostPath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 0, 1} }
+
+func (m *Volume_Source_HostPath) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *Volume_Source_HostPath) GetMountPropagation() *MountPropagation {
+	if m != nil {
+		return m.MountPropagation
+	}
+	return nil
+}
+
+// Describe a path from a container's sandbox. The container can
+// be the current container (SELF), or its parent container
+// (PARENT). PARENT allows all child containers to share a volume
+// from their parent container's sandbox. It'll be an error if
+// the current container is a top level container.
+type Volume_Source_SandboxPath struct {
+	Type *Volume_Source_SandboxPath_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.Volume_Source_SandboxPath_Type" json:"type,omitempty"`
+	// A path relative to the corresponding container's sandbox.
+	// Note that upwards traversal (i.e. ../../abc) is not allowed.
+	Path             *string `protobuf:"bytes,2,req,name=path" json:"path,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Volume_Source_SandboxPath) Reset()         { *m = Volume_Source_SandboxPath{} }
+func (m *Volume_Source_SandboxPath) String() string { return proto.CompactTextString(m) }
+func (*Volume_Source_SandboxPath) ProtoMessage()    {}
+func (*Volume_Source_SandboxPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{64, 0, 2}
+}
+
+func (m *Volume_Source_SandboxPath) GetType() Volume_Source_SandboxPath_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Volume_Source_SandboxPath_UNKNOWN
+}
+
+func (m *Volume_Source_SandboxPath) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+// Describes a network request from a framework as well as network resolution
+// provided by Mesos.
+//
+// A framework may request the network isolator on the Agent to isolate the
+// container in a network namespace and create a virtual network interface.
+// The `NetworkInfo` message describes the properties of that virtual
+// interface, including the IP addresses and network isolation policy
+// (network group membership).
+//
+// The NetworkInfo message is not interpreted by the Master or Agent and is
+// intended to be used by Agent and Master modules implementing network
+// isolation. If the modules are missing, the message is simply ignored. In
+// future, the task launch will fail if there is no module providing the
+// network isolation capabilities (MESOS-3390).
+//
+// An executor, Agent, or an Agent module may append NetworkInfos inside
+// TaskStatus::container_status to provide information such as the container IP
+// address and isolation groups.
+type NetworkInfo struct {
+	// When included in a ContainerInfo, each of these represent a
+	// request for an IP address. Each request can specify an explicit address
+	// or the IP protocol to use.
+	//
+	// When included in a TaskStatus message, these inform the framework
+	// scheduler about the IP addresses that are bound to the container
+	// interface. When there are no custom network isolator modules installed,
+	// this field is filled in automatically with the Agent IP address.
+	IpAddresses []*NetworkInfo_IPAddress `protobuf:"bytes,5,rep,name=ip_addresses,json=ipAddresses" json:"ip_addresses,omitempty"`
+	// Name of the network which will be used by network isolator to determine
+	// the network that the container joins. It's up to the network isolator
+	// to decide how to interpret this field.
+	Name *string `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
+	// A group is the name given to a set of logically-related interfaces that
+	// are allowed to communicate among themselves. Network traffic is allowed
+	// between two container interfaces that share at least one network group.
+	// For example, one might want to create separate groups for isolating dev,
+	// testing, qa and prod deployment environments.
+	Groups []string `protobuf:"bytes,3,rep,name=groups" json:"groups,omitempty"`
+	// To tag certain metadata to be used by Isolator/IPAM, e.g., rack, etc.
+	Labels           *Labels                    `protobuf:"bytes,4,opt,name=labels" json:"labels,omitempty"`
+	PortMappings     []*NetworkInfo_PortMapping `protobuf:"bytes,7,rep,name=port_mappings,json=portMappings" json:"port_mappings,omitempty"`
+	XXX_unrecognized []byte                     `json:"-"`
+}
+
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *NetworkInfo) GetIpAddresses() []*NetworkInfo_IPAddress {
+	if m != nil {
+		return m.IpAddresses
+	}
+	return nil
+}
+
+func (m *NetworkInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *NetworkInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NetworkInfo) GetPortMappings() []*NetworkInfo_PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
+// Specifies a request for an IP address, or reports the assigned container
+// IP address.
+//
+// Users can request an automatically assigned IP (for example, via an
+// IPAM service) or a specific IP by adding a NetworkInfo to the
+// ContainerInfo for a task.  On a request, specifying neither `protocol`
+// nor `ip_address` means that any available address may be assigned.
+type NetworkInfo_IPAddress struct {
+	// Specify IP address requirement. Set protocol to the desired value to
+	// request the network isolator on the Agent to assign an IP address to the
+	// container being launched. If a specific IP address is specified in
+	// ip_address, this field should not be set.
+	Protocol *NetworkInfo_Protocol `protobuf:"varint,1,opt,name=protocol,enum=mesos.v1.NetworkInfo_Protocol,def=1" json:"protocol,omitempty"`
+	// Statically assigned IP provided by the Framework. This IP will be
+	// assigned to the container by the network isolator module on the Agent.
+	// This field should not be used with the protocol field above.
+	//
+	// If an explicit address is requested but is unavailable, the network
+	// isolator should fail the task.
+	IpAddress        *string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress" json:"ip_address,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *NetworkInfo_IPAddress) Reset()                    { *m = NetworkInfo_IPAddress{} }
+func (m *NetworkInfo_IPAddress) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo_IPAddress) ProtoMessage()               {}
+func (*NetworkInfo_IPAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
+
+const Default_NetworkInfo_IPAddress_Protocol NetworkInfo_Protocol = NetworkInfo_IPv4
+
+func (m *NetworkInfo_IPAddress) GetProtocol() NetworkInfo_Protocol {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return Default_NetworkInfo_IPAddress_Protocol
+}
+
+func (m *NetworkInfo_IPAddress) GetIpAddress() string {
+	if m != nil && m.IpAddress != nil {
+		return *m.IpAddress
+	}
+	return ""
+}
+
+// Specifies a port mapping request for the task on this network.
+type NetworkInfo_PortMapping struct {
+	HostPort      *uint32 `protobuf:"varint,1,req,name=host_port,json=hostPort" json:"host_port,omitempty"`
+	ContainerPort *uint32 `protobuf:"varint,2,req,name=container_port,json=containerPort" json:"container_port,omitempty"`
+	// Protocol to expose as (ie: tcp, udp).
+	Protocol         *string `protobuf:"bytes,3,opt,name=protocol" json:"protocol,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *NetworkInfo_PortMapping) Reset()                    { *m = NetworkInfo_PortMapping{} }
+func (m *NetworkInfo_PortMapping) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo_PortMapping) ProtoMessage()               {}
+func (*NetworkInfo_PortMapping) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 1} }
+
+func (m *NetworkInfo_PortMapping) GetHostPort() uint32 {
+	if m != nil && m.HostPort != nil {
+		return *m.HostPort
+	}
+	return 0
+}
+
+func (m *NetworkInfo_PortMapping) GetContainerPort() uint32 {
+	if m != nil && m.ContainerPort != nil {
+		return *m.ContainerPort
+	}
+	return 0
+}
+
+func (m *NetworkInfo_PortMapping) GetProtocol() string {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return ""
+}
+
+// Encapsulation of `Capabilities` supported by Linux.
+// Reference: http://linux.die.net/man/7/capabilities.
+type CapabilityInfo struct {
+	Capabilities     []CapabilityInfo_Capability `protobuf:"varint,1,rep,name=capabilities,enum=mesos.v1.CapabilityInfo_Capability" json:"capabilities,omitempty"`
+	XXX_unrecognized []byte                      `json:"-"`
+}
+
+func (m *CapabilityInfo) Reset()                    { *m = CapabilityInfo{} }
+func (m *CapabilityInfo) String() string            { return proto.CompactTextString(m) }
+func (*CapabilityInfo) ProtoMessage()               {}
+func (*CapabilityInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *CapabilityInfo) GetCapabilities() []CapabilityInfo_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+// Encapsulation for Seccomp configuration, which is Linux specific.
+type SeccompInfo struct {
+	// A filename of the Seccomp profile. This should be a path
+	// relative to the directory containing Seccomp profiles,
+	// which is specified on the agent via the `--seccomp_config_dir` flag.
+	ProfileName *string `protobuf:"bytes,1,opt,name=profile_name,json=profileName" json:"profile_name,omitempty"`
+	// If set to `true`, Seccomp is not applied to the container.
+	// If not set or set to `false`, the container is launched with
+	// the profile specified in the `profile_name` field.
+	//
+	// NOTE: `profile_name` must not be specified if `unconfined` set to `true`.
+	// `profile_name` must be specified if `unconfined` is not set or
+	// is set to `false`.
+	Unconfined       *bool  `protobuf:"varint,2,opt,name=unconfined" json:"unconfined,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *SeccompInfo) Reset()                    { *m = SeccompInfo{} }
+func (m *SeccompInfo) String() string            { return proto.CompactTextString(m) }
+func (*SeccompInfo) ProtoMessage()               {}
+func (*SeccompInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *SeccompInfo) GetProfileName() string {
+	if m != nil && m.ProfileName != nil {
+		return *m.ProfileName
+	}
+	return ""
+}
+
+func (m *SeccompInfo) GetUnconfined() bool {
+	if m != nil && m.Unconfined != nil {
+		return *m.Unconfined
+	}
+	return false
+}
+
+// Encapsulation for Linux specific configuration.
+// E.g, capabilities, limits etc.
+type LinuxInfo struct {
+	// Since 1.4.0, deprecated in favor of `effective_capabilities`.
+	CapabilityInfo *CapabilityInfo `protobuf:"bytes,1,opt,name=capability_info,json=capabilityInfo" json:"capability_info,omitempty"`
+	// The set of capabilities that are allowed but not initially
+	// granted to tasks.
+	BoundingCapabilities *CapabilityInfo `protobuf:"bytes,2,opt,name=bounding_capabilities,json=boundingCapabilities" json:"bounding_capabilities,omitempty"`
+	// Represents the set of capabilities that the task will
+	// be executed with.
+	EffectiveCapabilities *CapabilityInfo `protobuf:"bytes,3,opt,name=effective_capabilities,json=effectiveCapabilities" json:"effective_capabilities,omitempty"`
+	// If set as 'true', the container shares the pid namespace with
+	// its parent. If the container is a top level container, it will
+	// share the pid namespace with the agent. If the container is a
+	// nested container, it will share the pid namespace with its
+	// parent container. This field will be ignored if 'namespaces/pid'
+	// isolator is not enabled.
+	SharePidNamespace *bool `protobuf:"varint,4,opt,name=share_pid_namespace,json=sharePidNamespace" json:"share_pid_namespace,omitempty"`
+	// Represents Seccomp configuration, which is used for syscall filtering.
+	// This field is used to override the agent's default Seccomp configuration.
+	Seccomp *SeccompInfo `protobuf:"bytes,5,opt,name=seccomp" json:"seccomp,omitempty"`
+	// There are two special cases that we need to handle for this field:
+	// 1. This field is not set: For backward compatibility we will keep the
+	//    previous behavior: Top level container will have its own IPC namespace
+	//    and nested container will share the IPC namespace from its parent
+	//    container. If the container does not have its own rootfs, it will share
+	//    agent's /dev/shm, otherwise it will have its own /dev/shm.
+	// 2. The `namespaces/ipc` isolator is not enabled: This field will be ignored
+	//    in this case. For backward compatibility, in the `filesystem/linux`
+	//    isolator we will keep the previous behavior: Any containers will share
+	//    IPC namespace from agent, and if the container does not have its own
+	//    rootfs, it will also share agent's /dev/shm, otherwise it will have its
+	//    own /dev/shm.
+	//
+	// TODO(qianzhang): Remove the support for the above two cases after the
+	// deprecation cycle (started in 1.9). Eventually we want a single isolator
+	// (`namespaces/ipc`) to handle both IPC namespace and /dev/shm, and decouple
+	// /dev/shm from container's rootfs (i.e., whether a container will have its
+	// own /dev/shm depends on its `ipc_mode` instead of whether the container
+	// has its own rootfs).
+	IpcMode *LinuxInfo_IpcMode `protobuf:"varint,6,opt,name=ipc_mode,json=ipcMode,enum=mesos.v1.LinuxInfo_IpcMode" json:"ipc_mode,omitempty"`
+	// Size of /dev/shm in MB. If not set, the size of the /dev/shm for container
+	// will be value of the `--default_container_shm_size` agent flag, if that
+	// flag is not set too, the size of the /dev/shm will be half of the host RAM
+	// which is the default behavior of Linux. This field will be ignored for the
+	// container which shares /dev/shm from its parent and it will be also ignored
+	// for any containers if the `namespaces/ipc` isolator is not enabled. Please
+	// note that we only support setting this field when the `ipc_mode` field is
+	// set to `PRIVATE` otherwise the container launch will be rejected.
+	ShmSize *uint32 `protobuf:"varint,7,opt,name=shm_size,json=shmSize" json:"shm_size,omitempty"`
+	// If set as 'true', the container will share the cgroups from its parent
+	// container, otherwise it will have its own cgroups created. Please note:
+	// 1. For tasks in a task group launched via the LAUNCH_GROUP operation,
+	//    this field may be set to 'true' or 'false'. Resource limits may only be
+	//    set for tasks in a task group when this field is set to 'false'.
+	// 2. For tasks launched via the LAUNCH operation, this field may only be set
+	//    to 'true', and in this case resource limits may be set on these tasks.
+	// 3. For containers launched via the agent's LAUNCH_NESTED_CONTAINER_SESSION
+	//    call, this field must be set to 'true'.
+	// 4. For executor containers, this field may only be set to 'false'.
+	// 5. All tasks under a single executor must share the same value of this
+	//    field, if it is set. Note that this means that all tasks within a single
+	//    task group must set this field to the same value.
+	ShareCgroups     *bool  `protobuf:"varint,8,opt,name=share_cgroups,json=shareCgroups,def=1" json:"share_cgroups,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *LinuxInfo) Reset()                    { *m = LinuxInfo{} }
+func (m *LinuxInfo) String() string            { return proto.CompactTextString(m) }
+func (*LinuxInfo) ProtoMessage()               {}
+func (*LinuxInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+const Default_LinuxInfo_ShareCgroups bool = true
+
+func (m *LinuxInfo) GetCapabilityInfo() *CapabilityInfo {
+	if m != nil {
+		return m.CapabilityInfo
+	}
+	return nil
+}
+
+func (m *LinuxInfo) GetBoundingCapabilities() *CapabilityInfo {
+	if m != nil {
+		return m.BoundingCapabilities
+	}
+	return nil
+}
+
+func (m *LinuxInfo) GetEffectiveCapabilities() *CapabilityInfo {
+	if m != nil {
+		return m.EffectiveCapabilities
+	}
+	return nil
+}
+
+func (m *LinuxInfo) GetSharePidNamespace() bool {
+	if m != nil && m.SharePidNamespace != nil {
+		return *m.SharePidNamespace
+	}
+	return false
+}
+
+func (m *LinuxInfo) GetSeccomp() *SeccompInfo {
+	if m != nil {
+		return m.Seccomp
+	}
+	return nil
+}
+
+func (m *LinuxInfo) GetIpcMode() LinuxInfo_IpcMode {
+	if m != nil && m.IpcMode != nil {
+		return *m.IpcMode
+	}
+	return LinuxInfo_UNKNOWN
+}
+
+func (m *LinuxInfo) GetShmSize() uint32 {
+	if m != nil && m.ShmSize != nil {
+		return *m.ShmSize
+	}
+	return 0
+}
+
+func (m *LinuxInfo) GetShareCgroups() bool {
+	if m != nil && m.ShareCgroups != nil {
+		return *m.ShareCgroups
+	}
+	return Default_LinuxInfo_ShareCgroups
+}
+
+// Encapsulation for POSIX rlimits, see
+// http://pubs.opengroup.org/onlinepubs/009695399/functions/getrlimit.html.
+// Note that some types might only be defined for Linux.
+// We use a custom prefix to avoid conflict with existing system macros
+// (e.g., `RLIMIT_CPU` or `NOFILE`).
+type RLimitInfo struct {
+	Rlimits          []*RLimitInfo_RLimit `protobuf:"bytes,1,rep,name=rlimits" json:"rlimits,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *RLimitInfo) Reset()                    { *m = RLimitInfo{} }
+func (m *RLimitInfo) String() string            { return proto.CompactTextString(m) }
+func (*RLimitInfo) ProtoMessage()               {}
+func (*RLimitInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *RLimitInfo) GetRlimits() []*RLimitInfo_RLimit {
+	if m != nil {
+		return m.Rlimits
+	}
+	return nil
+}
+
+type RLimitInfo_RLimit struct {
+	Type *RLimitInfo_RLimit_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.RLimitInfo_RLimit_Type" json:"type,omitempty"`
+	// Either both are set or both are not set.
+	// If both are not set, it represents unlimited.
+	// If both are set, we require `soft` <= `hard`.
+	Hard             *uint64 `protobuf:"varint,2,opt,name=hard" json:"hard,omitempty"`
+	Soft             *uint64 `protobuf:"varint,3,opt,name=soft" json:"soft,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RLimitInfo_RLimit) Reset()                    { *m = RLimitInfo_RLimit{} }
+func (m *RLimitInfo_RLimit) String() string            { return proto.CompactTextString(m) }
+func (*RLimitInfo_RLimit) ProtoMessage()               {}
+func (*RLimitInfo_RLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69, 0} }
+
+func (m *RLimitInfo_RLimit) GetType() RLimitInfo_RLimit_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return RLimitInfo_RLimit_UNKNOWN
+}
+
+func (m *RLimitInfo_RLimit) GetHard() uint64 {
+	if m != nil && m.Hard != nil {
+		return *m.Hard
+	}
+	return 0
+}
+
+func (m *RLimitInfo_RLimit) GetSoft() uint64 {
+	if m != nil && m.Soft != nil {
+		return *m.Soft
+	}
+	return 0
+}
+
+// Describes the information about (pseudo) TTY that can
+// be attached to a process running in a container.
+type TTYInfo struct {
+	WindowSize       *TTYInfo_WindowSize `protobuf:"bytes,1,opt,name=window_size,json=windowSize" json:"window_size,omitempty"`
+	XXX_unrecognized []byte              `json:"-"`
+}
+
+func (m *TTYInfo) Reset()                    { *m = TTYInfo{} }
+func (m *TTYInfo) String() string            { return proto.CompactTextString(m) }
+func (*TTYInfo) ProtoMessage()               {}
+func (*TTYInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *TTYInfo) GetWindowSize() *TTYInfo_WindowSize {
+	if m != nil {
+		return m.WindowSize
+	}
+	return nil
+}
+
+type TTYInfo_WindowSize struct {
+	Rows             *uint32 `protobuf:"varint,1,req,name=rows" json:"rows,omitempty"`
+	Columns          *uint32 `protobuf:"varint,2,req,name=columns" json:"columns,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TTYInfo_WindowSize) Reset()                    { *m = TTYInfo_WindowSize{} }
+func (m *TTYInfo_WindowSize) String() string            { return proto.CompactTextString(m) }
+func (*TTYInfo_WindowSize) ProtoMessage()               {}
+func (*TTYInfo_WindowSize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70, 0} }
+
+func (m *TTYInfo_WindowSize) GetRows() uint32 {
+	if m != nil && m.Rows != nil {
+		return *m.Rows
+	}
+	return 0
+}
+
+func (m *TTYInfo_WindowSize) GetColumns() uint32 {
+	if m != nil && m.Columns != nil {
+		return *m.Columns
+	}
+	return 0
+}
+
+// Describes a container configuration and allows extensible
+// configurations for different container implementations.
+//
+// NOTE: `ContainerInfo` may be specified, e.g., by a task, even if no
+// container image is provided. In this case neither `MesosInfo` nor
+// `DockerInfo` is set, the required `type` must be `MESOS`. This is to
+// address a case when a task without an image, e.g., a shell script
+// with URIs, wants to use features originally designed for containers,
+// for example custom network isolation via `NetworkInfo`.
+type ContainerInfo struct {
+	Type     *ContainerInfo_Type `protobuf:"varint,1,req,name=type,enum=mesos.v1.ContainerInfo_Type" json:"type,omitempty"`
+	Volumes  []*Volume           `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty"`
+	Hostname *string             `protobuf:"bytes,4,opt,name=hostname" json:"hostname,omitempty"`
+	// At most one of the following *Info messages should be set to match
+	// the type, i.e. the "protobuf union" in ContainerInfo should be valid.
+	Docker *ContainerInfo_DockerInfo `protobuf:"bytes,3,opt,name=docker" json:"docker,omitempty"`
+	Mesos  *ContainerInfo_MesosInfo  `protobuf:"bytes,5,opt,name=mesos" json:"mesos,omitempty"`
+	// A list of network requests. A framework can request multiple IP addresses
+	// for the container.
+	NetworkInfos []*NetworkInfo `protobuf:"bytes,7,rep,name=network_infos,json=networkInfos" json:"network_infos,omitempty"`
+	// Linux specific information for the container.
+	LinuxInfo *LinuxInfo `protobuf:"bytes,8,opt,name=linux_info,json=linuxInfo" json:"linux_info,omitempty"`
+	// (POSIX only) rlimits of the container.
+	RlimitInfo *RLimitInfo `protobuf:"bytes,9,opt,name=rlimit_info,json=rlimitInfo" json:"rlimit_info,omitempty"`
+	// If specified a tty will be attached to the container entrypoint.
+	TtyInfo          *TTYInfo `protobuf:"bytes,10,opt,name=tty_info,json=ttyInfo" json:"tty_info,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ContainerInfo) Reset()                    { *m = ContainerInfo{} }
+func (m *ContainerInfo) String() string            { return proto.CompactTextString(m) }
+func (*ContainerInfo) ProtoMessage()               {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *ContainerInfo) GetType() ContainerInfo_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ContainerInfo_DOCKER
+}
+
+func (m *ContainerInfo) GetVolumes() []*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetDocker() *ContainerInfo_DockerInfo {
+	if m != nil {
+		return m.Docker
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetMesos() *ContainerInfo_MesosInfo {
+	if m != nil {
+		return m.Mesos
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetNetworkInfos() []*NetworkInfo {
+	if m != nil {
+		return m.NetworkInfos
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetLinuxInfo() *LinuxInfo {
+	if m != nil {
+		return m.LinuxInfo
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetRlimitInfo() *RLimitInfo {
+	if m != nil {
+		return m.RlimitInfo
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetTtyInfo() *TTYInfo {
+	if m != nil {
+		return m.TtyInfo
+	}
+	return nil
+}
+
+type ContainerInfo_DockerInfo struct {
+	// The docker image that is going to be passed to the registry.
+	Image        *string                                 `protobuf:"bytes,1,req,name=image" json:"image,omitempty"`
+	Network      *ContainerInfo_DockerInfo_Network       `protobuf:"varint,2,opt,name=network,enum=mesos.v1.ContainerInfo_DockerInfo_Network,def=1" json:"network,omitempty"`
+	PortMappings []*ContainerInfo_DockerInfo_PortMapping `protobuf:"bytes,3,rep,name=port_mappings,json=portMappings" json:"port_mappings,omitempty"`
+	Privileged   *bool                                   `protobuf:"varint,4,opt,name=privileged,def=0" json:"privileged,omitempty"`
+	// Allowing arbitrary parameters to be passed to docker CLI.
+	// Note that anything passed to this field is not guaranteed
+	// to be supported moving forward, as we might move away from
+	// the docker CLI.
+	Parameters []*Parameter `protobuf:"bytes,5,rep,name=parameters" json:"parameters,omitempty"`
+	// With this flag set to true, the docker containerizer will
+	// pull the docker image from the registry even if the image
+	// is already downloaded on the agent.
+	ForcePullImage *bool `protobuf:"varint,6,opt,name=force_pull_image,json=forcePullImage" json:"force_pull_image,omitempty"`
+	// The name of volume driver plugin.
+	VolumeDriver     *string `protobuf:"bytes,7,opt,name=volume_driver,json=volumeDriver" json:"volume_driver,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ContainerInfo_DockerInfo) Reset()                    { *m = ContainerInfo_DockerInfo{} }
+func (m *ContainerInfo_DockerInfo) String() string            { return proto.CompactTextString(m) }
+func (*ContainerInfo_DockerInfo) ProtoMessage()               {}
+func (*ContainerInfo_DockerInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71, 0} }
+
+const Default_ContainerInfo_DockerInfo_Network ContainerInfo_DockerInfo_Network = ContainerInfo_DockerInfo_HOST
+const Default_ContainerInfo_DockerInfo_Privileged bool = false
+
+func (m *ContainerInfo_DockerInfo) GetImage() string {
+	if m != nil && m.Image != nil {
+		return *m.Image
+	}
+	return ""
+}
+
+func (m *ContainerInfo_DockerInfo) GetNetwork() ContainerInfo_DockerInfo_Network {
+	if m != nil && m.Network != nil {
+		return *m.Network
+	}
+	return Default_ContainerInfo_DockerInfo_Network
+}
+
+func (m *ContainerInfo_DockerInfo) GetPortMappings() []*ContainerInfo_DockerInfo_PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
+func (m *ContainerInfo_DockerInfo) GetPrivileged() bool {
+	if m != nil && m.Privileged != nil {
+		return *m.Privileged
+	}
+	return Default_ContainerInfo_DockerInfo_Privileged
+}
+
+func (m *ContainerInfo_DockerInfo) GetParameters() []*Parameter {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *ContainerInfo_DockerInfo) GetForcePullImage() bool {
+	if m != nil && m.ForcePullImage != nil {
+		return *m.ForcePullImage
+	}
+	return false
+}
+
+func (m *ContainerInfo_DockerInfo) GetVolumeDriver() string {
+	if m != nil && m.VolumeDriver != nil {
+		return *m.VolumeDriver
+	}
+	return ""
+}
+
+type ContainerInfo_DockerInfo_PortMapping struct {
+	HostPort      *uint32 `protobuf:"varint,1,req,name=host_port,json=hostPort" json:"host_port,omitempty"`
+	ContainerPort *uint32 `protobuf:"varint,2,req,name=container_port,json=containerPort" json:"container_port,omitempty"`
+	// Protocol to expose as (ie: tcp, udp).
+	Protocol         *string `protobuf:"bytes,3,opt,name=protocol" json:"protocol,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ContainerInfo_DockerInfo_PortMapping) Reset()         { *m = ContainerInfo_DockerInfo_PortMapping{} }
+func (m *ContainerInfo_DockerInfo_PortMapping) String() string { return proto.CompactTextString(m) }
+func (*ContainerInfo_DockerInfo_PortMapping) ProtoMessage()    {}
+func (*ContainerInfo_DockerInfo_PortMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{71, 0, 0}
+}
+
+func (m *ContainerInfo_DockerInfo_PortMapping) GetHostPort() uint32 {
+	if m != nil && m.HostPort != nil {
+		return *m.HostPort
+	}
+	return 0
+}
+
+func (m *ContainerInfo_DockerInfo_PortMapping) GetContainerPort() uint32 {
+	if m != nil && m.ContainerPort != nil {
+		return *m.ContainerPort
+	}
+	return 0
+}
+
+func (m *ContainerInfo_DockerInfo_PortMapping) GetProtocol() string {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return ""
+}
+
+type ContainerInfo_MesosInfo struct {
+	Image            *Image `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ContainerInfo_MesosInfo) Reset()                    { *m = ContainerInfo_MesosInfo{} }
+func (m *ContainerInfo_MesosInfo) String() string            { return proto.CompactTextString(m) }
+func (*ContainerInfo_MesosInfo) ProtoMessage()               {}
+func (*ContainerInfo_MesosInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71, 1} }
+
+func (m *ContainerInfo_MesosInfo) GetImage() *Image {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+// Container related information that is resolved during container
+// setup. The information is sent back to the framework as part of the
+// TaskStatus message.
+type ContainerStatus struct {
+	ContainerId *ContainerID `protobuf:"bytes,4,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// This field can be reliably used to identify the container IP address.
+	NetworkInfos []*NetworkInfo `protobuf:"bytes,1,rep,name=network_infos,json=networkInfos" json:"network_infos,omitempty"`
+	// Information about Linux control group (cgroup).
+	CgroupInfo *CgroupInfo `protobuf:"bytes,2,opt,name=cgroup_info,json=cgroupInfo" json:"cgroup_info,omitempty"`
+	// Information about Executor PID.
+	ExecutorPid      *uint32 `protobuf:"varint,3,opt,name=executor_pid,json=executorPid" json:"executor_pid,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
+func (m *ContainerStatus) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatus) ProtoMessage()               {}
+func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *ContainerStatus) GetContainerId() *ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetNetworkInfos() []*NetworkInfo {
+	if m != nil {
+		return m.NetworkInfos
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetCgroupInfo() *CgroupInfo {
+	if m != nil {
+		return m.CgroupInfo
+	}
+	return nil
+}
+
+func (m *ContainerStatus) GetExecutorPid() uint32 {
+	if m != nil && m.ExecutorPid != nil {
+		return *m.ExecutorPid
+	}
+	return 0
+}
+
+// Linux control group (cgroup) information.
+type CgroupInfo struct {
+	NetCls           *CgroupInfo_NetCls `protobuf:"bytes,1,opt,name=net_cls,json=netCls" json:"net_cls,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *CgroupInfo) Reset()                    { *m = CgroupInfo{} }
+func (m *CgroupInfo) String() string            { return proto.CompactTextString(m) }
+func (*CgroupInfo) ProtoMessage()               {}
+func (*CgroupInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *CgroupInfo) GetNetCls() *CgroupInfo_NetCls {
+	if m != nil {
+		return m.NetCls
+	}
+	return nil
+}
+
+// Configuration of a blkio cgroup subsystem.
+type CgroupInfo_Blkio struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio) Reset()                    { *m = CgroupInfo_Blkio{} }
+func (m *CgroupInfo_Blkio) String() string            { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio) ProtoMessage()               {}
+func (*CgroupInfo_Blkio) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73, 0} }
+
+// Describes a stat value without the device descriptor part.
+type CgroupInfo_Blkio_Value struct {
+	Op               *CgroupInfo_Blkio_Operation `protobuf:"varint,1,opt,name=op,enum=mesos.v1.CgroupInfo_Blkio_Operation" json:"op,omitempty"`
+	Value            *uint64                     `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte                      `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_Value) Reset()                    { *m = CgroupInfo_Blkio_Value{} }
+func (m *CgroupInfo_Blkio_Value) String() string            { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_Value) ProtoMessage()               {}
+func (*CgroupInfo_Blkio_Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73, 0, 0} }
+
+func (m *CgroupInfo_Blkio_Value) GetOp() CgroupInfo_Blkio_Operation {
+	if m != nil && m.Op != nil {
+		return *m.Op
+	}
+	return CgroupInfo_Blkio_UNKNOWN
+}
+
+func (m *CgroupInfo_Blkio_Value) GetValue() uint64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+type CgroupInfo_Blkio_CFQ struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_CFQ) Reset()                    { *m = CgroupInfo_Blkio_CFQ{} }
+func (m *CgroupInfo_Blkio_CFQ) String() string            { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_CFQ) ProtoMessage()               {}
+func (*CgroupInfo_Blkio_CFQ) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73, 0, 1} }
+
+type CgroupInfo_Blkio_CFQ_Statistics struct {
+	// Stats are grouped by block devices. If `device` is not
+	// set, it represents `Total`.
+	Device *Device_Number `protobuf:"bytes,1,opt,name=device" json:"device,omitempty"`
+	// blkio.sectors
+	Sectors *uint64 `protobuf:"varint,2,opt,name=sectors" json:"sectors,omitempty"`
+	// blkio.time
+	Time *uint64 `protobuf:"varint,3,opt,name=time" json:"time,omitempty"`
+	// blkio.io_serviced
+	IoServiced []*CgroupInfo_Blkio_Value `protobuf:"bytes,4,rep,name=io_serviced,json=ioServiced" json:"io_serviced,omitempty"`
+	// blkio.io_service_bytes
+	IoServiceBytes []*CgroupInfo_Blkio_Value `protobuf:"bytes,5,rep,name=io_service_bytes,json=ioServiceBytes" json:"io_service_bytes,omitempty"`
+	// blkio.io_service_time
+	IoServiceTime []*CgroupInfo_Blkio_Value `protobuf:"bytes,6,rep,name=io_service_time,json=ioServiceTime" json:"io_service_time,omitempty"`
+	// blkio.io_wait_time
+	IoWaitTime []*CgroupInfo_Blkio_Value `protobuf:"bytes,7,rep,name=io_wait_time,json=ioWaitTime" json:"io_wait_time,omitempty"`
+	// blkio.io_merged
+	IoMerged []*CgroupInfo_Blkio_Value `protobuf:"bytes,8,rep,name=io_merged,json=ioMerged" json:"io_merged,omitempty"`
+	// blkio.io_queued
+	IoQueued         []*CgroupInfo_Blkio_Value `protobuf:"bytes,9,rep,name=io_queued,json=ioQueued" json:"io_queued,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) Reset()         { *m = CgroupInfo_Blkio_CFQ_Statistics{} }
+func (m *CgroupInfo_Blkio_CFQ_Statistics) String() string { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_CFQ_Statistics) ProtoMessage()    {}
+func (*CgroupInfo_Blkio_CFQ_Statistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{73, 0, 1, 0}
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetDevice() *Device_Number {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetSectors() uint64 {
+	if m != nil && m.Sectors != nil {
+		return *m.Sectors
+	}
+	return 0
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoServiced() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoServiced
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoServiceBytes() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoServiceBytes
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoServiceTime() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoServiceTime
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoWaitTime() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoWaitTime
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoMerged() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoMerged
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_CFQ_Statistics) GetIoQueued() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoQueued
+	}
+	return nil
+}
+
+type CgroupInfo_Blkio_Throttling struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_Throttling) Reset()         { *m = CgroupInfo_Blkio_Throttling{} }
+func (m *CgroupInfo_Blkio_Throttling) String() string { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_Throttling) ProtoMessage()    {}
+func (*CgroupInfo_Blkio_Throttling) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{73, 0, 2}
+}
+
+type CgroupInfo_Blkio_Throttling_Statistics struct {
+	// Stats are grouped by block devices. If `device` is not
+	// set, it represents `Total`.
+	Device *Device_Number `protobuf:"bytes,1,opt,name=device" json:"device,omitempty"`
+	// blkio.throttle.io_serviced
+	IoServiced []*CgroupInfo_Blkio_Value `protobuf:"bytes,2,rep,name=io_serviced,json=ioServiced" json:"io_serviced,omitempty"`
+	// blkio.throttle.io_service_bytes
+	IoServiceBytes   []*CgroupInfo_Blkio_Value `protobuf:"bytes,3,rep,name=io_service_bytes,json=ioServiceBytes" json:"io_service_bytes,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_Throttling_Statistics) Reset() {
+	*m = CgroupInfo_Blkio_Throttling_Statistics{}
+}
+func (m *CgroupInfo_Blkio_Throttling_Statistics) String() string { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_Throttling_Statistics) ProtoMessage()    {}
+func (*CgroupInfo_Blkio_Throttling_Statistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{73, 0, 2, 0}
+}
+
+func (m *CgroupInfo_Blkio_Throttling_Statistics) GetDevice() *Device_Number {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_Throttling_Statistics) GetIoServiced() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoServiced
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_Throttling_Statistics) GetIoServiceBytes() []*CgroupInfo_Blkio_Value {
+	if m != nil {
+		return m.IoServiceBytes
+	}
+	return nil
+}
+
+type CgroupInfo_Blkio_Statistics struct {
+	Cfq              []*CgroupInfo_Blkio_CFQ_Statistics        `protobuf:"bytes,1,rep,name=cfq" json:"cfq,omitempty"`
+	CfqRecursive     []*CgroupInfo_Blkio_CFQ_Statistics        `protobuf:"bytes,2,rep,name=cfq_recursive,json=cfqRecursive" json:"cfq_recursive,omitempty"`
+	Throttling       []*CgroupInfo_Blkio_Throttling_Statistics `protobuf:"bytes,3,rep,name=throttling" json:"throttling,omitempty"`
+	XXX_unrecognized []byte                                    `json:"-"`
+}
+
+func (m *CgroupInfo_Blkio_Statistics) Reset()         { *m = CgroupInfo_Blkio_Statistics{} }
+func (m *CgroupInfo_Blkio_Statistics) String() string { return proto.CompactTextString(m) }
+func (*CgroupInfo_Blkio_Statistics) ProtoMessage()    {}
+func (*CgroupInfo_Blkio_Statistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{73, 0, 3}
+}
+
+func (m *CgroupInfo_Blkio_Statistics) GetCfq() []*CgroupInfo_Blkio_CFQ_Statistics {
+	if m != nil {
+		return m.Cfq
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_Statistics) GetCfqRecursive() []*CgroupInfo_Blkio_CFQ_Statistics {
+	if m != nil {
+		return m.CfqRecursive
+	}
+	return nil
+}
+
+func (m *CgroupInfo_Blkio_Statistics) GetThrottling() []*CgroupInfo_Blkio_Throttling_Statistics {
+	if m != nil {
+		return m.Throttling
+	}
+	return nil
+}
+
+// Configuration of a net_cls cgroup subsystem.
+type CgroupInfo_NetCls struct {
+	// The 32-bit classid consists of two parts, a 16 bit major handle
+	// and a 16-bit minor handle. The major and minor handle are
+	// represented using the format 0xAAAABBBB, where 0xAAAA is the
+	// 16-bit major handle and 0xBBBB is the 16-bit minor handle.
+	Classid          *uint32 `protobuf:"varint,1,opt,name=classid" json:"classid,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *CgroupInfo_NetCls) Reset()                    { *m = CgroupInfo_NetCls{} }
+func (m *CgroupInfo_NetCls) String() string            { return proto.CompactTextString(m) }
+func (*CgroupInfo_NetCls) ProtoMessage()               {}
+func (*CgroupInfo_NetCls) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73, 1} }
+
+func (m *CgroupInfo_NetCls) GetClassid() uint32 {
+	if m != nil && m.Classid != nil {
+		return *m.Classid
+	}
+	return 0
+}
+
+// Collection of labels. Labels should not contain duplicate key-value
+// pairs.
+type Labels struct {
+	Labels           []*Label `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Labels) Reset()                    { *m = Labels{} }
+func (m *Labels) String() string            { return proto.CompactTextString(m) }
+func (*Labels) ProtoMessage()               {}
+func (*Labels) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *Labels) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Key, value pair used to store free form user-data.
+type Label struct {
+	Key              *string `protobuf:"bytes,1,req,name=key" json:"key,omitempty"`
+	Value            *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Label) Reset()                    { *m = Label{} }
+func (m *Label) String() string            { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()               {}
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *Label) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *Label) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Named port used for service discovery.
+type Port struct {
+	// Port number on which the framework exposes a service.
+	Number *uint32 `protobuf:"varint,1,req,name=number" json:"number,omitempty"`
+	// Name of the service hosted on this port.
+	Name *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Layer 4-7 protocol on which the framework exposes its services.
+	Protocol *string `protobuf:"bytes,3,opt,name=protocol" json:"protocol,omitempty"`
+	// This field restricts discovery within a framework (FRAMEWORK),
+	// within a Mesos cluster (CLUSTER), or places no restrictions (EXTERNAL).
+	// The visibility setting for a Port overrides the general visibility setting
+	// in the DiscoveryInfo.
+	Visibility *DiscoveryInfo_Visibility `protobuf:"varint,4,opt,name=visibility,enum=mesos.v1.DiscoveryInfo_Visibility" json:"visibility,omitempty"`
+	// This can be used to decorate the message with metadata to be
+	// interpreted by external applications such as firewalls.
+	Labels           *Labels `protobuf:"bytes,5,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Port) Reset()                    { *m = Port{} }
+func (m *Port) String() string            { return proto.CompactTextString(m) }
+func (*Port) ProtoMessage()               {}
+func (*Port) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *Port) GetNumber() uint32 {
+	if m != nil && m.Number != nil {
+		return *m.Number
+	}
+	return 0
+}
+
+func (m *Port) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Port) GetProtocol() string {
+	if m != nil && m.Protocol != nil {
+		return *m.Protocol
+	}
+	return ""
+}
+
+func (m *Port) GetVisibility() DiscoveryInfo_Visibility {
+	if m != nil && m.Visibility != nil {
+		return *m.Visibility
+	}
+	return DiscoveryInfo_FRAMEWORK
+}
+
+func (m *Port) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Collection of ports.
+type Ports struct {
+	Ports            []*Port `protobuf:"bytes,1,rep,name=ports" json:"ports,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Ports) Reset()                    { *m = Ports{} }
+func (m *Ports) String() string            { return proto.CompactTextString(m) }
+func (*Ports) ProtoMessage()               {}
+func (*Ports) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *Ports) GetPorts() []*Port {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+// Service discovery information.
+// The visibility field restricts discovery within a framework (FRAMEWORK),
+// within a Mesos cluster (CLUSTER), or places no restrictions (EXTERNAL).
+// Each port in the ports field also has an optional visibility field.
+// If visibility is specified for a port, it overrides the default service-wide
+// DiscoveryInfo.visibility for that port.
+// The environment, location, and version fields provide first class support for
+// common attributes used to differentiate between similar services. The
+// environment may receive values such as PROD/QA/DEV, the location field may
+// receive values like EAST-US/WEST-US/EUROPE/AMEA, and the version field may
+// receive values like v2.0/v0.9. The exact use of these fields is up to each
+// service discovery system.
+type DiscoveryInfo struct {
+	Visibility       *DiscoveryInfo_Visibility `protobuf:"varint,1,req,name=visibility,enum=mesos.v1.DiscoveryInfo_Visibility" json:"visibility,omitempty"`
+	Name             *string                   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Environment      *string                   `protobuf:"bytes,3,opt,name=environment" json:"environment,omitempty"`
+	Location         *string                   `protobuf:"bytes,4,opt,name=location" json:"location,omitempty"`
+	Version          *string                   `protobuf:"bytes,5,opt,name=version" json:"version,omitempty"`
+	Ports            *Ports                    `protobuf:"bytes,6,opt,name=ports" json:"ports,omitempty"`
+	Labels           *Labels                   `protobuf:"bytes,7,opt,name=labels" json:"labels,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *DiscoveryInfo) Reset()                    { *m = DiscoveryInfo{} }
+func (m *DiscoveryInfo) String() string            { return proto.CompactTextString(m) }
+func (*DiscoveryInfo) ProtoMessage()               {}
+func (*DiscoveryInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *DiscoveryInfo) GetVisibility() DiscoveryInfo_Visibility {
+	if m != nil && m.Visibility != nil {
+		return *m.Visibility
+	}
+	return DiscoveryInfo_FRAMEWORK
+}
+
+func (m *DiscoveryInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *DiscoveryInfo) GetEnvironment() string {
+	if m != nil && m.Environment != nil {
+		return *m.Environment
+	}
+	return ""
+}
+
+func (m *DiscoveryInfo) GetLocation() string {
+	if m != nil && m.Location != nil {
+		return *m.Location
+	}
+	return ""
+}
+
+func (m *DiscoveryInfo) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *DiscoveryInfo) GetPorts() *Ports {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *DiscoveryInfo) GetLabels() *Labels {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Named WeightInfo to indicate resource allocation
+// priority between the different roles.
+type WeightInfo struct {
+	Weight *float64 `protobuf:"fixed64,1,req,name=weight" json:"weight,omitempty"`
+	// Related role name.
+	Role             *string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *WeightInfo) Reset()                    { *m = WeightInfo{} }
+func (m *WeightInfo) String() string            { return proto.CompactTextString(m) }
+func (*WeightInfo) ProtoMessage()               {}
+func (*WeightInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *WeightInfo) GetWeight() float64 {
+	if m != nil && m.Weight != nil {
+		return *m.Weight
+	}
+	return 0
+}
+
+func (m *WeightInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+// Version information of a component.
+type VersionInfo struct {
+	Version          *string  `protobuf:"bytes,1,req,name=version" json:"version,omitempty"`
+	BuildDate        *string  `protobuf:"bytes,2,opt,name=build_date,json=buildDate" json:"build_date,omitempty"`
+	BuildTime        *float64 `protobuf:"fixed64,3,opt,name=build_time,json=buildTime" json:"build_time,omitempty"`
+	BuildUser        *string  `protobuf:"bytes,4,opt,name=build_user,json=buildUser" json:"build_user,omitempty"`
+	GitSha           *string  `protobuf:"bytes,5,opt,name=git_sha,json=gitSha" json:"git_sha,omitempty"`
+	GitBranch        *string  `protobuf:"bytes,6,opt,name=git_branch,json=gitBranch" json:"git_branch,omitempty"`
+	GitTag           *string  `protobuf:"bytes,7,opt,name=git_tag,json=gitTag" json:"git_tag,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *VersionInfo) Reset()                    { *m = VersionInfo{} }
+func (m *VersionInfo) String() string            { return proto.CompactTextString(m) }
+func (*VersionInfo) ProtoMessage()               {}
+func (*VersionInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *VersionInfo) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *VersionInfo) GetBuildDate() string {
+	if m != nil && m.BuildDate != nil {
+		return *m.BuildDate
+	}
+	return ""
+}
+
+func (m *VersionInfo) GetBuildTime() float64 {
+	if m != nil && m.BuildTime != nil {
+		return *m.BuildTime
+	}
+	return 0
+}
+
+func (m *VersionInfo) GetBuildUser() string {
+	if m != nil && m.BuildUser != nil {
+		return *m.BuildUser
+	}
+	return ""
+}
+
+func (m *VersionInfo) GetGitSha() string {
+	if m != nil && m.GitSha != nil {
+		return *m.GitSha
+	}
+	return ""
+}
+
+func (m *VersionInfo) GetGitBranch() string {
+	if m != nil && m.GitBranch != nil {
+		return *m.GitBranch
+	}
+	return ""
+}
+
+func (m *VersionInfo) GetGitTag() string {
+	if m != nil && m.GitTag != nil {
+		return *m.GitTag
+	}
+	return ""
+}
+
+// Flag consists of a name and optionally its value.
+type Flag struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Value            *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Flag) Reset()                    { *m = Flag{} }
+func (m *Flag) String() string            { return proto.CompactTextString(m) }
+func (*Flag) ProtoMessage()               {}
+func (*Flag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *Flag) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Flag) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Describes a Role. Roles can be used to specify that certain resources are
+// reserved for the use of one or more frameworks.
+type Role struct {
+	Name       *string        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Weight     *float64       `protobuf:"fixed64,2,req,name=weight" json:"weight,omitempty"`
+	Frameworks []*FrameworkID `protobuf:"bytes,3,rep,name=frameworks" json:"frameworks,omitempty"`
+	// TODO(bmahler): Deprecate `resources` and introduce quota,
+	// consumed quota, allocated, offered, and reserved resource
+	// quantity fields. This is blocked by MESOS-9497 since the
+	// computation of these quantities is currently expensive.
+	Resources        []*Resource `protobuf:"bytes,4,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Role) Reset()                    { *m = Role{} }
+func (m *Role) String() string            { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()               {}
+func (*Role) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *Role) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetWeight() float64 {
+	if m != nil && m.Weight != nil {
+		return *m.Weight
+	}
+	return 0
+}
+
+func (m *Role) GetFrameworks() []*FrameworkID {
+	if m != nil {
+		return m.Frameworks
+	}
+	return nil
+}
+
+func (m *Role) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// Metric consists of a name and optionally its value.
+type Metric struct {
+	Name             *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Value            *float64 `protobuf:"fixed64,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Metric) Reset()                    { *m = Metric{} }
+func (m *Metric) String() string            { return proto.CompactTextString(m) }
+func (*Metric) ProtoMessage()               {}
+func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *Metric) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Metric) GetValue() float64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+// Describes a File.
+type FileInfo struct {
+	// Absolute path to the file.
+	Path *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	// Number of hard links.
+	Nlink *int32 `protobuf:"varint,2,opt,name=nlink" json:"nlink,omitempty"`
+	// Total size in bytes.
+	Size *uint64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	// Last modification time.
+	Mtime *TimeInfo `protobuf:"bytes,4,opt,name=mtime" json:"mtime,omitempty"`
+	// Represents a file's mode and permission bits. The bits have the same
+	// definition on all systems and is portable.
+	Mode *uint32 `protobuf:"varint,5,opt,name=mode" json:"mode,omitempty"`
+	// User ID of owner.
+	Uid *string `protobuf:"bytes,6,opt,name=uid" json:"uid,omitempty"`
+	// Group ID of owner.
+	Gid              *string `protobuf:"bytes,7,opt,name=gid" json:"gid,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *FileInfo) Reset()                    { *m = FileInfo{} }
+func (m *FileInfo) String() string            { return proto.CompactTextString(m) }
+func (*FileInfo) ProtoMessage()               {}
+func (*FileInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *FileInfo) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *FileInfo) GetNlink() int32 {
+	if m != nil && m.Nlink != nil {
+		return *m.Nlink
+	}
+	return 0
+}
+
+func (m *FileInfo) GetSize() uint64 {
+	if m != nil && m.Size != nil {
+		return *m.Size
+	}
+	return 0
+}
+
+func (m *FileInfo) GetMtime() *TimeInfo {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *FileInfo) GetMode() uint32 {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return 0
+}
+
+func (m *FileInfo) GetUid() string {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return ""
+}
+
+func (m *FileInfo) GetGid() string {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return ""
+}
+
+// Describes information about a device.
+type Device struct {
+	Path             *string        `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Number           *Device_Number `protobuf:"bytes,2,opt,name=number" json:"number,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Device) Reset()                    { *m = Device{} }
+func (m *Device) String() string            { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()               {}
+func (*Device) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *Device) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *Device) GetNumber() *Device_Number {
+	if m != nil {
+		return m.Number
+	}
+	return nil
+}
+
+type Device_Number struct {
+	MajorNumber      *uint64 `protobuf:"varint,1,req,name=major_number,json=majorNumber" json:"major_number,omitempty"`
+	MinorNumber      *uint64 `protobuf:"varint,2,req,name=minor_number,json=minorNumber" json:"minor_number,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Device_Number) Reset()                    { *m = Device_Number{} }
+func (m *Device_Number) String() string            { return proto.CompactTextString(m) }
+func (*Device_Number) ProtoMessage()               {}
+func (*Device_Number) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85, 0} }
+
+func (m *Device_Number) GetMajorNumber() uint64 {
+	if m != nil && m.MajorNumber != nil {
+		return *m.MajorNumber
+	}
+	return 0
+}
+
+func (m *Device_Number) GetMinorNumber() uint64 {
+	if m != nil && m.MinorNumber != nil {
+		return *m.MinorNumber
+	}
+	return 0
+}
+
+// Describes a device whitelist entry that expose from host to container.
+type DeviceAccess struct {
+	Device           *Device              `protobuf:"bytes,1,req,name=device" json:"device,omitempty"`
+	Access           *DeviceAccess_Access `protobuf:"bytes,2,req,name=access" json:"access,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *DeviceAccess) Reset()                    { *m = DeviceAccess{} }
+func (m *DeviceAccess) String() string            { return proto.CompactTextString(m) }
+func (*DeviceAccess) ProtoMessage()               {}
+func (*DeviceAccess) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *DeviceAccess) GetDevice() *Device {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *DeviceAccess) GetAccess() *DeviceAccess_Access {
+	if m != nil {
+		return m.Access
+	}
+	return nil
+}
+
+type DeviceAccess_Access struct {
+	Read             *bool  `protobuf:"varint,1,opt,name=read" json:"read,omitempty"`
+	Write            *bool  `protobuf:"varint,2,opt,name=write" json:"write,omitempty"`
+	Mknod            *bool  `protobuf:"varint,3,opt,name=mknod" json:"mknod,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DeviceAccess_Access) Reset()                    { *m = DeviceAccess_Access{} }
+func (m *DeviceAccess_Access) String() string            { return proto.CompactTextString(m) }
+func (*DeviceAccess_Access) ProtoMessage()               {}
+func (*DeviceAccess_Access) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86, 0} }
+
+func (m *DeviceAccess_Access) GetRead() bool {
+	if m != nil && m.Read != nil {
+		return *m.Read
+	}
+	return false
+}
+
+func (m *DeviceAccess_Access) GetWrite() bool {
+	if m != nil && m.Write != nil {
+		return *m.Write
+	}
+	return false
+}
+
+func (m *DeviceAccess_Access) GetMknod() bool {
+	if m != nil && m.Mknod != nil {
+		return *m.Mknod
+	}
+	return false
+}
+
+type DeviceWhitelist struct {
+	AllowedDevices   []*DeviceAccess `protobuf:"bytes,1,rep,name=allowed_devices,json=allowedDevices" json:"allowed_devices,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *DeviceWhitelist) Reset()                    { *m = DeviceWhitelist{} }
+func (m *DeviceWhitelist) String() string            { return proto.CompactTextString(m) }
+func (*DeviceWhitelist) ProtoMessage()               {}
+func (*DeviceWhitelist) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *DeviceWhitelist) GetAllowedDevices() []*DeviceAccess {
+	if m != nil {
+		return m.AllowedDevices
+	}
+	return nil
+}
+
+type DrainConfig struct {
+	// An upper bound for tasks with a KillPolicy.
+	// If a task has a KillPolicy grace period greater than this value, this value
+	// will be used instead. This allows the operator to limit the maximum time it
+	// will take the agent to drain. If this field is unset, the task's KillPolicy
+	// or the executor's default grace period is used.
+	//
+	// NOTE: Grace periods start when the executor receives the associated kill.
+	// If, for example, the agent is unreachable when this call is made,
+	// tasks will still receive their full grace period to kill gracefully.
+	MaxGracePeriod *DurationInfo `protobuf:"bytes,1,opt,name=max_grace_period,json=maxGracePeriod" json:"max_grace_period,omitempty"`
+	// Whether or not this agent will be removed permanently from the cluster when
+	// draining is complete. This transition is automatic and does **NOT** require
+	// a separate call to `MarkAgentGone`. If this field is unset, then the
+	// default value of `false` is used.
+	//
+	// Compared to `MarkAgentGone`, which is used for unreachable agents,
+	// marking agents gone after draining will respect kill policies.
+	// To notify frameworks, tasks terminated during draining will return
+	// a `TASK_GONE_BY_OPERATOR` status update instead of any other terminal
+	// status. Executors will not need to account for this case, because
+	// the terminal status update will be intercepted and modified by the agent.
+	MarkGone         *bool  `protobuf:"varint,2,opt,name=mark_gone,json=markGone,def=0" json:"mark_gone,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DrainConfig) Reset()                    { *m = DrainConfig{} }
+func (m *DrainConfig) String() string            { return proto.CompactTextString(m) }
+func (*DrainConfig) ProtoMessage()               {}
+func (*DrainConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+const Default_DrainConfig_MarkGone bool = false
+
+func (m *DrainConfig) GetMaxGracePeriod() *DurationInfo {
+	if m != nil {
+		return m.MaxGracePeriod
+	}
+	return nil
+}
+
+func (m *DrainConfig) GetMarkGone() bool {
+	if m != nil && m.MarkGone != nil {
+		return *m.MarkGone
+	}
+	return Default_DrainConfig_MarkGone
+}
+
+type DrainInfo struct {
+	// The drain state of the agent.
+	State *DrainState `protobuf:"varint,1,req,name=state,enum=mesos.v1.DrainState" json:"state,omitempty"`
+	// The configuration used to drain the agent.
+	Config           *DrainConfig `protobuf:"bytes,2,req,name=config" json:"config,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *DrainInfo) Reset()                    { *m = DrainInfo{} }
+func (m *DrainInfo) String() string            { return proto.CompactTextString(m) }
+func (*DrainInfo) ProtoMessage()               {}
+func (*DrainInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *DrainInfo) GetState() DrainState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return DrainState_UNKNOWN
+}
+
+func (m *DrainInfo) GetConfig() *DrainConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FrameworkID)(nil), "mesos.v1.FrameworkID")
+	proto.RegisterType((*OfferID)(nil), "mesos.v1.OfferID")
+	proto.RegisterType((*AgentID)(nil), "mesos.v1.AgentID")
+	proto.RegisterType((*TaskID)(nil), "mesos.v1.TaskID")
+	proto.RegisterType((*ExecutorID)(nil), "mesos.v1.ExecutorID")
+	proto.RegisterType((*ContainerID)(nil), "mesos.v1.ContainerID")
+	proto.RegisterType((*ResourceProviderID)(nil), "mesos.v1.ResourceProviderID")
+	proto.RegisterType((*OperationID)(nil), "mesos.v1.OperationID")
+	proto.RegisterType((*TimeInfo)(nil), "mesos.v1.TimeInfo")
+	proto.RegisterType((*DurationInfo)(nil), "mesos.v1.DurationInfo")
+	proto.RegisterType((*Address)(nil), "mesos.v1.Address")
+	proto.RegisterType((*URL)(nil), "mesos.v1.URL")
+	proto.RegisterType((*Unavailability)(nil), "mesos.v1.Unavailability")
+	proto.RegisterType((*MachineID)(nil), "mesos.v1.MachineID")
+	proto.RegisterType((*MachineInfo)(nil), "mesos.v1.MachineInfo")
+	proto.RegisterType((*FrameworkInfo)(nil), "mesos.v1.FrameworkInfo")
+	proto.RegisterType((*FrameworkInfo_Capability)(nil), "mesos.v1.FrameworkInfo.Capability")
+	proto.RegisterType((*CheckInfo)(nil), "mesos.v1.CheckInfo")
+	proto.RegisterType((*CheckInfo_Command)(nil), "mesos.v1.CheckInfo.Command")
+	proto.RegisterType((*CheckInfo_Http)(nil), "mesos.v1.CheckInfo.Http")
+	proto.RegisterType((*CheckInfo_Tcp)(nil), "mesos.v1.CheckInfo.Tcp")
+	proto.RegisterType((*HealthCheck)(nil), "mesos.v1.HealthCheck")
+	proto.RegisterType((*HealthCheck_HTTPCheckInfo)(nil), "mesos.v1.HealthCheck.HTTPCheckInfo")
+	proto.RegisterType((*HealthCheck_TCPCheckInfo)(nil), "mesos.v1.HealthCheck.TCPCheckInfo")
+	proto.RegisterType((*KillPolicy)(nil), "mesos.v1.KillPolicy")
+	proto.RegisterType((*CommandInfo)(nil), "mesos.v1.CommandInfo")
+	proto.RegisterType((*CommandInfo_URI)(nil), "mesos.v1.CommandInfo.URI")
+	proto.RegisterType((*ExecutorInfo)(nil), "mesos.v1.ExecutorInfo")
+	proto.RegisterType((*DomainInfo)(nil), "mesos.v1.DomainInfo")
+	proto.RegisterType((*DomainInfo_FaultDomain)(nil), "mesos.v1.DomainInfo.FaultDomain")
+	proto.RegisterType((*DomainInfo_FaultDomain_RegionInfo)(nil), "mesos.v1.DomainInfo.FaultDomain.RegionInfo")
+	proto.RegisterType((*DomainInfo_FaultDomain_ZoneInfo)(nil), "mesos.v1.DomainInfo.FaultDomain.ZoneInfo")
+	proto.RegisterType((*MasterInfo)(nil), "mesos.v1.MasterInfo")
+	proto.RegisterType((*MasterInfo_Capability)(nil), "mesos.v1.MasterInfo.Capability")
+	proto.RegisterType((*AgentInfo)(nil), "mesos.v1.AgentInfo")
+	proto.RegisterType((*AgentInfo_Capability)(nil), "mesos.v1.AgentInfo.Capability")
+	proto.RegisterType((*CSIPluginContainerInfo)(nil), "mesos.v1.CSIPluginContainerInfo")
+	proto.RegisterType((*CSIPluginInfo)(nil), "mesos.v1.CSIPluginInfo")
+	proto.RegisterType((*ResourceProviderInfo)(nil), "mesos.v1.ResourceProviderInfo")
+	proto.RegisterType((*ResourceProviderInfo_Storage)(nil), "mesos.v1.ResourceProviderInfo.Storage")
+	proto.RegisterType((*Value)(nil), "mesos.v1.Value")
+	proto.RegisterType((*Value_Scalar)(nil), "mesos.v1.Value.Scalar")
+	proto.RegisterType((*Value_Range)(nil), "mesos.v1.Value.Range")
+	proto.RegisterType((*Value_Ranges)(nil), "mesos.v1.Value.Ranges")
+	proto.RegisterType((*Value_Set)(nil), "mesos.v1.Value.Set")
+	proto.RegisterType((*Value_Text)(nil), "mesos.v1.Value.Text")
+	proto.RegisterType((*Attribute)(nil), "mesos.v1.Attribute")
+	proto.RegisterType((*Resource)(nil), "mesos.v1.Resource")
+	proto.RegisterType((*Resource_AllocationInfo)(nil), "mesos.v1.Resource.AllocationInfo")
+	proto.RegisterType((*Resource_ReservationInfo)(nil), "mesos.v1.Resource.ReservationInfo")
+	proto.RegisterType((*Resource_DiskInfo)(nil), "mesos.v1.Resource.DiskInfo")
+	proto.RegisterType((*Resource_DiskInfo_Persistence)(nil), "mesos.v1.Resource.DiskInfo.Persistence")
+	proto.RegisterType((*Resource_DiskInfo_Source)(nil), "mesos.v1.Resource.DiskInfo.Source")
+	proto.RegisterType((*Resource_DiskInfo_Source_Path)(nil), "mesos.v1.Resource.DiskInfo.Source.Path")
+	proto.RegisterType((*Resource_DiskInfo_Source_Mount)(nil), "mesos.v1.Resource.DiskInfo.Source.Mount")
+	proto.RegisterType((*Resource_RevocableInfo)(nil), "mesos.v1.Resource.RevocableInfo")
+	proto.RegisterType((*Resource_SharedInfo)(nil), "mesos.v1.Resource.SharedInfo")
+	proto.RegisterType((*TrafficControlStatistics)(nil), "mesos.v1.TrafficControlStatistics")
+	proto.RegisterType((*IpStatistics)(nil), "mesos.v1.IpStatistics")
+	proto.RegisterType((*IcmpStatistics)(nil), "mesos.v1.IcmpStatistics")
+	proto.RegisterType((*TcpStatistics)(nil), "mesos.v1.TcpStatistics")
+	proto.RegisterType((*UdpStatistics)(nil), "mesos.v1.UdpStatistics")
+	proto.RegisterType((*SNMPStatistics)(nil), "mesos.v1.SNMPStatistics")
+	proto.RegisterType((*DiskStatistics)(nil), "mesos.v1.DiskStatistics")
+	proto.RegisterType((*ResourceStatistics)(nil), "mesos.v1.ResourceStatistics")
+	proto.RegisterType((*ResourceUsage)(nil), "mesos.v1.ResourceUsage")
+	proto.RegisterType((*ResourceUsage_Executor)(nil), "mesos.v1.ResourceUsage.Executor")
+	proto.RegisterType((*ResourceUsage_Executor_Task)(nil), "mesos.v1.ResourceUsage.Executor.Task")
+	proto.RegisterType((*PerfStatistics)(nil), "mesos.v1.PerfStatistics")
+	proto.RegisterType((*OfferFilters)(nil), "mesos.v1.OfferFilters")
+	proto.RegisterType((*OfferFilters_ResourceQuantities)(nil), "mesos.v1.OfferFilters.ResourceQuantities")
+	proto.RegisterType((*OfferFilters_MinAllocatableResources)(nil), "mesos.v1.OfferFilters.MinAllocatableResources")
+	proto.RegisterType((*Request)(nil), "mesos.v1.Request")
+	proto.RegisterType((*Offer)(nil), "mesos.v1.Offer")
+	proto.RegisterType((*Offer_Operation)(nil), "mesos.v1.Offer.Operation")
+	proto.RegisterType((*Offer_Operation_Launch)(nil), "mesos.v1.Offer.Operation.Launch")
+	proto.RegisterType((*Offer_Operation_LaunchGroup)(nil), "mesos.v1.Offer.Operation.LaunchGroup")
+	proto.RegisterType((*Offer_Operation_Reserve)(nil), "mesos.v1.Offer.Operation.Reserve")
+	proto.RegisterType((*Offer_Operation_Unreserve)(nil), "mesos.v1.Offer.Operation.Unreserve")
+	proto.RegisterType((*Offer_Operation_Create)(nil), "mesos.v1.Offer.Operation.Create")
+	proto.RegisterType((*Offer_Operation_Destroy)(nil), "mesos.v1.Offer.Operation.Destroy")
+	proto.RegisterType((*Offer_Operation_GrowVolume)(nil), "mesos.v1.Offer.Operation.GrowVolume")
+	proto.RegisterType((*Offer_Operation_ShrinkVolume)(nil), "mesos.v1.Offer.Operation.ShrinkVolume")
+	proto.RegisterType((*Offer_Operation_CreateDisk)(nil), "mesos.v1.Offer.Operation.CreateDisk")
+	proto.RegisterType((*Offer_Operation_DestroyDisk)(nil), "mesos.v1.Offer.Operation.DestroyDisk")
+	proto.RegisterType((*InverseOffer)(nil), "mesos.v1.InverseOffer")
+	proto.RegisterType((*TaskInfo)(nil), "mesos.v1.TaskInfo")
+	proto.RegisterType((*TaskGroupInfo)(nil), "mesos.v1.TaskGroupInfo")
+	proto.RegisterType((*Task)(nil), "mesos.v1.Task")
+	proto.RegisterType((*TaskResourceLimitation)(nil), "mesos.v1.TaskResourceLimitation")
+	proto.RegisterType((*UUID)(nil), "mesos.v1.UUID")
+	proto.RegisterType((*Operation)(nil), "mesos.v1.Operation")
+	proto.RegisterType((*OperationStatus)(nil), "mesos.v1.OperationStatus")
+	proto.RegisterType((*CheckStatusInfo)(nil), "mesos.v1.CheckStatusInfo")
+	proto.RegisterType((*CheckStatusInfo_Command)(nil), "mesos.v1.CheckStatusInfo.Command")
+	proto.RegisterType((*CheckStatusInfo_Http)(nil), "mesos.v1.CheckStatusInfo.Http")
+	proto.RegisterType((*CheckStatusInfo_Tcp)(nil), "mesos.v1.CheckStatusInfo.Tcp")
+	proto.RegisterType((*TaskStatus)(nil), "mesos.v1.TaskStatus")
+	proto.RegisterType((*Filters)(nil), "mesos.v1.Filters")
+	proto.RegisterType((*Environment)(nil), "mesos.v1.Environment")
+	proto.RegisterType((*Environment_Variable)(nil), "mesos.v1.Environment.Variable")
+	proto.RegisterType((*Parameter)(nil), "mesos.v1.Parameter")
+	proto.RegisterType((*Parameters)(nil), "mesos.v1.Parameters")
+	proto.RegisterType((*Credential)(nil), "mesos.v1.Credential")
+	proto.RegisterType((*Credentials)(nil), "mesos.v1.Credentials")
+	proto.RegisterType((*Secret)(nil), "mesos.v1.Secret")
+	proto.RegisterType((*Secret_Reference)(nil), "mesos.v1.Secret.Reference")
+	proto.RegisterType((*Secret_Value)(nil), "mesos.v1.Secret.Value")
+	proto.RegisterType((*RateLimit)(nil), "mesos.v1.RateLimit")
+	proto.RegisterType((*RateLimits)(nil), "mesos.v1.RateLimits")
+	proto.RegisterType((*Image)(nil), "mesos.v1.Image")
+	proto.RegisterType((*Image_Appc)(nil), "mesos.v1.Image.Appc")
+	proto.RegisterType((*Image_Docker)(nil), "mesos.v1.Image.Docker")
+	proto.RegisterType((*MountPropagation)(nil), "mesos.v1.MountPropagation")
+	proto.RegisterType((*Volume)(nil), "mesos.v1.Volume")
+	proto.RegisterType((*Volume_Source)(nil), "mesos.v1.Volume.Source")
+	proto.RegisterType((*Volume_Source_DockerVolume)(nil), "mesos.v1.Volume.Source.DockerVolume")
+	proto.RegisterType((*Volume_Source_HostPath)(nil), "mesos.v1.Volume.Source.HostPath")
+	proto.RegisterType((*Volume_Source_SandboxPath)(nil), "mesos.v1.Volume.Source.SandboxPath")
+	proto.RegisterType((*NetworkInfo)(nil), "mesos.v1.NetworkInfo")
+	proto.RegisterType((*NetworkInfo_IPAddress)(nil), "mesos.v1.NetworkInfo.IPAddress")
+	proto.RegisterType((*NetworkInfo_PortMapping)(nil), "mesos.v1.NetworkInfo.PortMapping")
+	proto.RegisterType((*CapabilityInfo)(nil), "mesos.v1.CapabilityInfo")
+	proto.RegisterType((*SeccompInfo)(nil), "mesos.v1.SeccompInfo")
+	proto.RegisterType((*LinuxInfo)(nil), "mesos.v1.LinuxInfo")
+	proto.RegisterType((*RLimitInfo)(nil), "mesos.v1.RLimitInfo")
+	proto.RegisterType((*RLimitInfo_RLimit)(nil), "mesos.v1.RLimitInfo.RLimit")
+	proto.RegisterType((*TTYInfo)(nil), "mesos.v1.TTYInfo")
+	proto.RegisterType((*TTYInfo_WindowSize)(nil), "mesos.v1.TTYInfo.WindowSize")
+	proto.RegisterType((*ContainerInfo)(nil), "mesos.v1.ContainerInfo")
+	proto.RegisterType((*ContainerInfo_DockerInfo)(nil), "mesos.v1.ContainerInfo.DockerInfo")
+	proto.RegisterType((*ContainerInfo_DockerInfo_PortMapping)(nil), "mesos.v1.ContainerInfo.DockerInfo.PortMapping")
+	proto.RegisterType((*ContainerInfo_MesosInfo)(nil), "mesos.v1.ContainerInfo.MesosInfo")
+	proto.RegisterType((*ContainerStatus)(nil), "mesos.v1.ContainerStatus")
+	proto.RegisterType((*CgroupInfo)(nil), "mesos.v1.CgroupInfo")
+	proto.RegisterType((*CgroupInfo_Blkio)(nil), "mesos.v1.CgroupInfo.Blkio")
+	proto.RegisterType((*CgroupInfo_Blkio_Value)(nil), "mesos.v1.CgroupInfo.Blkio.Value")
+	proto.RegisterType((*CgroupInfo_Blkio_CFQ)(nil), "mesos.v1.CgroupInfo.Blkio.CFQ")
+	proto.RegisterType((*CgroupInfo_Blkio_CFQ_Statistics)(nil), "mesos.v1.CgroupInfo.Blkio.CFQ.Statistics")
+	proto.RegisterType((*CgroupInfo_Blkio_Throttling)(nil), "mesos.v1.CgroupInfo.Blkio.Throttling")
+	proto.RegisterType((*CgroupInfo_Blkio_Throttling_Statistics)(nil), "mesos.v1.CgroupInfo.Blkio.Throttling.Statistics")
+	proto.RegisterType((*CgroupInfo_Blkio_Statistics)(nil), "mesos.v1.CgroupInfo.Blkio.Statistics")
+	proto.RegisterType((*CgroupInfo_NetCls)(nil), "mesos.v1.CgroupInfo.NetCls")
+	proto.RegisterType((*Labels)(nil), "mesos.v1.Labels")
+	proto.RegisterType((*Label)(nil), "mesos.v1.Label")
+	proto.RegisterType((*Port)(nil), "mesos.v1.Port")
+	proto.RegisterType((*Ports)(nil), "mesos.v1.Ports")
+	proto.RegisterType((*DiscoveryInfo)(nil), "mesos.v1.DiscoveryInfo")
+	proto.RegisterType((*WeightInfo)(nil), "mesos.v1.WeightInfo")
+	proto.RegisterType((*VersionInfo)(nil), "mesos.v1.VersionInfo")
+	proto.RegisterType((*Flag)(nil), "mesos.v1.Flag")
+	proto.RegisterType((*Role)(nil), "mesos.v1.Role")
+	proto.RegisterType((*Metric)(nil), "mesos.v1.Metric")
+	proto.RegisterType((*FileInfo)(nil), "mesos.v1.FileInfo")
+	proto.RegisterType((*Device)(nil), "mesos.v1.Device")
+	proto.RegisterType((*Device_Number)(nil), "mesos.v1.Device.Number")
+	proto.RegisterType((*DeviceAccess)(nil), "mesos.v1.DeviceAccess")
+	proto.RegisterType((*DeviceAccess_Access)(nil), "mesos.v1.DeviceAccess.Access")
+	proto.RegisterType((*DeviceWhitelist)(nil), "mesos.v1.DeviceWhitelist")
+	proto.RegisterType((*DrainConfig)(nil), "mesos.v1.DrainConfig")
+	proto.RegisterType((*DrainInfo)(nil), "mesos.v1.DrainInfo")
+	proto.RegisterEnum("mesos.v1.Status", Status_name, Status_value)
+	proto.RegisterEnum("mesos.v1.TaskState", TaskState_name, TaskState_value)
+	proto.RegisterEnum("mesos.v1.OperationState", OperationState_name, OperationState_value)
+	proto.RegisterEnum("mesos.v1.DrainState", DrainState_name, DrainState_value)
+	proto.RegisterEnum("mesos.v1.MachineInfo_Mode", MachineInfo_Mode_name, MachineInfo_Mode_value)
+	proto.RegisterEnum("mesos.v1.FrameworkInfo_Capability_Type", FrameworkInfo_Capability_Type_name, FrameworkInfo_Capability_Type_value)
+	proto.RegisterEnum("mesos.v1.CheckInfo_Type", CheckInfo_Type_name, CheckInfo_Type_value)
+	proto.RegisterEnum("mesos.v1.HealthCheck_Type", HealthCheck_Type_name, HealthCheck_Type_value)
+	proto.RegisterEnum("mesos.v1.ExecutorInfo_Type", ExecutorInfo_Type_name, ExecutorInfo_Type_value)
+	proto.RegisterEnum("mesos.v1.MasterInfo_Capability_Type", MasterInfo_Capability_Type_name, MasterInfo_Capability_Type_value)
+	proto.RegisterEnum("mesos.v1.AgentInfo_Capability_Type", AgentInfo_Capability_Type_name, AgentInfo_Capability_Type_value)
+	proto.RegisterEnum("mesos.v1.CSIPluginContainerInfo_Service", CSIPluginContainerInfo_Service_name, CSIPluginContainerInfo_Service_value)
+	proto.RegisterEnum("mesos.v1.Value_Type", Value_Type_name, Value_Type_value)
+	proto.RegisterEnum("mesos.v1.Resource_ReservationInfo_Type", Resource_ReservationInfo_Type_name, Resource_ReservationInfo_Type_value)
+	proto.RegisterEnum("mesos.v1.Resource_DiskInfo_Source_Type", Resource_DiskInfo_Source_Type_name, Resource_DiskInfo_Source_Type_value)
+	proto.RegisterEnum("mesos.v1.Offer_Operation_Type", Offer_Operation_Type_name, Offer_Operation_Type_value)
+	proto.RegisterEnum("mesos.v1.TaskStatus_Source", TaskStatus_Source_name, TaskStatus_Source_value)
+	proto.RegisterEnum("mesos.v1.TaskStatus_Reason", TaskStatus_Reason_name, TaskStatus_Reason_value)
+	proto.RegisterEnum("mesos.v1.Environment_Variable_Type", Environment_Variable_Type_name, Environment_Variable_Type_value)
+	proto.RegisterEnum("mesos.v1.Secret_Type", Secret_Type_name, Secret_Type_value)
+	proto.RegisterEnum("mesos.v1.Image_Type", Image_Type_name, Image_Type_value)
+	proto.RegisterEnum("mesos.v1.MountPropagation_Mode", MountPropagation_Mode_name, MountPropagation_Mode_value)
+	proto.RegisterEnum("mesos.v1.Volume_Mode", Volume_Mode_name, Volume_Mode_value)
+	proto.RegisterEnum("mesos.v1.Volume_Source_Type", Volume_Source_Type_name, Volume_Source_Type_value)
+	proto.RegisterEnum("mesos.v1.Volume_Source_SandboxPath_Type", Volume_Source_SandboxPath_Type_name, Volume_Source_SandboxPath_Type_value)
+	proto.RegisterEnum("mesos.v1.NetworkInfo_Protocol", NetworkInfo_Protocol_name, NetworkInfo_Protocol_value)
+	proto.RegisterEnum("mesos.v1.CapabilityInfo_Capability", CapabilityInfo_Capability_name, CapabilityInfo_Capability_value)
+	proto.RegisterEnum("mesos.v1.LinuxInfo_IpcMode", LinuxInfo_IpcMode_name, LinuxInfo_IpcMode_value)
+	proto.RegisterEnum("mesos.v1.RLimitInfo_RLimit_Type", RLimitInfo_RLimit_Type_name, RLimitInfo_RLimit_Type_value)
+	proto.RegisterEnum("mesos.v1.ContainerInfo_Type", ContainerInfo_Type_name, ContainerInfo_Type_value)
+	proto.RegisterEnum("mesos.v1.ContainerInfo_DockerInfo_Network", ContainerInfo_DockerInfo_Network_name, ContainerInfo_DockerInfo_Network_value)
+	proto.RegisterEnum("mesos.v1.CgroupInfo_Blkio_Operation", CgroupInfo_Blkio_Operation_name, CgroupInfo_Blkio_Operation_value)
+	proto.RegisterEnum("mesos.v1.DiscoveryInfo_Visibility", DiscoveryInfo_Visibility_name, DiscoveryInfo_Visibility_value)
+}
+
+var fileDescriptor0 = []byte{
+	// 11648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x6b, 0xac, 0x23, 0xd9,
+	0x76, 0x17, 0x7e, 0xfd, 0xb6, 0x97, 0xed, 0x73, 0xaa, 0xab, 0x1f, 0xe3, 0x76, 0xbf, 0xce, 0xd4,
+	0xbc, 0xba, 0x7b, 0x66, 0xce, 0x74, 0xf7, 0x4c, 0xcf, 0xa3, 0xe7, 0xce, 0xcd, 0xb8, 0xed, 0x3a,
+	0xdd, 0xbe, 0x7d, 0xfc, 0x98, 0x6d, 0xbb, 0xfb, 0xce, 0xd5, 0x5f, 0xb2, 0xaa, 0xed, 0x3a, 0xe7,
+	0x54, 0xda, 0x76, 0x79, 0xaa, 0xca, 0xa7, 0xbb, 0xf3, 0x29, 0xaf, 0x7f, 0x12, 0x42, 0x88, 0x10,
+	0x82, 0x28, 0x10, 0x21, 0xf1, 0x01, 0x04, 0x17, 0x14, 0x3e, 0xf0, 0x0d, 0x04, 0x84, 0x2f, 0x41,
+	0x01, 0xe5, 0x0b, 0x42, 0x10, 0x24, 0x90, 0x10, 0x12, 0x48, 0x09, 0x09, 0x01, 0x02, 0x04, 0x08,
+	0x21, 0x41, 0x6b, 0xbf, 0x6a, 0x57, 0xd9, 0x3e, 0x8f, 0xb9, 0x57, 0x48, 0x7c, 0xb2, 0xf7, 0xda,
+	0xbf, 0xb5, 0x1f, 0x6b, 0xbf, 0xd6, 0xde, 0x7b, 0xed, 0x55, 0xb0, 0x31, 0xb5, 0x7d, 0xd7, 0x1f,
+	0x1e, 0xde, 0xde, 0x9e, 0x7b, 0x6e, 0xe0, 0xea, 0x79, 0x1a, 0xde, 0x3e, 0xbc, 0x6d, 0xbc, 0x06,
+	0xc5, 0x1d, 0xcf, 0x9a, 0xda, 0xcf, 0x5d, 0xef, 0x59, 0xb3, 0xa1, 0x9f, 0x83, 0xcc, 0xa1, 0x35,
+	0x59, 0xd8, 0x95, 0xc4, 0x56, 0xf2, 0x7a, 0x81, 0xb0, 0x80, 0x71, 0x0d, 0x72, 0x9d, 0xbd, 0x3d,
+	0xdb, 0x3b, 0x0a, 0x50, 0xdb, 0xb7, 0x67, 0xc1, 0x5a, 0xc0, 0x55, 0xc8, 0xf6, 0x2d, 0x7f, 0x7d,
+	0x0e, 0x06, 0x80, 0xf9, 0xc2, 0x1e, 0x2d, 0x02, 0x77, 0x7d, 0x26, 0x04, 0x8a, 0x75, 0x77, 0x16,
+	0x58, 0xce, 0x6c, 0x7d, 0x49, 0xf4, 0x77, 0x21, 0x3b, 0xb7, 0x3c, 0x7b, 0x16, 0x54, 0x92, 0x5b,
+	0x89, 0xeb, 0xc5, 0x3b, 0xe7, 0xb7, 0x45, 0x55, 0xb7, 0x15, 0x66, 0xc2, 0x41, 0xc6, 0x4d, 0xd0,
+	0x89, 0xed, 0xbb, 0x0b, 0x6f, 0x64, 0x77, 0x3d, 0xf7, 0xd0, 0x19, 0x1f, 0x51, 0xc9, 0xd7, 0xa0,
+	0xd8, 0x99, 0xdb, 0x9e, 0x15, 0x38, 0xee, 0x6c, 0x2d, 0xe8, 0x1d, 0xc8, 0xf7, 0x9d, 0xa9, 0xdd,
+	0x9c, 0xed, 0xb9, 0xfa, 0x16, 0x14, 0x67, 0xd6, 0xcc, 0xf5, 0xed, 0x91, 0x3b, 0x1b, 0xfb, 0x14,
+	0x97, 0x22, 0x2a, 0xc9, 0xb8, 0x05, 0xa5, 0xc6, 0x82, 0xa7, 0x78, 0x32, 0x8e, 0x26, 0xe4, 0x6a,
+	0xe3, 0xb1, 0x67, 0xfb, 0xbe, 0x5e, 0x85, 0xfc, 0x81, 0xeb, 0x07, 0x33, 0x6b, 0x8a, 0x65, 0x48,
+	0x5c, 0x2f, 0x10, 0x19, 0xd6, 0x37, 0x20, 0xe9, 0xcc, 0xa9, 0x08, 0x0a, 0x24, 0xe9, 0xcc, 0x75,
+	0x1d, 0xd2, 0x73, 0xd7, 0x0b, 0x2a, 0xa9, 0xad, 0xe4, 0xf5, 0x0c, 0xa1, 0xff, 0x8d, 0xbf, 0x92,
+	0x80, 0xd4, 0x80, 0xec, 0xea, 0x17, 0x20, 0xeb, 0x8f, 0x0e, 0xec, 0xa9, 0xa8, 0x09, 0x0f, 0xe9,
+	0x6f, 0x43, 0xce, 0x62, 0x59, 0x55, 0x92, 0x5b, 0xc9, 0xeb, 0xc5, 0x3b, 0x67, 0x42, 0x59, 0xf2,
+	0x32, 0x10, 0x81, 0xa0, 0x19, 0x58, 0xc1, 0x41, 0x25, 0x45, 0xb3, 0xa4, 0xff, 0xf5, 0x1b, 0x90,
+	0xf9, 0x6a, 0x61, 0x7b, 0x2f, 0x2b, 0xe9, 0xad, 0xd4, 0xf5, 0xe2, 0x9d, 0xb3, 0x21, 0x7b, 0xd7,
+	0xc2, 0x3e, 0x17, 0xd8, 0x1e, 0x61, 0x08, 0xac, 0xcb, 0x9e, 0x67, 0xed, 0x4f, 0xb1, 0xe1, 0x32,
+	0xac, 0x2e, 0x22, 0x6c, 0xcc, 0x60, 0x63, 0x30, 0xb3, 0x0e, 0x2d, 0x67, 0x62, 0x3d, 0x75, 0x26,
+	0x4e, 0xf0, 0x52, 0xbf, 0x0e, 0x19, 0x3f, 0xb0, 0xbc, 0x80, 0x16, 0xb8, 0x78, 0x47, 0x0f, 0x13,
+	0x16, 0xb2, 0x27, 0x0c, 0xa0, 0xdf, 0x81, 0xfc, 0x98, 0x0b, 0x98, 0x77, 0x88, 0x0b, 0x21, 0x58,
+	0x15, 0x3d, 0x91, 0x38, 0xe3, 0x23, 0x28, 0xb4, 0xac, 0xd1, 0x81, 0x33, 0xb3, 0x9b, 0x8d, 0xd3,
+	0x08, 0xd9, 0xf8, 0xf5, 0x04, 0x14, 0x05, 0x27, 0xb6, 0xe6, 0x6b, 0x90, 0x74, 0xc6, 0xbc, 0x8c,
+	0x4a, 0xe5, 0x65, 0xe2, 0x24, 0xe9, 0x8c, 0xf5, 0x6d, 0x48, 0x4f, 0xdd, 0xb1, 0x4d, 0x93, 0xd9,
+	0xb8, 0x53, 0x5d, 0x86, 0xcd, 0xf6, 0xdc, 0xed, 0x96, 0x3b, 0xb6, 0x09, 0xc5, 0xe9, 0x9f, 0xc3,
+	0xc6, 0x22, 0x22, 0x0d, 0x2a, 0xf2, 0xe2, 0x9d, 0x4a, 0xc8, 0x19, 0x95, 0x16, 0x89, 0xe1, 0x8d,
+	0x37, 0x21, 0x8d, 0xe9, 0xe9, 0x59, 0x48, 0x0e, 0xba, 0x5a, 0x42, 0x2f, 0x41, 0xbe, 0x41, 0x6a,
+	0xcd, 0x76, 0xb3, 0xfd, 0x40, 0x4b, 0xea, 0x79, 0x48, 0x37, 0x3a, 0x4f, 0xda, 0x5a, 0xca, 0xf8,
+	0x77, 0x59, 0x28, 0x87, 0x73, 0x03, 0x56, 0x48, 0x87, 0xf4, 0xc2, 0xb7, 0x3d, 0xde, 0x4f, 0xe8,
+	0x7f, 0xa4, 0x51, 0xe1, 0x24, 0x19, 0x0d, 0xff, 0xeb, 0x6f, 0xd0, 0x8a, 0xa7, 0xe2, 0x03, 0x50,
+	0x99, 0x68, 0x68, 0xd5, 0xdf, 0x01, 0x6d, 0xcf, 0x72, 0x26, 0xee, 0xa1, 0xed, 0x0d, 0x03, 0x67,
+	0x6a, 0xbb, 0x8b, 0xa0, 0x92, 0xde, 0x4a, 0x5c, 0x4f, 0xdc, 0x4b, 0xdc, 0x22, 0x9b, 0x22, 0xaa,
+	0xcf, 0x62, 0xf4, 0x37, 0x00, 0x46, 0x07, 0xf6, 0xe8, 0xd9, 0xdc, 0x75, 0x78, 0x27, 0xc9, 0xdf,
+	0xcb, 0xec, 0x59, 0x13, 0xdf, 0x26, 0x4a, 0x84, 0x7e, 0x11, 0xd2, 0x9e, 0x3b, 0xb1, 0x2b, 0x59,
+	0x6c, 0x96, 0x7b, 0x89, 0x9b, 0xf7, 0x93, 0x95, 0x04, 0xa1, 0x24, 0x1c, 0xb1, 0xf8, 0xeb, 0x57,
+	0x4a, 0x5b, 0x29, 0x1c, 0xb1, 0x34, 0x10, 0x69, 0xe1, 0x5c, 0xac, 0x85, 0x2f, 0x43, 0x61, 0xee,
+	0x39, 0xb3, 0x91, 0x33, 0xb7, 0x26, 0x95, 0x3c, 0x8d, 0x0c, 0x09, 0xfa, 0x25, 0x28, 0x3c, 0xb7,
+	0x9f, 0x2e, 0x9c, 0xe1, 0xc2, 0x9b, 0x54, 0x0a, 0x8c, 0x95, 0x12, 0x06, 0xde, 0x44, 0xdf, 0x81,
+	0xd2, 0xc8, 0x9a, 0x33, 0x99, 0x3b, 0xb6, 0x5f, 0x01, 0x3a, 0x06, 0x8c, 0x55, 0xd2, 0xc0, 0x16,
+	0xae, 0x0b, 0xec, 0x4b, 0x12, 0xe1, 0xd3, 0xaf, 0x43, 0x76, 0x62, 0x3d, 0xb5, 0x27, 0x7e, 0xa5,
+	0x48, 0xe5, 0xa9, 0x85, 0x29, 0xec, 0x52, 0x3a, 0xe1, 0xf1, 0x7a, 0x1b, 0xca, 0x2e, 0xce, 0xd2,
+	0xc3, 0x3d, 0x67, 0x12, 0xd8, 0x9e, 0x5f, 0x29, 0xd3, 0x2c, 0x6f, 0xac, 0xcb, 0x92, 0x4e, 0xe9,
+	0x3b, 0x0c, 0x6b, 0xce, 0x02, 0xef, 0x25, 0x29, 0xb9, 0x0a, 0xa9, 0xfa, 0x73, 0x49, 0x80, 0xb0,
+	0x58, 0xfa, 0xa7, 0x90, 0x0e, 0x5e, 0xce, 0xd9, 0x28, 0xd8, 0xb8, 0xf3, 0xd6, 0xf1, 0x15, 0xd9,
+	0xee, 0xbf, 0x9c, 0xdb, 0x84, 0x32, 0x19, 0xff, 0x20, 0x01, 0x69, 0x0c, 0xea, 0x45, 0xc8, 0x0d,
+	0xda, 0x8f, 0xda, 0xd8, 0xc3, 0xbe, 0xa1, 0xbf, 0x02, 0x67, 0x89, 0xf9, 0xb8, 0x53, 0xaf, 0xdd,
+	0xdf, 0x35, 0x87, 0xc4, 0xec, 0x75, 0x06, 0xa4, 0x6e, 0xf6, 0xb4, 0x84, 0x7e, 0x01, 0xf4, 0x7e,
+	0xad, 0xf7, 0x68, 0xf8, 0xa8, 0xb9, 0xbb, 0xdb, 0x6c, 0x3f, 0x18, 0xf6, 0xfa, 0xb5, 0xbe, 0xa9,
+	0x25, 0xf5, 0x33, 0x50, 0x7e, 0xd0, 0x1d, 0x28, 0xd0, 0x94, 0x7e, 0x0e, 0xb4, 0xde, 0xc3, 0x1a,
+	0x31, 0x1b, 0x0a, 0x35, 0xad, 0x9f, 0x85, 0xcd, 0x6e, 0x8d, 0xf4, 0x9b, 0xfd, 0x66, 0xa7, 0x3d,
+	0xac, 0x3d, 0xa9, 0x11, 0x53, 0xcb, 0xe8, 0x1b, 0x00, 0xad, 0xc1, 0x6e, 0xbf, 0x39, 0x24, 0x9d,
+	0x5d, 0x53, 0xcb, 0xea, 0x55, 0xb8, 0x40, 0xcc, 0x9e, 0x49, 0x1e, 0xd7, 0x28, 0x8c, 0x98, 0x3b,
+	0xcd, 0xb6, 0xd9, 0x32, 0xdb, 0x7d, 0x2d, 0xa7, 0x6b, 0x50, 0x22, 0xe6, 0x83, 0x90, 0x3b, 0x5f,
+	0x7d, 0x02, 0x67, 0x96, 0x24, 0xa6, 0x6b, 0x90, 0x7a, 0x66, 0xbf, 0xe4, 0x33, 0x03, 0xfe, 0xd5,
+	0xdf, 0x11, 0xcb, 0xc2, 0xd2, 0x74, 0xa3, 0x72, 0xf3, 0xe5, 0xe2, 0x5e, 0xf2, 0xe3, 0x84, 0xf1,
+	0xb3, 0x69, 0x28, 0xd4, 0xb1, 0x03, 0xd3, 0x31, 0xf6, 0x4e, 0x44, 0xcc, 0xca, 0xa8, 0x96, 0x10,
+	0x45, 0xae, 0xfa, 0x5d, 0xc8, 0x8d, 0xdc, 0xe9, 0xd4, 0x9a, 0x8d, 0x79, 0x7e, 0x97, 0x56, 0x31,
+	0xd4, 0x19, 0x84, 0x08, 0x2c, 0x66, 0x72, 0x10, 0x04, 0xf3, 0xe5, 0xa9, 0x23, 0xe4, 0x79, 0x18,
+	0x04, 0x73, 0x42, 0x51, 0xfa, 0x0d, 0x48, 0x05, 0xa3, 0x39, 0x1d, 0x1c, 0xc5, 0x3b, 0xaf, 0xac,
+	0x2c, 0xd1, 0x68, 0x4e, 0x10, 0xa3, 0xbf, 0x05, 0xe5, 0xb1, 0x3d, 0xb1, 0x5e, 0x0e, 0xc5, 0x12,
+	0xc6, 0xc6, 0x73, 0xf2, 0xf6, 0x5d, 0x52, 0xa2, 0x11, 0x3d, 0x46, 0xd7, 0xdf, 0x05, 0xcd, 0x99,
+	0x05, 0xb6, 0x77, 0x68, 0x4d, 0x24, 0x36, 0xc3, 0xb1, 0xb7, 0xc8, 0xa6, 0x88, 0x13, 0xf0, 0xb7,
+	0x61, 0x93, 0xcf, 0x10, 0x12, 0x9d, 0x65, 0xe8, 0x3b, 0xb7, 0xc8, 0x06, 0x8f, 0xe2, 0xe0, 0xea,
+	0x3d, 0xc8, 0xf1, 0x1a, 0xeb, 0xef, 0x85, 0xf2, 0x61, 0xf3, 0x70, 0x44, 0x1f, 0xa0, 0x11, 0x74,
+	0xf6, 0x17, 0xa8, 0xea, 0x36, 0xa4, 0xb1, 0xe6, 0x72, 0xc1, 0x44, 0xae, 0x32, 0x5b, 0x30, 0xe5,
+	0x1a, 0x97, 0x0c, 0xd7, 0xb8, 0xea, 0x45, 0x48, 0xf5, 0x47, 0x2b, 0xe1, 0xc6, 0xfb, 0xab, 0xba,
+	0x7c, 0x11, 0x72, 0xf5, 0x4e, 0xab, 0x55, 0x6b, 0x37, 0xb4, 0x04, 0xce, 0xb5, 0x0f, 0xfb, 0xfd,
+	0xae, 0x96, 0xd4, 0x73, 0x90, 0xea, 0xd7, 0xbb, 0x5a, 0xca, 0xf8, 0xf3, 0x59, 0x28, 0x3e, 0xb4,
+	0xad, 0x49, 0x70, 0x40, 0xa5, 0xbb, 0x2c, 0xd0, 0xe4, 0x29, 0x04, 0x9a, 0x3a, 0x95, 0x40, 0xd3,
+	0xeb, 0x04, 0xaa, 0x7f, 0x00, 0xe7, 0x46, 0xee, 0xcc, 0x47, 0xfd, 0xcc, 0x39, 0xb4, 0x87, 0x38,
+	0x33, 0x2f, 0x3c, 0x9b, 0x35, 0x58, 0xf9, 0x5e, 0xe2, 0x7d, 0x72, 0x56, 0x89, 0xde, 0xe1, 0xb1,
+	0xc8, 0xb5, 0xef, 0x59, 0x23, 0x7b, 0x38, 0xb7, 0x3d, 0xc7, 0x1d, 0xc7, 0x1b, 0xee, 0xf6, 0x2d,
+	0xa2, 0xd3, 0xf8, 0x2e, 0x8d, 0x16, 0x79, 0x6d, 0xf3, 0xfe, 0x9f, 0x8f, 0xaf, 0x87, 0x8a, 0x54,
+	0xd4, 0x11, 0xa0, 0xb4, 0x70, 0x6e, 0x59, 0xe3, 0x5b, 0x6e, 0x61, 0xfd, 0x23, 0xde, 0xf7, 0x13,
+	0x14, 0xfd, 0xda, 0xea, 0x0c, 0xb0, 0x59, 0x64, 0xf7, 0xe6, 0xc3, 0xe0, 0x03, 0x36, 0x0c, 0x0a,
+	0x5b, 0x89, 0xe8, 0x44, 0x1e, 0x29, 0x58, 0x5d, 0x61, 0x43, 0x78, 0xf5, 0x97, 0x13, 0x50, 0x8e,
+	0xa4, 0xa6, 0x7f, 0x0e, 0x79, 0xaa, 0x85, 0x8f, 0xdc, 0x09, 0x95, 0xe0, 0xc6, 0x9d, 0xab, 0x61,
+	0x62, 0x6d, 0x3b, 0x90, 0x53, 0x69, 0x97, 0xa3, 0xee, 0xa5, 0x9b, 0xdd, 0xc3, 0x0f, 0x88, 0xe4,
+	0x52, 0x34, 0x36, 0xa6, 0x6e, 0xf1, 0xd0, 0x49, 0x3b, 0x2d, 0x2e, 0x79, 0x7e, 0x60, 0x05, 0x0b,
+	0xdf, 0xf6, 0xa9, 0x6e, 0x56, 0x26, 0x32, 0x5c, 0x1d, 0x43, 0xa9, 0x5f, 0x5f, 0x53, 0xda, 0xe4,
+	0xd7, 0x2a, 0xed, 0x0f, 0x6c, 0x6c, 0x3c, 0x00, 0x78, 0xe4, 0x4c, 0x26, 0x5d, 0x77, 0xe2, 0x8c,
+	0x5e, 0xea, 0x9f, 0x40, 0x49, 0xed, 0x5e, 0x95, 0x44, 0x7c, 0xbe, 0x8d, 0xa8, 0x77, 0x45, 0xa5,
+	0xab, 0x19, 0xbf, 0x9b, 0xc4, 0xad, 0x84, 0xec, 0x1b, 0xfa, 0xbb, 0x90, 0x5e, 0x78, 0x0e, 0xea,
+	0xdb, 0xb8, 0x60, 0x5e, 0x5c, 0xd9, 0x81, 0xb6, 0x07, 0xa4, 0x49, 0x28, 0x4c, 0xff, 0x08, 0x8a,
+	0xf6, 0xec, 0xd0, 0xf1, 0xdc, 0xd9, 0x74, 0xe5, 0x46, 0xc3, 0x0c, 0x23, 0x89, 0x8a, 0xd4, 0xab,
+	0x90, 0xf1, 0x0f, 0xec, 0xc9, 0x84, 0x0e, 0x81, 0xfc, 0xbd, 0x74, 0xe0, 0x2d, 0x6c, 0xc2, 0x48,
+	0xe1, 0x76, 0x82, 0x35, 0x29, 0x0b, 0xa0, 0x02, 0x62, 0x79, 0xfb, 0x0b, 0xe4, 0xf6, 0x2b, 0x39,
+	0xaa, 0xb6, 0x84, 0x04, 0xa9, 0x8f, 0x31, 0x8d, 0x99, 0xfe, 0xaf, 0xfe, 0x19, 0xaa, 0xd5, 0x37,
+	0xd7, 0x6c, 0x8f, 0xae, 0x02, 0xd8, 0x74, 0x9f, 0x65, 0x3d, 0x9d, 0xb0, 0x25, 0x2a, 0x4f, 0x14,
+	0x8a, 0x7e, 0x15, 0x72, 0xf6, 0x8b, 0xc0, 0xb3, 0x46, 0x41, 0x25, 0xa5, 0x94, 0x51, 0x10, 0x31,
+	0xd5, 0x91, 0x35, 0x3a, 0xb0, 0xe9, 0x64, 0x91, 0x27, 0x2c, 0xa0, 0x5f, 0x83, 0xa2, 0xbb, 0x08,
+	0xe6, 0x8b, 0x00, 0x55, 0x0f, 0x9b, 0x17, 0x07, 0x18, 0x69, 0xc7, 0x99, 0xd8, 0xc6, 0x6f, 0xa5,
+	0xa1, 0x24, 0xf7, 0x77, 0x28, 0xf1, 0xf7, 0xf8, 0x28, 0xdf, 0xa4, 0x3d, 0x4a, 0x59, 0xb4, 0x54,
+	0x54, 0x74, 0xa1, 0x2b, 0xda, 0x3c, 0x6a, 0x28, 0x95, 0xea, 0x73, 0x2b, 0xf8, 0x1a, 0xa2, 0x3e,
+	0xae, 0xd7, 0x1c, 0xeb, 0x1f, 0x43, 0x69, 0x4f, 0xa8, 0x27, 0xc8, 0x97, 0x3f, 0x4a, 0x27, 0x2d,
+	0x4a, 0x68, 0x73, 0x7c, 0xfa, 0x79, 0xe5, 0x2e, 0x14, 0x46, 0x62, 0x87, 0x59, 0x29, 0x2e, 0xad,
+	0x95, 0x22, 0x8a, 0x32, 0x85, 0x48, 0xfd, 0x16, 0x14, 0x3c, 0xbe, 0x03, 0xc5, 0x09, 0x35, 0x15,
+	0xdd, 0xcf, 0x88, 0xcd, 0x29, 0x09, 0x41, 0x52, 0xe3, 0x66, 0x1a, 0x27, 0xfd, 0xaf, 0x57, 0x21,
+	0xcb, 0xa2, 0x2b, 0x80, 0x54, 0xaa, 0xf4, 0x72, 0x0a, 0xe2, 0xc7, 0x56, 0x60, 0xd1, 0x26, 0x2b,
+	0x11, 0xfa, 0x1f, 0x0b, 0x3b, 0x76, 0xfc, 0x11, 0xea, 0xd7, 0x2f, 0x2b, 0xa5, 0x78, 0x61, 0x1b,
+	0x22, 0x8a, 0x15, 0x56, 0x22, 0xf5, 0x6f, 0xc3, 0x79, 0xff, 0x60, 0x11, 0x8c, 0xdd, 0xe7, 0xb3,
+	0x61, 0x64, 0xf0, 0x95, 0x8f, 0x1c, 0x7c, 0x67, 0x05, 0xd3, 0x83, 0x70, 0x10, 0x2a, 0x8a, 0xed,
+	0xc6, 0xd1, 0x8a, 0xad, 0xf1, 0xce, 0x9a, 0xc9, 0xa2, 0x61, 0xee, 0xd4, 0x06, 0xbb, 0x7d, 0x2d,
+	0xa1, 0x03, 0x64, 0xeb, 0x83, 0x5e, 0xbf, 0xd3, 0xd2, 0x92, 0xc6, 0x5f, 0x4d, 0x02, 0x34, 0xdc,
+	0xa9, 0xe5, 0xd0, 0xbc, 0xf5, 0x3a, 0x94, 0xf6, 0xac, 0xc5, 0x24, 0x18, 0x8e, 0x29, 0x8d, 0x4f,
+	0x13, 0x5b, 0x4a, 0x49, 0x25, 0x76, 0x7b, 0x07, 0x81, 0x2c, 0x4c, 0x8a, 0x7b, 0x61, 0xa0, 0xfa,
+	0x4f, 0x13, 0x50, 0x54, 0x22, 0xf5, 0x3a, 0x64, 0x3d, 0x7b, 0x1f, 0x37, 0x95, 0xac, 0x23, 0xbe,
+	0x7d, 0x5c, 0x72, 0xdb, 0x84, 0xc2, 0xa9, 0x34, 0x38, 0xab, 0xfe, 0x19, 0xa4, 0x7f, 0xc4, 0x9d,
+	0xd9, 0x7c, 0x73, 0x7d, 0xe3, 0xd8, 0x24, 0xbe, 0xeb, 0xb2, 0xdd, 0x20, 0xa1, 0x6c, 0xd5, 0x2d,
+	0x80, 0x30, 0x51, 0xd9, 0x29, 0x12, 0xe1, 0x36, 0xac, 0x7a, 0x15, 0xf2, 0x82, 0x67, 0x55, 0xbc,
+	0xf1, 0xb7, 0x52, 0x00, 0x2d, 0xcb, 0x0f, 0x58, 0xa7, 0xa4, 0xdb, 0xd9, 0x31, 0x07, 0xe0, 0xf6,
+	0x4c, 0x6c, 0x6f, 0x71, 0xd6, 0xc6, 0x33, 0x84, 0x8a, 0x72, 0x86, 0x50, 0xbe, 0x97, 0xbe, 0x7b,
+	0xeb, 0xee, 0x2d, 0xbe, 0xc6, 0x68, 0x90, 0x9a, 0x3b, 0x63, 0xda, 0xc1, 0x0a, 0x04, 0xff, 0x46,
+	0x36, 0x55, 0x99, 0xd8, 0xa6, 0xaa, 0x02, 0xb9, 0x43, 0xdb, 0xf3, 0x51, 0x7a, 0x74, 0x93, 0x46,
+	0x44, 0x50, 0x3d, 0x71, 0x60, 0x63, 0xee, 0xa8, 0x13, 0x87, 0x77, 0x20, 0xcb, 0x9b, 0x94, 0x0d,
+	0xea, 0x73, 0xab, 0x04, 0x48, 0x38, 0x06, 0xbb, 0x41, 0x64, 0x3b, 0x56, 0xa0, 0x23, 0xed, 0x9a,
+	0xba, 0xdd, 0x16, 0x82, 0x58, 0xbb, 0x17, 0xab, 0xfe, 0x58, 0x22, 0xb2, 0x23, 0xfa, 0x38, 0xa2,
+	0xaa, 0xbf, 0x7e, 0x4c, 0x5a, 0xea, 0x76, 0xe8, 0x93, 0x55, 0x3d, 0x5a, 0x83, 0x52, 0xed, 0x81,
+	0xd9, 0xee, 0x0f, 0x07, 0xdd, 0x06, 0x6e, 0x77, 0x12, 0xba, 0x0e, 0x1b, 0x8c, 0x12, 0xee, 0xcf,
+	0x8d, 0x3f, 0x9d, 0x86, 0x02, 0x3b, 0x6b, 0xc3, 0x36, 0x8b, 0x1e, 0x4f, 0x24, 0x63, 0x72, 0x66,
+	0xed, 0x85, 0xe2, 0xc9, 0xd0, 0xf6, 0xba, 0xcd, 0xdb, 0x2b, 0x32, 0xe7, 0xa4, 0x4e, 0x32, 0xe7,
+	0xbc, 0x0f, 0x60, 0x05, 0x81, 0xe7, 0x3c, 0x5d, 0x04, 0x72, 0x9a, 0x52, 0x8e, 0x34, 0x6a, 0x22,
+	0x8e, 0x28, 0x30, 0xfd, 0x55, 0xda, 0xa1, 0xb2, 0x4b, 0x2d, 0xc9, 0x4e, 0x0a, 0xf9, 0x11, 0x80,
+	0x68, 0x44, 0x38, 0xbe, 0x11, 0xab, 0x7f, 0x36, 0xba, 0x23, 0xfd, 0x28, 0x22, 0xff, 0xd7, 0xe2,
+	0x39, 0xac, 0x17, 0xff, 0xaf, 0xaf, 0xdc, 0x8d, 0x46, 0xb7, 0x87, 0x09, 0xfd, 0x3c, 0x9c, 0x79,
+	0xd8, 0x34, 0x49, 0x8d, 0xd4, 0x1f, 0x36, 0xeb, 0xb5, 0x5d, 0x46, 0x4e, 0x1e, 0xb1, 0x6b, 0x4c,
+	0x21, 0x8b, 0xd8, 0x85, 0x0e, 0xbb, 0xa4, 0xf3, 0xb8, 0xd9, 0x30, 0x89, 0x96, 0xc6, 0x6d, 0x2b,
+	0x31, 0x7b, 0xcd, 0xef, 0x9a, 0xc3, 0xc7, 0x9d, 0xdd, 0x41, 0x0b, 0xf7, 0xa2, 0x97, 0xa1, 0xc2,
+	0x9a, 0xb6, 0xd3, 0x35, 0x09, 0x4b, 0x69, 0xc7, 0x34, 0x1b, 0xf7, 0x6b, 0xf5, 0x47, 0x5a, 0x76,
+	0x45, 0xc3, 0xe7, 0xf4, 0x0a, 0x9c, 0xa3, 0x7b, 0x62, 0x99, 0xc1, 0x6e, 0xb3, 0xd5, 0xec, 0xf7,
+	0xb4, 0xbc, 0xf1, 0x77, 0x92, 0x70, 0xa1, 0xde, 0x6b, 0x76, 0x27, 0x8b, 0x7d, 0x67, 0x16, 0x59,
+	0x68, 0xf4, 0x06, 0xe4, 0x7d, 0xdb, 0x3b, 0x74, 0x46, 0x36, 0xd3, 0x6e, 0x36, 0xee, 0x5c, 0x57,
+	0xd6, 0xa4, 0x95, 0x3c, 0xdb, 0x3d, 0xc6, 0x40, 0x24, 0xa7, 0xba, 0x16, 0x26, 0x4f, 0xb4, 0x16,
+	0x9e, 0xbe, 0x83, 0x45, 0x56, 0xcf, 0xf4, 0x49, 0x57, 0x4f, 0xe3, 0x73, 0xc8, 0xf1, 0xe2, 0x46,
+	0xdb, 0xf2, 0x02, 0xe8, 0xf5, 0x4e, 0xbb, 0x4f, 0x3a, 0xbb, 0xbb, 0x26, 0x19, 0x62, 0x6b, 0x35,
+	0xeb, 0xd8, 0xa6, 0x1a, 0x94, 0xda, 0x9d, 0x86, 0x29, 0x29, 0x49, 0xe3, 0x25, 0x94, 0xa5, 0x1c,
+	0xc4, 0x4c, 0xc9, 0x7b, 0x15, 0x9d, 0x29, 0xf1, 0xff, 0xca, 0x43, 0xae, 0xcf, 0x01, 0x64, 0x39,
+	0x44, 0x25, 0xb7, 0x8e, 0x13, 0x2e, 0x51, 0x78, 0x8c, 0x5f, 0x49, 0xc1, 0xb9, 0xa5, 0xd3, 0x67,
+	0x76, 0x06, 0xc0, 0x66, 0x62, 0x94, 0xc2, 0xe5, 0x65, 0xb9, 0x49, 0x2c, 0x1b, 0x43, 0xd1, 0xb1,
+	0x99, 0x3c, 0xd9, 0xd8, 0x14, 0xb5, 0x4c, 0xad, 0xa8, 0x65, 0x5a, 0xa9, 0xe5, 0x00, 0xce, 0x8d,
+	0x6d, 0xb6, 0x80, 0x7a, 0x36, 0x76, 0x08, 0xba, 0xaa, 0x8b, 0x29, 0xc0, 0x58, 0x2e, 0xdc, 0x36,
+	0x09, 0x61, 0x6c, 0xf1, 0xe7, 0xfc, 0x0a, 0xdd, 0xd7, 0x3f, 0x87, 0x9c, 0x1f, 0xb8, 0x9e, 0xb5,
+	0x6f, 0xf3, 0xf9, 0xe1, 0xcd, 0x23, 0xaa, 0x49, 0x3b, 0x25, 0x43, 0x13, 0xc1, 0x56, 0xfd, 0xf1,
+	0x04, 0xe4, 0x38, 0x51, 0x7f, 0x0f, 0xb2, 0x73, 0x2a, 0x6b, 0xbe, 0x1c, 0xbf, 0xb2, 0xa2, 0x19,
+	0xd8, 0x44, 0xc2, 0x60, 0xfa, 0x0e, 0x5c, 0xf3, 0x70, 0xbf, 0x39, 0x72, 0x26, 0x0e, 0x2d, 0xd1,
+	0x70, 0x69, 0xef, 0x4c, 0xf7, 0xd9, 0xe4, 0x4a, 0x14, 0xd6, 0x8c, 0xee, 0xa2, 0x8d, 0x7f, 0x9e,
+	0x82, 0xcc, 0x63, 0xaa, 0x58, 0x5f, 0x57, 0x7a, 0xcd, 0x86, 0x3a, 0x8d, 0xd1, 0x68, 0x55, 0x93,
+	0xdd, 0xc6, 0xcd, 0x9b, 0x35, 0xb1, 0xbc, 0xe5, 0x13, 0x22, 0x86, 0xed, 0xd1, 0x58, 0xc2, 0x51,
+	0x88, 0xf7, 0xac, 0xd9, 0xbe, 0xed, 0x57, 0x52, 0xab, 0xf1, 0x84, 0xc6, 0x12, 0x8e, 0xd2, 0xdf,
+	0x80, 0x94, 0x6f, 0x07, 0x7c, 0x0c, 0x9d, 0x5d, 0x4a, 0xdc, 0x0e, 0x08, 0xc6, 0xd3, 0x02, 0xdb,
+	0x2f, 0xd8, 0x41, 0x6a, 0x71, 0x45, 0x81, 0xed, 0x17, 0x01, 0xa1, 0x88, 0xea, 0x55, 0xc8, 0xb2,
+	0x22, 0x45, 0xf7, 0x14, 0x09, 0xbe, 0xa7, 0xa8, 0xbe, 0x07, 0x19, 0x5a, 0x04, 0x8c, 0x7e, 0x6a,
+	0x8b, 0x56, 0x48, 0x13, 0x16, 0x40, 0xe5, 0xc0, 0xa6, 0x13, 0x07, 0xd2, 0xf0, 0x6f, 0xf5, 0x2e,
+	0x64, 0x59, 0x99, 0xf5, 0xb7, 0x21, 0x43, 0x4b, 0xcd, 0x77, 0x5e, 0xe7, 0x57, 0x56, 0x8d, 0x30,
+	0x0c, 0x1e, 0xb5, 0xf4, 0x6c, 0xba, 0xa1, 0x75, 0x02, 0x7b, 0x4a, 0x59, 0x0a, 0x84, 0xfe, 0xaf,
+	0x5e, 0x86, 0x34, 0x16, 0x78, 0xcd, 0x9d, 0xcc, 0x6d, 0x3e, 0xdb, 0x03, 0x64, 0x7b, 0xf5, 0xda,
+	0x6e, 0x8d, 0x68, 0xdf, 0xc0, 0xff, 0xa4, 0xd6, 0x7e, 0x40, 0x4f, 0x1b, 0x73, 0x90, 0xea, 0x99,
+	0x7d, 0x76, 0xf6, 0xdd, 0x37, 0xbf, 0xd3, 0xd7, 0x52, 0xc6, 0x1f, 0x24, 0xa0, 0x20, 0x07, 0xce,
+	0x2a, 0xe5, 0x49, 0x36, 0x78, 0xf2, 0x14, 0x0d, 0x9e, 0x3a, 0x65, 0x83, 0xa7, 0x4f, 0xd3, 0xe0,
+	0xd9, 0x1f, 0x54, 0x83, 0x1b, 0xbf, 0x57, 0x82, 0xbc, 0x18, 0x84, 0xfa, 0x67, 0x50, 0x9c, 0xf3,
+	0x81, 0x88, 0x1b, 0xa8, 0xd2, 0x09, 0x26, 0x25, 0x10, 0x0c, 0xcd, 0xf1, 0xff, 0x1b, 0xa2, 0xcb,
+	0x1c, 0x23, 0xba, 0x23, 0xee, 0x14, 0xbe, 0x0d, 0x9b, 0xd6, 0x64, 0xe2, 0x8e, 0xc4, 0x2c, 0xb2,
+	0xe7, 0xf2, 0xbd, 0xdf, 0xab, 0x2b, 0xa6, 0xc6, 0x9a, 0x44, 0xd2, 0xd9, 0x68, 0xc3, 0x8a, 0x84,
+	0xf5, 0x06, 0x14, 0x95, 0x39, 0x96, 0xab, 0xb5, 0x27, 0x99, 0x62, 0x55, 0x36, 0xbc, 0x78, 0x88,
+	0xcc, 0xd4, 0xe5, 0x13, 0xcf, 0xd4, 0x11, 0x3e, 0xdc, 0xa2, 0x8f, 0x1d, 0xff, 0x19, 0xd7, 0xc4,
+	0x2f, 0xad, 0xe0, 0x6f, 0x38, 0x3e, 0x3f, 0x1f, 0x43, 0xa0, 0xfe, 0x2d, 0x5c, 0xf4, 0x0f, 0xdd,
+	0x11, 0x3d, 0x5a, 0x28, 0xc4, 0xb7, 0x59, 0x4a, 0xae, 0x1c, 0xc3, 0xd6, 0x72, 0xc9, 0xa2, 0xdf,
+	0x85, 0xac, 0x7f, 0x60, 0x79, 0xf6, 0x98, 0xeb, 0x82, 0x57, 0x56, 0x30, 0xf7, 0x28, 0x80, 0xcd,
+	0xe5, 0x0c, 0x5c, 0x7d, 0x1d, 0x36, 0xa2, 0x72, 0xc5, 0x3e, 0x47, 0x9b, 0x8b, 0x9d, 0xca, 0xd3,
+	0xff, 0xd5, 0x7f, 0x91, 0x80, 0xcd, 0x58, 0x7d, 0xe5, 0x8d, 0x46, 0x3a, 0x7e, 0xa3, 0xb1, 0x4e,
+	0x42, 0x6a, 0xd7, 0x14, 0x99, 0xa4, 0xc2, 0x4c, 0xa2, 0xd7, 0x45, 0x89, 0xf8, 0x75, 0x51, 0xb8,
+	0xe1, 0x4d, 0x7e, 0x9d, 0x0d, 0x2f, 0xce, 0x5e, 0xfd, 0x5a, 0xbf, 0x59, 0xd7, 0x12, 0x18, 0xd1,
+	0xf8, 0xb2, 0x5d, 0x6b, 0x35, 0xeb, 0x5a, 0xb2, 0xfa, 0x97, 0x33, 0x90, 0x17, 0x4d, 0xa1, 0x37,
+	0xa1, 0x38, 0xc7, 0xdd, 0x94, 0x1f, 0xd8, 0xb3, 0x91, 0xcd, 0x75, 0x88, 0xb7, 0x8e, 0x68, 0xbc,
+	0xed, 0x6e, 0x08, 0x27, 0x2a, 0x2f, 0x96, 0xf7, 0xd0, 0x9d, 0x2c, 0xa6, 0xf6, 0x72, 0x79, 0x1f,
+	0x53, 0x3a, 0xe1, 0xf1, 0xfa, 0x3d, 0x79, 0xfa, 0x90, 0x5a, 0xdb, 0x67, 0x65, 0x7e, 0x3d, 0x1a,
+	0x16, 0xa7, 0x13, 0xd5, 0x4f, 0xa1, 0xa8, 0x94, 0x60, 0x69, 0x13, 0x1a, 0x11, 0x69, 0x32, 0x26,
+	0xd2, 0xea, 0xf7, 0x52, 0x90, 0x65, 0xe9, 0x29, 0xd7, 0x53, 0xc9, 0x35, 0x8d, 0x19, 0x2b, 0x81,
+	0xda, 0x98, 0x9f, 0x2a, 0x87, 0xa4, 0xc5, 0x13, 0x31, 0x77, 0xad, 0xe0, 0x80, 0x9f, 0xa6, 0x7e,
+	0x0b, 0x32, 0x53, 0x77, 0x31, 0x0b, 0x78, 0xe5, 0xaf, 0x9f, 0x80, 0xbb, 0x85, 0x78, 0xc2, 0xd8,
+	0xf0, 0x34, 0xf7, 0xd0, 0x9e, 0x8d, 0x5d, 0x8f, 0x5f, 0x3f, 0xf2, 0x10, 0x17, 0x45, 0x9a, 0x5f,
+	0x2f, 0xe3, 0x5e, 0x29, 0x3f, 0xb5, 0x03, 0x8b, 0x9e, 0xe5, 0x64, 0xd6, 0xf4, 0x20, 0x89, 0xc0,
+	0x5d, 0xf6, 0xdc, 0x73, 0xf7, 0x1c, 0x31, 0x6d, 0x11, 0x11, 0xac, 0x56, 0x21, 0x8d, 0xa5, 0x67,
+	0x3d, 0xd8, 0x0d, 0xc2, 0x61, 0xe2, 0x06, 0xd5, 0x4b, 0x90, 0xa1, 0x65, 0x5b, 0x15, 0x69, 0x7c,
+	0x73, 0x55, 0xb7, 0xcc, 0x43, 0xba, 0x5b, 0xeb, 0x3f, 0xd4, 0x12, 0x7a, 0x01, 0x32, 0xad, 0xce,
+	0xa0, 0x8d, 0x0b, 0x69, 0x01, 0x32, 0xf7, 0x77, 0x3b, 0xf5, 0x47, 0x5a, 0x0a, 0x17, 0x57, 0x52,
+	0x7b, 0xa2, 0xa5, 0xab, 0x9b, 0x50, 0x8e, 0x0c, 0xfd, 0x6a, 0x09, 0x20, 0x1c, 0xce, 0xc6, 0xcf,
+	0x27, 0xa1, 0xd2, 0xf7, 0xac, 0xbd, 0x3d, 0x67, 0x84, 0x1a, 0xb3, 0xe7, 0x4e, 0x7a, 0x81, 0x15,
+	0x38, 0x7e, 0xe0, 0x8c, 0xfc, 0xa5, 0x5e, 0x51, 0x81, 0xdc, 0x53, 0x6b, 0xf4, 0x6c, 0xe2, 0xee,
+	0xd3, 0x26, 0x4b, 0x13, 0x11, 0xa4, 0x3a, 0xc8, 0xcb, 0x80, 0x2b, 0x4b, 0x69, 0xc2, 0x02, 0x48,
+	0x1d, 0x7b, 0xee, 0x9c, 0x2d, 0x0b, 0x69, 0xc2, 0x02, 0x78, 0x18, 0x8a, 0xc7, 0x5a, 0x13, 0x67,
+	0xea, 0x04, 0xec, 0x32, 0x23, 0x4d, 0x14, 0x0a, 0x15, 0xa1, 0x35, 0x7a, 0x66, 0x07, 0xec, 0xce,
+	0x22, 0x4d, 0x44, 0x10, 0xa5, 0xf3, 0xd5, 0xc4, 0x9e, 0xd1, 0x06, 0x4b, 0x13, 0xfa, 0x1f, 0xd1,
+	0x9e, 0x15, 0xd8, 0x4f, 0xe7, 0x3e, 0x9d, 0xb9, 0xd3, 0x44, 0x04, 0x45, 0xcc, 0x7c, 0xee, 0x57,
+	0x0a, 0x61, 0xcc, 0x7c, 0x4e, 0xef, 0x9e, 0x3d, 0xfb, 0xab, 0x85, 0xbd, 0xa0, 0x17, 0xc4, 0x18,
+	0x25, 0xc3, 0xc6, 0x4f, 0x66, 0xa0, 0xd4, 0x9c, 0x2b, 0x42, 0xb8, 0x0a, 0xb0, 0xe3, 0x7a, 0xcf,
+	0x2d, 0x6f, 0xec, 0xcc, 0xf6, 0x69, 0xc3, 0xa4, 0x88, 0x42, 0xc1, 0xf8, 0x06, 0x53, 0xb5, 0xfb,
+	0xfd, 0x5d, 0x2a, 0x97, 0x14, 0x51, 0x28, 0x18, 0xdf, 0x9c, 0x11, 0x7b, 0x64, 0x3b, 0x87, 0x5c,
+	0x3e, 0x29, 0xa2, 0x50, 0xd0, 0xf8, 0xa4, 0x39, 0x7b, 0x38, 0xf6, 0x4c, 0xcf, 0x73, 0x3d, 0x26,
+	0xaa, 0x14, 0x51, 0x49, 0xba, 0x01, 0xa5, 0xe6, 0xac, 0x36, 0x16, 0x61, 0x2a, 0xb2, 0x14, 0x89,
+	0xd0, 0xf4, 0xd7, 0xa1, 0x8c, 0x65, 0x6a, 0x58, 0x81, 0xb5, 0xef, 0x59, 0x53, 0x26, 0xba, 0x14,
+	0x89, 0x12, 0xf5, 0xeb, 0xb0, 0xd9, 0x9c, 0x0d, 0x66, 0xcf, 0x66, 0xee, 0xf3, 0x19, 0xbd, 0x38,
+	0x60, 0x27, 0x3e, 0x29, 0x12, 0x27, 0xb3, 0x52, 0xe3, 0x81, 0xa4, 0xe5, 0x8d, 0x99, 0x64, 0x53,
+	0x44, 0xa1, 0xf0, 0x78, 0x7b, 0xe2, 0xe0, 0x21, 0x52, 0xa5, 0x20, 0xe3, 0x39, 0x05, 0x6b, 0xd5,
+	0x59, 0x04, 0x04, 0xa5, 0xea, 0x07, 0x4c, 0xca, 0x29, 0xa2, 0x92, 0x38, 0x42, 0x66, 0x51, 0x94,
+	0x08, 0x99, 0x07, 0x43, 0xb4, 0x5d, 0xe2, 0xd2, 0x2d, 0x56, 0x49, 0x22, 0x04, 0x09, 0x25, 0x43,
+	0x6c, 0xcb, 0x9f, 0x72, 0x63, 0x05, 0x7a, 0x1e, 0x9a, 0x22, 0x11, 0x1a, 0x96, 0x94, 0x86, 0x89,
+	0xfd, 0xd5, 0x98, 0x1d, 0x7a, 0xa6, 0x88, 0x42, 0xc1, 0xce, 0x40, 0x43, 0x9d, 0x47, 0x3e, 0x3d,
+	0x17, 0x4f, 0x11, 0x19, 0x96, 0xbc, 0x78, 0xb9, 0xe6, 0x57, 0x34, 0x85, 0x97, 0x52, 0xb0, 0x8b,
+	0xed, 0x78, 0xd6, 0x3e, 0xb2, 0x9e, 0xa1, 0x91, 0x22, 0x88, 0x13, 0x28, 0xfe, 0x65, 0x8c, 0x3a,
+	0x8d, 0x0b, 0x09, 0x58, 0x33, 0x0c, 0xd4, 0x3d, 0xdb, 0xc2, 0x9a, 0x9d, 0x65, 0x35, 0x53, 0x48,
+	0xc6, 0xdf, 0xcf, 0xc1, 0x46, 0x73, 0x34, 0x55, 0x3b, 0xe2, 0x05, 0xc8, 0x36, 0x67, 0x2d, 0x7f,
+	0xdf, 0xe7, 0x9d, 0x90, 0x87, 0xb0, 0x02, 0xcd, 0x19, 0xef, 0x1a, 0xac, 0xfb, 0xc9, 0x30, 0xeb,
+	0x3a, 0x75, 0x7f, 0x31, 0xe5, 0xf1, 0x29, 0xd1, 0x75, 0x42, 0x9a, 0xfe, 0x26, 0x6c, 0x60, 0xc3,
+	0xf9, 0xc1, 0x60, 0xe6, 0xd9, 0xd6, 0xe8, 0x40, 0xf4, 0xc1, 0x18, 0x95, 0x75, 0x54, 0x94, 0xaa,
+	0xf9, 0x62, 0x34, 0x16, 0xbd, 0x50, 0x25, 0x31, 0x44, 0xd7, 0xf2, 0xa6, 0x5d, 0xcf, 0x7d, 0x2a,
+	0xba, 0xa0, 0x4a, 0x62, 0xe5, 0xe9, 0x79, 0xa3, 0x2f, 0x16, 0xf6, 0x6c, 0x74, 0x20, 0x7a, 0x5f,
+	0x84, 0xc6, 0x52, 0x21, 0xf6, 0xd8, 0xf1, 0xec, 0x51, 0x20, 0xfa, 0x9e, 0x4a, 0x42, 0xb1, 0x37,
+	0x67, 0xe6, 0xe8, 0xc0, 0x15, 0x3d, 0x4f, 0x04, 0x59, 0xb7, 0xc4, 0xbf, 0xc4, 0x9e, 0x8b, 0x5e,
+	0xa7, 0x50, 0x58, 0xfe, 0x58, 0x60, 0x3f, 0xb0, 0xa6, 0x73, 0xd1, 0xeb, 0x22, 0x34, 0x36, 0x48,
+	0x64, 0x98, 0x26, 0x54, 0x12, 0x83, 0x24, 0x42, 0x66, 0x25, 0xc5, 0x41, 0xd8, 0xb2, 0xfc, 0x67,
+	0x3e, 0xef, 0x7d, 0x2a, 0x89, 0xc9, 0x56, 0x04, 0x69, 0x52, 0x1b, 0x42, 0xb6, 0x2a, 0x15, 0x6b,
+	0xd4, 0x59, 0x04, 0xb4, 0x71, 0x59, 0x1f, 0x14, 0x41, 0xec, 0x48, 0x9d, 0x45, 0xc0, 0x9b, 0x8f,
+	0xf5, 0xc0, 0x90, 0x80, 0x65, 0xc5, 0x11, 0xa3, 0x36, 0x1e, 0xeb, 0x88, 0x71, 0x32, 0xd6, 0xbc,
+	0xb3, 0x08, 0xc2, 0xe6, 0x63, 0x7d, 0x32, 0x42, 0xe3, 0x98, 0xb0, 0x01, 0xcf, 0x4a, 0x4c, 0xd8,
+	0x82, 0xaf, 0x43, 0xb9, 0xb3, 0x08, 0x94, 0x26, 0x3c, 0xc7, 0x26, 0x9a, 0x08, 0x91, 0xa7, 0x14,
+	0x36, 0xe2, 0x79, 0x99, 0x52, 0xd8, 0x8a, 0x55, 0xc8, 0x63, 0x45, 0x68, 0x33, 0x5e, 0x60, 0xfd,
+	0x56, 0x84, 0xf9, 0xd0, 0x97, 0x0d, 0xf9, 0x8a, 0x1c, 0xfa, 0xb2, 0x25, 0x59, 0x39, 0x94, 0xa6,
+	0xac, 0xc8, 0x72, 0x84, 0x44, 0xfd, 0x26, 0x68, 0x2a, 0x81, 0x26, 0x76, 0x91, 0x02, 0x97, 0xe8,
+	0xbc, 0xcc, 0x61, 0x73, 0x56, 0x65, 0x99, 0xc3, 0xf6, 0x64, 0xf2, 0x8e, 0x34, 0xe8, 0x25, 0x29,
+	0x6f, 0x95, 0x6c, 0xfc, 0x93, 0x14, 0x94, 0xfb, 0x23, 0x75, 0xfc, 0xe2, 0x64, 0x15, 0xb8, 0xb5,
+	0xc9, 0xbe, 0xeb, 0x39, 0xc1, 0xc1, 0x94, 0x8f, 0xe2, 0x08, 0x0d, 0xc7, 0x38, 0x09, 0xdc, 0x96,
+	0x33, 0xe3, 0x23, 0x99, 0x87, 0x04, 0xdd, 0x7a, 0xc1, 0x47, 0x30, 0x0f, 0x61, 0xbf, 0x69, 0x59,
+	0x2f, 0xea, 0xee, 0x6c, 0xc6, 0x07, 0xad, 0x08, 0xa2, 0x04, 0x6b, 0x23, 0x34, 0x0c, 0xe8, 0xcc,
+	0xed, 0x99, 0x1c, 0xad, 0x0a, 0x09, 0xcb, 0xd3, 0xb5, 0x7c, 0x5f, 0x42, 0xd8, 0x70, 0x8d, 0xd0,
+	0x10, 0x53, 0x0b, 0x02, 0x7b, 0x3a, 0x0f, 0xd8, 0x4c, 0xc6, 0xc7, 0xab, 0x4a, 0xc3, 0x9c, 0x4c,
+	0x3f, 0xb0, 0x9e, 0x12, 0xdb, 0xb7, 0xc3, 0xf1, 0xaa, 0x90, 0xb0, 0x0f, 0xd7, 0x17, 0x9e, 0x47,
+	0x49, 0x7c, 0xc4, 0x86, 0x04, 0x36, 0xaf, 0xf5, 0xec, 0x7d, 0x31, 0x5e, 0x79, 0x88, 0x8f, 0x09,
+	0x1a, 0x51, 0x94, 0x63, 0x82, 0xc6, 0x6c, 0x41, 0x91, 0xd8, 0x81, 0x67, 0xcd, 0x7c, 0x1a, 0xcb,
+	0x17, 0x06, 0x85, 0xc4, 0xd2, 0x34, 0x3d, 0x4f, 0x0c, 0x4a, 0x1e, 0xe2, 0x69, 0x12, 0x3f, 0x10,
+	0x03, 0x51, 0x04, 0x97, 0x66, 0xca, 0xcd, 0xe5, 0x99, 0xd2, 0xf8, 0xa5, 0x24, 0x94, 0x07, 0x63,
+	0xb5, 0x4d, 0xe9, 0x0c, 0x10, 0x2e, 0xba, 0x09, 0x31, 0x03, 0x48, 0x12, 0xe6, 0xd8, 0x76, 0xbb,
+	0xae, 0x17, 0x88, 0xc9, 0x59, 0x04, 0x23, 0xf3, 0x76, 0x6a, 0x79, 0xde, 0xc6, 0x01, 0x2c, 0x13,
+	0x4e, 0xcb, 0xbe, 0x18, 0xa6, 0x8c, 0xfd, 0x69, 0x74, 0xf8, 0x74, 0xb1, 0x17, 0x55, 0x0b, 0x54,
+	0x1a, 0x62, 0x7a, 0xb3, 0x71, 0x88, 0xe1, 0x6d, 0xac, 0xd2, 0x96, 0x6a, 0x9e, 0x5b, 0xb1, 0x46,
+	0x20, 0x66, 0x7f, 0xe6, 0x7a, 0xf6, 0xb8, 0xb5, 0x98, 0x04, 0x0e, 0x6f, 0xe4, 0x08, 0xcd, 0xf8,
+	0xcd, 0x04, 0x6c, 0xf4, 0xda, 0xad, 0xae, 0x22, 0x9e, 0xdb, 0x90, 0x77, 0xe6, 0x43, 0x3f, 0xb0,
+	0x02, 0x7f, 0xd9, 0x50, 0x40, 0xd5, 0xb2, 0x48, 0xce, 0xa1, 0x21, 0xbc, 0xe5, 0x07, 0x67, 0x34,
+	0x15, 0x4c, 0xc9, 0xb8, 0xa5, 0x54, 0x74, 0x4d, 0x24, 0x05, 0x87, 0x87, 0xd1, 0xee, 0xa5, 0x10,
+	0x8c, 0x04, 0x5f, 0x2a, 0x7e, 0x94, 0x1d, 0x19, 0x8a, 0x24, 0x1f, 0x8c, 0x42, 0xae, 0xc5, 0x58,
+	0x70, 0x2d, 0x1d, 0x80, 0x47, 0x1a, 0x9b, 0xe4, 0x17, 0x2c, 0xe8, 0x1b, 0xbf, 0x91, 0x80, 0x0d,
+	0xdc, 0x5c, 0x28, 0x55, 0x0d, 0x37, 0x63, 0x89, 0xd3, 0x6e, 0xc6, 0xe2, 0xbb, 0xc7, 0xe4, 0xf7,
+	0xb1, 0x7b, 0xbc, 0x06, 0x45, 0xaa, 0x46, 0x0f, 0x55, 0x75, 0x1c, 0x28, 0xe9, 0x3e, 0x52, 0xf4,
+	0x2b, 0x00, 0x0b, 0xdf, 0x1e, 0xf3, 0x78, 0xa6, 0x98, 0x17, 0x90, 0x42, 0xa3, 0x8d, 0x9f, 0x3e,
+	0x13, 0x9a, 0x66, 0x2b, 0xb5, 0xbb, 0x0c, 0x85, 0x40, 0x4c, 0x96, 0xfc, 0x18, 0x32, 0x24, 0xb0,
+	0xdd, 0xa2, 0x3b, 0xb2, 0x7d, 0xdf, 0xf6, 0x2b, 0x57, 0xd1, 0x3a, 0x89, 0x84, 0x04, 0x1c, 0x01,
+	0xc1, 0x81, 0x67, 0x5b, 0x63, 0xbf, 0x72, 0x8d, 0xc6, 0x89, 0xa0, 0xfe, 0x2e, 0x9c, 0x1d, 0xcd,
+	0x17, 0xfe, 0x70, 0xe1, 0x73, 0x53, 0x54, 0x3c, 0x06, 0x16, 0x67, 0xc0, 0x1a, 0x46, 0x0d, 0x7c,
+	0x66, 0x89, 0xda, 0xb3, 0x69, 0x6f, 0x3a, 0x4f, 0xe1, 0xfe, 0x4b, 0x3f, 0xb0, 0xa7, 0x0a, 0x43,
+	0x8a, 0x32, 0xe8, 0x18, 0xd9, 0xa3, 0x71, 0x92, 0xe5, 0x0a, 0x00, 0x65, 0xa1, 0x02, 0xa0, 0xb5,
+	0x4d, 0x90, 0x02, 0x52, 0x76, 0x91, 0xa0, 0xbf, 0x09, 0x9b, 0x2c, 0x45, 0x77, 0x2f, 0xe0, 0x98,
+	0x77, 0x29, 0xa6, 0x4c, 0xd3, 0x72, 0xf7, 0x82, 0x28, 0x6e, 0xe6, 0xf1, 0x9b, 0x77, 0x36, 0x4a,
+	0xca, 0x0c, 0xd7, 0xf6, 0xd8, 0xdd, 0x3a, 0x2e, 0x37, 0x67, 0x04, 0x2e, 0x38, 0xf0, 0xdc, 0x20,
+	0x98, 0xd8, 0xcc, 0xf8, 0xa1, 0x4c, 0x36, 0x19, 0xb2, 0x2f, 0xc8, 0xfa, 0x47, 0x50, 0xa1, 0x58,
+	0x09, 0x54, 0x2a, 0x54, 0xa0, 0x85, 0xa0, 0xb5, 0x95, 0x0c, 0xb2, 0x4e, 0x6f, 0xc2, 0xe6, 0x14,
+	0xab, 0xef, 0x06, 0xd6, 0x84, 0x37, 0xe3, 0xeb, 0xb4, 0x19, 0xcb, 0x53, 0x7b, 0xda, 0x47, 0x2a,
+	0x6b, 0xe9, 0xdb, 0x70, 0x3e, 0xc4, 0x4d, 0xed, 0xa9, 0xff, 0x9c, 0xa3, 0xdf, 0xa0, 0x68, 0x5d,
+	0xa0, 0x5b, 0x18, 0xc5, 0x58, 0x78, 0xd2, 0x6a, 0x0f, 0xca, 0xca, 0xa4, 0x77, 0xc3, 0x4e, 0xf4,
+	0x1e, 0x9c, 0x43, 0x5c, 0x28, 0x36, 0x0e, 0x7e, 0x93, 0x82, 0xcf, 0x4c, 0xed, 0xa9, 0x94, 0x1d,
+	0x63, 0x78, 0x1d, 0xdf, 0x42, 0x4c, 0xa9, 0x9d, 0x0a, 0x87, 0xb2, 0x7d, 0x57, 0x69, 0x6a, 0x4f,
+	0xd1, 0x54, 0x25, 0x82, 0xb2, 0x66, 0xee, 0x8c, 0xa3, 0x8a, 0x12, 0x55, 0x9b, 0xb9, 0xb3, 0x48,
+	0x21, 0xa9, 0x0d, 0x0c, 0x87, 0xbd, 0x25, 0x0b, 0x59, 0x47, 0x2a, 0xc3, 0x19, 0x80, 0x84, 0xa1,
+	0xe7, 0xfb, 0x1c, 0xc5, 0xb6, 0x9a, 0xc5, 0xa9, 0x3d, 0x25, 0xbe, 0x1f, 0x91, 0xd1, 0xd4, 0x9a,
+	0xcf, 0xed, 0xb1, 0x5a, 0xbc, 0x92, 0x94, 0x51, 0x8b, 0xc6, 0x2d, 0x15, 0xd2, 0x7f, 0x6e, 0xcd,
+	0x39, 0xf6, 0xba, 0x2c, 0x64, 0xef, 0xb9, 0x35, 0x67, 0xa8, 0x3b, 0x2c, 0xe1, 0xc5, 0xcc, 0x3e,
+	0x74, 0x46, 0xd4, 0xc8, 0x87, 0x83, 0x6f, 0x50, 0xf0, 0xd9, 0xa9, 0x3d, 0x1d, 0x84, 0x71, 0x8c,
+	0xe7, 0x23, 0xa8, 0x50, 0xe9, 0xbb, 0xcf, 0x87, 0x73, 0xcf, 0xf6, 0xfd, 0x85, 0x67, 0x0f, 0x47,
+	0x78, 0x2c, 0x60, 0x7b, 0x95, 0x2d, 0xca, 0x86, 0x69, 0xee, 0xba, 0xcf, 0xbb, 0x3c, 0xb6, 0xce,
+	0x22, 0xf5, 0xcf, 0xe0, 0x12, 0xad, 0x85, 0x3d, 0x76, 0x16, 0xd3, 0x65, 0xde, 0x57, 0x29, 0x2f,
+	0xa6, 0xdd, 0xa2, 0x88, 0x38, 0x7b, 0x0d, 0xae, 0x50, 0x81, 0x7a, 0x4e, 0xe0, 0x8c, 0xac, 0xc9,
+	0x72, 0x02, 0x06, 0x4d, 0xa0, 0x8a, 0xe2, 0xe5, 0x98, 0x78, 0x12, 0xd7, 0x41, 0xc3, 0xc3, 0xc8,
+	0x48, 0x67, 0xa8, 0x52, 0xae, 0x0d, 0xa4, 0x2b, 0x3d, 0xe1, 0x4d, 0xd8, 0xa4, 0x48, 0x65, 0x12,
+	0xba, 0xc4, 0x5a, 0x0f, 0xc9, 0x03, 0x31, 0x11, 0xe9, 0x35, 0x8e, 0xf3, 0xe5, 0x24, 0x54, 0x79,
+	0x7b, 0x2b, 0x15, 0x5d, 0x0c, 0xa2, 0x53, 0x30, 0xcb, 0x2a, 0x0c, 0xeb, 0x5d, 0xd0, 0x9e, 0x4e,
+	0x9e, 0x39, 0xae, 0x9a, 0xc6, 0x3b, 0x74, 0x6e, 0x7d, 0x23, 0x4c, 0xa3, 0xbe, 0xef, 0xb9, 0x8b,
+	0x39, 0x9d, 0x54, 0xef, 0x23, 0x78, 0x5b, 0x49, 0x70, 0x93, 0xb2, 0x2b, 0x29, 0xbe, 0x03, 0xe9,
+	0xb9, 0xed, 0xed, 0x71, 0xbb, 0x1b, 0xa5, 0x24, 0x5d, 0xdb, 0xdb, 0x53, 0x18, 0x29, 0x0a, 0x7b,
+	0xca, 0xcc, 0x0e, 0x86, 0xde, 0x8b, 0xa1, 0x38, 0xcf, 0xd8, 0x60, 0x3d, 0x65, 0x66, 0x07, 0xe4,
+	0x45, 0x97, 0xd1, 0xf4, 0x2d, 0x28, 0x71, 0x14, 0x93, 0xc6, 0x26, 0xc5, 0x00, 0xc5, 0xc8, 0x8e,
+	0xcc, 0x11, 0x76, 0xb8, 0x0d, 0x48, 0x93, 0x22, 0x85, 0xc8, 0xfd, 0xbf, 0xc8, 0x0b, 0x0f, 0x59,
+	0xe6, 0xf6, 0xb8, 0x72, 0x46, 0xc9, 0xab, 0xc1, 0x68, 0x02, 0x15, 0x84, 0x25, 0xd2, 0x25, 0xaa,
+	0x1f, 0x2f, 0x51, 0x20, 0x4a, 0x74, 0x56, 0x96, 0xa8, 0x1f, 0x2d, 0x51, 0x20, 0x4b, 0x74, 0x4e,
+	0x96, 0xa8, 0x1f, 0x2b, 0x51, 0x10, 0x96, 0xe8, 0xbc, 0x92, 0x97, 0x28, 0xd1, 0xc7, 0x70, 0x91,
+	0xa2, 0x46, 0xf3, 0xa1, 0x17, 0x04, 0xc3, 0xa9, 0x33, 0xf2, 0x5c, 0x9c, 0x02, 0x87, 0xf3, 0xbb,
+	0xb7, 0xe8, 0xae, 0x20, 0x41, 0xce, 0x23, 0xc3, 0x68, 0x4e, 0x82, 0xa0, 0x25, 0x62, 0xbb, 0x77,
+	0x6f, 0x1d, 0xc1, 0xf9, 0xc9, 0xad, 0xca, 0x2b, 0x6b, 0x39, 0x3f, 0x39, 0x92, 0xf3, 0x6e, 0xa5,
+	0xb2, 0x9e, 0xf3, 0xee, 0x51, 0x9c, 0x9f, 0x54, 0x2e, 0xae, 0xe7, 0xfc, 0x44, 0xff, 0x14, 0xaa,
+	0x82, 0xd3, 0xa2, 0x3a, 0xf8, 0x70, 0xe4, 0xce, 0x66, 0xf6, 0x88, 0x5d, 0x16, 0x5c, 0xa6, 0xac,
+	0xaf, 0x30, 0x56, 0xa6, 0xa3, 0xd7, 0xc3, 0x68, 0xfd, 0x73, 0xb8, 0x22, 0x98, 0xe9, 0x1a, 0xf1,
+	0xdc, 0x72, 0x82, 0x08, 0xff, 0x15, 0xca, 0x7f, 0x91, 0xf1, 0xe3, 0x42, 0xf1, 0xc4, 0x72, 0x02,
+	0x35, 0x85, 0x7d, 0xb8, 0x4a, 0x53, 0x60, 0x27, 0x7d, 0xc3, 0x11, 0x3b, 0xea, 0x53, 0x07, 0xc6,
+	0x6b, 0xf1, 0xfb, 0x8a, 0x75, 0xa7, 0x82, 0xe4, 0x12, 0x66, 0xb3, 0x26, 0x52, 0x7f, 0x08, 0x67,
+	0x31, 0x23, 0x7f, 0xc6, 0x55, 0x38, 0x9e, 0xfa, 0xcd, 0xf8, 0x80, 0x89, 0x2a, 0x8a, 0xe4, 0xcc,
+	0xcc, 0x0e, 0x7a, 0x33, 0x55, 0xb5, 0x33, 0x7e, 0x35, 0x0d, 0x65, 0xa1, 0x89, 0x0c, 0x7c, 0xbc,
+	0x6f, 0xfe, 0x16, 0x14, 0x84, 0x8d, 0xa1, 0x30, 0x1a, 0x5d, 0x71, 0xd3, 0x41, 0xb1, 0xd2, 0x30,
+	0x91, 0x84, 0x2c, 0xf8, 0x7e, 0x89, 0x2e, 0x86, 0x95, 0xe4, 0x5a, 0xd3, 0x08, 0x06, 0xa8, 0xfe,
+	0xa3, 0x14, 0xe4, 0x45, 0x0a, 0xfa, 0xa7, 0x50, 0x0e, 0x6d, 0x20, 0xf1, 0xa6, 0x89, 0xdd, 0x76,
+	0x5f, 0x58, 0x6d, 0x3d, 0x49, 0x4a, 0xb6, 0x12, 0x42, 0x93, 0x0c, 0x7e, 0xdd, 0x64, 0x8f, 0x8f,
+	0xc8, 0x37, 0x04, 0xe9, 0xdf, 0x04, 0x50, 0x04, 0x97, 0x5a, 0x77, 0xf1, 0xa7, 0x08, 0x4f, 0xc1,
+	0xa3, 0xe5, 0xa5, 0x34, 0x75, 0x18, 0xd2, 0x73, 0xec, 0xe4, 0xfa, 0xe7, 0x78, 0x45, 0x09, 0x6d,
+	0x8e, 0xf5, 0x4f, 0x21, 0x13, 0xd0, 0x7d, 0x2f, 0xb3, 0x31, 0x78, 0xe3, 0x38, 0xc9, 0x6e, 0xe3,
+	0xcb, 0x42, 0xc2, 0x78, 0xaa, 0xbf, 0x88, 0xa6, 0x3d, 0x96, 0xff, 0x6c, 0xe5, 0xc5, 0xe3, 0x16,
+	0x3d, 0x46, 0x66, 0xf6, 0x76, 0xca, 0xd9, 0x39, 0x7b, 0x99, 0x48, 0x0f, 0x96, 0x4f, 0x6f, 0xb8,
+	0x12, 0xde, 0xea, 0xa4, 0x8f, 0xb9, 0xd5, 0xf9, 0x57, 0x1a, 0x6c, 0x44, 0xa7, 0xe7, 0x63, 0xb4,
+	0xd9, 0x6a, 0xe4, 0xf1, 0x1a, 0x46, 0xca, 0x30, 0xee, 0x2b, 0x47, 0x2f, 0x47, 0x13, 0xa9, 0x59,
+	0xf3, 0x90, 0xfe, 0x21, 0xbc, 0xe2, 0x07, 0xd6, 0x04, 0xb5, 0x38, 0x46, 0x19, 0xee, 0x79, 0xee,
+	0x2c, 0xc0, 0x1b, 0x78, 0xa6, 0x62, 0x9f, 0xe7, 0xd1, 0x75, 0x1a, 0xbb, 0xc3, 0x23, 0xf5, 0x0f,
+	0xe0, 0x42, 0x8c, 0x0f, 0x4f, 0xd4, 0x91, 0x8d, 0x29, 0x2b, 0xe7, 0x22, 0x6c, 0xf7, 0x59, 0x1c,
+	0xee, 0xc6, 0x9c, 0x99, 0x1f, 0x78, 0x0b, 0x3e, 0xfc, 0x99, 0x8e, 0x16, 0xa1, 0xe9, 0x37, 0x40,
+	0x63, 0x1a, 0x92, 0x67, 0xef, 0xd9, 0x1e, 0xee, 0x0d, 0x7c, 0x7e, 0x6e, 0xbe, 0x49, 0xe9, 0x44,
+	0x92, 0xf5, 0x57, 0xa1, 0xc4, 0xa0, 0x53, 0x87, 0x6a, 0xf0, 0xec, 0x1c, 0xbd, 0x48, 0x69, 0x2d,
+	0x4a, 0x42, 0x99, 0x3c, 0xf5, 0xac, 0xd9, 0xe8, 0xc0, 0x16, 0x87, 0xe9, 0x32, 0xac, 0xbf, 0x06,
+	0x65, 0xf6, 0x5f, 0xf0, 0x73, 0xd5, 0x8e, 0x11, 0x79, 0x02, 0x57, 0x00, 0x9e, 0x2e, 0x7c, 0x5e,
+	0x49, 0xae, 0xd6, 0x15, 0x9e, 0x2e, 0x7c, 0x56, 0x31, 0x8c, 0xf6, 0xec, 0x3d, 0x11, 0xcd, 0x94,
+	0xaf, 0x82, 0x67, 0xef, 0xf1, 0xe8, 0x4b, 0x80, 0x4a, 0xfb, 0x70, 0x34, 0x71, 0x47, 0xcf, 0xe8,
+	0xe2, 0x9b, 0x20, 0xf9, 0xd1, 0x7c, 0x51, 0xc7, 0x30, 0xf2, 0x62, 0x27, 0xe4, 0xb1, 0x1b, 0x34,
+	0xb6, 0x80, 0x14, 0x16, 0x7d, 0x0d, 0x8a, 0x73, 0x6b, 0x1f, 0x9f, 0x4f, 0x2c, 0x26, 0x81, 0x5c,
+	0x5e, 0x91, 0x44, 0xad, 0x3c, 0x69, 0xf5, 0xa7, 0xce, 0xcc, 0xf5, 0x04, 0x82, 0xaf, 0xae, 0x94,
+	0xa6, 0x40, 0xac, 0x1f, 0x0e, 0x21, 0x67, 0x38, 0xc4, 0xfa, 0x61, 0x09, 0x41, 0x79, 0x63, 0xa3,
+	0xbe, 0x08, 0x86, 0xfe, 0x73, 0x27, 0xa0, 0x92, 0xd2, 0xb9, 0xbc, 0x19, 0xbd, 0xc7, 0xc9, 0xfa,
+	0x1b, 0xb0, 0x81, 0xb5, 0x99, 0x3a, 0xfb, 0x1e, 0xbf, 0x2c, 0x66, 0x2b, 0x2c, 0x6e, 0x26, 0x5a,
+	0x92, 0x88, 0x29, 0x5a, 0x13, 0x67, 0x9f, 0xda, 0xb0, 0x8b, 0x8c, 0xd9, 0x3a, 0xbb, 0x29, 0xe9,
+	0x61, 0xe6, 0xf6, 0x74, 0x31, 0xa1, 0x8c, 0x02, 0xca, 0x56, 0xdb, 0x4d, 0x49, 0xe7, 0xd0, 0x37,
+	0x61, 0x73, 0x72, 0x7b, 0x38, 0x66, 0x0d, 0x3e, 0x71, 0x71, 0x57, 0x76, 0x81, 0xe5, 0x3e, 0xb9,
+	0xdd, 0xa0, 0xd4, 0x5d, 0x24, 0xa2, 0x66, 0x1c, 0xc5, 0x89, 0xd6, 0x7d, 0x85, 0xa2, 0x75, 0x15,
+	0xcd, 0xdb, 0xf8, 0x3a, 0x68, 0x21, 0x8b, 0x1f, 0xb8, 0x9e, 0xcd, 0x4e, 0xe5, 0xd2, 0x64, 0x43,
+	0xa0, 0x7b, 0x94, 0xaa, 0xbf, 0x0f, 0x17, 0x62, 0x48, 0x91, 0xfa, 0x45, 0xa6, 0x1e, 0x47, 0xf0,
+	0x3c, 0xf9, 0x5b, 0x70, 0x2e, 0x64, 0x9a, 0x63, 0xb7, 0x66, 0x52, 0xae, 0x46, 0x0b, 0xd4, 0x95,
+	0x31, 0xfa, 0x27, 0x70, 0x71, 0x99, 0x43, 0xe4, 0xc4, 0xb4, 0xce, 0x0b, 0x71, 0x36, 0x9e, 0x19,
+	0x13, 0x93, 0xa3, 0x8a, 0xe9, 0xb2, 0x10, 0x53, 0x73, 0x49, 0x4c, 0xce, 0xb2, 0x98, 0xae, 0x88,
+	0x52, 0x35, 0xe3, 0x62, 0x62, 0xf5, 0x70, 0x96, 0xea, 0x71, 0x35, 0xca, 0xb1, 0x54, 0x0f, 0x67,
+	0x75, 0x3d, 0xae, 0x89, 0x7a, 0x34, 0x57, 0xd5, 0xe3, 0x12, 0x14, 0x26, 0x93, 0x11, 0xaf, 0x01,
+	0xdb, 0x44, 0xe4, 0x27, 0x93, 0x11, 0x2b, 0x3c, 0x56, 0x92, 0x47, 0x8a, 0xd4, 0x5e, 0xe5, 0x95,
+	0x64, 0x90, 0x70, 0xf0, 0x22, 0x8e, 0x37, 0x29, 0xdb, 0x0d, 0x60, 0xb2, 0xbc, 0x35, 0xb1, 0xdd,
+	0x45, 0xb4, 0x48, 0xe7, 0x35, 0xde, 0xee, 0x1c, 0xc4, 0x13, 0x7a, 0x03, 0x90, 0xa2, 0x56, 0xfa,
+	0x75, 0x99, 0x9f, 0x52, 0xdf, 0x6d, 0x38, 0xab, 0xc2, 0x44, 0x9a, 0x6c, 0xdf, 0x7a, 0x46, 0xc1,
+	0x86, 0xe5, 0x1b, 0x07, 0x93, 0xa7, 0xbc, 0x96, 0x6c, 0x13, 0x5a, 0x40, 0x0a, 0xab, 0x26, 0x6e,
+	0x4e, 0x44, 0xb4, 0x48, 0xeb, 0x2d, 0xbe, 0x39, 0xe1, 0x20, 0x9e, 0xd0, 0x35, 0x28, 0x52, 0x24,
+	0xaf, 0x29, 0xdb, 0xd8, 0xd1, 0xb4, 0x79, 0x55, 0x6f, 0xc2, 0x99, 0x10, 0x20, 0xd2, 0x62, 0x5b,
+	0xba, 0x4d, 0x09, 0xe3, 0x89, 0xbd, 0x05, 0x94, 0xa4, 0xd6, 0xf6, 0x66, 0x98, 0xab, 0x52, 0xdd,
+	0x5b, 0x70, 0x2e, 0x02, 0x14, 0xe9, 0xbe, 0xcd, 0x3a, 0x84, 0x8a, 0x0e, 0x2b, 0xec, 0x84, 0x15,
+	0x7e, 0x87, 0x55, 0xd8, 0x51, 0x2b, 0xec, 0xc4, 0x2b, 0xfc, 0x2e, 0xcb, 0xda, 0x89, 0x56, 0xf8,
+	0x55, 0xe0, 0xd3, 0x34, 0x4f, 0x6a, 0x9b, 0x4d, 0x6c, 0x8c, 0xc6, 0x12, 0x7b, 0x07, 0x74, 0x05,
+	0x22, 0x92, 0x7b, 0x8f, 0x02, 0xb5, 0x10, 0x18, 0x96, 0x6c, 0xe6, 0x8e, 0xc5, 0x90, 0xb9, 0xc5,
+	0x4a, 0x86, 0x14, 0x59, 0x32, 0x19, 0x2d, 0x92, 0xba, 0xcd, 0x4a, 0x26, 0x40, 0x61, 0x53, 0x50,
+	0x24, 0x6f, 0x8a, 0x3b, 0x7c, 0x0f, 0xe2, 0x8e, 0xed, 0xb0, 0x29, 0x42, 0x80, 0x48, 0xeb, 0x7d,
+	0xd6, 0x14, 0x12, 0x16, 0x36, 0x05, 0xc5, 0x2a, 0x4d, 0xf1, 0x41, 0x98, 0x6b, 0xb4, 0x29, 0x22,
+	0x40, 0x91, 0xee, 0x5d, 0xd6, 0x14, 0x2a, 0x9a, 0x25, 0x6d, 0x7c, 0x2f, 0x05, 0x25, 0xf5, 0x89,
+	0xa9, 0xfe, 0xc3, 0x70, 0x71, 0xea, 0xcc, 0x86, 0x5c, 0xa1, 0xa3, 0x3b, 0xff, 0x50, 0xb7, 0x61,
+	0x67, 0x83, 0xdb, 0xab, 0x5f, 0xa7, 0x6e, 0xb7, 0x9c, 0x59, 0x2d, 0x64, 0x13, 0x6a, 0x8f, 0x4f,
+	0x5e, 0x99, 0xae, 0x8e, 0xa8, 0xfe, 0xc3, 0x44, 0x78, 0x5a, 0xf7, 0xc5, 0xc2, 0x9a, 0x05, 0xec,
+	0xf1, 0xf2, 0x97, 0x00, 0x5f, 0xc9, 0x10, 0xd7, 0x94, 0x3f, 0x59, 0x93, 0xe7, 0x32, 0xfb, 0x76,
+	0xf8, 0x97, 0xbd, 0x4f, 0x56, 0x12, 0xab, 0x0e, 0x60, 0x33, 0x16, 0x7d, 0xaa, 0xc7, 0xb8, 0x11,
+	0xf3, 0xb1, 0xf0, 0x31, 0x6e, 0x75, 0x0c, 0xaf, 0xac, 0xa9, 0xbc, 0xde, 0x5c, 0x51, 0x99, 0x1b,
+	0x27, 0xae, 0x8c, 0x5a, 0x78, 0xc3, 0x81, 0x1c, 0xbf, 0x7e, 0x46, 0xab, 0x0e, 0x0b, 0xcd, 0xd5,
+	0x87, 0xd2, 0xe2, 0x77, 0x85, 0xa9, 0x7c, 0x8e, 0x42, 0x9a, 0x31, 0xfd, 0x34, 0x79, 0x02, 0xfd,
+	0xd4, 0xf8, 0x7d, 0x1d, 0x32, 0xb4, 0x68, 0xdc, 0x1c, 0x3f, 0x11, 0x77, 0xe5, 0xc0, 0x3d, 0x7b,
+	0x50, 0xf5, 0x37, 0xfe, 0x5c, 0x2a, 0xb9, 0x95, 0x3c, 0xe1, 0x73, 0x29, 0xb5, 0x1a, 0xa9, 0xad,
+	0xe4, 0x31, 0xd5, 0x50, 0x9f, 0x2d, 0xa4, 0x63, 0xcf, 0x16, 0xae, 0x41, 0x0a, 0xdf, 0xd3, 0x33,
+	0xeb, 0xb7, 0xb2, 0x72, 0x04, 0x4e, 0x76, 0x09, 0xc6, 0x28, 0x6f, 0x06, 0x8a, 0x27, 0x78, 0xf8,
+	0x71, 0xfa, 0xf7, 0x55, 0x51, 0x7b, 0xea, 0xdc, 0xc9, 0xec, 0xa9, 0x3f, 0x82, 0x92, 0xf2, 0x3e,
+	0x0d, 0x35, 0xe1, 0xd4, 0xda, 0x07, 0x6a, 0xc5, 0xf0, 0x81, 0x9a, 0xbf, 0xc2, 0x9f, 0x43, 0xe1,
+	0x74, 0xfe, 0x1c, 0x56, 0x99, 0x20, 0xc2, 0xd7, 0x34, 0x41, 0xac, 0xfe, 0xda, 0x06, 0x14, 0xa4,
+	0x93, 0x13, 0xfd, 0x4e, 0xe4, 0x81, 0xc5, 0xd5, 0x58, 0x9f, 0xd9, 0x96, 0x40, 0xd5, 0x94, 0x8a,
+	0xf9, 0x7e, 0x28, 0xc5, 0x9f, 0x09, 0x28, 0x9e, 0x53, 0x78, 0x4f, 0xcb, 0x4e, 0xac, 0xc5, 0x6c,
+	0x24, 0x6c, 0xae, 0xb6, 0xd6, 0x27, 0xbe, 0x4b, 0x71, 0x84, 0xe3, 0xf5, 0x87, 0x50, 0x62, 0xff,
+	0x86, 0xf4, 0xc0, 0x8c, 0xdb, 0x27, 0xbe, 0x71, 0x1c, 0xff, 0x03, 0x04, 0x93, 0xe2, 0x24, 0x0c,
+	0xe8, 0x9f, 0x42, 0x8e, 0x59, 0x3c, 0x0a, 0xbb, 0xb5, 0x57, 0xd7, 0x27, 0xc2, 0x2c, 0x01, 0x6d,
+	0x22, 0x38, 0xf4, 0x1a, 0x14, 0x16, 0x33, 0xc1, 0x9e, 0x8e, 0xbf, 0x25, 0x8e, 0xb3, 0x0f, 0x04,
+	0x94, 0x84, 0x5c, 0x28, 0x83, 0x11, 0xb5, 0xb2, 0xa8, 0x64, 0x8e, 0x93, 0x01, 0xb3, 0xc6, 0x20,
+	0x1c, 0x8f, 0x25, 0x1f, 0xdb, 0x7e, 0xe0, 0xb9, 0x2f, 0x2b, 0xd9, 0xe3, 0x4a, 0xde, 0x60, 0x40,
+	0x22, 0x38, 0x74, 0x13, 0x8a, 0xfb, 0x9e, 0xfb, 0x7c, 0xc8, 0x8d, 0xfb, 0xd8, 0x11, 0xe2, 0xeb,
+	0xeb, 0x13, 0x78, 0xe0, 0xb9, 0xcf, 0xb9, 0xc1, 0x1f, 0xec, 0xcb, 0xff, 0xfa, 0x23, 0x28, 0xfb,
+	0x07, 0x9e, 0x33, 0x7b, 0x26, 0x12, 0xda, 0x88, 0x1b, 0xf2, 0xc7, 0x13, 0xea, 0x51, 0x38, 0x4f,
+	0xaa, 0xe4, 0x2b, 0x21, 0x2c, 0x13, 0xab, 0xda, 0x90, 0xda, 0x9c, 0x6e, 0x1e, 0x57, 0x26, 0x26,
+	0x0f, 0x3c, 0x76, 0x25, 0x30, 0x92, 0xff, 0xb1, 0x6f, 0xf0, 0x5a, 0xb2, 0x74, 0xb4, 0xe3, 0xfa,
+	0x06, 0x17, 0x0e, 0x4d, 0xa8, 0x38, 0x0e, 0x03, 0xd5, 0x4f, 0x21, 0xcb, 0xfa, 0x8d, 0x7e, 0x9b,
+	0xef, 0xea, 0x70, 0x60, 0x89, 0x69, 0x5f, 0x8f, 0x1d, 0x1e, 0x50, 0x4b, 0xd6, 0x80, 0xff, 0xf3,
+	0xab, 0x2f, 0xa1, 0xa8, 0x74, 0x3a, 0x74, 0x42, 0x23, 0x46, 0xfc, 0x31, 0x47, 0x36, 0x12, 0xa7,
+	0x7f, 0xc8, 0x73, 0x65, 0x7d, 0x3c, 0x19, 0x7f, 0xd6, 0x80, 0xb9, 0x3e, 0x10, 0xe7, 0xc5, 0x2c,
+	0x6b, 0x1a, 0xac, 0xee, 0xe3, 0xca, 0xc2, 0xba, 0xd7, 0x4d, 0x79, 0x11, 0xb8, 0x7e, 0xa1, 0xe0,
+	0x88, 0xe8, 0x2c, 0x99, 0x38, 0xc1, 0x2c, 0x59, 0xfd, 0x0c, 0x0a, 0xb2, 0x53, 0x7f, 0x0d, 0xf6,
+	0x0f, 0x21, 0xcb, 0xda, 0x50, 0x7f, 0x07, 0x72, 0xac, 0x03, 0x1d, 0xc5, 0x29, 0x20, 0xd5, 0x8f,
+	0x20, 0xc7, 0xdb, 0xec, 0x94, 0x8c, 0x07, 0x00, 0x61, 0x47, 0x46, 0xd9, 0xf0, 0x5e, 0xbb, 0xe4,
+	0x42, 0x28, 0x94, 0x0d, 0x43, 0xe8, 0xdb, 0x90, 0xb7, 0xc6, 0x63, 0x47, 0x1e, 0xc3, 0xac, 0x46,
+	0x4b, 0x4c, 0x75, 0x06, 0x25, 0xb5, 0xa7, 0x9f, 0x2a, 0xaf, 0x3b, 0x90, 0xf7, 0x17, 0x4f, 0xd9,
+	0x03, 0xec, 0xe4, 0x56, 0xf2, 0x08, 0x9d, 0x45, 0xe2, 0xaa, 0xdf, 0xc3, 0x57, 0x89, 0xe1, 0x18,
+	0xb8, 0xa9, 0xdc, 0xff, 0x26, 0x8f, 0x69, 0xf6, 0x87, 0x50, 0x0c, 0x2c, 0x6f, 0x1f, 0x0f, 0x64,
+	0x43, 0x83, 0xfc, 0x13, 0xdb, 0xce, 0x02, 0xe3, 0xed, 0xb3, 0x69, 0x7f, 0x83, 0xa7, 0x24, 0xac,
+	0x4e, 0x99, 0x61, 0x74, 0x99, 0x51, 0xbb, 0x8c, 0x58, 0xfd, 0x04, 0x8a, 0xca, 0x90, 0x3b, 0x4d,
+	0x59, 0x8d, 0x5f, 0x4e, 0xac, 0xb1, 0x8a, 0xde, 0xad, 0x0d, 0xda, 0xf5, 0x87, 0xec, 0x71, 0x17,
+	0xfb, 0x3f, 0x7c, 0x40, 0x3a, 0x83, 0xae, 0x96, 0x45, 0x28, 0x7b, 0xab, 0x87, 0x0f, 0xf7, 0xca,
+	0x50, 0x18, 0xb4, 0x45, 0x30, 0x85, 0x9c, 0x75, 0x62, 0xe2, 0x43, 0xcb, 0x34, 0x7b, 0x4c, 0xdc,
+	0xeb, 0x93, 0xce, 0x97, 0x5a, 0x46, 0xdf, 0x84, 0xe2, 0x03, 0xd2, 0x79, 0x22, 0xde, 0xea, 0x15,
+	0xf1, 0xf9, 0x5e, 0xef, 0x21, 0x69, 0xb6, 0x1f, 0x09, 0x52, 0x09, 0x31, 0x8c, 0x79, 0xd8, 0x68,
+	0xf6, 0x1e, 0x69, 0x65, 0xcc, 0x9b, 0xa7, 0xc0, 0x28, 0x1b, 0xc6, 0xdf, 0x4c, 0xa2, 0x39, 0xc3,
+	0xa1, 0xed, 0xf9, 0xf6, 0x89, 0xf5, 0x2f, 0xae, 0xfb, 0x24, 0xd7, 0xea, 0x3e, 0x71, 0x05, 0x2d,
+	0xf5, 0xb5, 0x14, 0xb4, 0xf4, 0xb1, 0x7a, 0xe6, 0xb2, 0x56, 0x92, 0xd9, 0x4a, 0x9e, 0x4a, 0x2b,
+	0x89, 0x4c, 0x09, 0xd9, 0x93, 0x68, 0xaa, 0xdf, 0xcb, 0x42, 0x5e, 0xcc, 0xa6, 0x2b, 0x8f, 0x6f,
+	0x6f, 0x40, 0x8e, 0xcd, 0xc4, 0xeb, 0xcf, 0x70, 0xb3, 0x74, 0x12, 0x3e, 0xad, 0x3a, 0x1a, 0x29,
+	0x6b, 0xfa, 0x24, 0x3a, 0xa2, 0x3a, 0xa5, 0x67, 0xb6, 0x12, 0x27, 0x9a, 0xd2, 0xbf, 0x3f, 0x8f,
+	0x02, 0x85, 0x13, 0x7b, 0x14, 0xf8, 0x18, 0x4a, 0x07, 0xd4, 0x25, 0xc9, 0x90, 0xba, 0xc5, 0x5a,
+	0xf6, 0x79, 0xa0, 0x38, 0x2c, 0x21, 0xc5, 0x83, 0x30, 0x80, 0x0e, 0xdb, 0x18, 0x4b, 0x39, 0xfe,
+	0x20, 0x26, 0x74, 0x6a, 0xc2, 0x10, 0xe8, 0x8f, 0xe1, 0x99, 0x33, 0x99, 0x0c, 0xe7, 0xd4, 0x19,
+	0x07, 0xd7, 0xf7, 0x14, 0x75, 0x37, 0x74, 0xd4, 0x41, 0xe0, 0x99, 0xfc, 0x2f, 0x7d, 0x11, 0x64,
+	0x15, 0x5f, 0x04, 0xe1, 0x09, 0x3a, 0x1c, 0xe3, 0xe1, 0x2a, 0xe2, 0xb5, 0xa0, 0x78, 0x62, 0xaf,
+	0x05, 0x3b, 0x70, 0x76, 0x6a, 0xbd, 0x18, 0x8e, 0xdc, 0xe9, 0x7c, 0x62, 0x53, 0x25, 0x39, 0x70,
+	0xa4, 0xbe, 0xb2, 0xce, 0x67, 0xc1, 0x99, 0x29, 0xda, 0xaf, 0x09, 0x0e, 0xbc, 0xca, 0xd2, 0x3f,
+	0x84, 0x2c, 0xb7, 0x15, 0xdf, 0xa4, 0x7d, 0xe4, 0xea, 0xb2, 0x16, 0xb0, 0x4d, 0x2f, 0x98, 0xf9,
+	0x76, 0x95, 0xa3, 0xab, 0x5f, 0x40, 0x51, 0x21, 0xff, 0x20, 0xb6, 0xa9, 0xc6, 0x27, 0x50, 0x8e,
+	0xa8, 0x00, 0xf4, 0x4a, 0x89, 0x5e, 0x9a, 0xac, 0x57, 0x50, 0x18, 0xc0, 0xf8, 0x89, 0xec, 0x11,
+	0x37, 0x24, 0xa7, 0x18, 0x62, 0x5f, 0x7f, 0x2a, 0x8a, 0xf9, 0xf2, 0x48, 0xc7, 0xfb, 0xce, 0x1a,
+	0x5f, 0x1e, 0xea, 0x98, 0xce, 0x1c, 0x3b, 0xa6, 0x6f, 0x50, 0x1f, 0x81, 0x01, 0xbe, 0x7e, 0xc0,
+	0x45, 0xed, 0x6c, 0xb4, 0x1e, 0x78, 0x07, 0x63, 0x13, 0x86, 0x88, 0x0e, 0xff, 0xdc, 0x49, 0x86,
+	0xff, 0x2d, 0xc5, 0x81, 0x4e, 0x3e, 0xbe, 0xd3, 0x13, 0xe9, 0x2f, 0xfc, 0xd0, 0xad, 0x8e, 0x5e,
+	0x87, 0xb3, 0xec, 0xff, 0x70, 0x31, 0x1f, 0xa3, 0x9e, 0xcb, 0x0a, 0x57, 0xd8, 0x4a, 0xac, 0x2b,
+	0xdc, 0x19, 0x86, 0x1f, 0x50, 0x38, 0x25, 0xe1, 0x09, 0x58, 0x34, 0x91, 0xc5, 0xc2, 0x61, 0xaf,
+	0xa5, 0x4a, 0x44, 0x53, 0xe1, 0x83, 0x85, 0x33, 0x3e, 0x85, 0xe7, 0xb8, 0xaf, 0xe9, 0x0d, 0x24,
+	0x32, 0x3f, 0x95, 0xbf, 0xf6, 0xfc, 0xb4, 0x79, 0xe2, 0xf9, 0x29, 0x36, 0xe9, 0x68, 0x27, 0x9f,
+	0x74, 0xa8, 0x9b, 0x9c, 0x8d, 0xd0, 0x4d, 0x8e, 0xf1, 0x6d, 0xb8, 0x40, 0xaf, 0x0d, 0x79, 0x93,
+	0xd2, 0xf1, 0xc9, 0x36, 0xbd, 0xa7, 0xd6, 0x65, 0x8d, 0xcb, 0x90, 0x1e, 0x0c, 0xe2, 0x1e, 0x41,
+	0x4b, 0xe2, 0xf5, 0xe9, 0xdf, 0x4b, 0xaa, 0x5b, 0xea, 0xf8, 0xa8, 0x49, 0x9c, 0xd8, 0x21, 0x8d,
+	0xda, 0xfd, 0x93, 0xc7, 0x2e, 0xe0, 0xef, 0x42, 0x9a, 0x9e, 0x04, 0xb0, 0x51, 0x79, 0x71, 0xed,
+	0x0e, 0x88, 0x50, 0x98, 0xfe, 0x2d, 0x28, 0x4f, 0xac, 0xc0, 0xf6, 0x83, 0x21, 0xeb, 0x46, 0x95,
+	0xf4, 0x12, 0x9f, 0xe0, 0xe0, 0x5d, 0xbb, 0xc4, 0xf0, 0x2c, 0xa4, 0xdf, 0x55, 0x06, 0x44, 0x66,
+	0x2b, 0x75, 0x34, 0x6b, 0x38, 0x2a, 0x0c, 0x48, 0xd3, 0x2e, 0x9c, 0xa5, 0xb9, 0x6d, 0x28, 0xca,
+	0xc5, 0xa0, 0xd9, 0x20, 0x34, 0xce, 0xf8, 0xc3, 0x24, 0x6c, 0xc6, 0x52, 0x40, 0x29, 0xba, 0x82,
+	0xb4, 0x52, 0x8a, 0xea, 0x71, 0x43, 0x51, 0x42, 0x9b, 0xe8, 0x6e, 0x93, 0x4f, 0x0b, 0x4c, 0xd7,
+	0xad, 0xac, 0x29, 0xa5, 0x9c, 0x1b, 0x2a, 0x90, 0x9b, 0xda, 0x3e, 0xde, 0x37, 0x73, 0x85, 0x56,
+	0x04, 0x71, 0x44, 0x8f, 0x5c, 0xd4, 0xef, 0x02, 0x7b, 0x3c, 0x3c, 0x89, 0xfa, 0xa0, 0x4b, 0x78,
+	0x78, 0xa6, 0x28, 0x04, 0xc0, 0x74, 0x88, 0x95, 0x02, 0x88, 0x34, 0x7c, 0xf6, 0xd8, 0x86, 0x6f,
+	0xc3, 0x39, 0x51, 0x98, 0xa1, 0xfa, 0x70, 0x37, 0x77, 0x82, 0x87, 0xbb, 0xba, 0x17, 0xa7, 0x8d,
+	0x8d, 0xdf, 0x48, 0xc2, 0x26, 0x1d, 0x7d, 0x4c, 0xf4, 0x5f, 0xc3, 0x47, 0xe1, 0xa7, 0x71, 0xef,
+	0x11, 0xaf, 0xc6, 0x18, 0xc2, 0x94, 0x97, 0x3d, 0x15, 0xde, 0x89, 0x78, 0x2a, 0xbc, 0xba, 0x9e,
+	0x53, 0xf1, 0x57, 0xf8, 0x1e, 0x73, 0xd4, 0x96, 0x8e, 0xbf, 0x22, 0x8d, 0xb3, 0x08, 0xaf, 0x85,
+	0xd5, 0x37, 0x43, 0x87, 0x81, 0x97, 0xd0, 0xb4, 0x83, 0x1a, 0xb5, 0x8c, 0x59, 0xfd, 0x32, 0xa8,
+	0xc1, 0xa1, 0x0d, 0xcb, 0xd8, 0xae, 0xbe, 0xc5, 0x9d, 0x03, 0x5e, 0x83, 0x22, 0x9f, 0x87, 0x25,
+	0xac, 0xcc, 0x8c, 0x1f, 0x16, 0x3e, 0x05, 0xbe, 0xc6, 0xbc, 0x02, 0x5e, 0x86, 0x82, 0xbf, 0x18,
+	0x8d, 0x6c, 0x7b, 0x6c, 0xb3, 0x3e, 0x9a, 0x27, 0x21, 0xc1, 0xf8, 0x6b, 0x9b, 0x00, 0xe1, 0x5a,
+	0xa1, 0x2e, 0xbd, 0x89, 0x63, 0x96, 0xde, 0x1b, 0xd1, 0x4e, 0x7c, 0xd4, 0xda, 0xa6, 0xf4, 0xdf,
+	0x74, 0xb4, 0xff, 0xbe, 0x2f, 0xf7, 0x5e, 0x85, 0xb8, 0x13, 0xae, 0xb0, 0x54, 0x71, 0x03, 0xe1,
+	0xf7, 0xd1, 0xf1, 0x91, 0xe5, 0xbb, 0xcc, 0x5f, 0xcb, 0x3a, 0x26, 0x42, 0x21, 0x84, 0x43, 0xa5,
+	0xd2, 0x97, 0x52, 0x94, 0xbe, 0xe8, 0x62, 0x7e, 0x5c, 0xa7, 0x8e, 0x69, 0x0c, 0xb9, 0x13, 0x6a,
+	0x0c, 0x11, 0xf3, 0x8a, 0x2c, 0xbf, 0x8f, 0x17, 0x04, 0x5d, 0xe7, 0x63, 0xaf, 0xc8, 0x8a, 0x85,
+	0xff, 0x51, 0x5c, 0x6c, 0xc1, 0x79, 0x49, 0xd5, 0xe6, 0x3c, 0x11, 0x41, 0xfd, 0x9b, 0x50, 0xa2,
+	0xcb, 0x95, 0x98, 0x20, 0xd9, 0xaa, 0x75, 0x71, 0x6d, 0xef, 0x22, 0xc5, 0x51, 0x48, 0x50, 0xd6,
+	0xe2, 0xd2, 0x31, 0x6b, 0x71, 0x03, 0x34, 0xb9, 0x54, 0x8a, 0xbc, 0xca, 0x4b, 0x79, 0x09, 0x04,
+	0x9f, 0x51, 0x37, 0x47, 0x51, 0x82, 0xfe, 0x19, 0x68, 0x0b, 0xf6, 0xe0, 0x86, 0xde, 0xfb, 0x28,
+	0xfa, 0xee, 0x2a, 0x67, 0xc9, 0x9b, 0x0a, 0xb6, 0xef, 0x30, 0xdf, 0x26, 0x13, 0xb9, 0x22, 0x56,
+	0xb4, 0xf8, 0xe9, 0xe4, 0xea, 0x95, 0x93, 0x28, 0x3c, 0xc6, 0x7d, 0xf9, 0x30, 0x17, 0x77, 0xcf,
+	0xcc, 0x61, 0x4d, 0xab, 0xd6, 0xeb, 0x9b, 0x84, 0x79, 0x3a, 0xe2, 0x24, 0xea, 0xe5, 0x46, 0x4b,
+	0xa0, 0xbf, 0x56, 0x4e, 0x31, 0xbf, 0x63, 0xd6, 0x07, 0xfd, 0x0e, 0xd1, 0x92, 0xc6, 0x4f, 0x17,
+	0x20, 0xcb, 0xba, 0x92, 0x6e, 0xc0, 0x55, 0x62, 0xd6, 0x7a, 0x9d, 0xf6, 0x90, 0x7b, 0x08, 0x94,
+	0xb8, 0xe1, 0x4e, 0xad, 0xb9, 0x6b, 0x36, 0xb4, 0x6f, 0x44, 0x30, 0xed, 0x7e, 0xad, 0xd9, 0x36,
+	0xc9, 0x90, 0x9f, 0x07, 0x70, 0xcc, 0x79, 0xfd, 0x1a, 0x5c, 0x5a, 0xc6, 0xa0, 0x1f, 0x1d, 0xea,
+	0x82, 0x47, 0x3b, 0xab, 0xbf, 0x0e, 0x5b, 0x47, 0x00, 0xd8, 0xde, 0xfe, 0x9c, 0xfe, 0x26, 0x18,
+	0x47, 0xa1, 0x5a, 0x66, 0xab, 0x43, 0xbe, 0xd4, 0xf2, 0xfa, 0x55, 0xa8, 0x2e, 0xe1, 0xba, 0xc4,
+	0x34, 0x5b, 0xdd, 0xbe, 0xd9, 0xd0, 0xce, 0xac, 0x2c, 0x32, 0xf3, 0xfe, 0x24, 0x8a, 0x7c, 0x41,
+	0xc9, 0xab, 0x55, 0xfb, 0x0e, 0x56, 0xbf, 0xbb, 0x6b, 0xd2, 0x5c, 0xfa, 0xcd, 0x16, 0x7a, 0xcd,
+	0xad, 0xd5, 0x1f, 0x9a, 0x0d, 0xed, 0x55, 0xfd, 0x3a, 0xbc, 0xce, 0x71, 0x52, 0x34, 0xe8, 0xc1,
+	0xb6, 0xd7, 0x27, 0x35, 0x09, 0xef, 0x0c, 0xfa, 0xda, 0x2b, 0xfa, 0x4d, 0x78, 0x73, 0x19, 0xb9,
+	0x12, 0x5b, 0x51, 0x6a, 0x20, 0xb1, 0x7d, 0x93, 0xb4, 0x9a, 0xed, 0x1a, 0xd6, 0x20, 0xa1, 0x6f,
+	0xc1, 0xe5, 0x78, 0xfc, 0xa0, 0xcd, 0xd2, 0x32, 0x89, 0xd9, 0xd0, 0x92, 0xe8, 0xe9, 0x88, 0x23,
+	0x76, 0x48, 0xad, 0x65, 0x3e, 0xe9, 0x10, 0xf4, 0x61, 0xd4, 0xea, 0x3c, 0x36, 0x1b, 0x5a, 0x0a,
+	0x1b, 0x9e, 0xc7, 0x3e, 0xa8, 0x0f, 0x4d, 0x42, 0x3a, 0xe8, 0x2f, 0x29, 0xcc, 0xb4, 0xd9, 0x7e,
+	0x5c, 0xdb, 0x6d, 0x36, 0x42, 0xd6, 0x66, 0x43, 0xcb, 0xe8, 0x17, 0xe1, 0x7c, 0x2c, 0xbe, 0xb3,
+	0xb3, 0x63, 0x92, 0x9e, 0x96, 0x55, 0xca, 0xd3, 0xec, 0x0c, 0x7b, 0x4f, 0x9a, 0xfd, 0xfa, 0xc3,
+	0xfb, 0x9d, 0x1a, 0xc1, 0xfe, 0xd2, 0xc4, 0x12, 0x5f, 0x52, 0x12, 0x67, 0xfd, 0x11, 0xdb, 0xb4,
+	0xde, 0x69, 0xb7, 0xcd, 0x3a, 0xc6, 0xe7, 0x94, 0xc4, 0x89, 0x59, 0xef, 0xb4, 0xeb, 0xcd, 0xdd,
+	0x26, 0xeb, 0x1c, 0x05, 0xa5, 0x2a, 0xd2, 0xd7, 0xf0, 0x50, 0x1c, 0x45, 0xe9, 0xfa, 0x15, 0xb8,
+	0xc8, 0x63, 0xb9, 0xef, 0x26, 0x35, 0x5d, 0x50, 0xd2, 0x8d, 0xb9, 0x76, 0x32, 0xd0, 0xb5, 0x53,
+	0x24, 0x4a, 0x88, 0xa7, 0xa8, 0x74, 0xc7, 0x48, 0xcc, 0xf0, 0xfe, 0x97, 0xdc, 0x71, 0x54, 0x87,
+	0x68, 0xd7, 0x96, 0x72, 0x26, 0xa6, 0xd2, 0x02, 0x5b, 0xcc, 0x63, 0x55, 0x24, 0xba, 0xd7, 0xaf,
+	0x11, 0x2c, 0x55, 0x69, 0x29, 0x6b, 0x51, 0x9d, 0xb2, 0x92, 0xb5, 0x74, 0xc5, 0x6c, 0x36, 0x86,
+	0x8d, 0x01, 0x41, 0x87, 0xcc, 0xfc, 0xcc, 0xed, 0x6a, 0x1c, 0x55, 0x7f, 0x68, 0xd6, 0x1f, 0x51,
+	0x77, 0xcd, 0x83, 0x1e, 0xef, 0xc9, 0x0d, 0xed, 0xb2, 0xfe, 0x36, 0xbc, 0xa5, 0xa2, 0x1e, 0x9a,
+	0xb5, 0xdd, 0xfe, 0xc3, 0xd5, 0xe0, 0x2b, 0x4a, 0x6d, 0x28, 0x98, 0x9e, 0xe5, 0x89, 0x86, 0xd6,
+	0x2e, 0x2a, 0x63, 0x46, 0x89, 0x1e, 0xb4, 0x6b, 0x83, 0xfe, 0xc3, 0x0e, 0x69, 0x7e, 0xd7, 0x6c,
+	0x68, 0x55, 0xe6, 0x58, 0x3a, 0xc4, 0x08, 0xe6, 0x0d, 0xa5, 0x05, 0x69, 0x44, 0x84, 0x6d, 0x33,
+	0xce, 0x26, 0x64, 0xa1, 0x19, 0xef, 0x43, 0x4e, 0xdc, 0xaa, 0x5f, 0x87, 0x0d, 0xcf, 0xde, 0x5b,
+	0xf8, 0xf6, 0x30, 0xf4, 0xd2, 0x4f, 0x5d, 0x96, 0xdf, 0x25, 0x65, 0x16, 0x21, 0x9c, 0x03, 0xfd,
+	0x62, 0x12, 0x8a, 0x8a, 0x2b, 0x50, 0xfd, 0x9b, 0x50, 0x38, 0xb4, 0x3c, 0x07, 0x27, 0x59, 0xb1,
+	0xb1, 0xb8, 0xba, 0xd2, 0x69, 0xe8, 0xf6, 0x63, 0x0e, 0x23, 0x21, 0x43, 0xf5, 0x1f, 0x27, 0x20,
+	0x2f, 0xe8, 0x2b, 0xb7, 0xee, 0xdf, 0x92, 0x1e, 0x9d, 0x62, 0xde, 0xd0, 0x56, 0xa5, 0x4c, 0xf5,
+	0xb3, 0x7b, 0x99, 0xc7, 0xb5, 0xdd, 0x81, 0xc9, 0xd5, 0xb4, 0x73, 0xea, 0x21, 0x84, 0x74, 0x18,
+	0x7a, 0x1d, 0xb2, 0xbe, 0x3d, 0xf2, 0xa4, 0x43, 0x21, 0x65, 0xe1, 0xea, 0x51, 0x3a, 0xe1, 0xf1,
+	0xc6, 0xcd, 0x55, 0xc7, 0xb3, 0x05, 0x60, 0x79, 0x30, 0x1f, 0x8d, 0x3d, 0xb3, 0x4e, 0xd0, 0xd1,
+	0x8e, 0xf1, 0x3e, 0x14, 0xe4, 0x27, 0x00, 0xc2, 0xf3, 0x90, 0xa4, 0x38, 0x0f, 0x51, 0x8a, 0xa2,
+	0xb8, 0xf1, 0xf9, 0x21, 0x00, 0xc9, 0x84, 0xf6, 0x4e, 0x85, 0xb9, 0x08, 0x71, 0x69, 0xae, 0xfc,
+	0xc0, 0x40, 0x88, 0x32, 0xee, 0xd3, 0x73, 0xf2, 0xb1, 0x3d, 0x0b, 0x1c, 0x6b, 0x12, 0x77, 0xd6,
+	0x91, 0x8c, 0x3a, 0xeb, 0xb8, 0x20, 0xeb, 0x9d, 0xe4, 0x2e, 0x76, 0x59, 0x2d, 0x4d, 0x28, 0x86,
+	0x69, 0xa0, 0xb9, 0x65, 0x71, 0x14, 0x06, 0x79, 0x39, 0x14, 0xc5, 0x24, 0xc4, 0x12, 0x15, 0x68,
+	0xfc, 0x85, 0x24, 0x64, 0x99, 0xfc, 0xf4, 0x1b, 0x11, 0x65, 0xfa, 0x7c, 0x5c, 0xbe, 0xaa, 0x26,
+	0xfd, 0x31, 0x6e, 0x4d, 0xb9, 0xb5, 0x24, 0xd7, 0xa5, 0xab, 0x4b, 0x78, 0x69, 0x4f, 0x49, 0x42,
+	0x70, 0x78, 0xc2, 0xb4, 0xe4, 0x47, 0x87, 0x73, 0xd1, 0x83, 0x26, 0xe1, 0xd1, 0xe9, 0x36, 0x14,
+	0x64, 0x2a, 0x2b, 0xfb, 0x1a, 0x6f, 0xb2, 0xa4, 0x3c, 0xc2, 0x42, 0xc7, 0x11, 0x34, 0x09, 0xa9,
+	0xec, 0xb1, 0x3d, 0x30, 0xfd, 0x6f, 0xbc, 0xbb, 0xaa, 0x6b, 0x94, 0xa1, 0x40, 0xcc, 0x1d, 0x93,
+	0x98, 0x6d, 0xea, 0x99, 0x4d, 0xf6, 0x94, 0xa4, 0xf1, 0x04, 0x0a, 0xc4, 0x0a, 0x98, 0x66, 0x81,
+	0x59, 0x7d, 0x35, 0xe7, 0x83, 0x8c, 0xe0, 0xdf, 0xb8, 0x4b, 0x90, 0x58, 0xc3, 0x55, 0x21, 0x8f,
+	0x3e, 0x1b, 0x47, 0xe2, 0xcb, 0x08, 0x69, 0x22, 0xc3, 0xc6, 0xdf, 0x48, 0x00, 0xc8, 0x94, 0xd1,
+	0xfb, 0x94, 0x38, 0xcf, 0x5b, 0xea, 0x3f, 0x12, 0x25, 0x0e, 0xf1, 0xf0, 0x1d, 0x8d, 0xb5, 0xbf,
+	0xef, 0xd9, 0xfb, 0xf4, 0x92, 0x92, 0xbb, 0x44, 0xc3, 0x92, 0xb1, 0x47, 0x62, 0x67, 0x65, 0x24,
+	0xf7, 0xb8, 0xf0, 0xc5, 0xdc, 0xd7, 0xbf, 0x09, 0xd5, 0x65, 0x9e, 0x58, 0xe9, 0x2a, 0x71, 0xc6,
+	0xba, 0x28, 0xed, 0x5f, 0x4a, 0x41, 0xa6, 0x39, 0xb5, 0xf6, 0x43, 0x87, 0x49, 0x4b, 0xce, 0xc5,
+	0x68, 0xb4, 0xda, 0x43, 0xae, 0x43, 0xda, 0x9a, 0xcf, 0x47, 0xbc, 0x73, 0x2c, 0x21, 0x6b, 0xf3,
+	0xf9, 0x88, 0x50, 0x04, 0xba, 0x4a, 0x1a, 0xbb, 0xa3, 0x67, 0xf6, 0x0a, 0xd7, 0x4a, 0x0c, 0xdb,
+	0xa0, 0xb1, 0x84, 0xa3, 0xf4, 0xcb, 0x90, 0xa5, 0x66, 0x7d, 0xec, 0xbc, 0x4e, 0x38, 0x06, 0xe6,
+	0xb4, 0x6a, 0x1f, 0xd2, 0x98, 0xf6, 0xca, 0xce, 0xb2, 0xc1, 0xad, 0xae, 0x85, 0x1f, 0x93, 0x50,
+	0x17, 0x4e, 0x1d, 0xad, 0x0b, 0x57, 0x7f, 0x34, 0x01, 0x59, 0x56, 0x8c, 0x95, 0x09, 0x7f, 0x0c,
+	0x10, 0x8e, 0xa9, 0xe5, 0x2a, 0x87, 0x63, 0x8f, 0xfa, 0x6b, 0x52, 0xb0, 0x58, 0x84, 0x91, 0x3b,
+	0xdb, 0x73, 0xf6, 0x97, 0x8b, 0x20, 0x66, 0x35, 0x16, 0x8f, 0x67, 0x3b, 0xb4, 0xeb, 0xe6, 0x21,
+	0x5d, 0xeb, 0x76, 0xeb, 0x6c, 0x1e, 0x6b, 0x74, 0xea, 0x8f, 0x4c, 0xd4, 0x50, 0x7f, 0x2a, 0x01,
+	0x1a, 0xf5, 0x97, 0xd2, 0xf5, 0xdc, 0xb9, 0xb5, 0xcf, 0x8e, 0x78, 0xde, 0xe7, 0x5f, 0xf4, 0x60,
+	0x03, 0x5a, 0x75, 0x31, 0x1a, 0x43, 0x2a, 0x9f, 0xf5, 0x30, 0x3e, 0xe3, 0x1f, 0xe5, 0x88, 0x0c,
+	0x11, 0xf4, 0x40, 0xd9, 0xe9, 0xf5, 0x87, 0xfd, 0x4e, 0xa8, 0x1f, 0x6a, 0x09, 0xd4, 0xa8, 0xef,
+	0x37, 0x1b, 0x4d, 0x62, 0xd6, 0x51, 0x2f, 0xa9, 0xed, 0x6a, 0x49, 0xe3, 0xdf, 0xe6, 0x20, 0xcb,
+	0xaf, 0x1b, 0x6f, 0xf0, 0xec, 0x53, 0x5b, 0xc9, 0xe8, 0x7c, 0xc2, 0xe2, 0x95, 0x4c, 0xa9, 0xfd,
+	0xaf, 0xdc, 0x6b, 0x50, 0x07, 0x38, 0x4c, 0xbc, 0x65, 0x49, 0xa5, 0x8e, 0x62, 0x2e, 0x41, 0x01,
+	0xad, 0x73, 0x86, 0x8a, 0x1f, 0x71, 0x6a, 0xae, 0x43, 0x23, 0xdf, 0x80, 0x8c, 0x33, 0x15, 0xdb,
+	0xcb, 0xe2, 0x9d, 0xcd, 0x58, 0x37, 0x22, 0x2c, 0x16, 0x5d, 0xf4, 0xf1, 0xdd, 0x66, 0x26, 0x7e,
+	0x50, 0xc8, 0xcb, 0x15, 0xf3, 0x0b, 0xf4, 0x07, 0x19, 0xb9, 0x83, 0xb8, 0x15, 0x99, 0x21, 0x2f,
+	0xaf, 0xe1, 0x54, 0x87, 0x41, 0x13, 0xca, 0xac, 0xdb, 0x0e, 0x23, 0x1e, 0x8c, 0x5e, 0x5f, 0xc7,
+	0xca, 0x3a, 0x99, 0xb0, 0x4c, 0x18, 0x2b, 0x21, 0xfd, 0x33, 0xb5, 0xf2, 0x4b, 0x76, 0x1a, 0xd1,
+	0x64, 0x1e, 0x72, 0xa1, 0x28, 0xe2, 0xd9, 0x81, 0x92, 0x6f, 0xcd, 0xc6, 0x4f, 0xdd, 0x17, 0x43,
+	0xf9, 0x7d, 0x9c, 0x88, 0xa5, 0x48, 0x34, 0x85, 0x1e, 0xc3, 0xd2, 0x44, 0x8a, 0x7e, 0x18, 0x38,
+	0xf9, 0x3a, 0x5c, 0x7d, 0x0e, 0x25, 0xb5, 0x3a, 0xb8, 0x92, 0x8d, 0x3d, 0xe7, 0x90, 0xae, 0x92,
+	0x74, 0x25, 0x63, 0xa1, 0x95, 0x3e, 0x2d, 0x3f, 0x85, 0x0d, 0x16, 0x3b, 0x74, 0xe7, 0xcc, 0x20,
+	0x3c, 0x15, 0x1f, 0x55, 0xe1, 0x12, 0x4c, 0xca, 0x0c, 0xdb, 0x61, 0xd0, 0xea, 0x3e, 0xe4, 0x85,
+	0x00, 0xa4, 0xd7, 0x79, 0x3e, 0x5c, 0xf1, 0xbf, 0xfe, 0x00, 0xce, 0x50, 0x87, 0x47, 0xc3, 0x79,
+	0x38, 0x04, 0x96, 0x57, 0xb1, 0xf8, 0x20, 0x21, 0xda, 0x34, 0x46, 0xa9, 0xfe, 0x6c, 0x02, 0x8a,
+	0x8a, 0xa0, 0xf4, 0x6f, 0x46, 0xfa, 0xc7, 0xf5, 0x13, 0xc8, 0x36, 0xe6, 0xc8, 0x8b, 0x77, 0x6c,
+	0x59, 0x54, 0xe3, 0xc6, 0x1a, 0x4f, 0x47, 0x3d, 0x73, 0x77, 0x87, 0x4d, 0x01, 0xdd, 0x1a, 0xc1,
+	0x9d, 0x6b, 0xd2, 0xe8, 0xad, 0x82, 0x9e, 0x81, 0x32, 0x9b, 0x23, 0xc4, 0x8d, 0x71, 0x02, 0x97,
+	0x3b, 0x3a, 0x96, 0xa9, 0xb3, 0xa4, 0x34, 0xdd, 0x02, 0xd7, 0xda, 0x8d, 0xfb, 0x9d, 0xef, 0x30,
+	0x4a, 0x52, 0xd1, 0x8f, 0x52, 0xc6, 0x85, 0xf0, 0x13, 0x3d, 0xe4, 0x89, 0x96, 0xa0, 0xbf, 0x1d,
+	0x2d, 0x69, 0xfc, 0x4e, 0x0a, 0x8a, 0x8a, 0xcf, 0x7d, 0xfd, 0x3e, 0x94, 0x9c, 0xf9, 0x90, 0x7b,
+	0x44, 0x96, 0x47, 0xaf, 0xd7, 0x56, 0x3b, 0xe8, 0x6f, 0x76, 0x85, 0x0f, 0xe5, 0xa2, 0x33, 0xaf,
+	0x09, 0x1e, 0xd9, 0x0f, 0xb2, 0x8a, 0x3b, 0xf1, 0x0b, 0x90, 0xa5, 0x86, 0x27, 0xec, 0x0d, 0x4c,
+	0x81, 0xf0, 0xd0, 0xc9, 0x1f, 0xbb, 0xe8, 0x3b, 0x50, 0x9e, 0xbb, 0x5e, 0x40, 0x1f, 0xb4, 0x3a,
+	0xb3, 0x7d, 0x71, 0xaf, 0xf2, 0xea, 0x9a, 0x6f, 0x07, 0xb8, 0x5e, 0xd0, 0x62, 0x48, 0x52, 0x9a,
+	0x87, 0x01, 0xbf, 0x3a, 0x81, 0x82, 0x2c, 0x77, 0xe4, 0x5b, 0x04, 0x89, 0xaf, 0xf5, 0x2d, 0x02,
+	0xb4, 0x57, 0x96, 0x02, 0x13, 0xfe, 0xc4, 0xa4, 0x34, 0xaa, 0x53, 0x28, 0x2a, 0x45, 0x09, 0x27,
+	0xbe, 0xf0, 0xf3, 0x05, 0x6c, 0x64, 0xbb, 0x5e, 0x10, 0x9b, 0x3c, 0x11, 0xc1, 0x5c, 0x65, 0x2b,
+	0x93, 0x27, 0xc2, 0xaa, 0x4a, 0x99, 0xd9, 0x09, 0xb2, 0x0c, 0x1b, 0x57, 0x21, 0x2f, 0x4a, 0x8a,
+	0xbd, 0x0b, 0xcb, 0xca, 0xbe, 0x7c, 0xd0, 0xec, 0x1e, 0x7e, 0xa8, 0x25, 0x8d, 0x7f, 0x9d, 0x81,
+	0x8d, 0xd0, 0x83, 0x31, 0x6d, 0xf1, 0x07, 0x31, 0x3f, 0xd6, 0xcc, 0xa9, 0xaf, 0x32, 0x9f, 0x44,
+	0xf1, 0x6b, 0x7d, 0x59, 0x1b, 0x3f, 0x91, 0x89, 0xf8, 0x52, 0x8e, 0x19, 0x55, 0x64, 0xea, 0x0f,
+	0xf1, 0xef, 0x6f, 0xe6, 0xf4, 0x33, 0x50, 0x6a, 0xd4, 0xea, 0xc3, 0xce, 0x63, 0x93, 0x90, 0x66,
+	0xc3, 0xd4, 0x7e, 0x2b, 0xa7, 0x9f, 0x83, 0x4d, 0x24, 0x11, 0xb3, 0xd6, 0x18, 0xf6, 0x4c, 0xf4,
+	0x8f, 0xac, 0xfd, 0xfb, 0x9c, 0x5e, 0x84, 0xec, 0x4e, 0xe7, 0x09, 0xae, 0x50, 0xbf, 0xcd, 0x02,
+	0x3d, 0xb3, 0xdf, 0x6c, 0x68, 0xbf, 0x93, 0xd3, 0x0b, 0x90, 0xc6, 0xdd, 0xa3, 0xf6, 0x1f, 0x28,
+	0xbd, 0x67, 0xf6, 0x1f, 0x34, 0x1b, 0xda, 0xef, 0x8a, 0xc0, 0xa0, 0xd9, 0xd0, 0xfe, 0x63, 0x4e,
+	0x2f, 0x41, 0xae, 0x67, 0xf6, 0xbb, 0xf5, 0x5a, 0x57, 0xfb, 0x4f, 0x34, 0x8b, 0xdd, 0x66, 0x7b,
+	0xf0, 0x9d, 0x61, 0xb3, 0xd5, 0x1a, 0xf4, 0xf1, 0xfb, 0x40, 0xda, 0x7f, 0xce, 0xe9, 0xe7, 0x41,
+	0x6b, 0x9b, 0xfd, 0xe1, 0xfd, 0x66, 0xbb, 0x21, 0x3d, 0xf8, 0xfe, 0x5e, 0x4e, 0xd7, 0xa1, 0x4c,
+	0xc9, 0xa4, 0x53, 0x6b, 0xd4, 0x6b, 0xbd, 0xbe, 0xf6, 0x5f, 0x72, 0xfa, 0x06, 0x14, 0x90, 0x56,
+	0x6b, 0xb4, 0x9a, 0x6d, 0xed, 0xbf, 0xd2, 0xe4, 0x31, 0x8c, 0xae, 0xc8, 0xfe, 0x5b, 0x4e, 0x2f,
+	0x43, 0xbe, 0xd9, 0xad, 0x0f, 0xa9, 0x8b, 0xb2, 0xdf, 0xa7, 0x60, 0x0c, 0xb2, 0xd2, 0xff, 0xf7,
+	0x9c, 0xbe, 0x09, 0xd0, 0xfb, 0xb2, 0x37, 0x6c, 0x75, 0x1a, 0x83, 0x5d, 0x53, 0xfb, 0x1f, 0x14,
+	0x80, 0x04, 0x52, 0x7b, 0xd2, 0xec, 0x68, 0xff, 0x53, 0x02, 0xea, 0x0f, 0x49, 0xa7, 0xd3, 0xd7,
+	0xfe, 0x40, 0x12, 0xba, 0x7d, 0x52, 0xab, 0x9b, 0xda, 0xff, 0x92, 0x1c, 0xdd, 0x5a, 0xbd, 0xde,
+	0xd7, 0xfe, 0x50, 0x86, 0x59, 0x79, 0xfe, 0x37, 0x2d, 0x01, 0x86, 0xef, 0x23, 0xff, 0x1f, 0xc9,
+	0x60, 0x1b, 0x6b, 0xf4, 0xc7, 0x54, 0xe8, 0x34, 0x3f, 0x7e, 0xca, 0xa0, 0xfd, 0x68, 0x5e, 0x20,
+	0xf0, 0x64, 0x46, 0xfb, 0xb1, 0xbc, 0x7e, 0x16, 0x36, 0x68, 0xb0, 0xff, 0x25, 0xaa, 0x06, 0x3b,
+	0xcd, 0x07, 0xda, 0x8f, 0xe7, 0xb1, 0xdd, 0x5a, 0x8f, 0xda, 0x9d, 0x86, 0xf6, 0x13, 0xf4, 0xff,
+	0xae, 0x59, 0xeb, 0x99, 0xda, 0x4f, 0xe6, 0x75, 0x0d, 0x8a, 0xb5, 0x41, 0xa3, 0xd9, 0x1f, 0x3e,
+	0x21, 0xcd, 0xbe, 0xa9, 0xfd, 0xff, 0x79, 0x14, 0x19, 0xa3, 0x70, 0x2f, 0xc9, 0xda, 0x4f, 0xe5,
+	0x79, 0x0b, 0xec, 0x60, 0x0b, 0xfc, 0x74, 0x1e, 0x8b, 0xd0, 0x52, 0xdb, 0xfd, 0x67, 0xf2, 0x58,
+	0x07, 0x24, 0xb1, 0x3a, 0xfc, 0x89, 0x3c, 0x6d, 0xbf, 0x2f, 0x7b, 0xbb, 0x9d, 0x07, 0xda, 0xcf,
+	0xe6, 0x51, 0x02, 0x4f, 0x6a, 0x8f, 0xcc, 0x21, 0xfa, 0x57, 0x6d, 0x69, 0x7f, 0x92, 0x66, 0x41,
+	0x7d, 0xc0, 0x0d, 0x7b, 0x83, 0x5e, 0xd7, 0x6c, 0x37, 0xb4, 0x9f, 0xa3, 0x20, 0x96, 0x2d, 0xf6,
+	0x1d, 0xed, 0x4f, 0xe5, 0x8d, 0x2e, 0x14, 0x7b, 0xf6, 0x08, 0x6f, 0xe6, 0x69, 0xef, 0x7e, 0x15,
+	0x4a, 0xdc, 0x7c, 0x68, 0xa8, 0x7c, 0x71, 0xad, 0xc8, 0x69, 0x6d, 0x9c, 0x9a, 0xae, 0x02, 0x2c,
+	0x66, 0x54, 0x39, 0x9b, 0xd9, 0x63, 0xf1, 0x05, 0x8b, 0x90, 0x62, 0xfc, 0x4c, 0x1a, 0x0a, 0xbb,
+	0xce, 0x6c, 0xf1, 0x82, 0x26, 0x68, 0xc2, 0xa6, 0xec, 0xf5, 0x2f, 0xc5, 0xa3, 0xc9, 0xf8, 0x37,
+	0x8f, 0x22, 0x23, 0x86, 0xea, 0x8a, 0x1b, 0xa3, 0x08, 0x4d, 0x6f, 0xc1, 0xf9, 0xa7, 0xee, 0x62,
+	0x86, 0x6e, 0xd6, 0x86, 0x91, 0xe1, 0x97, 0x3c, 0x3a, 0x31, 0x72, 0x4e, 0xb0, 0xd5, 0x15, 0x2e,
+	0xbd, 0x03, 0x17, 0xec, 0xbd, 0x3d, 0x9b, 0x3f, 0xbf, 0x55, 0xd3, 0x4b, 0x1d, 0x93, 0xde, 0x79,
+	0xc9, 0x17, 0x49, 0x70, 0x1b, 0xce, 0x52, 0x6f, 0x98, 0xc3, 0xb9, 0x33, 0xa6, 0x92, 0xf3, 0xe7,
+	0xd6, 0x48, 0x7c, 0xa4, 0xe3, 0x0c, 0x8d, 0xea, 0x3a, 0xe3, 0xb6, 0x88, 0x40, 0x53, 0x14, 0x9f,
+	0x89, 0x9d, 0xab, 0x34, 0xd1, 0x6d, 0xa7, 0x68, 0x0f, 0x22, 0x50, 0xfa, 0x87, 0xe8, 0x3f, 0x65,
+	0x34, 0xa4, 0x8a, 0x65, 0x36, 0x7e, 0xf2, 0x2f, 0xc5, 0xbd, 0xdd, 0x9c, 0x8f, 0xa8, 0x7a, 0x99,
+	0x73, 0xd8, 0x1f, 0xfd, 0x22, 0xe4, 0xfd, 0x83, 0xe9, 0xd0, 0x77, 0x7e, 0xc4, 0xe6, 0x8e, 0x2a,
+	0x72, 0xfe, 0xc1, 0xb4, 0xe7, 0xfc, 0x08, 0xea, 0xa9, 0x65, 0x56, 0xe6, 0x11, 0x5f, 0x6a, 0xf2,
+	0xca, 0xbe, 0xa2, 0x44, 0xa3, 0xd8, 0x93, 0x78, 0xdf, 0xb8, 0x0b, 0x39, 0x9e, 0xf2, 0xd2, 0x37,
+	0x20, 0xba, 0xa4, 0xf9, 0x98, 0x39, 0xcb, 0xc7, 0x15, 0x15, 0x3f, 0x04, 0x36, 0x94, 0x4b, 0xf3,
+	0xaf, 0xa6, 0x00, 0x08, 0xdd, 0xc5, 0xd1, 0x46, 0xbc, 0x0b, 0x39, 0x2f, 0xb2, 0xdf, 0x53, 0xaa,
+	0x10, 0xc2, 0xf8, 0x5f, 0x22, 0xb0, 0xd5, 0x3f, 0x4e, 0x42, 0x96, 0xd1, 0xf4, 0x0f, 0x22, 0x8a,
+	0xc6, 0xd6, 0x11, 0xec, 0x31, 0x05, 0xe3, 0xc0, 0xf2, 0xc6, 0xdc, 0x53, 0x21, 0xfd, 0x8f, 0x34,
+	0xdf, 0xdd, 0x0b, 0xf8, 0x1e, 0x90, 0xfe, 0x37, 0x7e, 0x21, 0xb9, 0xe6, 0x3b, 0x17, 0x64, 0xb7,
+	0xd5, 0x1f, 0xd6, 0x7a, 0x4c, 0x89, 0xa0, 0x81, 0x7a, 0x87, 0xa0, 0x45, 0x1b, 0xfa, 0xe9, 0xa5,
+	0xc1, 0xee, 0x40, 0x4b, 0xc9, 0xc8, 0x46, 0xad, 0x5f, 0xd3, 0xd2, 0xe8, 0xce, 0x9e, 0x06, 0x77,
+	0xd0, 0xf1, 0xbc, 0x96, 0x91, 0x61, 0x1c, 0x93, 0x78, 0x52, 0x8a, 0x5f, 0x38, 0xc3, 0x70, 0xcb,
+	0x6c, 0xd1, 0x79, 0x30, 0x47, 0xdd, 0xd4, 0x53, 0x4a, 0xef, 0xc1, 0x17, 0x03, 0x73, 0x60, 0x6a,
+	0x79, 0x99, 0x26, 0x9d, 0x98, 0x0a, 0x68, 0xf6, 0xc6, 0x82, 0x9d, 0x9d, 0xe6, 0xae, 0xa9, 0x81,
+	0x4c, 0xb4, 0xdd, 0x25, 0x9d, 0xba, 0x56, 0x94, 0x25, 0x22, 0xbd, 0x1e, 0xb3, 0x92, 0x63, 0xa1,
+	0x7e, 0x97, 0x34, 0x3b, 0x5a, 0x59, 0x21, 0xd0, 0x79, 0x6c, 0x83, 0x1e, 0xff, 0x22, 0xa1, 0xd7,
+	0x7c, 0x80, 0x73, 0x04, 0x1e, 0x87, 0x6e, 0xca, 0x44, 0x7b, 0x7d, 0xf4, 0x86, 0xaf, 0x19, 0x3f,
+	0x99, 0x80, 0x5c, 0xbf, 0xff, 0x25, 0x6d, 0xc4, 0xcf, 0xa0, 0xf8, 0xdc, 0x99, 0x8d, 0xdd, 0xe7,
+	0xac, 0x4f, 0x2d, 0xf9, 0x48, 0xe7, 0xb8, 0xed, 0x27, 0x14, 0x84, 0x1d, 0x8d, 0xc0, 0x73, 0xf9,
+	0xbf, 0x7a, 0x0f, 0x20, 0x8c, 0x61, 0x4e, 0x2e, 0x9f, 0xfb, 0xe2, 0xcb, 0x44, 0xf8, 0x1f, 0x6f,
+	0x80, 0x46, 0xa8, 0x36, 0xce, 0x7c, 0xbe, 0x9e, 0x8b, 0xa0, 0xf1, 0x2b, 0x79, 0x28, 0x47, 0xbd,
+	0xeb, 0xdf, 0x8a, 0xec, 0xcb, 0x2f, 0xaf, 0xb1, 0x7d, 0x50, 0xfb, 0xc2, 0xcd, 0xd0, 0x66, 0x95,
+	0x19, 0xe5, 0x2e, 0x3b, 0x55, 0x15, 0x80, 0xd8, 0x23, 0x89, 0xe8, 0x37, 0x34, 0xee, 0xc5, 0x76,
+	0xef, 0xc6, 0xba, 0xbc, 0xd9, 0x56, 0x40, 0xbc, 0x88, 0xc0, 0xff, 0xfa, 0x47, 0x90, 0xa1, 0x60,
+	0x3e, 0xf4, 0x5f, 0x5d, 0xc7, 0xda, 0x42, 0x32, 0xe5, 0x64, 0x78, 0xfd, 0x1e, 0x75, 0xb6, 0xc0,
+	0x2c, 0x17, 0xa8, 0x31, 0x74, 0x2e, 0xee, 0xb5, 0x5b, 0xd1, 0xc1, 0xa8, 0x7b, 0x05, 0x11, 0xc0,
+	0xe3, 0x13, 0x98, 0xe0, 0x34, 0xc1, 0xe6, 0xe0, 0x7c, 0xdc, 0xbe, 0x4c, 0x4e, 0x21, 0xa4, 0x30,
+	0x11, 0x7f, 0xf1, 0xd6, 0x8f, 0x0d, 0x42, 0xc6, 0x54, 0x88, 0x6f, 0x45, 0xc2, 0x51, 0x47, 0xc0,
+	0x9b, 0x88, 0xff, 0x78, 0xb5, 0x18, 0x88, 0xc9, 0x1e, 0xe2, 0x57, 0x8b, 0xbc, 0x7f, 0x90, 0x5c,
+	0xc0, 0x66, 0xd4, 0xea, 0x2f, 0xa4, 0xf1, 0x73, 0x31, 0x42, 0x48, 0x78, 0xf4, 0xc8, 0xb6, 0xb3,
+	0xdc, 0x83, 0x38, 0x0d, 0xe8, 0xdf, 0x86, 0x1c, 0xaf, 0x0d, 0xff, 0x06, 0xd6, 0xcd, 0xe3, 0xe5,
+	0x2d, 0x84, 0x71, 0x2f, 0x8d, 0x7a, 0x3f, 0x11, 0x09, 0xe8, 0xbd, 0xb8, 0x66, 0xcc, 0x9e, 0x99,
+	0x6f, 0x9f, 0x20, 0xc5, 0xb5, 0x6a, 0x32, 0x7e, 0x1c, 0x73, 0xee, 0x39, 0x87, 0xce, 0xc4, 0xde,
+	0x97, 0x27, 0x34, 0xe2, 0xe3, 0x98, 0x61, 0x04, 0x3e, 0x6d, 0x91, 0xc7, 0xa1, 0x2b, 0x3e, 0xe3,
+	0x11, 0x9e, 0x9a, 0x2a, 0x30, 0x7c, 0x2a, 0xb7, 0xe7, 0x52, 0x73, 0x82, 0xc5, 0x64, 0x32, 0x64,
+	0xd2, 0xa1, 0x1f, 0xb0, 0x22, 0x1b, 0x94, 0xde, 0x5d, 0x4c, 0x26, 0xec, 0x9c, 0xea, 0x2d, 0x28,
+	0xb3, 0xce, 0x3b, 0xe4, 0x3b, 0xce, 0x9c, 0xfc, 0x18, 0x51, 0x89, 0x45, 0x34, 0x28, 0xfd, 0xff,
+	0xb6, 0x9e, 0xfd, 0x3e, 0xe4, 0x78, 0x63, 0xd0, 0xcf, 0x8a, 0x75, 0x7a, 0xfc, 0x9b, 0x41, 0xf7,
+	0x49, 0xb3, 0xf1, 0xc0, 0x64, 0x8e, 0xdf, 0xdb, 0x9d, 0x36, 0x5a, 0x05, 0xe7, 0x21, 0x3d, 0xe8,
+	0xe1, 0x47, 0x3b, 0xaa, 0x77, 0xa0, 0x20, 0x47, 0x40, 0x78, 0xca, 0x91, 0x38, 0xea, 0x94, 0xc3,
+	0xb8, 0x12, 0x7a, 0x9a, 0xe7, 0x67, 0x44, 0xcc, 0x2d, 0xae, 0xd9, 0xeb, 0xf4, 0xb4, 0xa4, 0xf1,
+	0x6f, 0x12, 0xb0, 0x19, 0xbb, 0xba, 0x5d, 0xe1, 0x27, 0x21, 0x71, 0x42, 0x3f, 0x09, 0x4b, 0xc3,
+	0x31, 0x71, 0xf2, 0xe1, 0x78, 0x17, 0x8a, 0x6c, 0xd9, 0x65, 0xc3, 0x64, 0xf9, 0xec, 0x4c, 0x3a,
+	0xa3, 0x21, 0x30, 0x92, 0xff, 0x51, 0x3f, 0x93, 0xf7, 0xf0, 0x73, 0xfe, 0x89, 0xd7, 0x72, 0xf8,
+	0x9e, 0xa9, 0xeb, 0x8c, 0x8d, 0x3f, 0x2a, 0x00, 0x84, 0xdc, 0xfa, 0x07, 0x74, 0xe4, 0x0c, 0x47,
+	0x13, 0xf1, 0xe4, 0xf0, 0xd2, 0xaa, 0x4c, 0xb0, 0xa4, 0x75, 0xdc, 0x3d, 0xce, 0xe8, 0x6f, 0xf5,
+	0xcf, 0xa1, 0x03, 0x61, 0x74, 0x79, 0x53, 0xed, 0x89, 0x73, 0xe5, 0x0f, 0x20, 0xe9, 0xce, 0x97,
+	0x3f, 0xb5, 0xb3, 0xe4, 0x35, 0x27, 0xb4, 0x6c, 0x4a, 0xba, 0xf3, 0xe8, 0xa5, 0x46, 0x5a, 0x9c,
+	0x6f, 0xff, 0x7c, 0x1a, 0x52, 0xf5, 0x9d, 0x2f, 0xaa, 0x3f, 0x95, 0x06, 0x50, 0xbc, 0x31, 0xbc,
+	0x07, 0xd9, 0xb1, 0x7d, 0xe8, 0x48, 0xcf, 0x69, 0xaa, 0x0d, 0x1c, 0xa5, 0x6f, 0xb7, 0x17, 0xd3,
+	0xa7, 0xf4, 0x4c, 0x94, 0x06, 0x71, 0xad, 0xf0, 0xed, 0x51, 0x20, 0xfc, 0x9d, 0xa6, 0x89, 0x08,
+	0xd2, 0xcf, 0x6b, 0x38, 0xfc, 0xfb, 0x7c, 0x69, 0x42, 0xff, 0xeb, 0x35, 0x28, 0x3a, 0xee, 0x90,
+	0x7f, 0x54, 0x65, 0xcc, 0x0d, 0x85, 0xb6, 0x8e, 0xa8, 0x0a, 0xad, 0x38, 0x01, 0xc7, 0xe5, 0x9f,
+	0x38, 0x19, 0xeb, 0xdf, 0x06, 0x2d, 0x4c, 0x42, 0x3a, 0x93, 0x3a, 0x59, 0x3a, 0x1b, 0x32, 0x1d,
+	0xe6, 0x3a, 0xe7, 0x21, 0x6c, 0x2a, 0x69, 0xd1, 0xd2, 0x66, 0x4f, 0x98, 0x54, 0x59, 0x26, 0x45,
+	0xad, 0x05, 0xf0, 0x14, 0xc2, 0x65, 0xbe, 0x60, 0x68, 0x32, 0xb9, 0x93, 0xd7, 0x0c, 0xdd, 0xc3,
+	0xd0, 0x34, 0x3e, 0x83, 0x82, 0xe3, 0x0e, 0xa7, 0xb6, 0xb7, 0x4f, 0x1d, 0x95, 0x9d, 0x2c, 0x81,
+	0xbc, 0xe3, 0xb6, 0x28, 0x07, 0x67, 0xa7, 0x9e, 0x93, 0xc7, 0x95, 0xc2, 0xc9, 0xd9, 0xbf, 0xa0,
+	0x1c, 0xd5, 0x7f, 0x99, 0x00, 0xe0, 0xfe, 0xcd, 0x9c, 0xd9, 0x7e, 0xf5, 0xd7, 0x13, 0xdf, 0x5f,
+	0xbf, 0x88, 0xb5, 0x74, 0xf2, 0x07, 0xd4, 0xd2, 0xa9, 0xaf, 0xd7, 0xd2, 0xd5, 0xdf, 0x8e, 0x56,
+	0xe7, 0x53, 0x48, 0x8d, 0xf6, 0xbe, 0x5a, 0x7e, 0xc0, 0xba, 0x94, 0x5a, 0x7d, 0xe7, 0x0b, 0xd5,
+	0x09, 0x15, 0x72, 0xe1, 0x47, 0x86, 0x47, 0x7b, 0x5f, 0x0d, 0x3d, 0x7b, 0xb4, 0xf0, 0x7c, 0xe7,
+	0x50, 0xbc, 0x2d, 0x3a, 0x45, 0x32, 0xa5, 0xd1, 0xde, 0x57, 0x44, 0xb0, 0xeb, 0x5d, 0x80, 0x40,
+	0x0a, 0x9e, 0xd7, 0xf0, 0xd6, 0x11, 0x89, 0x85, 0xad, 0xa4, 0xa6, 0xa9, 0xa4, 0x61, 0x7c, 0x57,
+	0x35, 0xb7, 0x8c, 0x5f, 0x46, 0xf6, 0x3b, 0xfd, 0xda, 0x2e, 0x3b, 0x63, 0xa1, 0x5b, 0x50, 0xea,
+	0xaa, 0x9c, 0xed, 0x8a, 0xe9, 0xdc, 0xdf, 0xfb, 0xb2, 0x5d, 0xd7, 0xd2, 0x48, 0xac, 0xd1, 0xbf,
+	0x19, 0x4c, 0x01, 0x2f, 0xf2, 0x6b, 0xa4, 0xa1, 0x65, 0xab, 0x06, 0x64, 0xd9, 0x4c, 0x45, 0xd5,
+	0xc4, 0x89, 0xe5, 0xfb, 0xdc, 0xf8, 0xb0, 0x4c, 0x44, 0xd0, 0xb8, 0x8d, 0x2f, 0xc7, 0xe8, 0x19,
+	0xd8, 0x5b, 0xf2, 0xb4, 0x8c, 0xc9, 0x7a, 0x33, 0x76, 0x5a, 0x26, 0x3d, 0xc3, 0xbc, 0x07, 0x19,
+	0x4a, 0x38, 0xfa, 0x2a, 0x34, 0xbc, 0x95, 0x35, 0xfe, 0x6e, 0x02, 0xd2, 0x74, 0xd5, 0xbb, 0x00,
+	0xd9, 0x19, 0xed, 0x7b, 0x7c, 0xd9, 0xe4, 0x21, 0xe5, 0x70, 0x57, 0xfd, 0x46, 0xe0, 0xda, 0x15,
+	0x52, 0xbf, 0x0f, 0x70, 0xe8, 0xf8, 0x0e, 0x7f, 0xeb, 0xc1, 0x3e, 0x88, 0x60, 0xac, 0x31, 0x01,
+	0xde, 0x7e, 0x2c, 0x91, 0x44, 0xe1, 0x52, 0x0e, 0x07, 0x33, 0xc7, 0x78, 0xc2, 0x79, 0x17, 0x32,
+	0xcc, 0xf3, 0xe8, 0xeb, 0x90, 0xc1, 0x15, 0x5d, 0x08, 0x48, 0xb1, 0x7f, 0xc4, 0x78, 0xc2, 0x22,
+	0x8d, 0x5f, 0x4b, 0x42, 0x39, 0x52, 0x82, 0x58, 0x71, 0x99, 0xfa, 0x7d, 0xda, 0xe2, 0xae, 0x12,
+	0xd1, 0x56, 0xf4, 0xcb, 0x9e, 0x4c, 0x4a, 0xb1, 0x4f, 0x78, 0xe6, 0xc5, 0x83, 0x59, 0xa1, 0x94,
+	0x8b, 0xb0, 0xfa, 0x61, 0xbb, 0x4c, 0xf4, 0xc3, 0x76, 0x6f, 0x88, 0x7a, 0x66, 0xe3, 0xea, 0x03,
+	0x95, 0x03, 0xaf, 0xa8, 0x22, 0xc1, 0xdc, 0x31, 0x12, 0xfc, 0x10, 0x20, 0xac, 0x16, 0xee, 0xd2,
+	0xa4, 0x81, 0x0c, 0xff, 0x8e, 0xea, 0xee, 0x80, 0xda, 0x5a, 0xd1, 0xaf, 0xbb, 0x9b, 0xdf, 0xe9,
+	0x9b, 0x84, 0xdd, 0x13, 0x7d, 0x0c, 0xf0, 0xc4, 0x76, 0xf6, 0x0f, 0x98, 0xa6, 0x7c, 0x01, 0xb2,
+	0xcf, 0x69, 0x88, 0xfb, 0x1e, 0xe2, 0x21, 0xf9, 0x6d, 0x8b, 0x64, 0xf8, 0x6d, 0x0b, 0xe3, 0x9f,
+	0x25, 0xa0, 0xf8, 0x98, 0x55, 0x87, 0xf2, 0x2a, 0x95, 0x65, 0xdd, 0x55, 0x56, 0x96, 0x7a, 0xd8,
+	0x71, 0x26, 0xe3, 0xe1, 0xd8, 0x0a, 0x44, 0x1a, 0x05, 0x4a, 0x69, 0x58, 0x81, 0x1d, 0x46, 0xcb,
+	0x05, 0x32, 0xc1, 0xa3, 0xe9, 0x42, 0x20, 0xa3, 0xa9, 0xc9, 0x76, 0x5a, 0xe1, 0x46, 0x17, 0x9c,
+	0xfa, 0x2b, 0x90, 0xdb, 0x77, 0x82, 0xa1, 0x7f, 0x60, 0x71, 0x19, 0x67, 0xf7, 0x9d, 0xa0, 0x77,
+	0x60, 0x21, 0x1f, 0x46, 0x30, 0x3f, 0x10, 0xfc, 0x30, 0xbb, 0xb0, 0xef, 0x04, 0xf7, 0x29, 0x41,
+	0xf0, 0x05, 0xd6, 0xbe, 0xf8, 0xca, 0xc2, 0xbe, 0x13, 0xf4, 0xad, 0x7d, 0xe3, 0x16, 0xa4, 0x77,
+	0x26, 0xd6, 0xfe, 0xca, 0x0b, 0xc6, 0xd5, 0x83, 0xef, 0x97, 0x12, 0x90, 0x26, 0xee, 0x1a, 0x2b,
+	0x8c, 0x50, 0xa4, 0xc9, 0x88, 0x48, 0xef, 0x02, 0x48, 0x63, 0x6e, 0x31, 0x93, 0xaf, 0xb1, 0xfa,
+	0x56, 0x80, 0xa7, 0x7f, 0x99, 0x64, 0xdc, 0x81, 0x6c, 0xcb, 0x0e, 0x3c, 0x67, 0x74, 0x7c, 0x8d,
+	0xc4, 0x17, 0x9c, 0x8c, 0xbf, 0x9e, 0x80, 0x3c, 0xfa, 0x95, 0x14, 0x2f, 0xaf, 0x96, 0xae, 0x6e,
+	0xce, 0x41, 0x66, 0x36, 0x71, 0x66, 0x6c, 0xf7, 0x93, 0x21, 0x2c, 0x80, 0x48, 0xba, 0x09, 0x17,
+	0x87, 0x18, 0xb8, 0xa5, 0xbe, 0x0e, 0x99, 0x29, 0x6d, 0xd8, 0xf4, 0x5a, 0x6b, 0x43, 0x06, 0x40,
+	0x6e, 0x7a, 0x9c, 0x44, 0x3f, 0x22, 0xcd, 0x2f, 0x24, 0x35, 0x48, 0x2d, 0xb8, 0x95, 0x73, 0x81,
+	0xe0, 0x5f, 0xa4, 0xec, 0x73, 0x8b, 0xcf, 0x02, 0xc1, 0xbf, 0xc6, 0x5f, 0xc4, 0x4b, 0x61, 0xb6,
+	0xce, 0x86, 0x45, 0x0d, 0xbf, 0x6d, 0xfc, 0x9e, 0x9c, 0x11, 0x93, 0xc7, 0x2c, 0xd6, 0x0c, 0x56,
+	0x6d, 0x43, 0x96, 0x51, 0x42, 0xe7, 0x4a, 0xca, 0x94, 0x2a, 0x9c, 0x2b, 0x29, 0x10, 0x67, 0x16,
+	0x42, 0x92, 0x1c, 0xe2, 0xcc, 0x04, 0xc4, 0xf8, 0xdb, 0x09, 0x28, 0xb1, 0x9c, 0x6a, 0xa3, 0x91,
+	0xed, 0xd3, 0x51, 0x2d, 0xd5, 0x87, 0x98, 0x5d, 0x2f, 0xc3, 0x49, 0xbd, 0xe1, 0x2e, 0x64, 0x2d,
+	0xca, 0xc3, 0x1f, 0xdf, 0x5c, 0x89, 0x23, 0x59, 0x8a, 0xdb, 0xec, 0x87, 0x70, 0x70, 0xf5, 0x21,
+	0x64, 0x79, 0x56, 0x38, 0x70, 0x6d, 0x4b, 0xd8, 0x1a, 0xd3, 0xff, 0xd8, 0x76, 0xcf, 0x3d, 0x27,
+	0x10, 0x5f, 0xfb, 0x65, 0x01, 0xa4, 0x4e, 0x9f, 0xcd, 0x5c, 0xa6, 0xc2, 0xe7, 0x09, 0x0b, 0x18,
+	0x04, 0x36, 0x59, 0x46, 0x4f, 0x0e, 0x9c, 0xc0, 0x9e, 0x38, 0x7e, 0xa0, 0xff, 0x10, 0xf3, 0x2e,
+	0xf0, 0xdc, 0x1e, 0x0f, 0x59, 0x29, 0xc5, 0x64, 0x7d, 0x61, 0x75, 0xe1, 0x98, 0x4b, 0x81, 0xe7,
+	0xf6, 0x98, 0x11, 0x7d, 0xc3, 0x87, 0x62, 0xc3, 0xb3, 0xe8, 0x77, 0xf0, 0xf6, 0x9c, 0x7d, 0xfd,
+	0x73, 0xd0, 0xf0, 0x31, 0xd6, 0x29, 0x3e, 0xdd, 0xbc, 0x31, 0xb5, 0x5e, 0xa8, 0x1f, 0x8e, 0x35,
+	0xa0, 0x30, 0xb5, 0xbc, 0x67, 0xc3, 0x7d, 0xf6, 0xf1, 0x54, 0x65, 0xab, 0x9b, 0x47, 0xfa, 0x03,
+	0x77, 0x66, 0x1b, 0x7b, 0x50, 0xa0, 0x99, 0xd2, 0x1e, 0x7d, 0x53, 0x98, 0x4b, 0x2f, 0xd9, 0x4f,
+	0x50, 0x4c, 0xc4, 0x5e, 0xfa, 0x5d, 0x69, 0x19, 0xb0, 0xe4, 0xfb, 0x42, 0xa9, 0x85, 0x30, 0x0f,
+	0xb8, 0xf9, 0xff, 0x41, 0x96, 0xef, 0xe3, 0x2e, 0x80, 0xde, 0x20, 0xcd, 0xc7, 0x26, 0x19, 0xb6,
+	0x3b, 0xfd, 0xa1, 0x30, 0xb0, 0xa3, 0xdf, 0xf0, 0xe4, 0x74, 0x32, 0x68, 0xb3, 0x6f, 0x78, 0x2a,
+	0xb4, 0xda, 0xfd, 0x0e, 0xc5, 0xa5, 0x14, 0x5a, 0xaf, 0xdf, 0xe9, 0x76, 0xcd, 0x86, 0x96, 0xbe,
+	0xf9, 0x73, 0x49, 0x28, 0x48, 0x8b, 0x6e, 0x3c, 0xb0, 0xa3, 0x66, 0x69, 0xbd, 0x7e, 0xed, 0x01,
+	0xa6, 0x93, 0xc5, 0x03, 0x3b, 0x41, 0x21, 0x7d, 0x24, 0x7d, 0x43, 0x82, 0x44, 0x66, 0x09, 0x49,
+	0xc1, 0x2b, 0x18, 0xa4, 0xe4, 0x25, 0xdb, 0x4e, 0xb3, 0xdd, 0xec, 0x3d, 0xa4, 0x46, 0x9a, 0x9b,
+	0x50, 0x64, 0x24, 0x66, 0x75, 0x9a, 0x92, 0x04, 0x66, 0xf6, 0xc7, 0x8e, 0x13, 0x29, 0x81, 0xd9,
+	0x68, 0xe2, 0x8d, 0x45, 0x81, 0x86, 0x77, 0x71, 0x37, 0x9d, 0x91, 0xb9, 0x34, 0x08, 0x2b, 0x7c,
+	0x41, 0x3f, 0x07, 0x1a, 0xb7, 0xa2, 0xa3, 0x36, 0xaa, 0xf4, 0x0e, 0x07, 0x24, 0xdb, 0x03, 0xdc,
+	0x6e, 0x17, 0xd1, 0x28, 0x52, 0x06, 0x23, 0x46, 0x8d, 0x25, 0x99, 0xa2, 0xb4, 0x48, 0xbc, 0xf9,
+	0x33, 0x49, 0xd8, 0x88, 0xbe, 0xd2, 0x40, 0xfe, 0xf0, 0x03, 0x9a, 0x83, 0x76, 0x6f, 0xd0, 0xed,
+	0x32, 0x81, 0x52, 0xe3, 0x89, 0x30, 0x4a, 0x1c, 0x2e, 0x26, 0xb0, 0x9d, 0x42, 0xb2, 0x22, 0x81,
+	0x73, 0xa0, 0x29, 0x74, 0x21, 0x86, 0xb3, 0xb0, 0x19, 0x52, 0x85, 0x79, 0x6a, 0x24, 0x65, 0x51,
+	0xe1, 0x4c, 0xbc, 0x2c, 0x61, 0xad, 0xb3, 0x68, 0x73, 0x1a, 0x46, 0x2d, 0xd5, 0x95, 0x7e, 0xdb,
+	0x33, 0x8c, 0x47, 0xb3, 0xd3, 0xc7, 0x26, 0x61, 0x6d, 0x15, 0xc9, 0x4b, 0x88, 0xa2, 0x70, 0xf3,
+	0x03, 0x80, 0xb0, 0xef, 0x46, 0xb5, 0xdc, 0x12, 0xe4, 0xa5, 0x69, 0x29, 0xfb, 0x52, 0x14, 0x86,
+	0xb0, 0x8e, 0xf7, 0xaf, 0xc0, 0x59, 0xd7, 0xdb, 0xdf, 0x46, 0x03, 0xa3, 0x03, 0x5b, 0x76, 0xec,
+	0xfb, 0x59, 0xf6, 0xa9, 0x95, 0xff, 0x33, 0x00, 0xa7, 0x24, 0xe2, 0x3b, 0x26, 0x8a, 0x00, 0x00,
+}
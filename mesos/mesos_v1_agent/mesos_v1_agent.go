@@ -0,0 +1,2439 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1_agent.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1_agent is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1_agent.proto
+
+It has these top-level messages:
+	Call
+	Response
+	ProcessIO
+*/
+package mesos_v1_agent
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import mesos_v1 "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+// If a call of type `Call::FOO` requires additional parameters they can be
+// included in the corresponding `Call::Foo` message. Similarly, if a call
+// receives a synchronous response it will be returned as a `Response`
+// message of type `Response::FOO`; see `Call::LaunchNestedContainerSession`
+// and `Call::AttachContainerOutput` for exceptions.
+type Call_Type int32
+
+const (
+	Call_UNKNOWN           Call_Type = 0
+	Call_GET_HEALTH        Call_Type = 1
+	Call_GET_FLAGS         Call_Type = 2
+	Call_GET_VERSION       Call_Type = 3
+	Call_GET_METRICS       Call_Type = 4
+	Call_GET_LOGGING_LEVEL Call_Type = 5
+	Call_SET_LOGGING_LEVEL Call_Type = 6
+	Call_LIST_FILES        Call_Type = 7
+	Call_READ_FILE         Call_Type = 8
+	Call_GET_STATE         Call_Type = 9
+	Call_GET_CONTAINERS    Call_Type = 10
+	// Retrieves the information about known frameworks.
+	Call_GET_FRAMEWORKS Call_Type = 11
+	// Retrieves the information about known executors.
+	Call_GET_EXECUTORS Call_Type = 12
+	// Retrieves the information about known operations.
+	Call_GET_OPERATIONS Call_Type = 31
+	// Retrieves the information about known tasks.
+	Call_GET_TASKS Call_Type = 13
+	// Retrieves the agent information.
+	Call_GET_AGENT Call_Type = 20
+	// Retrieves the information about known resource providers.
+	Call_GET_RESOURCE_PROVIDERS Call_Type = 26
+	// Calls for managing nested containers underneath an executor's container.
+	// Some of these calls are deprecated in favor of the calls
+	// for both standalone or nested containers further below.
+	Call_LAUNCH_NESTED_CONTAINER Call_Type = 14
+	Call_WAIT_NESTED_CONTAINER   Call_Type = 15
+	Call_KILL_NESTED_CONTAINER   Call_Type = 16
+	Call_REMOVE_NESTED_CONTAINER Call_Type = 21
+	// See 'LaunchNestedContainerSession' below.
+	Call_LAUNCH_NESTED_CONTAINER_SESSION Call_Type = 17
+	Call_ATTACH_CONTAINER_INPUT          Call_Type = 18
+	Call_ATTACH_CONTAINER_OUTPUT         Call_Type = 19
+	// Calls for managing standalone containers
+	// or containers nested underneath another container.
+	Call_LAUNCH_CONTAINER                Call_Type = 22
+	Call_WAIT_CONTAINER                  Call_Type = 23
+	Call_KILL_CONTAINER                  Call_Type = 24
+	Call_REMOVE_CONTAINER                Call_Type = 25
+	Call_ADD_RESOURCE_PROVIDER_CONFIG    Call_Type = 27
+	Call_UPDATE_RESOURCE_PROVIDER_CONFIG Call_Type = 28
+	Call_REMOVE_RESOURCE_PROVIDER_CONFIG Call_Type = 29
+	Call_MARK_RESOURCE_PROVIDER_GONE     Call_Type = 32
+	// Prune unused container images.
+	Call_PRUNE_IMAGES Call_Type = 30
+)
+
+var Call_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "GET_HEALTH",
+	2:  "GET_FLAGS",
+	3:  "GET_VERSION",
+	4:  "GET_METRICS",
+	5:  "GET_LOGGING_LEVEL",
+	6:  "SET_LOGGING_LEVEL",
+	7:  "LIST_FILES",
+	8:  "READ_FILE",
+	9:  "GET_STATE",
+	10: "GET_CONTAINERS",
+	11: "GET_FRAMEWORKS",
+	12: "GET_EXECUTORS",
+	31: "GET_OPERATIONS",
+	13: "GET_TASKS",
+	20: "GET_AGENT",
+	26: "GET_RESOURCE_PROVIDERS",
+	14: "LAUNCH_NESTED_CONTAINER",
+	15: "WAIT_NESTED_CONTAINER",
+	16: "KILL_NESTED_CONTAINER",
+	21: "REMOVE_NESTED_CONTAINER",
+	17: "LAUNCH_NESTED_CONTAINER_SESSION",
+	18: "ATTACH_CONTAINER_INPUT",
+	19: "ATTACH_CONTAINER_OUTPUT",
+	22: "LAUNCH_CONTAINER",
+	23: "WAIT_CONTAINER",
+	24: "KILL_CONTAINER",
+	25: "REMOVE_CONTAINER",
+	27: "ADD_RESOURCE_PROVIDER_CONFIG",
+	28: "UPDATE_RESOURCE_PROVIDER_CONFIG",
+	29: "REMOVE_RESOURCE_PROVIDER_CONFIG",
+	32: "MARK_RESOURCE_PROVIDER_GONE",
+	30: "PRUNE_IMAGES",
+}
+var Call_Type_value = map[string]int32{
+	"UNKNOWN":                         0,
+	"GET_HEALTH":                      1,
+	"GET_FLAGS":                       2,
+	"GET_VERSION":                     3,
+	"GET_METRICS":                     4,
+	"GET_LOGGING_LEVEL":               5,
+	"SET_LOGGING_LEVEL":               6,
+	"LIST_FILES":                      7,
+	"READ_FILE":                       8,
+	"GET_STATE":                       9,
+	"GET_CONTAINERS":                  10,
+	"GET_FRAMEWORKS":                  11,
+	"GET_EXECUTORS":                   12,
+	"GET_OPERATIONS":                  31,
+	"GET_TASKS":                       13,
+	"GET_AGENT":                       20,
+	"GET_RESOURCE_PROVIDERS":          26,
+	"LAUNCH_NESTED_CONTAINER":         14,
+	"WAIT_NESTED_CONTAINER":           15,
+	"KILL_NESTED_CONTAINER":           16,
+	"REMOVE_NESTED_CONTAINER":         21,
+	"LAUNCH_NESTED_CONTAINER_SESSION": 17,
+	"ATTACH_CONTAINER_INPUT":          18,
+	"ATTACH_CONTAINER_OUTPUT":         19,
+	"LAUNCH_CONTAINER":                22,
+	"WAIT_CONTAINER":                  23,
+	"KILL_CONTAINER":                  24,
+	"REMOVE_CONTAINER":                25,
+	"ADD_RESOURCE_PROVIDER_CONFIG":    27,
+	"UPDATE_RESOURCE_PROVIDER_CONFIG": 28,
+	"REMOVE_RESOURCE_PROVIDER_CONFIG": 29,
+	"MARK_RESOURCE_PROVIDER_GONE":     32,
+	"PRUNE_IMAGES":                    30,
+}
+
+func (x Call_Type) Enum() *Call_Type {
+	p := new(Call_Type)
+	*p = x
+	return p
+}
+func (x Call_Type) String() string {
+	return proto.EnumName(Call_Type_name, int32(x))
+}
+func (x *Call_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Call_Type_value, data, "Call_Type")
+	if err != nil {
+		return err
+	}
+	*x = Call_Type(value)
+	return nil
+}
+func (Call_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Call_AttachContainerInput_Type int32
+
+const (
+	Call_AttachContainerInput_UNKNOWN      Call_AttachContainerInput_Type = 0
+	Call_AttachContainerInput_CONTAINER_ID Call_AttachContainerInput_Type = 1
+	Call_AttachContainerInput_PROCESS_IO   Call_AttachContainerInput_Type = 2
+)
+
+var Call_AttachContainerInput_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CONTAINER_ID",
+	2: "PROCESS_IO",
+}
+var Call_AttachContainerInput_Type_value = map[string]int32{
+	"UNKNOWN":      0,
+	"CONTAINER_ID": 1,
+	"PROCESS_IO":   2,
+}
+
+func (x Call_AttachContainerInput_Type) Enum() *Call_AttachContainerInput_Type {
+	p := new(Call_AttachContainerInput_Type)
+	*p = x
+	return p
+}
+func (x Call_AttachContainerInput_Type) String() string {
+	return proto.EnumName(Call_AttachContainerInput_Type_name, int32(x))
+}
+func (x *Call_AttachContainerInput_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Call_AttachContainerInput_Type_value, data, "Call_AttachContainerInput_Type")
+	if err != nil {
+		return err
+	}
+	*x = Call_AttachContainerInput_Type(value)
+	return nil
+}
+func (Call_AttachContainerInput_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 10, 0}
+}
+
+// Each of the responses of type `FOO` corresponds to `Foo` message below.
+type Response_Type int32
+
+const (
+	Response_UNKNOWN                Response_Type = 0
+	Response_GET_HEALTH             Response_Type = 1
+	Response_GET_FLAGS              Response_Type = 2
+	Response_GET_VERSION            Response_Type = 3
+	Response_GET_METRICS            Response_Type = 4
+	Response_GET_LOGGING_LEVEL      Response_Type = 5
+	Response_LIST_FILES             Response_Type = 6
+	Response_READ_FILE              Response_Type = 7
+	Response_GET_STATE              Response_Type = 8
+	Response_GET_CONTAINERS         Response_Type = 9
+	Response_GET_FRAMEWORKS         Response_Type = 10
+	Response_GET_EXECUTORS          Response_Type = 11
+	Response_GET_OPERATIONS         Response_Type = 17
+	Response_GET_TASKS              Response_Type = 12
+	Response_GET_AGENT              Response_Type = 14
+	Response_GET_RESOURCE_PROVIDERS Response_Type = 16
+	Response_WAIT_NESTED_CONTAINER  Response_Type = 13
+	Response_WAIT_CONTAINER         Response_Type = 15
+)
+
+var Response_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "GET_HEALTH",
+	2:  "GET_FLAGS",
+	3:  "GET_VERSION",
+	4:  "GET_METRICS",
+	5:  "GET_LOGGING_LEVEL",
+	6:  "LIST_FILES",
+	7:  "READ_FILE",
+	8:  "GET_STATE",
+	9:  "GET_CONTAINERS",
+	10: "GET_FRAMEWORKS",
+	11: "GET_EXECUTORS",
+	17: "GET_OPERATIONS",
+	12: "GET_TASKS",
+	14: "GET_AGENT",
+	16: "GET_RESOURCE_PROVIDERS",
+	13: "WAIT_NESTED_CONTAINER",
+	15: "WAIT_CONTAINER",
+}
+var Response_Type_value = map[string]int32{
+	"UNKNOWN":                0,
+	"GET_HEALTH":             1,
+	"GET_FLAGS":              2,
+	"GET_VERSION":            3,
+	"GET_METRICS":            4,
+	"GET_LOGGING_LEVEL":      5,
+	"LIST_FILES":             6,
+	"READ_FILE":              7,
+	"GET_STATE":              8,
+	"GET_CONTAINERS":         9,
+	"GET_FRAMEWORKS":         10,
+	"GET_EXECUTORS":          11,
+	"GET_OPERATIONS":         17,
+	"GET_TASKS":              12,
+	"GET_AGENT":              14,
+	"GET_RESOURCE_PROVIDERS": 16,
+	"WAIT_NESTED_CONTAINER":  13,
+	"WAIT_CONTAINER":         15,
+}
+
+func (x Response_Type) Enum() *Response_Type {
+	p := new(Response_Type)
+	*p = x
+	return p
+}
+func (x Response_Type) String() string {
+	return proto.EnumName(Response_Type_name, int32(x))
+}
+func (x *Response_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Response_Type_value, data, "Response_Type")
+	if err != nil {
+		return err
+	}
+	*x = Response_Type(value)
+	return nil
+}
+func (Response_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+type ProcessIO_Type int32
+
+const (
+	ProcessIO_UNKNOWN ProcessIO_Type = 0
+	ProcessIO_DATA    ProcessIO_Type = 1
+	ProcessIO_CONTROL ProcessIO_Type = 2
+)
+
+var ProcessIO_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DATA",
+	2: "CONTROL",
+}
+var ProcessIO_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"DATA":    1,
+	"CONTROL": 2,
+}
+
+func (x ProcessIO_Type) Enum() *ProcessIO_Type {
+	p := new(ProcessIO_Type)
+	*p = x
+	return p
+}
+func (x ProcessIO_Type) String() string {
+	return proto.EnumName(ProcessIO_Type_name, int32(x))
+}
+func (x *ProcessIO_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ProcessIO_Type_value, data, "ProcessIO_Type")
+	if err != nil {
+		return err
+	}
+	*x = ProcessIO_Type(value)
+	return nil
+}
+func (ProcessIO_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+type ProcessIO_Data_Type int32
+
+const (
+	ProcessIO_Data_UNKNOWN ProcessIO_Data_Type = 0
+	ProcessIO_Data_STDIN   ProcessIO_Data_Type = 1
+	ProcessIO_Data_STDOUT  ProcessIO_Data_Type = 2
+	ProcessIO_Data_STDERR  ProcessIO_Data_Type = 3
+)
+
+var ProcessIO_Data_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "STDIN",
+	2: "STDOUT",
+	3: "STDERR",
+}
+var ProcessIO_Data_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"STDIN":   1,
+	"STDOUT":  2,
+	"STDERR":  3,
+}
+
+func (x ProcessIO_Data_Type) Enum() *ProcessIO_Data_Type {
+	p := new(ProcessIO_Data_Type)
+	*p = x
+	return p
+}
+func (x ProcessIO_Data_Type) String() string {
+	return proto.EnumName(ProcessIO_Data_Type_name, int32(x))
+}
+func (x *ProcessIO_Data_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ProcessIO_Data_Type_value, data, "ProcessIO_Data_Type")
+	if err != nil {
+		return err
+	}
+	*x = ProcessIO_Data_Type(value)
+	return nil
+}
+func (ProcessIO_Data_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0, 0} }
+
+type ProcessIO_Control_Type int32
+
+const (
+	ProcessIO_Control_UNKNOWN   ProcessIO_Control_Type = 0
+	ProcessIO_Control_TTY_INFO  ProcessIO_Control_Type = 1
+	ProcessIO_Control_HEARTBEAT ProcessIO_Control_Type = 2
+)
+
+var ProcessIO_Control_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "TTY_INFO",
+	2: "HEARTBEAT",
+}
+var ProcessIO_Control_Type_value = map[string]int32{
+	"UNKNOWN":   0,
+	"TTY_INFO":  1,
+	"HEARTBEAT": 2,
+}
+
+func (x ProcessIO_Control_Type) Enum() *ProcessIO_Control_Type {
+	p := new(ProcessIO_Control_Type)
+	*p = x
+	return p
+}
+func (x ProcessIO_Control_Type) String() string {
+	return proto.EnumName(ProcessIO_Control_Type_name, int32(x))
+}
+func (x *ProcessIO_Control_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ProcessIO_Control_Type_value, data, "ProcessIO_Control_Type")
+	if err != nil {
+		return err
+	}
+	*x = ProcessIO_Control_Type(value)
+	return nil
+}
+func (ProcessIO_Control_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 1, 0}
+}
+
+// Calls that can be sent to the v1 agent API.
+//
+// A call is described using the standard protocol buffer "union"
+// trick, see
+// https://developers.google.com/protocol-buffers/docs/techniques#union.
+type Call struct {
+	Type                         *Call_Type                         `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.Call_Type" json:"type,omitempty"`
+	GetMetrics                   *Call_GetMetrics                   `protobuf:"bytes,2,opt,name=get_metrics,json=getMetrics" json:"get_metrics,omitempty"`
+	SetLoggingLevel              *Call_SetLoggingLevel              `protobuf:"bytes,3,opt,name=set_logging_level,json=setLoggingLevel" json:"set_logging_level,omitempty"`
+	ListFiles                    *Call_ListFiles                    `protobuf:"bytes,4,opt,name=list_files,json=listFiles" json:"list_files,omitempty"`
+	ReadFile                     *Call_ReadFile                     `protobuf:"bytes,5,opt,name=read_file,json=readFile" json:"read_file,omitempty"`
+	GetContainers                *Call_GetContainers                `protobuf:"bytes,20,opt,name=get_containers,json=getContainers" json:"get_containers,omitempty"`
+	LaunchNestedContainer        *Call_LaunchNestedContainer        `protobuf:"bytes,6,opt,name=launch_nested_container,json=launchNestedContainer" json:"launch_nested_container,omitempty"`
+	WaitNestedContainer          *Call_WaitNestedContainer          `protobuf:"bytes,7,opt,name=wait_nested_container,json=waitNestedContainer" json:"wait_nested_container,omitempty"`
+	KillNestedContainer          *Call_KillNestedContainer          `protobuf:"bytes,8,opt,name=kill_nested_container,json=killNestedContainer" json:"kill_nested_container,omitempty"`
+	RemoveNestedContainer        *Call_RemoveNestedContainer        `protobuf:"bytes,12,opt,name=remove_nested_container,json=removeNestedContainer" json:"remove_nested_container,omitempty"`
+	LaunchNestedContainerSession *Call_LaunchNestedContainerSession `protobuf:"bytes,9,opt,name=launch_nested_container_session,json=launchNestedContainerSession" json:"launch_nested_container_session,omitempty"`
+	AttachContainerInput         *Call_AttachContainerInput         `protobuf:"bytes,10,opt,name=attach_container_input,json=attachContainerInput" json:"attach_container_input,omitempty"`
+	AttachContainerOutput        *Call_AttachContainerOutput        `protobuf:"bytes,11,opt,name=attach_container_output,json=attachContainerOutput" json:"attach_container_output,omitempty"`
+	LaunchContainer              *Call_LaunchContainer              `protobuf:"bytes,13,opt,name=launch_container,json=launchContainer" json:"launch_container,omitempty"`
+	WaitContainer                *Call_WaitContainer                `protobuf:"bytes,14,opt,name=wait_container,json=waitContainer" json:"wait_container,omitempty"`
+	KillContainer                *Call_KillContainer                `protobuf:"bytes,15,opt,name=kill_container,json=killContainer" json:"kill_container,omitempty"`
+	RemoveContainer              *Call_RemoveContainer              `protobuf:"bytes,16,opt,name=remove_container,json=removeContainer" json:"remove_container,omitempty"`
+	AddResourceProviderConfig    *Call_AddResourceProviderConfig    `protobuf:"bytes,17,opt,name=add_resource_provider_config,json=addResourceProviderConfig" json:"add_resource_provider_config,omitempty"`
+	UpdateResourceProviderConfig *Call_UpdateResourceProviderConfig `protobuf:"bytes,18,opt,name=update_resource_provider_config,json=updateResourceProviderConfig" json:"update_resource_provider_config,omitempty"`
+	RemoveResourceProviderConfig *Call_RemoveResourceProviderConfig `protobuf:"bytes,19,opt,name=remove_resource_provider_config,json=removeResourceProviderConfig" json:"remove_resource_provider_config,omitempty"`
+	MarkResourceProviderGone     *Call_MarkResourceProviderGone     `protobuf:"bytes,22,opt,name=mark_resource_provider_gone,json=markResourceProviderGone" json:"mark_resource_provider_gone,omitempty"`
+	PruneImages                  *Call_PruneImages                  `protobuf:"bytes,21,opt,name=prune_images,json=pruneImages" json:"prune_images,omitempty"`
+	XXX_unrecognized             []byte                             `json:"-"`
+}
+
+func (m *Call) Reset()                    { *m = Call{} }
+func (m *Call) String() string            { return proto.CompactTextString(m) }
+func (*Call) ProtoMessage()               {}
+func (*Call) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Call) GetType() Call_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Call_UNKNOWN
+}
+
+func (m *Call) GetGetMetrics() *Call_GetMetrics {
+	if m != nil {
+		return m.GetMetrics
+	}
+	return nil
+}
+
+func (m *Call) GetSetLoggingLevel() *Call_SetLoggingLevel {
+	if m != nil {
+		return m.SetLoggingLevel
+	}
+	return nil
+}
+
+func (m *Call) GetListFiles() *Call_ListFiles {
+	if m != nil {
+		return m.ListFiles
+	}
+	return nil
+}
+
+func (m *Call) GetReadFile() *Call_ReadFile {
+	if m != nil {
+		return m.ReadFile
+	}
+	return nil
+}
+
+func (m *Call) GetGetContainers() *Call_GetContainers {
+	if m != nil {
+		return m.GetContainers
+	}
+	return nil
+}
+
+func (m *Call) GetLaunchNestedContainer() *Call_LaunchNestedContainer {
+	if m != nil {
+		return m.LaunchNestedContainer
+	}
+	return nil
+}
+
+func (m *Call) GetWaitNestedContainer() *Call_WaitNestedContainer {
+	if m != nil {
+		return m.WaitNestedContainer
+	}
+	return nil
+}
+
+func (m *Call) GetKillNestedContainer() *Call_KillNestedContainer {
+	if m != nil {
+		return m.KillNestedContainer
+	}
+	return nil
+}
+
+func (m *Call) GetRemoveNestedContainer() *Call_RemoveNestedContainer {
+	if m != nil {
+		return m.RemoveNestedContainer
+	}
+	return nil
+}
+
+func (m *Call) GetLaunchNestedContainerSession() *Call_LaunchNestedContainerSession {
+	if m != nil {
+		return m.LaunchNestedContainerSession
+	}
+	return nil
+}
+
+func (m *Call) GetAttachContainerInput() *Call_AttachContainerInput {
+	if m != nil {
+		return m.AttachContainerInput
+	}
+	return nil
+}
+
+func (m *Call) GetAttachContainerOutput() *Call_AttachContainerOutput {
+	if m != nil {
+		return m.AttachContainerOutput
+	}
+	return nil
+}
+
+func (m *Call) GetLaunchContainer() *Call_LaunchContainer {
+	if m != nil {
+		return m.LaunchContainer
+	}
+	return nil
+}
+
+func (m *Call) GetWaitContainer() *Call_WaitContainer {
+	if m != nil {
+		return m.WaitContainer
+	}
+	return nil
+}
+
+func (m *Call) GetKillContainer() *Call_KillContainer {
+	if m != nil {
+		return m.KillContainer
+	}
+	return nil
+}
+
+func (m *Call) GetRemoveContainer() *Call_RemoveContainer {
+	if m != nil {
+		return m.RemoveContainer
+	}
+	return nil
+}
+
+func (m *Call) GetAddResourceProviderConfig() *Call_AddResourceProviderConfig {
+	if m != nil {
+		return m.AddResourceProviderConfig
+	}
+	return nil
+}
+
+func (m *Call) GetUpdateResourceProviderConfig() *Call_UpdateResourceProviderConfig {
+	if m != nil {
+		return m.UpdateResourceProviderConfig
+	}
+	return nil
+}
+
+func (m *Call) GetRemoveResourceProviderConfig() *Call_RemoveResourceProviderConfig {
+	if m != nil {
+		return m.RemoveResourceProviderConfig
+	}
+	return nil
+}
+
+func (m *Call) GetMarkResourceProviderGone() *Call_MarkResourceProviderGone {
+	if m != nil {
+		return m.MarkResourceProviderGone
+	}
+	return nil
+}
+
+func (m *Call) GetPruneImages() *Call_PruneImages {
+	if m != nil {
+		return m.PruneImages
+	}
+	return nil
+}
+
+// Provides a snapshot of the current metrics tracked by the agent.
+type Call_GetMetrics struct {
+	// If set, `timeout` would be used to determines the maximum amount of time
+	// the API will take to respond. If the timeout is exceeded, some metrics
+	// may not be included in the response.
+	Timeout          *mesos_v1.DurationInfo `protobuf:"bytes,1,opt,name=timeout" json:"timeout,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_GetMetrics) Reset()                    { *m = Call_GetMetrics{} }
+func (m *Call_GetMetrics) String() string            { return proto.CompactTextString(m) }
+func (*Call_GetMetrics) ProtoMessage()               {}
+func (*Call_GetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+func (m *Call_GetMetrics) GetTimeout() *mesos_v1.DurationInfo {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+// Sets the logging verbosity level for a specified duration. Mesos uses
+// [glog](https://github.com/google/glog) for logging. The library only uses
+// verbose logging which means nothing will be output unless the verbosity
+// level is set (by default it's 0, libprocess uses levels 1, 2, and 3).
+type Call_SetLoggingLevel struct {
+	// The verbosity level.
+	Level *uint32 `protobuf:"varint,1,req,name=level" json:"level,omitempty"`
+	// The duration to keep verbosity level toggled. After this duration, the
+	// verbosity level of log would revert to the original level.
+	Duration         *mesos_v1.DurationInfo `protobuf:"bytes,2,req,name=duration" json:"duration,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_SetLoggingLevel) Reset()                    { *m = Call_SetLoggingLevel{} }
+func (m *Call_SetLoggingLevel) String() string            { return proto.CompactTextString(m) }
+func (*Call_SetLoggingLevel) ProtoMessage()               {}
+func (*Call_SetLoggingLevel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
+func (m *Call_SetLoggingLevel) GetLevel() uint32 {
+	if m != nil && m.Level != nil {
+		return *m.Level
+	}
+	return 0
+}
+
+func (m *Call_SetLoggingLevel) GetDuration() *mesos_v1.DurationInfo {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+// Provides the file listing for a directory.
+type Call_ListFiles struct {
+	Path             *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_ListFiles) Reset()                    { *m = Call_ListFiles{} }
+func (m *Call_ListFiles) String() string            { return proto.CompactTextString(m) }
+func (*Call_ListFiles) ProtoMessage()               {}
+func (*Call_ListFiles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 2} }
+
+func (m *Call_ListFiles) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+// Reads data from a file.
+type Call_ReadFile struct {
+	// The path of file.
+	Path *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	// Initial offset in file to start reading from.
+	Offset *uint64 `protobuf:"varint,2,req,name=offset" json:"offset,omitempty"`
+	// The maximum number of bytes to read. The read length is capped at 16
+	// memory pages.
+	Length           *uint64 `protobuf:"varint,3,opt,name=length" json:"length,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_ReadFile) Reset()                    { *m = Call_ReadFile{} }
+func (m *Call_ReadFile) String() string            { return proto.CompactTextString(m) }
+func (*Call_ReadFile) ProtoMessage()               {}
+func (*Call_ReadFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 3} }
+
+func (m *Call_ReadFile) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *Call_ReadFile) GetOffset() uint64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *Call_ReadFile) GetLength() uint64 {
+	if m != nil && m.Length != nil {
+		return *m.Length
+	}
+	return 0
+}
+
+// Lists active containers on the agent.
+type Call_GetContainers struct {
+	ShowNested       *bool  `protobuf:"varint,1,opt,name=show_nested,json=showNested" json:"show_nested,omitempty"`
+	ShowStandalone   *bool  `protobuf:"varint,2,opt,name=show_standalone,json=showStandalone" json:"show_standalone,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Call_GetContainers) Reset()                    { *m = Call_GetContainers{} }
+func (m *Call_GetContainers) String() string            { return proto.CompactTextString(m) }
+func (*Call_GetContainers) ProtoMessage()               {}
+func (*Call_GetContainers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 4} }
+
+func (m *Call_GetContainers) GetShowNested() bool {
+	if m != nil && m.ShowNested != nil {
+		return *m.ShowNested
+	}
+	return false
+}
+
+func (m *Call_GetContainers) GetShowStandalone() bool {
+	if m != nil && m.ShowStandalone != nil {
+		return *m.ShowStandalone
+	}
+	return false
+}
+
+// Deprecated in favor of `LaunchContainer`.
+type Call_LaunchNestedContainer struct {
+	ContainerId      *mesos_v1.ContainerID   `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	Command          *mesos_v1.CommandInfo   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Container        *mesos_v1.ContainerInfo `protobuf:"bytes,3,opt,name=container" json:"container,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Call_LaunchNestedContainer) Reset()                    { *m = Call_LaunchNestedContainer{} }
+func (m *Call_LaunchNestedContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_LaunchNestedContainer) ProtoMessage()               {}
+func (*Call_LaunchNestedContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 5} }
+
+func (m *Call_LaunchNestedContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_LaunchNestedContainer) GetCommand() *mesos_v1.CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *Call_LaunchNestedContainer) GetContainer() *mesos_v1.ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+// Deprecated in favor of `WaitContainer`.
+type Call_WaitNestedContainer struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_WaitNestedContainer) Reset()                    { *m = Call_WaitNestedContainer{} }
+func (m *Call_WaitNestedContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_WaitNestedContainer) ProtoMessage()               {}
+func (*Call_WaitNestedContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 6} }
+
+func (m *Call_WaitNestedContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+// Deprecated in favor of `KillContainer`.
+type Call_KillNestedContainer struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	Signal           *int32                `protobuf:"varint,2,opt,name=signal" json:"signal,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_KillNestedContainer) Reset()                    { *m = Call_KillNestedContainer{} }
+func (m *Call_KillNestedContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_KillNestedContainer) ProtoMessage()               {}
+func (*Call_KillNestedContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 7} }
+
+func (m *Call_KillNestedContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_KillNestedContainer) GetSignal() int32 {
+	if m != nil && m.Signal != nil {
+		return *m.Signal
+	}
+	return 0
+}
+
+// Deprecated in favor of `RemoveContainer`.
+type Call_RemoveNestedContainer struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_RemoveNestedContainer) Reset()                    { *m = Call_RemoveNestedContainer{} }
+func (m *Call_RemoveNestedContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_RemoveNestedContainer) ProtoMessage()               {}
+func (*Call_RemoveNestedContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 8} }
+
+func (m *Call_RemoveNestedContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+// Launches a nested container within an executor's tree of containers.
+// The differences between this call and `LaunchNestedContainer` are:
+//  1. The container's life-cycle is tied to the lifetime of the
+//     connection used to make this call, i.e., if the connection ever
+//     breaks, the container will be destroyed.
+//  2. The nested container shares the same namespaces and cgroups as
+//     its parent container.
+//  3. Results in a streaming response of type `ProcessIO`. So the call
+//     needs to be made on a persistent connection.
+type Call_LaunchNestedContainerSession struct {
+	ContainerId      *mesos_v1.ContainerID   `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	Command          *mesos_v1.CommandInfo   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Container        *mesos_v1.ContainerInfo `protobuf:"bytes,3,opt,name=container" json:"container,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Call_LaunchNestedContainerSession) Reset()         { *m = Call_LaunchNestedContainerSession{} }
+func (m *Call_LaunchNestedContainerSession) String() string { return proto.CompactTextString(m) }
+func (*Call_LaunchNestedContainerSession) ProtoMessage()    {}
+func (*Call_LaunchNestedContainerSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 9}
+}
+
+func (m *Call_LaunchNestedContainerSession) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_LaunchNestedContainerSession) GetCommand() *mesos_v1.CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *Call_LaunchNestedContainerSession) GetContainer() *mesos_v1.ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+// Attaches the caller to the STDIN of the entry point of the container.
+// Clients can use this to stream input data to a container.
+// Note that this call needs to be made on a persistent connection by
+// streaming a CONTAINER_ID message followed by one or more PROCESS_IO
+// messages.
+type Call_AttachContainerInput struct {
+	Type             *Call_AttachContainerInput_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.Call_AttachContainerInput_Type" json:"type,omitempty"`
+	ContainerId      *mesos_v1.ContainerID           `protobuf:"bytes,2,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	ProcessIo        *ProcessIO                      `protobuf:"bytes,3,opt,name=process_io,json=processIo" json:"process_io,omitempty"`
+	XXX_unrecognized []byte                          `json:"-"`
+}
+
+func (m *Call_AttachContainerInput) Reset()                    { *m = Call_AttachContainerInput{} }
+func (m *Call_AttachContainerInput) String() string            { return proto.CompactTextString(m) }
+func (*Call_AttachContainerInput) ProtoMessage()               {}
+func (*Call_AttachContainerInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 10} }
+
+func (m *Call_AttachContainerInput) GetType() Call_AttachContainerInput_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Call_AttachContainerInput_UNKNOWN
+}
+
+func (m *Call_AttachContainerInput) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_AttachContainerInput) GetProcessIo() *ProcessIO {
+	if m != nil {
+		return m.ProcessIo
+	}
+	return nil
+}
+
+// Attaches the caller to the STDOUT and STDERR of the entrypoint of
+// the container. Clients can use this to stream output/error from the
+// container. This call will result in a streaming response of `ProcessIO`;
+// so this call needs to be made on a persistent connection.
+type Call_AttachContainerOutput struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_AttachContainerOutput) Reset()                    { *m = Call_AttachContainerOutput{} }
+func (m *Call_AttachContainerOutput) String() string            { return proto.CompactTextString(m) }
+func (*Call_AttachContainerOutput) ProtoMessage()               {}
+func (*Call_AttachContainerOutput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 11} }
+
+func (m *Call_AttachContainerOutput) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+// Launches a either a "standalone" container on this agent
+// or a nested container within another tree of containers.
+//
+// A standalone container is launched by specifying a ContainerID
+// with no parent. Standalone containers bypass the normal offer cycle
+// between the master and agent. Unlike other containers, a standalone
+// container does not have an executor or any tasks. This means the
+// standalone container does not report back to Mesos or any framework
+// and must be supervised separately.
+//
+// A nested container is launched by specifying a ContainerID with
+// another existing container (including standalone containers)
+// as the parent.
+//
+// Returns 200 OK if the new container launch succeeds.
+// Returns 202 Accepted if the requested ContainerID is already in use
+//
+//	by a standalone or nested container.
+//
+// Returns 400 Bad Request if the container launch fails.
+type Call_LaunchContainer struct {
+	// NOTE: Some characters cannot be used in the ID. All characters
+	// must be valid filesystem path characters.  In addition, '/' and '.'
+	// are reserved.
+	ContainerId *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	Command     *mesos_v1.CommandInfo `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	// NOTE: Nested containers may not specify resources and instead
+	// share resources with its parent container.
+	//
+	// TODO(josephw): These resources are purely used for isolation
+	// and are not accounted for by the Mesos master (if connected).
+	// It is the caller's responsibility to ensure that resources are
+	// not overcommitted (e.g. CPU and memory) or conflicting (e.g. ports
+	// and volumes). Once there is support for preempting tasks and a
+	// way to update the resources advertised by the agent, these standalone
+	// container resources should be accounted for by the master.
+	Resources []*mesos_v1.Resource    `protobuf:"bytes,3,rep,name=resources" json:"resources,omitempty"`
+	Container *mesos_v1.ContainerInfo `protobuf:"bytes,4,opt,name=container" json:"container,omitempty"`
+	// Resource limits associated with the container during launch.
+	Limits           map[string]*mesos_v1.Value_Scalar `protobuf:"bytes,5,rep,name=limits" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_unrecognized []byte                            `json:"-"`
+}
+
+func (m *Call_LaunchContainer) Reset()                    { *m = Call_LaunchContainer{} }
+func (m *Call_LaunchContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_LaunchContainer) ProtoMessage()               {}
+func (*Call_LaunchContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 12} }
+
+func (m *Call_LaunchContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_LaunchContainer) GetCommand() *mesos_v1.CommandInfo {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *Call_LaunchContainer) GetResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Call_LaunchContainer) GetContainer() *mesos_v1.ContainerInfo {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+func (m *Call_LaunchContainer) GetLimits() map[string]*mesos_v1.Value_Scalar {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// Waits for the standalone or nested container to terminate
+// and returns the exit status.
+//
+// Returns 200 OK if and when the container exits.
+// Returns 404 Not Found if the container does not exist.
+type Call_WaitContainer struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_WaitContainer) Reset()                    { *m = Call_WaitContainer{} }
+func (m *Call_WaitContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_WaitContainer) ProtoMessage()               {}
+func (*Call_WaitContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 13} }
+
+func (m *Call_WaitContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+// Kills the standalone or nested container. The signal to be sent
+// to the container can be specified in the 'signal' field.
+//
+// Returns 200 OK if the signal is sent successfully.
+// Returns 404 Not Found if the container does not exist.
+type Call_KillContainer struct {
+	ContainerId *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// Defaults to SIGKILL.
+	Signal           *int32 `protobuf:"varint,2,opt,name=signal" json:"signal,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Call_KillContainer) Reset()                    { *m = Call_KillContainer{} }
+func (m *Call_KillContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_KillContainer) ProtoMessage()               {}
+func (*Call_KillContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 14} }
+
+func (m *Call_KillContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Call_KillContainer) GetSignal() int32 {
+	if m != nil && m.Signal != nil {
+		return *m.Signal
+	}
+	return 0
+}
+
+// Removes a container's artifacts (runtime and sandbox directories).
+//
+// For nested containers, it is important to use this call if multiple
+// nested containers are launched under the same parent container, because
+// garbage collection only takes place at the parent container. Artifacts
+// belonging to nested containers will not be garbage collected while
+// the parent container is running.
+//
+// TODO(josephw): A standalone container's runtime directory is currently
+// garbage collected as soon as the container exits. To allow the user to
+// retrieve the exit status reliably, the runtime directory cannot be
+// garbage collected immediately. Instead, the user will eventually be
+// required to make this call after the standalone container has exited.
+// Also, a standalone container's sandbox directory is currently not
+// garbage collected and is only deleted via this call.
+//
+// Returns 200 OK if the removal is successful or if the parent container
+//
+//	(for nested containers) does not exist.
+//
+// Returns 500 Internal Server Error if anything goes wrong, including
+//
+//	if the container is still running or does not exist.
+//
+// TODO(josephw): Consider returning a 400 Bad Request instead of 500
+// Internal Server Error when the user tries to remove a running or
+// nonexistent nested container.
+type Call_RemoveContainer struct {
+	ContainerId      *mesos_v1.ContainerID `protobuf:"bytes,1,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_RemoveContainer) Reset()                    { *m = Call_RemoveContainer{} }
+func (m *Call_RemoveContainer) String() string            { return proto.CompactTextString(m) }
+func (*Call_RemoveContainer) ProtoMessage()               {}
+func (*Call_RemoveContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 15} }
+
+func (m *Call_RemoveContainer) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+// Adds a new resource provider config file.
+//
+// The content of the `info` field will be written into a new config file in
+// the resource provider config directory, and a new resource provider will be
+// launched asynchronously based on the config. Callers must not set the
+// `info.id` field. This call is idempotent, so if a config file identical to
+// the content of the `info` field already exists, this call will return
+// without launching a resource provider. Note that if a config file is
+// placed into the resource provider config directory out-of-band after the
+// agent starts up, it will not be checked against this call.
+//
+// Returns 200 OK if a new config file is created, or an identical config file
+//
+//	exists.
+//
+// Returns 400 Bad Request if `info` is not well-formed.
+// Returns 403 Forbidden if the call is not authorized.
+// Returns 409 Conflict if another config file that describes a resource
+//
+//	provider of the same type and name exists, but the content is not
+//	identical.
+//
+// Returns 500 Internal Server Error if anything goes wrong.
+//
+// NOTE: For the time being, this API is subject to change and the related
+// feature is experimental.
+type Call_AddResourceProviderConfig struct {
+	Info             *mesos_v1.ResourceProviderInfo `protobuf:"bytes,1,req,name=info" json:"info,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *Call_AddResourceProviderConfig) Reset()         { *m = Call_AddResourceProviderConfig{} }
+func (m *Call_AddResourceProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*Call_AddResourceProviderConfig) ProtoMessage()    {}
+func (*Call_AddResourceProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 16}
+}
+
+func (m *Call_AddResourceProviderConfig) GetInfo() *mesos_v1.ResourceProviderInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// Updates an existing resource provider config file.
+//
+// The content of the `info` field will be written into an existing config
+// file that describes a resource provider of the specified type and name in
+// the resource provider config directory, and the corresponding resource
+// provider will be relaunched asynchronously to reflect the changes in the
+// config. Callers must not set the `info.id` field. This call is idempotent,
+// so if there is no change in the config, this call will return without
+// relaunching the resource provider. Note that if a config file is placed
+// into the resource provider config directory out-of-band after the agent
+// starts up, it will not be checked against this call.
+//
+// Returns 200 OK if an existing config file is updated, or there is no change
+//
+//	in the config file.
+//
+// Returns 400 Bad Request if `info` is not well-formed.
+// Returns 403 Forbidden if the call is not authorized.
+// Returns 409 Conflict if no config file describes a resource provider of the
+//
+//	same type and name exists.
+//
+// Returns 500 Internal Server Error if anything goes wrong.
+//
+// NOTE: For the time being, this API is subject to change and the related
+// feature is experimental.
+type Call_UpdateResourceProviderConfig struct {
+	Info             *mesos_v1.ResourceProviderInfo `protobuf:"bytes,1,req,name=info" json:"info,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *Call_UpdateResourceProviderConfig) Reset()         { *m = Call_UpdateResourceProviderConfig{} }
+func (m *Call_UpdateResourceProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*Call_UpdateResourceProviderConfig) ProtoMessage()    {}
+func (*Call_UpdateResourceProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 17}
+}
+
+func (m *Call_UpdateResourceProviderConfig) GetInfo() *mesos_v1.ResourceProviderInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// Removes a config file from the resource provider config directory.
+//
+// The config file that describes the resource provider of the specified type
+// and name will be removed, and the corresponding resource provider will be
+// terminated asynchronously. This call is idempotent, so if no matching
+// config file exists, this call will return without terminating any resource
+// provider. Note that if a config file is placed into the resource provider
+// config directory out-of-band after the agent starts up, it will not be
+// checked against this call.
+//
+// Returns 200 OK if the config file is removed, or no matching config file
+//
+//	exists.
+//
+// Returns 403 Forbidden if the call is not authorized.
+// Returns 500 Internal Server Error if anything goes wrong.
+type Call_RemoveResourceProviderConfig struct {
+	Type             *string `protobuf:"bytes,1,req,name=type" json:"type,omitempty"`
+	Name             *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_RemoveResourceProviderConfig) Reset()         { *m = Call_RemoveResourceProviderConfig{} }
+func (m *Call_RemoveResourceProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*Call_RemoveResourceProviderConfig) ProtoMessage()    {}
+func (*Call_RemoveResourceProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 18}
+}
+
+func (m *Call_RemoveResourceProviderConfig) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *Call_RemoveResourceProviderConfig) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+// Mark a resource provider as gone.
+type Call_MarkResourceProviderGone struct {
+	ResourceProviderId *mesos_v1.ResourceProviderID `protobuf:"bytes,1,req,name=resource_provider_id,json=resourceProviderId" json:"resource_provider_id,omitempty"`
+	XXX_unrecognized   []byte                       `json:"-"`
+}
+
+func (m *Call_MarkResourceProviderGone) Reset()         { *m = Call_MarkResourceProviderGone{} }
+func (m *Call_MarkResourceProviderGone) String() string { return proto.CompactTextString(m) }
+func (*Call_MarkResourceProviderGone) ProtoMessage()    {}
+func (*Call_MarkResourceProviderGone) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 19}
+}
+
+func (m *Call_MarkResourceProviderGone) GetResourceProviderId() *mesos_v1.ResourceProviderID {
+	if m != nil {
+		return m.ResourceProviderId
+	}
+	return nil
+}
+
+// Prune unused container images from image store.
+//
+// Images and layers referenced by active containers as well as
+// image references specified in `excluded_images` will not be pruned.
+type Call_PruneImages struct {
+	ExcludedImages   []*mesos_v1.Image `protobuf:"bytes,1,rep,name=excluded_images,json=excludedImages" json:"excluded_images,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Call_PruneImages) Reset()                    { *m = Call_PruneImages{} }
+func (m *Call_PruneImages) String() string            { return proto.CompactTextString(m) }
+func (*Call_PruneImages) ProtoMessage()               {}
+func (*Call_PruneImages) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 20} }
+
+func (m *Call_PruneImages) GetExcludedImages() []*mesos_v1.Image {
+	if m != nil {
+		return m.ExcludedImages
+	}
+	return nil
+}
+
+// Synchronous responses for all calls made to the v1 agent API.
+type Response struct {
+	Type                 *Response_Type                 `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.Response_Type" json:"type,omitempty"`
+	GetHealth            *Response_GetHealth            `protobuf:"bytes,2,opt,name=get_health,json=getHealth" json:"get_health,omitempty"`
+	GetFlags             *Response_GetFlags             `protobuf:"bytes,3,opt,name=get_flags,json=getFlags" json:"get_flags,omitempty"`
+	GetVersion           *Response_GetVersion           `protobuf:"bytes,4,opt,name=get_version,json=getVersion" json:"get_version,omitempty"`
+	GetMetrics           *Response_GetMetrics           `protobuf:"bytes,5,opt,name=get_metrics,json=getMetrics" json:"get_metrics,omitempty"`
+	GetLoggingLevel      *Response_GetLoggingLevel      `protobuf:"bytes,6,opt,name=get_logging_level,json=getLoggingLevel" json:"get_logging_level,omitempty"`
+	ListFiles            *Response_ListFiles            `protobuf:"bytes,7,opt,name=list_files,json=listFiles" json:"list_files,omitempty"`
+	ReadFile             *Response_ReadFile             `protobuf:"bytes,8,opt,name=read_file,json=readFile" json:"read_file,omitempty"`
+	GetState             *Response_GetState             `protobuf:"bytes,9,opt,name=get_state,json=getState" json:"get_state,omitempty"`
+	GetContainers        *Response_GetContainers        `protobuf:"bytes,10,opt,name=get_containers,json=getContainers" json:"get_containers,omitempty"`
+	GetFrameworks        *Response_GetFrameworks        `protobuf:"bytes,11,opt,name=get_frameworks,json=getFrameworks" json:"get_frameworks,omitempty"`
+	GetExecutors         *Response_GetExecutors         `protobuf:"bytes,12,opt,name=get_executors,json=getExecutors" json:"get_executors,omitempty"`
+	GetOperations        *Response_GetOperations        `protobuf:"bytes,18,opt,name=get_operations,json=getOperations" json:"get_operations,omitempty"`
+	GetTasks             *Response_GetTasks             `protobuf:"bytes,13,opt,name=get_tasks,json=getTasks" json:"get_tasks,omitempty"`
+	GetAgent             *Response_GetAgent             `protobuf:"bytes,15,opt,name=get_agent,json=getAgent" json:"get_agent,omitempty"`
+	GetResourceProviders *Response_GetResourceProviders `protobuf:"bytes,17,opt,name=get_resource_providers,json=getResourceProviders" json:"get_resource_providers,omitempty"`
+	WaitNestedContainer  *Response_WaitNestedContainer  `protobuf:"bytes,14,opt,name=wait_nested_container,json=waitNestedContainer" json:"wait_nested_container,omitempty"`
+	WaitContainer        *Response_WaitContainer        `protobuf:"bytes,16,opt,name=wait_container,json=waitContainer" json:"wait_container,omitempty"`
+	XXX_unrecognized     []byte                         `json:"-"`
+}
+
+func (m *Response) Reset()                    { *m = Response{} }
+func (m *Response) String() string            { return proto.CompactTextString(m) }
+func (*Response) ProtoMessage()               {}
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Response) GetType() Response_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Response_UNKNOWN
+}
+
+func (m *Response) GetGetHealth() *Response_GetHealth {
+	if m != nil {
+		return m.GetHealth
+	}
+	return nil
+}
+
+func (m *Response) GetGetFlags() *Response_GetFlags {
+	if m != nil {
+		return m.GetFlags
+	}
+	return nil
+}
+
+func (m *Response) GetGetVersion() *Response_GetVersion {
+	if m != nil {
+		return m.GetVersion
+	}
+	return nil
+}
+
+func (m *Response) GetGetMetrics() *Response_GetMetrics {
+	if m != nil {
+		return m.GetMetrics
+	}
+	return nil
+}
+
+func (m *Response) GetGetLoggingLevel() *Response_GetLoggingLevel {
+	if m != nil {
+		return m.GetLoggingLevel
+	}
+	return nil
+}
+
+func (m *Response) GetListFiles() *Response_ListFiles {
+	if m != nil {
+		return m.ListFiles
+	}
+	return nil
+}
+
+func (m *Response) GetReadFile() *Response_ReadFile {
+	if m != nil {
+		return m.ReadFile
+	}
+	return nil
+}
+
+func (m *Response) GetGetState() *Response_GetState {
+	if m != nil {
+		return m.GetState
+	}
+	return nil
+}
+
+func (m *Response) GetGetContainers() *Response_GetContainers {
+	if m != nil {
+		return m.GetContainers
+	}
+	return nil
+}
+
+func (m *Response) GetGetFrameworks() *Response_GetFrameworks {
+	if m != nil {
+		return m.GetFrameworks
+	}
+	return nil
+}
+
+func (m *Response) GetGetExecutors() *Response_GetExecutors {
+	if m != nil {
+		return m.GetExecutors
+	}
+	return nil
+}
+
+func (m *Response) GetGetOperations() *Response_GetOperations {
+	if m != nil {
+		return m.GetOperations
+	}
+	return nil
+}
+
+func (m *Response) GetGetTasks() *Response_GetTasks {
+	if m != nil {
+		return m.GetTasks
+	}
+	return nil
+}
+
+func (m *Response) GetGetAgent() *Response_GetAgent {
+	if m != nil {
+		return m.GetAgent
+	}
+	return nil
+}
+
+func (m *Response) GetGetResourceProviders() *Response_GetResourceProviders {
+	if m != nil {
+		return m.GetResourceProviders
+	}
+	return nil
+}
+
+func (m *Response) GetWaitNestedContainer() *Response_WaitNestedContainer {
+	if m != nil {
+		return m.WaitNestedContainer
+	}
+	return nil
+}
+
+func (m *Response) GetWaitContainer() *Response_WaitContainer {
+	if m != nil {
+		return m.WaitContainer
+	}
+	return nil
+}
+
+// `healthy` would be true if the agent is healthy. Delayed responses are also
+// indicative of the poor health of the agent.
+type Response_GetHealth struct {
+	Healthy          *bool  `protobuf:"varint,1,req,name=healthy" json:"healthy,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Response_GetHealth) Reset()                    { *m = Response_GetHealth{} }
+func (m *Response_GetHealth) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetHealth) ProtoMessage()               {}
+func (*Response_GetHealth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+func (m *Response_GetHealth) GetHealthy() bool {
+	if m != nil && m.Healthy != nil {
+		return *m.Healthy
+	}
+	return false
+}
+
+// Contains the flag configuration of the agent.
+type Response_GetFlags struct {
+	Flags            []*mesos_v1.Flag `protobuf:"bytes,1,rep,name=flags" json:"flags,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Response_GetFlags) Reset()                    { *m = Response_GetFlags{} }
+func (m *Response_GetFlags) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetFlags) ProtoMessage()               {}
+func (*Response_GetFlags) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 1} }
+
+func (m *Response_GetFlags) GetFlags() []*mesos_v1.Flag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// Contains the version information of the agent.
+type Response_GetVersion struct {
+	VersionInfo      *mesos_v1.VersionInfo `protobuf:"bytes,1,req,name=version_info,json=versionInfo" json:"version_info,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Response_GetVersion) Reset()                    { *m = Response_GetVersion{} }
+func (m *Response_GetVersion) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetVersion) ProtoMessage()               {}
+func (*Response_GetVersion) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 2} }
+
+func (m *Response_GetVersion) GetVersionInfo() *mesos_v1.VersionInfo {
+	if m != nil {
+		return m.VersionInfo
+	}
+	return nil
+}
+
+// Contains a snapshot of the current metrics.
+type Response_GetMetrics struct {
+	Metrics          []*mesos_v1.Metric `protobuf:"bytes,1,rep,name=metrics" json:"metrics,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *Response_GetMetrics) Reset()                    { *m = Response_GetMetrics{} }
+func (m *Response_GetMetrics) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetMetrics) ProtoMessage()               {}
+func (*Response_GetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 3} }
+
+func (m *Response_GetMetrics) GetMetrics() []*mesos_v1.Metric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+// Contains the logging level of the agent.
+type Response_GetLoggingLevel struct {
+	Level            *uint32 `protobuf:"varint,1,req,name=level" json:"level,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Response_GetLoggingLevel) Reset()                    { *m = Response_GetLoggingLevel{} }
+func (m *Response_GetLoggingLevel) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetLoggingLevel) ProtoMessage()               {}
+func (*Response_GetLoggingLevel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 4} }
+
+func (m *Response_GetLoggingLevel) GetLevel() uint32 {
+	if m != nil && m.Level != nil {
+		return *m.Level
+	}
+	return 0
+}
+
+// Contains the file listing(similar to `ls -l`) for a directory.
+type Response_ListFiles struct {
+	FileInfos        []*mesos_v1.FileInfo `protobuf:"bytes,1,rep,name=file_infos,json=fileInfos" json:"file_infos,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Response_ListFiles) Reset()                    { *m = Response_ListFiles{} }
+func (m *Response_ListFiles) String() string            { return proto.CompactTextString(m) }
+func (*Response_ListFiles) ProtoMessage()               {}
+func (*Response_ListFiles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 5} }
+
+func (m *Response_ListFiles) GetFileInfos() []*mesos_v1.FileInfo {
+	if m != nil {
+		return m.FileInfos
+	}
+	return nil
+}
+
+// Contains the file data.
+type Response_ReadFile struct {
+	// The size of file (in bytes).
+	Size             *uint64 `protobuf:"varint,1,req,name=size" json:"size,omitempty"`
+	Data             []byte  `protobuf:"bytes,2,req,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Response_ReadFile) Reset()                    { *m = Response_ReadFile{} }
+func (m *Response_ReadFile) String() string            { return proto.CompactTextString(m) }
+func (*Response_ReadFile) ProtoMessage()               {}
+func (*Response_ReadFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 6} }
+
+func (m *Response_ReadFile) GetSize() uint64 {
+	if m != nil && m.Size != nil {
+		return *m.Size
+	}
+	return 0
+}
+
+func (m *Response_ReadFile) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Contains full state of the agent i.e. information about the tasks,
+// frameworks and executors running in the cluster.
+type Response_GetState struct {
+	GetTasks         *Response_GetTasks      `protobuf:"bytes,1,opt,name=get_tasks,json=getTasks" json:"get_tasks,omitempty"`
+	GetExecutors     *Response_GetExecutors  `protobuf:"bytes,2,opt,name=get_executors,json=getExecutors" json:"get_executors,omitempty"`
+	GetFrameworks    *Response_GetFrameworks `protobuf:"bytes,3,opt,name=get_frameworks,json=getFrameworks" json:"get_frameworks,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Response_GetState) Reset()                    { *m = Response_GetState{} }
+func (m *Response_GetState) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetState) ProtoMessage()               {}
+func (*Response_GetState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 7} }
+
+func (m *Response_GetState) GetGetTasks() *Response_GetTasks {
+	if m != nil {
+		return m.GetTasks
+	}
+	return nil
+}
+
+func (m *Response_GetState) GetGetExecutors() *Response_GetExecutors {
+	if m != nil {
+		return m.GetExecutors
+	}
+	return nil
+}
+
+func (m *Response_GetState) GetGetFrameworks() *Response_GetFrameworks {
+	if m != nil {
+		return m.GetFrameworks
+	}
+	return nil
+}
+
+// Information about containers running on this agent. It contains
+// ContainerStatus and ResourceStatistics along with some metadata
+// of the containers.
+type Response_GetContainers struct {
+	Containers       []*Response_GetContainers_Container `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
+	XXX_unrecognized []byte                              `json:"-"`
+}
+
+func (m *Response_GetContainers) Reset()                    { *m = Response_GetContainers{} }
+func (m *Response_GetContainers) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetContainers) ProtoMessage()               {}
+func (*Response_GetContainers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 8} }
+
+func (m *Response_GetContainers) GetContainers() []*Response_GetContainers_Container {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+type Response_GetContainers_Container struct {
+	FrameworkId        *mesos_v1.FrameworkID        `protobuf:"bytes,1,opt,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	ExecutorId         *mesos_v1.ExecutorID         `protobuf:"bytes,2,opt,name=executor_id,json=executorId" json:"executor_id,omitempty"`
+	ExecutorName       *string                      `protobuf:"bytes,3,opt,name=executor_name,json=executorName" json:"executor_name,omitempty"`
+	ContainerId        *mesos_v1.ContainerID        `protobuf:"bytes,4,req,name=container_id,json=containerId" json:"container_id,omitempty"`
+	ContainerStatus    *mesos_v1.ContainerStatus    `protobuf:"bytes,5,opt,name=container_status,json=containerStatus" json:"container_status,omitempty"`
+	ResourceStatistics *mesos_v1.ResourceStatistics `protobuf:"bytes,6,opt,name=resource_statistics,json=resourceStatistics" json:"resource_statistics,omitempty"`
+	XXX_unrecognized   []byte                       `json:"-"`
+}
+
+func (m *Response_GetContainers_Container) Reset()         { *m = Response_GetContainers_Container{} }
+func (m *Response_GetContainers_Container) String() string { return proto.CompactTextString(m) }
+func (*Response_GetContainers_Container) ProtoMessage()    {}
+func (*Response_GetContainers_Container) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 8, 0}
+}
+
+func (m *Response_GetContainers_Container) GetFrameworkId() *mesos_v1.FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *Response_GetContainers_Container) GetExecutorId() *mesos_v1.ExecutorID {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return nil
+}
+
+func (m *Response_GetContainers_Container) GetExecutorName() string {
+	if m != nil && m.ExecutorName != nil {
+		return *m.ExecutorName
+	}
+	return ""
+}
+
+func (m *Response_GetContainers_Container) GetContainerId() *mesos_v1.ContainerID {
+	if m != nil {
+		return m.ContainerId
+	}
+	return nil
+}
+
+func (m *Response_GetContainers_Container) GetContainerStatus() *mesos_v1.ContainerStatus {
+	if m != nil {
+		return m.ContainerStatus
+	}
+	return nil
+}
+
+func (m *Response_GetContainers_Container) GetResourceStatistics() *mesos_v1.ResourceStatistics {
+	if m != nil {
+		return m.ResourceStatistics
+	}
+	return nil
+}
+
+// Information about all the frameworks known to the agent at the current
+// time.
+type Response_GetFrameworks struct {
+	Frameworks          []*Response_GetFrameworks_Framework `protobuf:"bytes,1,rep,name=frameworks" json:"frameworks,omitempty"`
+	CompletedFrameworks []*Response_GetFrameworks_Framework `protobuf:"bytes,2,rep,name=completed_frameworks,json=completedFrameworks" json:"completed_frameworks,omitempty"`
+	XXX_unrecognized    []byte                              `json:"-"`
+}
+
+func (m *Response_GetFrameworks) Reset()                    { *m = Response_GetFrameworks{} }
+func (m *Response_GetFrameworks) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetFrameworks) ProtoMessage()               {}
+func (*Response_GetFrameworks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 9} }
+
+func (m *Response_GetFrameworks) GetFrameworks() []*Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.Frameworks
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks) GetCompletedFrameworks() []*Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.CompletedFrameworks
+	}
+	return nil
+}
+
+type Response_GetFrameworks_Framework struct {
+	FrameworkInfo    *mesos_v1.FrameworkInfo `protobuf:"bytes,1,req,name=framework_info,json=frameworkInfo" json:"framework_info,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Response_GetFrameworks_Framework) Reset()         { *m = Response_GetFrameworks_Framework{} }
+func (m *Response_GetFrameworks_Framework) String() string { return proto.CompactTextString(m) }
+func (*Response_GetFrameworks_Framework) ProtoMessage()    {}
+func (*Response_GetFrameworks_Framework) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 9, 0}
+}
+
+func (m *Response_GetFrameworks_Framework) GetFrameworkInfo() *mesos_v1.FrameworkInfo {
+	if m != nil {
+		return m.FrameworkInfo
+	}
+	return nil
+}
+
+// Lists information about all the executors known to the agent at the
+// current time.
+type Response_GetExecutors struct {
+	Executors          []*Response_GetExecutors_Executor `protobuf:"bytes,1,rep,name=executors" json:"executors,omitempty"`
+	CompletedExecutors []*Response_GetExecutors_Executor `protobuf:"bytes,2,rep,name=completed_executors,json=completedExecutors" json:"completed_executors,omitempty"`
+	XXX_unrecognized   []byte                            `json:"-"`
+}
+
+func (m *Response_GetExecutors) Reset()                    { *m = Response_GetExecutors{} }
+func (m *Response_GetExecutors) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetExecutors) ProtoMessage()               {}
+func (*Response_GetExecutors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 10} }
+
+func (m *Response_GetExecutors) GetExecutors() []*Response_GetExecutors_Executor {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *Response_GetExecutors) GetCompletedExecutors() []*Response_GetExecutors_Executor {
+	if m != nil {
+		return m.CompletedExecutors
+	}
+	return nil
+}
+
+type Response_GetExecutors_Executor struct {
+	ExecutorInfo     *mesos_v1.ExecutorInfo `protobuf:"bytes,1,req,name=executor_info,json=executorInfo" json:"executor_info,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Response_GetExecutors_Executor) Reset()         { *m = Response_GetExecutors_Executor{} }
+func (m *Response_GetExecutors_Executor) String() string { return proto.CompactTextString(m) }
+func (*Response_GetExecutors_Executor) ProtoMessage()    {}
+func (*Response_GetExecutors_Executor) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 10, 0}
+}
+
+func (m *Response_GetExecutors_Executor) GetExecutorInfo() *mesos_v1.ExecutorInfo {
+	if m != nil {
+		return m.ExecutorInfo
+	}
+	return nil
+}
+
+// Lists information about all operations known to the agent at the
+// current time.
+type Response_GetOperations struct {
+	Operations       []*mesos_v1.Operation `protobuf:"bytes,1,rep,name=operations" json:"operations,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Response_GetOperations) Reset()                    { *m = Response_GetOperations{} }
+func (m *Response_GetOperations) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetOperations) ProtoMessage()               {}
+func (*Response_GetOperations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 11} }
+
+func (m *Response_GetOperations) GetOperations() []*mesos_v1.Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// Lists information about all the tasks known to the agent at the current
+// time.
+type Response_GetTasks struct {
+	// Tasks that are pending in the agent's queue before an executor is
+	// launched.
+	PendingTasks []*mesos_v1.Task `protobuf:"bytes,1,rep,name=pending_tasks,json=pendingTasks" json:"pending_tasks,omitempty"`
+	// Tasks that are enqueued for a launched executor that has not yet
+	// registered.
+	QueuedTasks []*mesos_v1.Task `protobuf:"bytes,2,rep,name=queued_tasks,json=queuedTasks" json:"queued_tasks,omitempty"`
+	// Tasks that are running.
+	LaunchedTasks []*mesos_v1.Task `protobuf:"bytes,3,rep,name=launched_tasks,json=launchedTasks" json:"launched_tasks,omitempty"`
+	// Tasks that are terminated but pending updates.
+	TerminatedTasks []*mesos_v1.Task `protobuf:"bytes,4,rep,name=terminated_tasks,json=terminatedTasks" json:"terminated_tasks,omitempty"`
+	// Tasks that are terminated and updates acked.
+	CompletedTasks   []*mesos_v1.Task `protobuf:"bytes,5,rep,name=completed_tasks,json=completedTasks" json:"completed_tasks,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Response_GetTasks) Reset()                    { *m = Response_GetTasks{} }
+func (m *Response_GetTasks) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetTasks) ProtoMessage()               {}
+func (*Response_GetTasks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 12} }
+
+func (m *Response_GetTasks) GetPendingTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.PendingTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetQueuedTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.QueuedTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetLaunchedTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.LaunchedTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetTerminatedTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.TerminatedTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetCompletedTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.CompletedTasks
+	}
+	return nil
+}
+
+// Contains the agent's information.
+type Response_GetAgent struct {
+	AgentInfo               *mesos_v1.AgentInfo   `protobuf:"bytes,1,opt,name=agent_info,json=agentInfo" json:"agent_info,omitempty"`
+	DrainConfig             *mesos_v1.DrainConfig `protobuf:"bytes,2,opt,name=drain_config,json=drainConfig" json:"drain_config,omitempty"`
+	EstimatedDrainStartTime *mesos_v1.TimeInfo    `protobuf:"bytes,3,opt,name=estimated_drain_start_time,json=estimatedDrainStartTime" json:"estimated_drain_start_time,omitempty"`
+	XXX_unrecognized        []byte                `json:"-"`
+}
+
+func (m *Response_GetAgent) Reset()                    { *m = Response_GetAgent{} }
+func (m *Response_GetAgent) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetAgent) ProtoMessage()               {}
+func (*Response_GetAgent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 13} }
+
+func (m *Response_GetAgent) GetAgentInfo() *mesos_v1.AgentInfo {
+	if m != nil {
+		return m.AgentInfo
+	}
+	return nil
+}
+
+func (m *Response_GetAgent) GetDrainConfig() *mesos_v1.DrainConfig {
+	if m != nil {
+		return m.DrainConfig
+	}
+	return nil
+}
+
+func (m *Response_GetAgent) GetEstimatedDrainStartTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.EstimatedDrainStartTime
+	}
+	return nil
+}
+
+// Lists information about all resource providers known to the agent
+// at the current time.
+type Response_GetResourceProviders struct {
+	ResourceProviders []*Response_GetResourceProviders_ResourceProvider `protobuf:"bytes,1,rep,name=resource_providers,json=resourceProviders" json:"resource_providers,omitempty"`
+	XXX_unrecognized  []byte                                            `json:"-"`
+}
+
+func (m *Response_GetResourceProviders) Reset()         { *m = Response_GetResourceProviders{} }
+func (m *Response_GetResourceProviders) String() string { return proto.CompactTextString(m) }
+func (*Response_GetResourceProviders) ProtoMessage()    {}
+func (*Response_GetResourceProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 14}
+}
+
+func (m *Response_GetResourceProviders) GetResourceProviders() []*Response_GetResourceProviders_ResourceProvider {
+	if m != nil {
+		return m.ResourceProviders
+	}
+	return nil
+}
+
+type Response_GetResourceProviders_ResourceProvider struct {
+	ResourceProviderInfo *mesos_v1.ResourceProviderInfo `protobuf:"bytes,1,req,name=resource_provider_info,json=resourceProviderInfo" json:"resource_provider_info,omitempty"`
+	TotalResources       []*mesos_v1.Resource           `protobuf:"bytes,2,rep,name=total_resources,json=totalResources" json:"total_resources,omitempty"`
+	XXX_unrecognized     []byte                         `json:"-"`
+}
+
+func (m *Response_GetResourceProviders_ResourceProvider) Reset() {
+	*m = Response_GetResourceProviders_ResourceProvider{}
+}
+func (m *Response_GetResourceProviders_ResourceProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Response_GetResourceProviders_ResourceProvider) ProtoMessage() {}
+func (*Response_GetResourceProviders_ResourceProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 14, 0}
+}
+
+func (m *Response_GetResourceProviders_ResourceProvider) GetResourceProviderInfo() *mesos_v1.ResourceProviderInfo {
+	if m != nil {
+		return m.ResourceProviderInfo
+	}
+	return nil
+}
+
+func (m *Response_GetResourceProviders_ResourceProvider) GetTotalResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.TotalResources
+	}
+	return nil
+}
+
+// Returns termination information about the nested container.
+type Response_WaitNestedContainer struct {
+	// Wait status of the lead process in the container. Note that this
+	// is the return value of `wait(2)`, so callers must use the `wait(2)`
+	// family of macros to extract whether the process exited cleanly and
+	// what the exit code was.
+	ExitStatus *int32 `protobuf:"varint,1,opt,name=exit_status,json=exitStatus" json:"exit_status,omitempty"`
+	// The `state` and `reason` fields may be populated if the Mesos agent
+	// terminates the container. In the absence of any special knowledge,
+	// executors should propagate this information via the `status` field
+	// of an `Update` call for the corresponding TaskID.
+	State  *mesos_v1.TaskState         `protobuf:"varint,2,opt,name=state,enum=mesos.v1.TaskState" json:"state,omitempty"`
+	Reason *mesos_v1.TaskStatus_Reason `protobuf:"varint,3,opt,name=reason,enum=mesos.v1.TaskStatus_Reason" json:"reason,omitempty"`
+	// This field will be populated if the task was terminated due to
+	// a resource limitation.
+	Limitation       *mesos_v1.TaskResourceLimitation `protobuf:"bytes,4,opt,name=limitation" json:"limitation,omitempty"`
+	Message          *string                          `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	XXX_unrecognized []byte                           `json:"-"`
+}
+
+func (m *Response_WaitNestedContainer) Reset()         { *m = Response_WaitNestedContainer{} }
+func (m *Response_WaitNestedContainer) String() string { return proto.CompactTextString(m) }
+func (*Response_WaitNestedContainer) ProtoMessage()    {}
+func (*Response_WaitNestedContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 15}
+}
+
+func (m *Response_WaitNestedContainer) GetExitStatus() int32 {
+	if m != nil && m.ExitStatus != nil {
+		return *m.ExitStatus
+	}
+	return 0
+}
+
+func (m *Response_WaitNestedContainer) GetState() mesos_v1.TaskState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return mesos_v1.TaskState_TASK_STAGING
+}
+
+func (m *Response_WaitNestedContainer) GetReason() mesos_v1.TaskStatus_Reason {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return mesos_v1.TaskStatus_REASON_COMMAND_EXECUTOR_FAILED
+}
+
+func (m *Response_WaitNestedContainer) GetLimitation() *mesos_v1.TaskResourceLimitation {
+	if m != nil {
+		return m.Limitation
+	}
+	return nil
+}
+
+func (m *Response_WaitNestedContainer) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+// Returns termination information about the standalone or nested container.
+type Response_WaitContainer struct {
+	// Wait status of the lead process in the container. Note that this
+	// is the return value of `wait(2)`, so callers must use the `wait(2)`
+	// family of macros to extract whether the process exited cleanly and
+	// what the exit code was.
+	ExitStatus *int32 `protobuf:"varint,1,opt,name=exit_status,json=exitStatus" json:"exit_status,omitempty"`
+	// The `state` and `reason` fields may be populated if the Mesos agent
+	// terminates the container. In the absence of any special knowledge,
+	// executors should propagate this information via the `status` field
+	// of an `Update` call for the corresponding TaskID.
+	State  *mesos_v1.TaskState         `protobuf:"varint,2,opt,name=state,enum=mesos.v1.TaskState" json:"state,omitempty"`
+	Reason *mesos_v1.TaskStatus_Reason `protobuf:"varint,3,opt,name=reason,enum=mesos.v1.TaskStatus_Reason" json:"reason,omitempty"`
+	// This field will be populated if the task was terminated due to
+	// a resource limitation.
+	Limitation       *mesos_v1.TaskResourceLimitation `protobuf:"bytes,4,opt,name=limitation" json:"limitation,omitempty"`
+	Message          *string                          `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	XXX_unrecognized []byte                           `json:"-"`
+}
+
+func (m *Response_WaitContainer) Reset()                    { *m = Response_WaitContainer{} }
+func (m *Response_WaitContainer) String() string            { return proto.CompactTextString(m) }
+func (*Response_WaitContainer) ProtoMessage()               {}
+func (*Response_WaitContainer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 16} }
+
+func (m *Response_WaitContainer) GetExitStatus() int32 {
+	if m != nil && m.ExitStatus != nil {
+		return *m.ExitStatus
+	}
+	return 0
+}
+
+func (m *Response_WaitContainer) GetState() mesos_v1.TaskState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return mesos_v1.TaskState_TASK_STAGING
+}
+
+func (m *Response_WaitContainer) GetReason() mesos_v1.TaskStatus_Reason {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return mesos_v1.TaskStatus_REASON_COMMAND_EXECUTOR_FAILED
+}
+
+func (m *Response_WaitContainer) GetLimitation() *mesos_v1.TaskResourceLimitation {
+	if m != nil {
+		return m.Limitation
+	}
+	return nil
+}
+
+func (m *Response_WaitContainer) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+// Streaming response to `Call::LAUNCH_NESTED_CONTAINER_SESSION` and
+// `Call::ATTACH_CONTAINER_OUTPUT`.
+//
+// This message is also used to stream request data for
+// `Call::ATTACH_CONTAINER_INPUT`.
+type ProcessIO struct {
+	Type             *ProcessIO_Type    `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.ProcessIO_Type" json:"type,omitempty"`
+	Data             *ProcessIO_Data    `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Control          *ProcessIO_Control `protobuf:"bytes,3,opt,name=control" json:"control,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *ProcessIO) Reset()                    { *m = ProcessIO{} }
+func (m *ProcessIO) String() string            { return proto.CompactTextString(m) }
+func (*ProcessIO) ProtoMessage()               {}
+func (*ProcessIO) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ProcessIO) GetType() ProcessIO_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ProcessIO_UNKNOWN
+}
+
+func (m *ProcessIO) GetData() *ProcessIO_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ProcessIO) GetControl() *ProcessIO_Control {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type ProcessIO_Data struct {
+	Type             *ProcessIO_Data_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.ProcessIO_Data_Type" json:"type,omitempty"`
+	Data             []byte               `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *ProcessIO_Data) Reset()                    { *m = ProcessIO_Data{} }
+func (m *ProcessIO_Data) String() string            { return proto.CompactTextString(m) }
+func (*ProcessIO_Data) ProtoMessage()               {}
+func (*ProcessIO_Data) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+func (m *ProcessIO_Data) GetType() ProcessIO_Data_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ProcessIO_Data_UNKNOWN
+}
+
+func (m *ProcessIO_Data) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProcessIO_Control struct {
+	Type             *ProcessIO_Control_Type      `protobuf:"varint,1,opt,name=type,enum=mesos.v1.agent.ProcessIO_Control_Type" json:"type,omitempty"`
+	TtyInfo          *mesos_v1.TTYInfo            `protobuf:"bytes,2,opt,name=tty_info,json=ttyInfo" json:"tty_info,omitempty"`
+	Heartbeat        *ProcessIO_Control_Heartbeat `protobuf:"bytes,3,opt,name=heartbeat" json:"heartbeat,omitempty"`
+	XXX_unrecognized []byte                       `json:"-"`
+}
+
+func (m *ProcessIO_Control) Reset()                    { *m = ProcessIO_Control{} }
+func (m *ProcessIO_Control) String() string            { return proto.CompactTextString(m) }
+func (*ProcessIO_Control) ProtoMessage()               {}
+func (*ProcessIO_Control) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 1} }
+
+func (m *ProcessIO_Control) GetType() ProcessIO_Control_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ProcessIO_Control_UNKNOWN
+}
+
+func (m *ProcessIO_Control) GetTtyInfo() *mesos_v1.TTYInfo {
+	if m != nil {
+		return m.TtyInfo
+	}
+	return nil
+}
+
+func (m *ProcessIO_Control) GetHeartbeat() *ProcessIO_Control_Heartbeat {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return nil
+}
+
+type ProcessIO_Control_Heartbeat struct {
+	Interval         *mesos_v1.DurationInfo `protobuf:"bytes,1,opt,name=interval" json:"interval,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *ProcessIO_Control_Heartbeat) Reset()         { *m = ProcessIO_Control_Heartbeat{} }
+func (m *ProcessIO_Control_Heartbeat) String() string { return proto.CompactTextString(m) }
+func (*ProcessIO_Control_Heartbeat) ProtoMessage()    {}
+func (*ProcessIO_Control_Heartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 1, 0}
+}
+
+func (m *ProcessIO_Control_Heartbeat) GetInterval() *mesos_v1.DurationInfo {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Call)(nil), "mesos.v1.agent.Call")
+	proto.RegisterType((*Call_GetMetrics)(nil), "mesos.v1.agent.Call.GetMetrics")
+	proto.RegisterType((*Call_SetLoggingLevel)(nil), "mesos.v1.agent.Call.SetLoggingLevel")
+	proto.RegisterType((*Call_ListFiles)(nil), "mesos.v1.agent.Call.ListFiles")
+	proto.RegisterType((*Call_ReadFile)(nil), "mesos.v1.agent.Call.ReadFile")
+	proto.RegisterType((*Call_GetContainers)(nil), "mesos.v1.agent.Call.GetContainers")
+	proto.RegisterType((*Call_LaunchNestedContainer)(nil), "mesos.v1.agent.Call.LaunchNestedContainer")
+	proto.RegisterType((*Call_WaitNestedContainer)(nil), "mesos.v1.agent.Call.WaitNestedContainer")
+	proto.RegisterType((*Call_KillNestedContainer)(nil), "mesos.v1.agent.Call.KillNestedContainer")
+	proto.RegisterType((*Call_RemoveNestedContainer)(nil), "mesos.v1.agent.Call.RemoveNestedContainer")
+	proto.RegisterType((*Call_LaunchNestedContainerSession)(nil), "mesos.v1.agent.Call.LaunchNestedContainerSession")
+	proto.RegisterType((*Call_AttachContainerInput)(nil), "mesos.v1.agent.Call.AttachContainerInput")
+	proto.RegisterType((*Call_AttachContainerOutput)(nil), "mesos.v1.agent.Call.AttachContainerOutput")
+	proto.RegisterType((*Call_LaunchContainer)(nil), "mesos.v1.agent.Call.LaunchContainer")
+	proto.RegisterType((*Call_WaitContainer)(nil), "mesos.v1.agent.Call.WaitContainer")
+	proto.RegisterType((*Call_KillContainer)(nil), "mesos.v1.agent.Call.KillContainer")
+	proto.RegisterType((*Call_RemoveContainer)(nil), "mesos.v1.agent.Call.RemoveContainer")
+	proto.RegisterType((*Call_AddResourceProviderConfig)(nil), "mesos.v1.agent.Call.AddResourceProviderConfig")
+	proto.RegisterType((*Call_UpdateResourceProviderConfig)(nil), "mesos.v1.agent.Call.UpdateResourceProviderConfig")
+	proto.RegisterType((*Call_RemoveResourceProviderConfig)(nil), "mesos.v1.agent.Call.RemoveResourceProviderConfig")
+	proto.RegisterType((*Call_MarkResourceProviderGone)(nil), "mesos.v1.agent.Call.MarkResourceProviderGone")
+	proto.RegisterType((*Call_PruneImages)(nil), "mesos.v1.agent.Call.PruneImages")
+	proto.RegisterType((*Response)(nil), "mesos.v1.agent.Response")
+	proto.RegisterType((*Response_GetHealth)(nil), "mesos.v1.agent.Response.GetHealth")
+	proto.RegisterType((*Response_GetFlags)(nil), "mesos.v1.agent.Response.GetFlags")
+	proto.RegisterType((*Response_GetVersion)(nil), "mesos.v1.agent.Response.GetVersion")
+	proto.RegisterType((*Response_GetMetrics)(nil), "mesos.v1.agent.Response.GetMetrics")
+	proto.RegisterType((*Response_GetLoggingLevel)(nil), "mesos.v1.agent.Response.GetLoggingLevel")
+	proto.RegisterType((*Response_ListFiles)(nil), "mesos.v1.agent.Response.ListFiles")
+	proto.RegisterType((*Response_ReadFile)(nil), "mesos.v1.agent.Response.ReadFile")
+	proto.RegisterType((*Response_GetState)(nil), "mesos.v1.agent.Response.GetState")
+	proto.RegisterType((*Response_GetContainers)(nil), "mesos.v1.agent.Response.GetContainers")
+	proto.RegisterType((*Response_GetContainers_Container)(nil), "mesos.v1.agent.Response.GetContainers.Container")
+	proto.RegisterType((*Response_GetFrameworks)(nil), "mesos.v1.agent.Response.GetFrameworks")
+	proto.RegisterType((*Response_GetFrameworks_Framework)(nil), "mesos.v1.agent.Response.GetFrameworks.Framework")
+	proto.RegisterType((*Response_GetExecutors)(nil), "mesos.v1.agent.Response.GetExecutors")
+	proto.RegisterType((*Response_GetExecutors_Executor)(nil), "mesos.v1.agent.Response.GetExecutors.Executor")
+	proto.RegisterType((*Response_GetOperations)(nil), "mesos.v1.agent.Response.GetOperations")
+	proto.RegisterType((*Response_GetTasks)(nil), "mesos.v1.agent.Response.GetTasks")
+	proto.RegisterType((*Response_GetAgent)(nil), "mesos.v1.agent.Response.GetAgent")
+	proto.RegisterType((*Response_GetResourceProviders)(nil), "mesos.v1.agent.Response.GetResourceProviders")
+	proto.RegisterType((*Response_GetResourceProviders_ResourceProvider)(nil), "mesos.v1.agent.Response.GetResourceProviders.ResourceProvider")
+	proto.RegisterType((*Response_WaitNestedContainer)(nil), "mesos.v1.agent.Response.WaitNestedContainer")
+	proto.RegisterType((*Response_WaitContainer)(nil), "mesos.v1.agent.Response.WaitContainer")
+	proto.RegisterType((*ProcessIO)(nil), "mesos.v1.agent.ProcessIO")
+	proto.RegisterType((*ProcessIO_Data)(nil), "mesos.v1.agent.ProcessIO.Data")
+	proto.RegisterType((*ProcessIO_Control)(nil), "mesos.v1.agent.ProcessIO.Control")
+	proto.RegisterType((*ProcessIO_Control_Heartbeat)(nil), "mesos.v1.agent.ProcessIO.Control.Heartbeat")
+	proto.RegisterEnum("mesos.v1.agent.Call_Type", Call_Type_name, Call_Type_value)
+	proto.RegisterEnum("mesos.v1.agent.Call_AttachContainerInput_Type", Call_AttachContainerInput_Type_name, Call_AttachContainerInput_Type_value)
+	proto.RegisterEnum("mesos.v1.agent.Response_Type", Response_Type_name, Response_Type_value)
+	proto.RegisterEnum("mesos.v1.agent.ProcessIO_Type", ProcessIO_Type_name, ProcessIO_Type_value)
+	proto.RegisterEnum("mesos.v1.agent.ProcessIO_Data_Type", ProcessIO_Data_Type_name, ProcessIO_Data_Type_value)
+	proto.RegisterEnum("mesos.v1.agent.ProcessIO_Control_Type", ProcessIO_Control_Type_name, ProcessIO_Control_Type_value)
+}
+
+var fileDescriptor0 = []byte{
+	// 3042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x73, 0xe3, 0xc6,
+	0xb1, 0x7f, 0xa4, 0x28, 0x89, 0x6c, 0x7e, 0x41, 0xa3, 0x2f, 0x2e, 0x56, 0x5e, 0xe9, 0x69, 0xfd,
+	0xb1, 0xf6, 0xb3, 0xf5, 0x76, 0xe5, 0xf2, 0xf3, 0x3e, 0xbb, 0xb2, 0x31, 0x57, 0x84, 0x28, 0x44,
+	0x14, 0x29, 0x0f, 0xa0, 0xdd, 0xb8, 0x72, 0x40, 0x60, 0x72, 0x44, 0x21, 0x02, 0x09, 0x06, 0x00,
+	0xb5, 0x56, 0x8e, 0xa9, 0x4a, 0x0e, 0xc9, 0x31, 0xe7, 0xe4, 0x96, 0xaa, 0x5c, 0x72, 0xf0, 0x31,
+	0xb7, 0x5c, 0x73, 0x4f, 0xfe, 0x84, 0xdc, 0x72, 0x48, 0xe5, 0x2f, 0x48, 0xcd, 0x0c, 0x3e, 0xc9,
+	0x21, 0x25, 0xaf, 0x2a, 0xae, 0x4a, 0x6e, 0x33, 0x3d, 0xbf, 0xee, 0x9e, 0xe9, 0x99, 0xe9, 0xee,
+	0x69, 0x00, 0xd6, 0x06, 0xc4, 0x73, 0x3c, 0xe3, 0xea, 0x89, 0x61, 0xf6, 0xc9, 0xd0, 0xdf, 0x1b,
+	0xb9, 0x8e, 0xef, 0xa0, 0x0a, 0xa3, 0xee, 0x5d, 0x3d, 0xd9, 0x63, 0x54, 0xb9, 0x12, 0xa2, 0xf8,
+	0xf8, 0xee, 0xd7, 0x8f, 0x20, 0x77, 0x60, 0xda, 0x36, 0xfa, 0x00, 0x72, 0xfe, 0xf5, 0x88, 0xd4,
+	0x32, 0x3b, 0x99, 0x47, 0x95, 0xfd, 0x7b, 0x7b, 0x69, 0xbe, 0x3d, 0x8a, 0xd9, 0xd3, 0xaf, 0x47,
+	0x04, 0x33, 0x18, 0xfa, 0x0c, 0x8a, 0x7d, 0xe2, 0x1b, 0x03, 0xe2, 0xbb, 0x56, 0xd7, 0xab, 0x65,
+	0x77, 0x32, 0x8f, 0x8a, 0xfb, 0xdb, 0x42, 0xae, 0x26, 0xf1, 0x4f, 0x38, 0x0c, 0x43, 0x3f, 0x6a,
+	0xa3, 0x53, 0x58, 0xf1, 0x88, 0x6f, 0xd8, 0x4e, 0xbf, 0x6f, 0x0d, 0xfb, 0x86, 0x4d, 0xae, 0x88,
+	0x5d, 0x5b, 0x60, 0x72, 0xde, 0x14, 0xca, 0xd1, 0x88, 0xdf, 0xe2, 0xe0, 0x16, 0xc5, 0xe2, 0xaa,
+	0x97, 0x26, 0xa0, 0xef, 0x00, 0xd8, 0x96, 0xe7, 0x1b, 0xe7, 0x96, 0x4d, 0xbc, 0x5a, 0x8e, 0x89,
+	0x7a, 0x20, 0x14, 0xd5, 0xb2, 0x3c, 0xff, 0x90, 0xa2, 0x70, 0xc1, 0x0e, 0x9b, 0xe8, 0x13, 0x28,
+	0xb8, 0xc4, 0xec, 0x31, 0xf6, 0xda, 0x22, 0xe3, 0x7e, 0x43, 0xc8, 0x8d, 0x89, 0xd9, 0xa3, 0x2c,
+	0x38, 0xef, 0x06, 0x2d, 0xa4, 0x42, 0x85, 0x9a, 0xa3, 0xeb, 0x0c, 0x7d, 0xd3, 0x1a, 0x12, 0xd7,
+	0xab, 0xad, 0x31, 0x01, 0xbb, 0xb3, 0x2c, 0x72, 0x10, 0x21, 0x71, 0xb9, 0x9f, 0xec, 0xa2, 0x73,
+	0xd8, 0xb4, 0xcd, 0xf1, 0xb0, 0x7b, 0x61, 0x0c, 0x89, 0xe7, 0x93, 0x5e, 0x2c, 0xb4, 0xb6, 0xc4,
+	0x64, 0xbe, 0x27, 0x5e, 0x12, 0xe3, 0x69, 0x33, 0x96, 0x48, 0xda, 0xf3, 0x6c, 0x2d, 0x83, 0xd7,
+	0x6d, 0xd1, 0x10, 0xfa, 0x21, 0xac, 0xbf, 0x32, 0x2d, 0x7f, 0x5a, 0xcb, 0x32, 0xd3, 0xf2, 0x48,
+	0xa8, 0xe5, 0xa5, 0x69, 0xf9, 0x22, 0x1d, 0xab, 0xaf, 0xa6, 0x07, 0xa8, 0x86, 0x4b, 0xcb, 0xb6,
+	0xa7, 0x35, 0xe4, 0xe7, 0x68, 0x38, 0xb6, 0x6c, 0x5b, 0xa8, 0xe1, 0x72, 0x7a, 0x80, 0xda, 0xca,
+	0x25, 0x03, 0xe7, 0x8a, 0x4c, 0xeb, 0x28, 0xcd, 0xb1, 0x15, 0x66, 0x3c, 0x42, 0x5b, 0xb9, 0xa2,
+	0x21, 0xf4, 0x15, 0x6c, 0xcf, 0xd8, 0x13, 0xc3, 0x23, 0x9e, 0x67, 0x39, 0xc3, 0x5a, 0x81, 0xe9,
+	0x7b, 0x72, 0xfb, 0xbd, 0xd1, 0x38, 0x23, 0xde, 0xb2, 0xe7, 0x8c, 0x22, 0x03, 0x36, 0x4c, 0xdf,
+	0x37, 0xbb, 0x17, 0x09, 0x95, 0xd6, 0x70, 0x34, 0xf6, 0x6b, 0xc0, 0x14, 0xbe, 0x2b, 0x54, 0x58,
+	0x67, 0x2c, 0x91, 0x30, 0x95, 0x32, 0xe0, 0x35, 0x53, 0x40, 0x45, 0x5f, 0xc2, 0xe6, 0x94, 0x02,
+	0x67, 0xec, 0x53, 0x0d, 0xc5, 0x39, 0x26, 0x9c, 0xd0, 0xd0, 0x61, 0x1c, 0x78, 0xdd, 0x14, 0x91,
+	0x51, 0x07, 0xa4, 0xc0, 0x7c, 0xf1, 0xfe, 0x94, 0xe7, 0xdc, 0x74, 0x6e, 0xaf, 0x48, 0x0a, 0xae,
+	0xda, 0x69, 0x02, 0xbd, 0x6e, 0xec, 0xec, 0xc6, 0xe2, 0x2a, 0x73, 0xae, 0x1b, 0x3d, 0xb4, 0xb1,
+	0xb0, 0xf2, 0xab, 0x64, 0x97, 0x8a, 0x62, 0x87, 0x34, 0x16, 0x55, 0x9d, 0x23, 0x8a, 0x9e, 0xce,
+	0x84, 0xa8, 0xcb, 0x64, 0x97, 0x2e, 0x33, 0x38, 0x8d, 0xb1, 0x30, 0x69, 0xce, 0x32, 0xf9, 0x31,
+	0x4c, 0x2c, 0xd3, 0x4d, 0x13, 0x90, 0x03, 0x5b, 0x66, 0xaf, 0x67, 0xb8, 0xc4, 0x73, 0xc6, 0x6e,
+	0x97, 0x18, 0x23, 0xd7, 0xb9, 0xb2, 0x7a, 0xc4, 0xa5, 0xf2, 0xcf, 0xad, 0x7e, 0x6d, 0x85, 0x09,
+	0xdf, 0x13, 0x6f, 0x50, 0xaf, 0x87, 0x03, 0xbe, 0xd3, 0x80, 0xed, 0x80, 0x71, 0xe1, 0x7b, 0xe6,
+	0xac, 0x21, 0x7a, 0xce, 0xc7, 0xa3, 0x9e, 0xe9, 0x93, 0xd9, 0x3a, 0xd1, 0x9c, 0x73, 0x7e, 0xc6,
+	0x78, 0x67, 0xa8, 0xdd, 0x1a, 0xcf, 0x19, 0xa5, 0x9a, 0x03, 0xdb, 0xcd, 0xd4, 0xbc, 0x3a, 0x47,
+	0x33, 0x37, 0xe5, 0x2c, 0xcd, 0xee, 0x9c, 0x51, 0x64, 0xc3, 0xfd, 0x81, 0xe9, 0x5e, 0x0a, 0xf4,
+	0xf6, 0x9d, 0x21, 0xa9, 0x6d, 0x30, 0xad, 0x1f, 0x08, 0xb5, 0x9e, 0x98, 0xee, 0xe5, 0xa4, 0xd4,
+	0xa6, 0x33, 0x24, 0xb8, 0x36, 0x98, 0x31, 0x82, 0x0e, 0xa0, 0x34, 0x72, 0xc7, 0x43, 0x62, 0x58,
+	0x03, 0xb3, 0x4f, 0xbc, 0xda, 0x3a, 0x13, 0xbf, 0x23, 0x14, 0x7f, 0x4a, 0x81, 0x2a, 0xc3, 0xe1,
+	0xe2, 0x28, 0xee, 0xc8, 0xcf, 0x00, 0xe2, 0xa0, 0x8a, 0x1e, 0xc3, 0xb2, 0x6f, 0x0d, 0x88, 0x33,
+	0xf6, 0x59, 0xf0, 0x2e, 0xee, 0x6f, 0xc4, 0xd2, 0x1a, 0x63, 0xd7, 0xf4, 0x2d, 0x67, 0xa8, 0x0e,
+	0xcf, 0x1d, 0x1c, 0xc2, 0xe4, 0x1f, 0x40, 0x75, 0x22, 0x98, 0xa2, 0x35, 0x58, 0xe4, 0x11, 0x38,
+	0xb3, 0x93, 0x7d, 0x54, 0xc6, 0xbc, 0x83, 0xf6, 0x21, 0xdf, 0x0b, 0x24, 0xd4, 0xb2, 0x3b, 0xd9,
+	0x39, 0xb2, 0x23, 0x9c, 0xbc, 0x0d, 0x85, 0x28, 0xbc, 0x22, 0x04, 0xb9, 0x91, 0xe9, 0x5f, 0x30,
+	0xa9, 0x05, 0xcc, 0xda, 0x72, 0x1b, 0xf2, 0x61, 0x04, 0x15, 0x8d, 0xa3, 0x0d, 0x58, 0x72, 0xce,
+	0xcf, 0x3d, 0xe2, 0x33, 0x95, 0x39, 0x1c, 0xf4, 0x28, 0xdd, 0x26, 0xc3, 0xbe, 0x7f, 0xc1, 0xb2,
+	0x84, 0x1c, 0x0e, 0x7a, 0xf2, 0x17, 0x50, 0x4e, 0x05, 0x54, 0xb4, 0x0d, 0x45, 0xef, 0xc2, 0x79,
+	0x15, 0xf8, 0x6a, 0x66, 0x94, 0x3c, 0x06, 0x4a, 0xe2, 0x4e, 0x16, 0xbd, 0x03, 0x55, 0x06, 0xf0,
+	0x7c, 0x73, 0xd8, 0x33, 0x6d, 0xba, 0xcd, 0x59, 0x06, 0xaa, 0x50, 0xb2, 0x16, 0x51, 0xe5, 0x3f,
+	0x64, 0x60, 0x5d, 0xe8, 0xbc, 0xd1, 0x53, 0x28, 0x25, 0x1c, 0x72, 0x8f, 0x2d, 0xa0, 0xb8, 0xbf,
+	0x1e, 0x5b, 0x27, 0x76, 0xb3, 0x0d, 0x5c, 0x8c, 0xa0, 0x6a, 0x0f, 0xfd, 0x2f, 0x2c, 0x77, 0x9d,
+	0xc1, 0xc0, 0x1c, 0xf6, 0x82, 0xac, 0x29, 0xc5, 0xc4, 0x06, 0xf8, 0x6e, 0x05, 0x28, 0xf4, 0x11,
+	0x14, 0x62, 0x7f, 0xc2, 0x13, 0xa4, 0x4d, 0x91, 0x1e, 0xca, 0x14, 0x23, 0xe5, 0x0e, 0xac, 0x0a,
+	0xa2, 0xf5, 0xeb, 0x4f, 0x5c, 0xee, 0xc3, 0xaa, 0x20, 0x38, 0xdf, 0xc1, 0x12, 0x1b, 0xb0, 0xe4,
+	0x59, 0xfd, 0xa1, 0x69, 0x33, 0x43, 0x2c, 0xe2, 0xa0, 0x27, 0x7f, 0x0e, 0xeb, 0xc2, 0x08, 0x7d,
+	0x87, 0xb9, 0xff, 0x31, 0x03, 0x5b, 0xf3, 0xa2, 0xf0, 0xbf, 0xc1, 0x7e, 0xfe, 0x2c, 0x0b, 0x6b,
+	0xa2, 0xb8, 0x8e, 0x9e, 0xa7, 0x32, 0xf7, 0xbd, 0x5b, 0x27, 0x04, 0xc9, 0x74, 0x7e, 0x72, 0xf9,
+	0x82, 0x95, 0xcc, 0x58, 0xfe, 0x53, 0x80, 0x91, 0xeb, 0x74, 0x89, 0xe7, 0x19, 0x96, 0x13, 0x2c,
+	0x67, 0xea, 0xf5, 0x70, 0xca, 0x11, 0x6a, 0x07, 0x17, 0x02, 0xb0, 0xea, 0xec, 0x7e, 0x04, 0x39,
+	0x3a, 0x03, 0x54, 0x84, 0xe5, 0xb3, 0xf6, 0x71, 0xbb, 0xf3, 0xb2, 0x2d, 0xfd, 0x17, 0x92, 0xa0,
+	0x74, 0xd0, 0x69, 0xeb, 0x75, 0xb5, 0xad, 0x60, 0x43, 0x6d, 0x48, 0x19, 0x54, 0x01, 0x38, 0xc5,
+	0x9d, 0x03, 0x45, 0xd3, 0x0c, 0xb5, 0x23, 0x65, 0xe9, 0xe9, 0x10, 0x26, 0x1f, 0x77, 0x38, 0x1d,
+	0x3f, 0x5d, 0x80, 0xea, 0x44, 0xce, 0xf1, 0x6d, 0x1e, 0x88, 0xc7, 0xf4, 0xe1, 0xc1, 0x63, 0x85,
+	0x57, 0x5b, 0xd8, 0x59, 0x78, 0x54, 0xdc, 0x47, 0x31, 0x4b, 0x18, 0x46, 0x70, 0x0c, 0x4a, 0x1f,
+	0xa1, 0xdc, 0x6d, 0x8f, 0x10, 0x3a, 0x82, 0x25, 0xdb, 0x1a, 0x58, 0xbe, 0x57, 0x5b, 0x64, 0x5a,
+	0x1e, 0xdf, 0x26, 0xfb, 0xda, 0x6b, 0x31, 0x16, 0x65, 0xe8, 0xbb, 0xd7, 0x38, 0xe0, 0x97, 0x3f,
+	0x87, 0x62, 0x82, 0x8c, 0x24, 0x58, 0xb8, 0x24, 0xd7, 0xec, 0x04, 0x16, 0x30, 0x6d, 0xa2, 0xf7,
+	0x61, 0xf1, 0xca, 0xb4, 0xc7, 0x24, 0x30, 0x41, 0x22, 0x6c, 0xbc, 0xa0, 0xe4, 0x3d, 0xad, 0x6b,
+	0xda, 0xa6, 0x8b, 0x39, 0xe8, 0x93, 0xec, 0xd3, 0x8c, 0xac, 0x42, 0x39, 0x95, 0xa8, 0xdd, 0x61,
+	0x3f, 0x4d, 0x28, 0xa7, 0x12, 0xb5, 0x7f, 0x81, 0x8f, 0x3a, 0x86, 0xea, 0x44, 0xfa, 0x76, 0x87,
+	0xf9, 0x76, 0xe0, 0xde, 0xcc, 0x74, 0x0d, 0xed, 0x43, 0xce, 0x1a, 0x9e, 0x3b, 0x81, 0xb8, 0x07,
+	0xd3, 0x07, 0x23, 0xc4, 0xb3, 0xdd, 0x66, 0x58, 0x19, 0xc3, 0xd6, 0xbc, 0x5c, 0xec, 0xb5, 0x64,
+	0x1e, 0xc2, 0xd6, 0xbc, 0x2c, 0x8b, 0x86, 0xf2, 0xc0, 0x0d, 0xb1, 0x50, 0x4e, 0xdb, 0x94, 0x36,
+	0x34, 0x07, 0x84, 0x05, 0xf2, 0x02, 0x66, 0x6d, 0xf9, 0x47, 0x50, 0x9b, 0x95, 0x37, 0xa1, 0x36,
+	0xac, 0x4d, 0xa7, 0x61, 0x91, 0x29, 0xb7, 0xe6, 0xcc, 0xb3, 0x81, 0x91, 0x3b, 0x49, 0xeb, 0xc9,
+	0x4d, 0x28, 0x26, 0x92, 0x28, 0xf4, 0x14, 0xaa, 0xe4, 0xab, 0xae, 0x3d, 0xee, 0x91, 0x5e, 0x98,
+	0x7f, 0x65, 0xd8, 0x45, 0xa8, 0xc6, 0x92, 0x19, 0x14, 0x57, 0x42, 0x1c, 0xe7, 0xdc, 0xfd, 0xc5,
+	0x92, 0xc8, 0x59, 0x55, 0x00, 0x9a, 0x8a, 0x6e, 0x1c, 0x29, 0xf5, 0x96, 0x7e, 0x24, 0x65, 0x50,
+	0x19, 0x0a, 0xb4, 0x7f, 0xd8, 0xaa, 0x37, 0x35, 0x29, 0x8b, 0xaa, 0x50, 0xa4, 0xdd, 0x17, 0x0a,
+	0xd6, 0xd4, 0x4e, 0x5b, 0x5a, 0x08, 0x09, 0x27, 0x8a, 0x8e, 0xd5, 0x03, 0x4d, 0xca, 0xa1, 0x75,
+	0x58, 0xa1, 0x84, 0x56, 0xa7, 0xd9, 0x54, 0xdb, 0x4d, 0xa3, 0xa5, 0xbc, 0x50, 0x5a, 0xd2, 0x22,
+	0x25, 0x6b, 0x53, 0xe4, 0x25, 0xaa, 0xae, 0xa5, 0x6a, 0xba, 0x71, 0xa8, 0xb6, 0x14, 0x4d, 0x5a,
+	0xa6, 0xea, 0xb0, 0x52, 0x6f, 0xb0, 0xbe, 0x94, 0x0f, 0xb5, 0x6b, 0x7a, 0x5d, 0x57, 0xa4, 0x02,
+	0x42, 0x50, 0xa1, 0xdd, 0xc8, 0x9b, 0x6a, 0x12, 0x84, 0xb4, 0x43, 0x5c, 0x3f, 0x51, 0x5e, 0x76,
+	0xf0, 0xb1, 0x26, 0x15, 0xd1, 0x0a, 0x94, 0x29, 0x4d, 0xf9, 0xbe, 0x72, 0x70, 0xa6, 0x77, 0xb0,
+	0x26, 0x95, 0x42, 0x58, 0xe7, 0x54, 0xc1, 0x75, 0x5d, 0xed, 0xb4, 0x35, 0x69, 0x3b, 0x94, 0xae,
+	0xd7, 0xb5, 0x63, 0x4d, 0x2a, 0x87, 0xdd, 0x7a, 0x53, 0x69, 0xeb, 0xd2, 0x1a, 0x92, 0x61, 0x83,
+	0x76, 0xb1, 0xa2, 0x75, 0xce, 0xf0, 0x81, 0x62, 0x9c, 0xe2, 0xce, 0x0b, 0xb5, 0x41, 0x95, 0xca,
+	0x68, 0x1b, 0x36, 0x5b, 0xf5, 0xb3, 0xf6, 0xc1, 0x91, 0xd1, 0x56, 0x34, 0x5d, 0x69, 0xc4, 0x53,
+	0x92, 0x2a, 0x72, 0x36, 0x9f, 0x41, 0x6f, 0xc0, 0xfa, 0xcb, 0xba, 0xaa, 0x4f, 0x0f, 0x57, 0xc3,
+	0xe1, 0x63, 0xb5, 0xd5, 0x9a, 0x1e, 0x96, 0xd8, 0xf0, 0x36, 0x6c, 0x62, 0xe5, 0xa4, 0xf3, 0x42,
+	0x99, 0x06, 0xac, 0x33, 0xc0, 0x43, 0xd8, 0x9e, 0xa1, 0xdf, 0xd0, 0x14, 0x8d, 0x6d, 0xcd, 0x0a,
+	0x5d, 0x40, 0x5d, 0xd7, 0xeb, 0x07, 0x47, 0x89, 0x51, 0xb5, 0x7d, 0x7a, 0xa6, 0x4b, 0x08, 0xdd,
+	0x87, 0xcd, 0xa9, 0xb1, 0xce, 0x99, 0x4e, 0x07, 0x57, 0xd1, 0x1a, 0x48, 0x81, 0xf4, 0x58, 0xef,
+	0x06, 0xb5, 0x20, 0x5b, 0x52, 0x4c, 0xdb, 0xa4, 0x34, 0xb6, 0x8e, 0x98, 0x56, 0xa3, 0xdc, 0xc1,
+	0xe4, 0x63, 0xea, 0x3d, 0xb4, 0x03, 0x5b, 0xf5, 0x46, 0x63, 0xda, 0x9a, 0x14, 0x74, 0xa8, 0x36,
+	0xa5, 0xfb, 0x74, 0x4d, 0x67, 0xa7, 0x8d, 0xba, 0xae, 0xcc, 0x06, 0x6d, 0x51, 0x50, 0x20, 0x7c,
+	0x26, 0xe8, 0x0d, 0xb4, 0x0d, 0xf7, 0x4f, 0xea, 0xf8, 0x58, 0x00, 0x69, 0x76, 0xda, 0x8a, 0xb4,
+	0x43, 0x23, 0xf2, 0x29, 0x3e, 0x6b, 0x2b, 0x86, 0x7a, 0x52, 0x6f, 0x2a, 0x9a, 0xf4, 0x60, 0xf7,
+	0x77, 0x6f, 0xd2, 0x0c, 0xde, 0x1b, 0x39, 0x43, 0x8f, 0xa0, 0x27, 0xa9, 0xec, 0x63, 0xaa, 0x60,
+	0x16, 0xe2, 0x92, 0xc9, 0x46, 0x1d, 0x68, 0x1d, 0xd0, 0xb8, 0x20, 0xa6, 0xed, 0x5f, 0xd4, 0xb2,
+	0xe2, 0xe7, 0x76, 0xc4, 0xd8, 0x24, 0xfe, 0x11, 0x43, 0xe2, 0x42, 0x3f, 0x6c, 0xa2, 0x67, 0x40,
+	0x3b, 0xc6, 0xb9, 0x6d, 0xf6, 0xbd, 0x20, 0xe9, 0xf8, 0xef, 0x79, 0x12, 0x0e, 0x29, 0x10, 0xe7,
+	0xfb, 0x41, 0x0b, 0x35, 0x78, 0xf9, 0xf2, 0x8a, 0xb8, 0xac, 0x78, 0xc3, 0x43, 0xe8, 0xc3, 0x79,
+	0x12, 0x5e, 0x70, 0x28, 0x2b, 0x61, 0x06, 0x6d, 0xd4, 0x48, 0x17, 0x41, 0x17, 0x6f, 0x96, 0x22,
+	0x2a, 0x84, 0xea, 0xb0, 0xd2, 0x9f, 0x2a, 0x84, 0x2e, 0x89, 0x4b, 0x64, 0x49, 0x59, 0xe9, 0x62,
+	0x68, 0x3f, 0x4d, 0xa0, 0x46, 0x4e, 0x14, 0x43, 0x97, 0x6f, 0x30, 0xb2, 0xb0, 0x20, 0xfa, 0x2c,
+	0x59, 0x10, 0xcd, 0xdf, 0x60, 0x64, 0x41, 0x51, 0x34, 0xd8, 0x24, 0xcf, 0x37, 0x7d, 0x52, 0x2b,
+	0xdc, 0xc0, 0xdf, 0x24, 0xbe, 0x46, 0x81, 0x6c, 0x93, 0x58, 0x0b, 0x9d, 0x4c, 0x15, 0x55, 0x79,
+	0xcd, 0xeb, 0xed, 0x79, 0x42, 0x66, 0x17, 0x56, 0x03, 0x71, 0xe7, 0xae, 0x39, 0x20, 0xaf, 0x1c,
+	0xf7, 0xd2, 0xab, 0x15, 0x6f, 0x16, 0x77, 0x18, 0xa1, 0x99, 0xb8, 0xb8, 0x8b, 0xbe, 0x07, 0x94,
+	0x60, 0x90, 0xaf, 0x48, 0x77, 0xec, 0x3b, 0xae, 0x17, 0x54, 0x1c, 0xdf, 0x9a, 0x27, 0x4d, 0x09,
+	0xc1, 0xb8, 0xd4, 0x4f, 0xf4, 0xc2, 0xa9, 0x39, 0x23, 0xc2, 0x1f, 0xd1, 0x5e, 0x0d, 0xdd, 0x3c,
+	0xb5, 0x4e, 0x84, 0x66, 0x53, 0x8b, 0xbb, 0xa1, 0xe1, 0x7d, 0xd3, 0xbb, 0xf4, 0x6a, 0xe5, 0x9b,
+	0x0d, 0xaf, 0x53, 0x20, 0x33, 0x3c, 0x6b, 0x85, 0xfc, 0x0c, 0x58, 0xab, 0xde, 0xcc, 0x5f, 0xa7,
+	0x14, 0xc6, 0xcf, 0x5a, 0xa8, 0x0b, 0x1b, 0x94, 0x7f, 0x2a, 0x94, 0x7b, 0xb5, 0x15, 0x71, 0x35,
+	0x25, 0x29, 0x6c, 0x32, 0xb4, 0x7b, 0x78, 0xad, 0x2f, 0xa0, 0xce, 0xae, 0x5f, 0xf3, 0x52, 0xe0,
+	0xfb, 0x33, 0x75, 0x08, 0x5e, 0xc5, 0xe2, 0xfa, 0xf5, 0xc9, 0x54, 0x95, 0x51, 0xba, 0x61, 0x57,
+	0xe6, 0x55, 0x1a, 0xe5, 0xb7, 0xa0, 0x10, 0xf9, 0x32, 0x54, 0x83, 0x65, 0xee, 0xff, 0xae, 0x59,
+	0x72, 0x93, 0xc7, 0x61, 0x57, 0x7e, 0x0c, 0xf9, 0xd0, 0x61, 0xa1, 0x37, 0x61, 0x91, 0xbb, 0x38,
+	0x9e, 0xa6, 0x54, 0x62, 0xc5, 0x74, 0x1c, 0xf3, 0x41, 0xf9, 0x90, 0x95, 0x83, 0x42, 0xa7, 0xf4,
+	0x14, 0x4a, 0x81, 0x5b, 0x33, 0x12, 0x39, 0x5e, 0x22, 0x0d, 0x0d, 0x80, 0x2c, 0xb5, 0x2b, 0x5e,
+	0xc5, 0x1d, 0xf9, 0x69, 0xaa, 0xac, 0xf4, 0x1e, 0x2c, 0x87, 0x8e, 0x8d, 0x6b, 0x97, 0x62, 0x11,
+	0x1c, 0x83, 0x43, 0x80, 0xfc, 0x0e, 0x54, 0x9b, 0xb7, 0x29, 0x28, 0xc9, 0xcf, 0x92, 0xc5, 0xa1,
+	0x27, 0x00, 0xd4, 0xb5, 0xb0, 0x69, 0x86, 0x4a, 0x12, 0x0f, 0x1f, 0x0a, 0xe2, 0x2f, 0x98, 0xf3,
+	0xa0, 0xe5, 0xc9, 0xfb, 0xe9, 0xda, 0x91, 0x67, 0xfd, 0x84, 0x27, 0x9c, 0x39, 0xcc, 0xda, 0x94,
+	0xd6, 0x33, 0x7d, 0x93, 0x25, 0x9c, 0x25, 0xcc, 0xda, 0xf2, 0x5f, 0x33, 0xcc, 0xa2, 0xdc, 0xa7,
+	0xa4, 0xae, 0x46, 0xe6, 0x9b, 0x5f, 0x8d, 0xa9, 0x5b, 0x9f, 0xbd, 0xf3, 0xad, 0x4f, 0x38, 0xa4,
+	0x85, 0x3b, 0x38, 0x24, 0xf9, 0xcf, 0x0b, 0x93, 0x85, 0xb0, 0x53, 0x80, 0x84, 0xf3, 0xcc, 0x88,
+	0xdf, 0x7c, 0x62, 0xe7, 0x19, 0x3f, 0x56, 0x70, 0x42, 0x86, 0xfc, 0xf7, 0x2c, 0x14, 0x52, 0x2f,
+	0x9e, 0x68, 0xf2, 0x3c, 0x4d, 0x9f, 0x78, 0xee, 0x46, 0xb3, 0xa3, 0x2f, 0x9e, 0x08, 0xaa, 0xd2,
+	0x1a, 0x48, 0x31, 0x34, 0x61, 0x5c, 0x6e, 0x58, 0x8b, 0x19, 0x43, 0x23, 0xa9, 0x0d, 0x0c, 0x21,
+	0x50, 0xed, 0xa1, 0x87, 0x50, 0x8e, 0xd8, 0xd8, 0xc3, 0x62, 0x81, 0xbd, 0x38, 0x4b, 0x21, 0xb1,
+	0x6d, 0x0e, 0xa6, 0x6b, 0x19, 0xb9, 0x5b, 0x3f, 0xf6, 0x1a, 0x20, 0xc5, 0x9c, 0x34, 0x6c, 0x8d,
+	0xc3, 0xa0, 0x7e, 0x4f, 0xc0, 0xad, 0x31, 0x00, 0xae, 0x76, 0xd3, 0x04, 0x74, 0x02, 0xab, 0x91,
+	0xe7, 0xa3, 0x42, 0x2c, 0xcf, 0xa7, 0x97, 0x88, 0x47, 0x74, 0xc1, 0x1b, 0x46, 0x8b, 0x30, 0xf1,
+	0x1b, 0x26, 0xa6, 0xc9, 0xbf, 0xca, 0xb2, 0x6d, 0x4d, 0x44, 0x9e, 0x53, 0x80, 0xc4, 0x99, 0xb9,
+	0xc5, 0xb6, 0xc6, 0xbc, 0xf1, 0x8e, 0xe0, 0x84, 0x0c, 0xd4, 0x85, 0xb5, 0xae, 0x33, 0x18, 0xd9,
+	0x84, 0x7a, 0xd2, 0x84, 0xec, 0xec, 0x6b, 0xca, 0x5e, 0x8d, 0xa4, 0x25, 0xce, 0xe7, 0x31, 0x14,
+	0xa2, 0x1e, 0x7a, 0x06, 0x95, 0xc4, 0xd1, 0x89, 0xfd, 0xd4, 0xa6, 0xe8, 0xf0, 0x50, 0x27, 0x50,
+	0x3e, 0x4f, 0x76, 0xe5, 0x9f, 0x67, 0xa1, 0x94, 0xbc, 0x5a, 0xa8, 0x05, 0x85, 0xf8, 0x52, 0x72,
+	0x9b, 0xec, 0xdd, 0xea, 0x52, 0x46, 0x67, 0x0d, 0xc7, 0x02, 0x90, 0x01, 0xf1, 0x12, 0x52, 0x97,
+	0xfd, 0x75, 0xe4, 0xa2, 0x48, 0x54, 0x34, 0x28, 0x37, 0x21, 0x1f, 0x76, 0xd0, 0xa7, 0x89, 0x53,
+	0x9d, 0x30, 0xc5, 0x86, 0xe0, 0x3a, 0x50, 0x4b, 0x94, 0x48, 0xa2, 0x27, 0x37, 0xd8, 0xe9, 0x48,
+	0x04, 0xff, 0x0f, 0x01, 0x12, 0x79, 0x04, 0xb7, 0xc4, 0x6a, 0x2c, 0x2a, 0x42, 0xe2, 0x04, 0x4c,
+	0xfe, 0x75, 0x96, 0xf9, 0x48, 0xee, 0xe3, 0x3e, 0x84, 0xf2, 0x88, 0x0c, 0x7b, 0x34, 0x19, 0x0d,
+	0xfd, 0xe4, 0x44, 0xf4, 0xa1, 0x38, 0x5c, 0x0a, 0x40, 0x9c, 0xe9, 0x09, 0x94, 0x7e, 0x3c, 0x26,
+	0x63, 0xd2, 0x0b, 0x78, 0xb2, 0x42, 0x9e, 0x22, 0xc7, 0x70, 0x96, 0x8f, 0xa0, 0xc2, 0x3f, 0xec,
+	0x45, 0x4c, 0x0b, 0x42, 0xa6, 0x72, 0x88, 0xe2, 0x6c, 0xff, 0x0f, 0x92, 0x4f, 0xdc, 0x81, 0x35,
+	0x34, 0xfd, 0x88, 0x31, 0x27, 0x64, 0xac, 0xc6, 0x38, 0xce, 0xfa, 0x31, 0x54, 0xe3, 0x6d, 0xe5,
+	0x9c, 0x8b, 0x42, 0xce, 0x4a, 0x04, 0x63, 0x8c, 0xf2, 0x9f, 0x78, 0x0c, 0xe1, 0xe9, 0xcd, 0x3e,
+	0x00, 0xdb, 0xf7, 0x70, 0xb3, 0x32, 0x69, 0x0b, 0x33, 0x10, 0x0f, 0x5c, 0x66, 0xd8, 0xa4, 0x4e,
+	0xa9, 0xe7, 0x9a, 0xd6, 0x30, 0xfc, 0x98, 0x35, 0x55, 0x19, 0x6c, 0xd0, 0xd1, 0xe0, 0x83, 0x55,
+	0xb1, 0x17, 0x77, 0x50, 0x07, 0x64, 0xe2, 0xf9, 0xd6, 0x80, 0xad, 0x96, 0xcb, 0xf0, 0x7c, 0xd3,
+	0xf5, 0x0d, 0xdf, 0x0a, 0x1c, 0x60, 0x2a, 0x6a, 0xea, 0xd6, 0x80, 0x47, 0xcd, 0xcd, 0x88, 0x8b,
+	0x89, 0xd6, 0x28, 0x0f, 0x1d, 0x94, 0x7f, 0x9f, 0x85, 0x35, 0x51, 0x9e, 0x85, 0x06, 0x80, 0x04,
+	0x29, 0x1b, 0xdf, 0xfc, 0x67, 0xdf, 0x28, 0x65, 0x9b, 0xaa, 0xcf, 0xe0, 0x95, 0xc9, 0xea, 0x8c,
+	0x27, 0xff, 0x36, 0x03, 0xd2, 0x24, 0x0e, 0xe9, 0xb0, 0x21, 0xa8, 0x00, 0xdd, 0xbe, 0x56, 0xb5,
+	0xe6, 0x0a, 0xa8, 0xe8, 0x53, 0xa8, 0xfa, 0x8e, 0x6f, 0xda, 0x46, 0x5c, 0x67, 0xcd, 0xce, 0xac,
+	0xb3, 0x56, 0x18, 0x34, 0xec, 0x7a, 0xf2, 0x3f, 0x32, 0xe2, 0x2f, 0x29, 0xdb, 0x34, 0x86, 0x59,
+	0x7e, 0x18, 0x28, 0x32, 0xac, 0x3e, 0x08, 0x94, 0x14, 0x04, 0x82, 0x77, 0x61, 0x91, 0xbf, 0x7d,
+	0xb2, 0xec, 0x6d, 0xbc, 0x9a, 0x3e, 0x63, 0xfc, 0xb5, 0xc3, 0x11, 0xe8, 0x43, 0x58, 0x72, 0x89,
+	0xe9, 0x39, 0x43, 0xb6, 0xa1, 0x95, 0xfd, 0xfb, 0xd3, 0xd8, 0x31, 0xb5, 0x2a, 0x85, 0xe0, 0x00,
+	0x8a, 0x3e, 0xa3, 0x4f, 0xbc, 0x81, 0xe5, 0x9b, 0x7e, 0xfc, 0x86, 0xdd, 0x99, 0x38, 0xc8, 0xc1,
+	0x2a, 0x5a, 0x11, 0x0e, 0x27, 0x78, 0x68, 0x16, 0x3a, 0x20, 0x9e, 0x67, 0xf6, 0xf9, 0x0f, 0x2f,
+	0x05, 0x1c, 0x76, 0xe5, 0xbf, 0x65, 0x26, 0xcb, 0xb1, 0xff, 0xc9, 0xcb, 0xdd, 0xfd, 0x4b, 0xf6,
+	0xdb, 0xac, 0xef, 0xa5, 0x0b, 0x79, 0x4b, 0xe9, 0x42, 0xde, 0x72, 0xba, 0x90, 0x97, 0x17, 0x14,
+	0xf2, 0x0a, 0x82, 0x42, 0x1e, 0x4c, 0x17, 0xf2, 0x8a, 0x82, 0x42, 0xde, 0x4a, 0xba, 0x90, 0x57,
+	0x4a, 0x17, 0xf2, 0x2a, 0x73, 0x0a, 0x79, 0xd2, 0xec, 0x3a, 0x5d, 0x99, 0xd5, 0xd9, 0xa6, 0x6b,
+	0x5e, 0xd5, 0xdd, 0xdf, 0x2c, 0x42, 0x21, 0xfa, 0xf8, 0x43, 0xcb, 0xce, 0x89, 0x5a, 0xd1, 0x83,
+	0x99, 0x5f, 0x89, 0x92, 0xc5, 0xa2, 0xfd, 0x28, 0xa3, 0x17, 0xfe, 0xce, 0x15, 0xf3, 0x34, 0x4c,
+	0xdf, 0xe4, 0x19, 0x3f, 0xfa, 0x94, 0x7e, 0x81, 0x19, 0xfa, 0xae, 0x63, 0xcf, 0xaa, 0x0d, 0xc5,
+	0x6c, 0x07, 0x1c, 0x88, 0x43, 0x0e, 0xf9, 0x97, 0x19, 0xc8, 0x51, 0x59, 0xe8, 0xe3, 0xd4, 0x6c,
+	0x1f, 0xce, 0xd7, 0x9c, 0x9c, 0x32, 0x4a, 0x4c, 0x39, 0x78, 0x84, 0xec, 0xfe, 0x9f, 0xe8, 0x7c,
+	0x15, 0x60, 0x51, 0xd3, 0x1b, 0x6a, 0x5b, 0xca, 0x20, 0x80, 0x25, 0x4d, 0x6f, 0x74, 0xce, 0x74,
+	0x29, 0x1b, 0xb4, 0x15, 0x8c, 0xa5, 0x05, 0xf9, 0xeb, 0x2c, 0x2c, 0x07, 0x53, 0x44, 0x9f, 0xa4,
+	0x26, 0xf4, 0xf6, 0x8d, 0x6b, 0x4a, 0xce, 0xe9, 0x7d, 0xc8, 0xfb, 0xfe, 0x35, 0xf7, 0xa4, 0xdc,
+	0x94, 0x2b, 0x89, 0xab, 0xa3, 0x7f, 0x11, 0xfc, 0x20, 0xe0, 0x5f, 0xd3, 0x06, 0x52, 0xa1, 0x70,
+	0x41, 0x4c, 0xd7, 0xff, 0x92, 0x98, 0x7e, 0x60, 0xc2, 0xff, 0xb9, 0x59, 0xdd, 0x51, 0xc8, 0x82,
+	0x63, 0x6e, 0xf9, 0xbb, 0x50, 0x88, 0xe8, 0xf4, 0x7f, 0x02, 0x6b, 0xe8, 0x13, 0xf7, 0xca, 0xb4,
+	0x6f, 0xf8, 0x57, 0x21, 0xc2, 0xed, 0x3e, 0x16, 0x59, 0xae, 0x04, 0x79, 0x5d, 0xff, 0xc2, 0x50,
+	0xdb, 0x87, 0x1d, 0x7e, 0x2f, 0x8f, 0x94, 0x3a, 0xd6, 0x9f, 0x2b, 0x75, 0x5d, 0xca, 0xee, 0xbe,
+	0x27, 0xe2, 0xc8, 0x43, 0xae, 0x51, 0xd7, 0xeb, 0x52, 0x86, 0x92, 0xe9, 0x11, 0xc5, 0x9d, 0x96,
+	0x94, 0x7d, 0xfe, 0x10, 0xee, 0x39, 0x6e, 0x7f, 0xcf, 0x1c, 0x99, 0xdd, 0x0b, 0x32, 0xb1, 0xc4,
+	0xe7, 0x4b, 0xa7, 0xae, 0xe3, 0x3b, 0xde, 0x3f, 0x07, 0x00, 0xc0, 0x85, 0x2f, 0xdc, 0x5b, 0x29,
+	0x00, 0x00,
+}
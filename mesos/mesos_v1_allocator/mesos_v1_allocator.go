@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1_allocator.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1_allocator is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1_allocator.proto
+
+It has these top-level messages:
+	InverseOfferStatus
+*/
+package mesos_v1_allocator
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import mesos_v1 "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+type InverseOfferStatus_Status int32
+
+const (
+	// We have not received a response yet. This is the default state before
+	// receiving a response.
+	InverseOfferStatus_UNKNOWN InverseOfferStatus_Status = 1
+	// The framework is ok with the inverse offer. This means it will not
+	// violate any SLAs and will attempt to evacuate any tasks running on the
+	// agent. If the tasks are not evacuated by the framework, the operator can
+	// manually shut down the slave knowing that the framework will not have
+	// violated its SLAs.
+	InverseOfferStatus_ACCEPT InverseOfferStatus_Status = 2
+	// The framework wants to block the maintenance operation from happening. An
+	// example would be that it cannot meet its SLA by losing resources.
+	InverseOfferStatus_DECLINE InverseOfferStatus_Status = 3
+)
+
+var InverseOfferStatus_Status_name = map[int32]string{
+	1: "UNKNOWN",
+	2: "ACCEPT",
+	3: "DECLINE",
+}
+var InverseOfferStatus_Status_value = map[string]int32{
+	"UNKNOWN": 1,
+	"ACCEPT":  2,
+	"DECLINE": 3,
+}
+
+func (x InverseOfferStatus_Status) Enum() *InverseOfferStatus_Status {
+	p := new(InverseOfferStatus_Status)
+	*p = x
+	return p
+}
+func (x InverseOfferStatus_Status) String() string {
+	return proto.EnumName(InverseOfferStatus_Status_name, int32(x))
+}
+func (x *InverseOfferStatus_Status) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(InverseOfferStatus_Status_value, data, "InverseOfferStatus_Status")
+	if err != nil {
+		return err
+	}
+	*x = InverseOfferStatus_Status(value)
+	return nil
+}
+func (InverseOfferStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 0}
+}
+
+// Describes the status of an inverse offer.
+//
+// This is a protobuf so as to be able to share the status to inverse offers
+// through endpoints such as the maintenance status endpoint.
+type InverseOfferStatus struct {
+	Status      *InverseOfferStatus_Status `protobuf:"varint,1,req,name=status,enum=mesos.v1.allocator.InverseOfferStatus_Status" json:"status,omitempty"`
+	FrameworkId *mesos_v1.FrameworkID      `protobuf:"bytes,2,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	// Time, since the epoch, when this status was last updated.
+	Timestamp        *mesos_v1.TimeInfo `protobuf:"bytes,3,req,name=timestamp" json:"timestamp,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *InverseOfferStatus) Reset()                    { *m = InverseOfferStatus{} }
+func (m *InverseOfferStatus) String() string            { return proto.CompactTextString(m) }
+func (*InverseOfferStatus) ProtoMessage()               {}
+func (*InverseOfferStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *InverseOfferStatus) GetStatus() InverseOfferStatus_Status {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return InverseOfferStatus_UNKNOWN
+}
+
+func (m *InverseOfferStatus) GetFrameworkId() *mesos_v1.FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *InverseOfferStatus) GetTimestamp() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InverseOfferStatus)(nil), "mesos.v1.allocator.InverseOfferStatus")
+	proto.RegisterEnum("mesos.v1.allocator.InverseOfferStatus_Status", InverseOfferStatus_Status_name, InverseOfferStatus_Status_value)
+}
+
+var fileDescriptor0 = []byte{
+	// 244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4b, 0xc3, 0x30,
+	0x14, 0x80, 0x69, 0x07, 0x15, 0x5f, 0x65, 0x94, 0x80, 0x50, 0x04, 0x61, 0xec, 0xe2, 0x2e, 0x06,
+	0xb7, 0x93, 0x57, 0xd7, 0x55, 0x08, 0x4a, 0x37, 0xea, 0xc4, 0x63, 0x09, 0xdb, 0x8b, 0x16, 0x97,
+	0xbd, 0x92, 0xc4, 0xfa, 0xa7, 0x7b, 0x95, 0x75, 0x3f, 0x7a, 0xe8, 0x4e, 0x09, 0x7c, 0xdf, 0xf7,
+	0x48, 0x1e, 0xc4, 0x1a, 0x2d, 0xd9, 0xa2, 0x1e, 0x17, 0x72, 0xb3, 0xa1, 0x95, 0x74, 0x64, 0x78,
+	0x65, 0xc8, 0x11, 0x63, 0x0d, 0xe1, 0xf5, 0x98, 0x9f, 0xc8, 0x4d, 0xff, 0x68, 0xef, 0x9d, 0xe1,
+	0x9f, 0x07, 0x4c, 0x6c, 0x6b, 0x34, 0x16, 0xe7, 0x4a, 0xa1, 0x79, 0x73, 0xd2, 0xfd, 0x58, 0x96,
+	0x42, 0x60, 0x9b, 0x5b, 0xec, 0x0d, 0xfc, 0x51, 0x7f, 0x72, 0xcf, 0xbb, 0xb3, 0x78, 0xb7, 0xe3,
+	0xfb, 0x23, 0x3f, 0xc4, 0xec, 0x11, 0xae, 0x94, 0x91, 0x1a, 0x7f, 0xc9, 0x7c, 0x17, 0xe5, 0x3a,
+	0xf6, 0x07, 0xfe, 0x28, 0x9c, 0x5c, 0xb7, 0xc3, 0x9e, 0x8f, 0x54, 0xcc, 0xf2, 0xf0, 0xa4, 0x8a,
+	0x35, 0x7b, 0x80, 0x4b, 0x57, 0x6a, 0xb4, 0x4e, 0xea, 0x2a, 0xee, 0x35, 0x19, 0x6b, 0xb3, 0x65,
+	0xa9, 0x51, 0x6c, 0x15, 0xe5, 0xad, 0x34, 0xe4, 0x10, 0x1c, 0x1e, 0x1f, 0xc2, 0xc5, 0x7b, 0xf6,
+	0x92, 0xcd, 0x3f, 0xb2, 0xc8, 0x63, 0x00, 0xc1, 0x53, 0x92, 0xa4, 0x8b, 0x65, 0xe4, 0xef, 0xc0,
+	0x2c, 0x4d, 0x5e, 0x45, 0x96, 0x46, 0xbd, 0xe9, 0x1d, 0xdc, 0x92, 0xf9, 0xe4, 0xb2, 0x92, 0xab,
+	0x2f, 0x3c, 0xf3, 0xbd, 0x69, 0xb0, 0xd8, 0x6d, 0xc8, 0xfe, 0x0f, 0x00, 0x5c, 0x6e, 0x5f, 0x41,
+	0x61, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1_maintenance.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1_maintenance is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1_maintenance.proto
+
+It has these top-level messages:
+	Window
+	Schedule
+	ClusterStatus
+*/
+package mesos_v1_maintenance
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import mesos_v1 "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+import mesos_v1_allocator "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_allocator"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+// A set of machines scheduled to go into maintenance
+// in the same `unavailability`.
+type Window struct {
+	// Machines affected by this maintenance window.
+	MachineIds []*mesos_v1.MachineID `protobuf:"bytes,1,rep,name=machine_ids,json=machineIds" json:"machine_ids,omitempty"`
+	// Interval during which this set of machines is expected to be down.
+	Unavailability   *mesos_v1.Unavailability `protobuf:"bytes,2,req,name=unavailability" json:"unavailability,omitempty"`
+	XXX_unrecognized []byte                   `json:"-"`
+}
+
+func (m *Window) Reset()                    { *m = Window{} }
+func (m *Window) String() string            { return proto.CompactTextString(m) }
+func (*Window) ProtoMessage()               {}
+func (*Window) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Window) GetMachineIds() []*mesos_v1.MachineID {
+	if m != nil {
+		return m.MachineIds
+	}
+	return nil
+}
+
+func (m *Window) GetUnavailability() *mesos_v1.Unavailability {
+	if m != nil {
+		return m.Unavailability
+	}
+	return nil
+}
+
+// A list of maintenance windows.
+// For example, this may represent a rolling restart of agents.
+type Schedule struct {
+	Windows          []*Window `protobuf:"bytes,1,rep,name=windows" json:"windows,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *Schedule) Reset()                    { *m = Schedule{} }
+func (m *Schedule) String() string            { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()               {}
+func (*Schedule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Schedule) GetWindows() []*Window {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// Represents the maintenance status of each machine in the cluster.
+// The lists correspond to the `MachineInfo.Mode` enumeration.
+type ClusterStatus struct {
+	DrainingMachines []*ClusterStatus_DrainingMachine `protobuf:"bytes,1,rep,name=draining_machines,json=drainingMachines" json:"draining_machines,omitempty"`
+	DownMachines     []*mesos_v1.MachineID            `protobuf:"bytes,2,rep,name=down_machines,json=downMachines" json:"down_machines,omitempty"`
+	XXX_unrecognized []byte                           `json:"-"`
+}
+
+func (m *ClusterStatus) Reset()                    { *m = ClusterStatus{} }
+func (m *ClusterStatus) String() string            { return proto.CompactTextString(m) }
+func (*ClusterStatus) ProtoMessage()               {}
+func (*ClusterStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ClusterStatus) GetDrainingMachines() []*ClusterStatus_DrainingMachine {
+	if m != nil {
+		return m.DrainingMachines
+	}
+	return nil
+}
+
+func (m *ClusterStatus) GetDownMachines() []*mesos_v1.MachineID {
+	if m != nil {
+		return m.DownMachines
+	}
+	return nil
+}
+
+type ClusterStatus_DrainingMachine struct {
+	Id *mesos_v1.MachineID `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	// A list of the most recent responses to inverse offers from frameworks
+	// running on this draining machine.
+	Statuses         []*mesos_v1_allocator.InverseOfferStatus `protobuf:"bytes,2,rep,name=statuses" json:"statuses,omitempty"`
+	XXX_unrecognized []byte                                   `json:"-"`
+}
+
+func (m *ClusterStatus_DrainingMachine) Reset()         { *m = ClusterStatus_DrainingMachine{} }
+func (m *ClusterStatus_DrainingMachine) String() string { return proto.CompactTextString(m) }
+func (*ClusterStatus_DrainingMachine) ProtoMessage()    {}
+func (*ClusterStatus_DrainingMachine) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 0}
+}
+
+func (m *ClusterStatus_DrainingMachine) GetId() *mesos_v1.MachineID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ClusterStatus_DrainingMachine) GetStatuses() []*mesos_v1_allocator.InverseOfferStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Window)(nil), "mesos.v1.maintenance.Window")
+	proto.RegisterType((*Schedule)(nil), "mesos.v1.maintenance.Schedule")
+	proto.RegisterType((*ClusterStatus)(nil), "mesos.v1.maintenance.ClusterStatus")
+	proto.RegisterType((*ClusterStatus_DrainingMachine)(nil), "mesos.v1.maintenance.ClusterStatus.DrainingMachine")
+}
+
+var fileDescriptor0 = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x5f, 0x4b, 0x02, 0x41,
+	0x14, 0xc5, 0x71, 0x02, 0x93, 0x6b, 0x5a, 0x4d, 0x3d, 0x2c, 0x4b, 0x90, 0x18, 0x84, 0xbd, 0x0c,
+	0x68, 0x11, 0x3d, 0xc6, 0xe6, 0x8b, 0x0f, 0x51, 0xac, 0x44, 0x8f, 0xdb, 0x6d, 0x67, 0xd4, 0x81,
+	0x75, 0x46, 0x76, 0x66, 0x57, 0xea, 0xa9, 0x6f, 0xd3, 0xd7, 0x0c, 0xdd, 0x7f, 0x29, 0xfa, 0xba,
+	0xe7, 0x9c, 0xdf, 0x3d, 0xf7, 0xee, 0x80, 0x3b, 0x17, 0x46, 0x9b, 0x20, 0xed, 0x07, 0x73, 0x94,
+	0xca, 0x0a, 0x85, 0x2a, 0x14, 0x6c, 0x11, 0x6b, 0xab, 0xe9, 0xf9, 0x5a, 0x63, 0x69, 0x9f, 0xfd,
+	0xd3, 0xdc, 0x76, 0x91, 0xc8, 0x5c, 0xae, 0x53, 0x12, 0x30, 0x8a, 0x74, 0x88, 0x56, 0xc7, 0x99,
+	0xd2, 0xfd, 0xa9, 0x41, 0xfd, 0x5d, 0x2a, 0xae, 0x97, 0xf4, 0x0e, 0x9a, 0x73, 0x0c, 0x67, 0x52,
+	0x89, 0x40, 0x72, 0xe3, 0xd4, 0x3a, 0x07, 0xbd, 0xe6, 0xe0, 0x8c, 0x95, 0x03, 0x9e, 0x33, 0x71,
+	0x34, 0xf4, 0x21, 0xf7, 0x8d, 0xb8, 0xa1, 0x8f, 0xd0, 0x4e, 0x14, 0xa6, 0x28, 0x23, 0xfc, 0x94,
+	0x91, 0xb4, 0x5f, 0x0e, 0xe9, 0x90, 0x5e, 0x73, 0xe0, 0x54, 0xc1, 0xb7, 0x0d, 0xdd, 0xdf, 0xf2,
+	0x77, 0x3d, 0x68, 0x8c, 0xc3, 0x99, 0xe0, 0x49, 0x24, 0xe8, 0x3d, 0x1c, 0x2e, 0xd7, 0x6d, 0x8a,
+	0xf9, 0x17, 0x6c, 0xd7, 0x82, 0x2c, 0xab, 0xec, 0x17, 0xe6, 0xee, 0x2f, 0x81, 0xd6, 0x53, 0x94,
+	0x18, 0x2b, 0xe2, 0xb1, 0x45, 0x9b, 0x18, 0xfa, 0x01, 0xa7, 0x3c, 0x46, 0xa9, 0xa4, 0x9a, 0x06,
+	0x79, 0xdd, 0x82, 0x79, 0xbb, 0x9b, 0xb9, 0x91, 0x67, 0xc3, 0x3c, 0x9c, 0x6f, 0xed, 0x9f, 0xf0,
+	0xcd, 0x0f, 0x86, 0x3e, 0x40, 0x8b, 0xeb, 0xa5, 0xaa, 0xe8, 0x64, 0xff, 0xc5, 0x8e, 0x56, 0xce,
+	0x22, 0xe9, 0x7e, 0xc3, 0xf1, 0x16, 0x9e, 0x5e, 0x01, 0x91, 0xdc, 0xa9, 0x75, 0xc8, 0x3e, 0x02,
+	0x91, 0x9c, 0x7a, 0xd0, 0x30, 0xeb, 0x76, 0xe5, 0xb0, 0xeb, 0xca, 0x5a, 0xfd, 0xd9, 0x91, 0x4a,
+	0x45, 0x6c, 0xc4, 0xcb, 0x64, 0x52, 0x6c, 0xe3, 0x97, 0x39, 0xef, 0x06, 0x2e, 0x75, 0x3c, 0x65,
+	0xb8, 0xc0, 0x70, 0x26, 0x76, 0x1e, 0xc2, 0xab, 0xbf, 0xae, 0x9e, 0x86, 0xf9, 0x1b, 0x00, 0xea,
+	0x19, 0x1a, 0x5b, 0x78, 0x02, 0x00, 0x00,
+}
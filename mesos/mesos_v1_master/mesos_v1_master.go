@@ -0,0 +1,2661 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1_master.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1_master is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1_master.proto
+
+It has these top-level messages:
+	Call
+	Response
+	Event
+*/
+package mesos_v1_master
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/duration"
+import mesos_v1 "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+import _ "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_allocator"
+import mesos_v1_maintenance "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_maintenance"
+import mesos_v1_quota "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_quota"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+type Call_Type int32
+
+const (
+	// If a call of type `Call::FOO` requires additional parameters they can be
+	// included in the corresponding `Call::Foo` message. Similarly, if a call
+	// receives a synchronous response it will be returned as a `Response`
+	// message of type `Response::FOO`. Currently all calls except
+	// `Call::SUBSCRIBE` receive synchronous responses; `Call::SUBSCRIBE` returns
+	// a streaming response of `Event`.
+	Call_UNKNOWN             Call_Type = 0
+	Call_GET_HEALTH          Call_Type = 1
+	Call_GET_FLAGS           Call_Type = 2
+	Call_GET_VERSION         Call_Type = 3
+	Call_GET_METRICS         Call_Type = 4
+	Call_GET_LOGGING_LEVEL   Call_Type = 5
+	Call_SET_LOGGING_LEVEL   Call_Type = 6
+	Call_LIST_FILES          Call_Type = 7
+	Call_READ_FILE           Call_Type = 8
+	Call_GET_STATE           Call_Type = 9
+	Call_GET_AGENTS          Call_Type = 10
+	Call_GET_FRAMEWORKS      Call_Type = 11
+	Call_GET_EXECUTORS       Call_Type = 12
+	Call_GET_OPERATIONS      Call_Type = 33
+	Call_GET_TASKS           Call_Type = 13
+	Call_GET_ROLES           Call_Type = 14
+	Call_GET_WEIGHTS         Call_Type = 15
+	Call_UPDATE_WEIGHTS      Call_Type = 16
+	Call_GET_MASTER          Call_Type = 17
+	Call_SUBSCRIBE           Call_Type = 18
+	Call_RESERVE_RESOURCES   Call_Type = 19
+	Call_UNRESERVE_RESOURCES Call_Type = 20
+	Call_CREATE_VOLUMES      Call_Type = 21
+	Call_DESTROY_VOLUMES     Call_Type = 22
+	Call_GROW_VOLUME         Call_Type = 34
+	Call_SHRINK_VOLUME       Call_Type = 35
+	// Retrieves the cluster's maintenance status.
+	Call_GET_MAINTENANCE_STATUS Call_Type = 23
+	// Retrieves the cluster's maintenance schedule.
+	Call_GET_MAINTENANCE_SCHEDULE    Call_Type = 24
+	Call_UPDATE_MAINTENANCE_SCHEDULE Call_Type = 25
+	Call_START_MAINTENANCE           Call_Type = 26
+	Call_STOP_MAINTENANCE            Call_Type = 27
+	Call_DRAIN_AGENT                 Call_Type = 37
+	Call_DEACTIVATE_AGENT            Call_Type = 38
+	Call_REACTIVATE_AGENT            Call_Type = 39
+	Call_GET_QUOTA                   Call_Type = 28
+	Call_UPDATE_QUOTA                Call_Type = 36
+	// `SET_QUOTA` and `REMOVE_QUOTA` are deprecated in favor
+	// of `UPDATE_QUOTA`. To reset a role's quota back to the default
+	// (no guarantees and no limits), simply update the role's quota
+	// with empty guarantees and limits.
+	Call_SET_QUOTA       Call_Type = 29
+	Call_REMOVE_QUOTA    Call_Type = 30
+	Call_TEARDOWN        Call_Type = 31
+	Call_MARK_AGENT_GONE Call_Type = 32
+)
+
+var Call_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "GET_HEALTH",
+	2:  "GET_FLAGS",
+	3:  "GET_VERSION",
+	4:  "GET_METRICS",
+	5:  "GET_LOGGING_LEVEL",
+	6:  "SET_LOGGING_LEVEL",
+	7:  "LIST_FILES",
+	8:  "READ_FILE",
+	9:  "GET_STATE",
+	10: "GET_AGENTS",
+	11: "GET_FRAMEWORKS",
+	12: "GET_EXECUTORS",
+	33: "GET_OPERATIONS",
+	13: "GET_TASKS",
+	14: "GET_ROLES",
+	15: "GET_WEIGHTS",
+	16: "UPDATE_WEIGHTS",
+	17: "GET_MASTER",
+	18: "SUBSCRIBE",
+	19: "RESERVE_RESOURCES",
+	20: "UNRESERVE_RESOURCES",
+	21: "CREATE_VOLUMES",
+	22: "DESTROY_VOLUMES",
+	34: "GROW_VOLUME",
+	35: "SHRINK_VOLUME",
+	23: "GET_MAINTENANCE_STATUS",
+	24: "GET_MAINTENANCE_SCHEDULE",
+	25: "UPDATE_MAINTENANCE_SCHEDULE",
+	26: "START_MAINTENANCE",
+	27: "STOP_MAINTENANCE",
+	37: "DRAIN_AGENT",
+	38: "DEACTIVATE_AGENT",
+	39: "REACTIVATE_AGENT",
+	28: "GET_QUOTA",
+	36: "UPDATE_QUOTA",
+	29: "SET_QUOTA",
+	30: "REMOVE_QUOTA",
+	31: "TEARDOWN",
+	32: "MARK_AGENT_GONE",
+}
+var Call_Type_value = map[string]int32{
+	"UNKNOWN":                     0,
+	"GET_HEALTH":                  1,
+	"GET_FLAGS":                   2,
+	"GET_VERSION":                 3,
+	"GET_METRICS":                 4,
+	"GET_LOGGING_LEVEL":           5,
+	"SET_LOGGING_LEVEL":           6,
+	"LIST_FILES":                  7,
+	"READ_FILE":                   8,
+	"GET_STATE":                   9,
+	"GET_AGENTS":                  10,
+	"GET_FRAMEWORKS":              11,
+	"GET_EXECUTORS":               12,
+	"GET_OPERATIONS":              33,
+	"GET_TASKS":                   13,
+	"GET_ROLES":                   14,
+	"GET_WEIGHTS":                 15,
+	"UPDATE_WEIGHTS":              16,
+	"GET_MASTER":                  17,
+	"SUBSCRIBE":                   18,
+	"RESERVE_RESOURCES":           19,
+	"UNRESERVE_RESOURCES":         20,
+	"CREATE_VOLUMES":              21,
+	"DESTROY_VOLUMES":             22,
+	"GROW_VOLUME":                 34,
+	"SHRINK_VOLUME":               35,
+	"GET_MAINTENANCE_STATUS":      23,
+	"GET_MAINTENANCE_SCHEDULE":    24,
+	"UPDATE_MAINTENANCE_SCHEDULE": 25,
+	"START_MAINTENANCE":           26,
+	"STOP_MAINTENANCE":            27,
+	"DRAIN_AGENT":                 37,
+	"DEACTIVATE_AGENT":            38,
+	"REACTIVATE_AGENT":            39,
+	"GET_QUOTA":                   28,
+	"UPDATE_QUOTA":                36,
+	"SET_QUOTA":                   29,
+	"REMOVE_QUOTA":                30,
+	"TEARDOWN":                    31,
+	"MARK_AGENT_GONE":             32,
+}
+
+func (x Call_Type) Enum() *Call_Type {
+	p := new(Call_Type)
+	*p = x
+	return p
+}
+func (x Call_Type) String() string {
+	return proto.EnumName(Call_Type_name, int32(x))
+}
+func (x *Call_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Call_Type_value, data, "Call_Type")
+	if err != nil {
+		return err
+	}
+	*x = Call_Type(value)
+	return nil
+}
+func (Call_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+// Each of the responses of type `FOO` corresponds to `Foo` message below.
+type Response_Type int32
+
+const (
+	Response_UNKNOWN                  Response_Type = 0
+	Response_GET_HEALTH               Response_Type = 1
+	Response_GET_FLAGS                Response_Type = 2
+	Response_GET_VERSION              Response_Type = 3
+	Response_GET_METRICS              Response_Type = 4
+	Response_GET_LOGGING_LEVEL        Response_Type = 5
+	Response_LIST_FILES               Response_Type = 6
+	Response_READ_FILE                Response_Type = 7
+	Response_GET_STATE                Response_Type = 8
+	Response_GET_AGENTS               Response_Type = 9
+	Response_GET_FRAMEWORKS           Response_Type = 10
+	Response_GET_EXECUTORS            Response_Type = 11
+	Response_GET_OPERATIONS           Response_Type = 19
+	Response_GET_TASKS                Response_Type = 12
+	Response_GET_ROLES                Response_Type = 13
+	Response_GET_WEIGHTS              Response_Type = 14
+	Response_GET_MASTER               Response_Type = 15
+	Response_GET_MAINTENANCE_STATUS   Response_Type = 16
+	Response_GET_MAINTENANCE_SCHEDULE Response_Type = 17
+	Response_GET_QUOTA                Response_Type = 18
+)
+
+var Response_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "GET_HEALTH",
+	2:  "GET_FLAGS",
+	3:  "GET_VERSION",
+	4:  "GET_METRICS",
+	5:  "GET_LOGGING_LEVEL",
+	6:  "LIST_FILES",
+	7:  "READ_FILE",
+	8:  "GET_STATE",
+	9:  "GET_AGENTS",
+	10: "GET_FRAMEWORKS",
+	11: "GET_EXECUTORS",
+	19: "GET_OPERATIONS",
+	12: "GET_TASKS",
+	13: "GET_ROLES",
+	14: "GET_WEIGHTS",
+	15: "GET_MASTER",
+	16: "GET_MAINTENANCE_STATUS",
+	17: "GET_MAINTENANCE_SCHEDULE",
+	18: "GET_QUOTA",
+}
+var Response_Type_value = map[string]int32{
+	"UNKNOWN":                  0,
+	"GET_HEALTH":               1,
+	"GET_FLAGS":                2,
+	"GET_VERSION":              3,
+	"GET_METRICS":              4,
+	"GET_LOGGING_LEVEL":        5,
+	"LIST_FILES":               6,
+	"READ_FILE":                7,
+	"GET_STATE":                8,
+	"GET_AGENTS":               9,
+	"GET_FRAMEWORKS":           10,
+	"GET_EXECUTORS":            11,
+	"GET_OPERATIONS":           19,
+	"GET_TASKS":                12,
+	"GET_ROLES":                13,
+	"GET_WEIGHTS":              14,
+	"GET_MASTER":               15,
+	"GET_MAINTENANCE_STATUS":   16,
+	"GET_MAINTENANCE_SCHEDULE": 17,
+	"GET_QUOTA":                18,
+}
+
+func (x Response_Type) Enum() *Response_Type {
+	p := new(Response_Type)
+	*p = x
+	return p
+}
+func (x Response_Type) String() string {
+	return proto.EnumName(Response_Type_name, int32(x))
+}
+func (x *Response_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Response_Type_value, data, "Response_Type")
+	if err != nil {
+		return err
+	}
+	*x = Response_Type(value)
+	return nil
+}
+func (Response_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+type Event_Type int32
+
+const (
+	Event_UNKNOWN           Event_Type = 0
+	Event_SUBSCRIBED        Event_Type = 1
+	Event_TASK_ADDED        Event_Type = 2
+	Event_TASK_UPDATED      Event_Type = 3
+	Event_AGENT_ADDED       Event_Type = 4
+	Event_AGENT_REMOVED     Event_Type = 5
+	Event_FRAMEWORK_ADDED   Event_Type = 6
+	Event_FRAMEWORK_UPDATED Event_Type = 7
+	Event_FRAMEWORK_REMOVED Event_Type = 8
+	// Periodic message sent by the master to the subscriber according to
+	// 'Subscribed.heartbeat_interval_seconds'. If the subscriber does not
+	// receive any events (including heartbeats) for an extended period of
+	// time (e.g., 5 x heartbeat_interval_seconds), it is likely that the
+	// connection is lost or there is a network partition. In that case,
+	// the subscriber should close the existing subscription connection and
+	// resubscribe using a backoff strategy.
+	Event_HEARTBEAT Event_Type = 9
+)
+
+var Event_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SUBSCRIBED",
+	2: "TASK_ADDED",
+	3: "TASK_UPDATED",
+	4: "AGENT_ADDED",
+	5: "AGENT_REMOVED",
+	6: "FRAMEWORK_ADDED",
+	7: "FRAMEWORK_UPDATED",
+	8: "FRAMEWORK_REMOVED",
+	9: "HEARTBEAT",
+}
+var Event_Type_value = map[string]int32{
+	"UNKNOWN":           0,
+	"SUBSCRIBED":        1,
+	"TASK_ADDED":        2,
+	"TASK_UPDATED":      3,
+	"AGENT_ADDED":       4,
+	"AGENT_REMOVED":     5,
+	"FRAMEWORK_ADDED":   6,
+	"FRAMEWORK_UPDATED": 7,
+	"FRAMEWORK_REMOVED": 8,
+	"HEARTBEAT":         9,
+}
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
+}
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+func (x *Event_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Event_Type_value, data, "Event_Type")
+	if err != nil {
+		return err
+	}
+	*x = Event_Type(value)
+	return nil
+}
+func (Event_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+// Calls that can be sent to the v1 master API.
+//
+// A call is described using the standard protocol buffer "union"
+// trick, see
+// https://developers.google.com/protocol-buffers/docs/techniques#union.
+type Call struct {
+	Type                      *Call_Type                      `protobuf:"varint,1,opt,name=type,enum=mesos.v1.master.Call_Type" json:"type,omitempty"`
+	GetMetrics                *Call_GetMetrics                `protobuf:"bytes,2,opt,name=get_metrics,json=getMetrics" json:"get_metrics,omitempty"`
+	SetLoggingLevel           *Call_SetLoggingLevel           `protobuf:"bytes,3,opt,name=set_logging_level,json=setLoggingLevel" json:"set_logging_level,omitempty"`
+	ListFiles                 *Call_ListFiles                 `protobuf:"bytes,4,opt,name=list_files,json=listFiles" json:"list_files,omitempty"`
+	ReadFile                  *Call_ReadFile                  `protobuf:"bytes,5,opt,name=read_file,json=readFile" json:"read_file,omitempty"`
+	UpdateWeights             *Call_UpdateWeights             `protobuf:"bytes,6,opt,name=update_weights,json=updateWeights" json:"update_weights,omitempty"`
+	ReserveResources          *Call_ReserveResources          `protobuf:"bytes,7,opt,name=reserve_resources,json=reserveResources" json:"reserve_resources,omitempty"`
+	UnreserveResources        *Call_UnreserveResources        `protobuf:"bytes,8,opt,name=unreserve_resources,json=unreserveResources" json:"unreserve_resources,omitempty"`
+	CreateVolumes             *Call_CreateVolumes             `protobuf:"bytes,9,opt,name=create_volumes,json=createVolumes" json:"create_volumes,omitempty"`
+	DestroyVolumes            *Call_DestroyVolumes            `protobuf:"bytes,10,opt,name=destroy_volumes,json=destroyVolumes" json:"destroy_volumes,omitempty"`
+	GrowVolume                *Call_GrowVolume                `protobuf:"bytes,18,opt,name=grow_volume,json=growVolume" json:"grow_volume,omitempty"`
+	ShrinkVolume              *Call_ShrinkVolume              `protobuf:"bytes,19,opt,name=shrink_volume,json=shrinkVolume" json:"shrink_volume,omitempty"`
+	UpdateMaintenanceSchedule *Call_UpdateMaintenanceSchedule `protobuf:"bytes,11,opt,name=update_maintenance_schedule,json=updateMaintenanceSchedule" json:"update_maintenance_schedule,omitempty"`
+	StartMaintenance          *Call_StartMaintenance          `protobuf:"bytes,12,opt,name=start_maintenance,json=startMaintenance" json:"start_maintenance,omitempty"`
+	StopMaintenance           *Call_StopMaintenance           `protobuf:"bytes,13,opt,name=stop_maintenance,json=stopMaintenance" json:"stop_maintenance,omitempty"`
+	DrainAgent                *Call_DrainAgent                `protobuf:"bytes,21,opt,name=drain_agent,json=drainAgent" json:"drain_agent,omitempty"`
+	DeactivateAgent           *Call_DeactivateAgent           `protobuf:"bytes,22,opt,name=deactivate_agent,json=deactivateAgent" json:"deactivate_agent,omitempty"`
+	ReactivateAgent           *Call_ReactivateAgent           `protobuf:"bytes,23,opt,name=reactivate_agent,json=reactivateAgent" json:"reactivate_agent,omitempty"`
+	UpdateQuota               *Call_UpdateQuota               `protobuf:"bytes,20,opt,name=update_quota,json=updateQuota" json:"update_quota,omitempty"`
+	Teardown                  *Call_Teardown                  `protobuf:"bytes,16,opt,name=teardown" json:"teardown,omitempty"`
+	MarkAgentGone             *Call_MarkAgentGone             `protobuf:"bytes,17,opt,name=mark_agent_gone,json=markAgentGone" json:"mark_agent_gone,omitempty"`
+	SetQuota                  *Call_SetQuota                  `protobuf:"bytes,14,opt,name=set_quota,json=setQuota" json:"set_quota,omitempty"`
+	RemoveQuota               *Call_RemoveQuota               `protobuf:"bytes,15,opt,name=remove_quota,json=removeQuota" json:"remove_quota,omitempty"`
+	XXX_unrecognized          []byte                          `json:"-"`
+}
+
+func (m *Call) Reset()                    { *m = Call{} }
+func (m *Call) String() string            { return proto.CompactTextString(m) }
+func (*Call) ProtoMessage()               {}
+func (*Call) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Call) GetType() Call_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Call_UNKNOWN
+}
+
+func (m *Call) GetGetMetrics() *Call_GetMetrics {
+	if m != nil {
+		return m.GetMetrics
+	}
+	return nil
+}
+
+func (m *Call) GetSetLoggingLevel() *Call_SetLoggingLevel {
+	if m != nil {
+		return m.SetLoggingLevel
+	}
+	return nil
+}
+
+func (m *Call) GetListFiles() *Call_ListFiles {
+	if m != nil {
+		return m.ListFiles
+	}
+	return nil
+}
+
+func (m *Call) GetReadFile() *Call_ReadFile {
+	if m != nil {
+		return m.ReadFile
+	}
+	return nil
+}
+
+func (m *Call) GetUpdateWeights() *Call_UpdateWeights {
+	if m != nil {
+		return m.UpdateWeights
+	}
+	return nil
+}
+
+func (m *Call) GetReserveResources() *Call_ReserveResources {
+	if m != nil {
+		return m.ReserveResources
+	}
+	return nil
+}
+
+func (m *Call) GetUnreserveResources() *Call_UnreserveResources {
+	if m != nil {
+		return m.UnreserveResources
+	}
+	return nil
+}
+
+func (m *Call) GetCreateVolumes() *Call_CreateVolumes {
+	if m != nil {
+		return m.CreateVolumes
+	}
+	return nil
+}
+
+func (m *Call) GetDestroyVolumes() *Call_DestroyVolumes {
+	if m != nil {
+		return m.DestroyVolumes
+	}
+	return nil
+}
+
+func (m *Call) GetGrowVolume() *Call_GrowVolume {
+	if m != nil {
+		return m.GrowVolume
+	}
+	return nil
+}
+
+func (m *Call) GetShrinkVolume() *Call_ShrinkVolume {
+	if m != nil {
+		return m.ShrinkVolume
+	}
+	return nil
+}
+
+func (m *Call) GetUpdateMaintenanceSchedule() *Call_UpdateMaintenanceSchedule {
+	if m != nil {
+		return m.UpdateMaintenanceSchedule
+	}
+	return nil
+}
+
+func (m *Call) GetStartMaintenance() *Call_StartMaintenance {
+	if m != nil {
+		return m.StartMaintenance
+	}
+	return nil
+}
+
+func (m *Call) GetStopMaintenance() *Call_StopMaintenance {
+	if m != nil {
+		return m.StopMaintenance
+	}
+	return nil
+}
+
+func (m *Call) GetDrainAgent() *Call_DrainAgent {
+	if m != nil {
+		return m.DrainAgent
+	}
+	return nil
+}
+
+func (m *Call) GetDeactivateAgent() *Call_DeactivateAgent {
+	if m != nil {
+		return m.DeactivateAgent
+	}
+	return nil
+}
+
+func (m *Call) GetReactivateAgent() *Call_ReactivateAgent {
+	if m != nil {
+		return m.ReactivateAgent
+	}
+	return nil
+}
+
+func (m *Call) GetUpdateQuota() *Call_UpdateQuota {
+	if m != nil {
+		return m.UpdateQuota
+	}
+	return nil
+}
+
+func (m *Call) GetTeardown() *Call_Teardown {
+	if m != nil {
+		return m.Teardown
+	}
+	return nil
+}
+
+func (m *Call) GetMarkAgentGone() *Call_MarkAgentGone {
+	if m != nil {
+		return m.MarkAgentGone
+	}
+	return nil
+}
+
+func (m *Call) GetSetQuota() *Call_SetQuota {
+	if m != nil {
+		return m.SetQuota
+	}
+	return nil
+}
+
+func (m *Call) GetRemoveQuota() *Call_RemoveQuota {
+	if m != nil {
+		return m.RemoveQuota
+	}
+	return nil
+}
+
+// Provides a snapshot of the current metrics tracked by the master.
+type Call_GetMetrics struct {
+	// If set, `timeout` would be used to determines the maximum amount of time
+	// the API will take to respond. If the timeout is exceeded, some metrics
+	// may not be included in the response.
+	Timeout          *mesos_v1.DurationInfo `protobuf:"bytes,1,opt,name=timeout" json:"timeout,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_GetMetrics) Reset()                    { *m = Call_GetMetrics{} }
+func (m *Call_GetMetrics) String() string            { return proto.CompactTextString(m) }
+func (*Call_GetMetrics) ProtoMessage()               {}
+func (*Call_GetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+func (m *Call_GetMetrics) GetTimeout() *mesos_v1.DurationInfo {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+// Sets the logging verbosity level for a specified duration. Mesos uses
+// [glog](https://github.com/google/glog) for logging. The library only uses
+// verbose logging which means nothing will be output unless the verbosity
+// level is set (by default it's 0, libprocess uses levels 1, 2, and 3).
+type Call_SetLoggingLevel struct {
+	// The verbosity level.
+	Level *uint32 `protobuf:"varint,1,req,name=level" json:"level,omitempty"`
+	// The duration to keep verbosity level toggled. After this duration, the
+	// verbosity level of log would revert to the original level.
+	Duration         *mesos_v1.DurationInfo `protobuf:"bytes,2,req,name=duration" json:"duration,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_SetLoggingLevel) Reset()                    { *m = Call_SetLoggingLevel{} }
+func (m *Call_SetLoggingLevel) String() string            { return proto.CompactTextString(m) }
+func (*Call_SetLoggingLevel) ProtoMessage()               {}
+func (*Call_SetLoggingLevel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
+func (m *Call_SetLoggingLevel) GetLevel() uint32 {
+	if m != nil && m.Level != nil {
+		return *m.Level
+	}
+	return 0
+}
+
+func (m *Call_SetLoggingLevel) GetDuration() *mesos_v1.DurationInfo {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+// Provides the file listing for a directory.
+type Call_ListFiles struct {
+	Path             *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_ListFiles) Reset()                    { *m = Call_ListFiles{} }
+func (m *Call_ListFiles) String() string            { return proto.CompactTextString(m) }
+func (*Call_ListFiles) ProtoMessage()               {}
+func (*Call_ListFiles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 2} }
+
+func (m *Call_ListFiles) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+// Reads data from a file.
+type Call_ReadFile struct {
+	// The path of file.
+	Path *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	// Initial offset in file to start reading from.
+	Offset *uint64 `protobuf:"varint,2,req,name=offset" json:"offset,omitempty"`
+	// The maximum number of bytes to read. The read length is capped at 16
+	// memory pages.
+	Length           *uint64 `protobuf:"varint,3,opt,name=length" json:"length,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_ReadFile) Reset()                    { *m = Call_ReadFile{} }
+func (m *Call_ReadFile) String() string            { return proto.CompactTextString(m) }
+func (*Call_ReadFile) ProtoMessage()               {}
+func (*Call_ReadFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 3} }
+
+func (m *Call_ReadFile) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *Call_ReadFile) GetOffset() uint64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *Call_ReadFile) GetLength() uint64 {
+	if m != nil && m.Length != nil {
+		return *m.Length
+	}
+	return 0
+}
+
+type Call_UpdateWeights struct {
+	WeightInfos      []*mesos_v1.WeightInfo `protobuf:"bytes,1,rep,name=weight_infos,json=weightInfos" json:"weight_infos,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_UpdateWeights) Reset()                    { *m = Call_UpdateWeights{} }
+func (m *Call_UpdateWeights) String() string            { return proto.CompactTextString(m) }
+func (*Call_UpdateWeights) ProtoMessage()               {}
+func (*Call_UpdateWeights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 4} }
+
+func (m *Call_UpdateWeights) GetWeightInfos() []*mesos_v1.WeightInfo {
+	if m != nil {
+		return m.WeightInfos
+	}
+	return nil
+}
+
+// Reserve resources dynamically on a specific agent.
+type Call_ReserveResources struct {
+	AgentId          *mesos_v1.AgentID    `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Source           []*mesos_v1.Resource `protobuf:"bytes,3,rep,name=source" json:"source,omitempty"`
+	Resources        []*mesos_v1.Resource `protobuf:"bytes,2,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Call_ReserveResources) Reset()                    { *m = Call_ReserveResources{} }
+func (m *Call_ReserveResources) String() string            { return proto.CompactTextString(m) }
+func (*Call_ReserveResources) ProtoMessage()               {}
+func (*Call_ReserveResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 5} }
+
+func (m *Call_ReserveResources) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_ReserveResources) GetSource() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Call_ReserveResources) GetResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// Unreserve resources dynamically on a specific agent.
+type Call_UnreserveResources struct {
+	AgentId          *mesos_v1.AgentID    `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Resources        []*mesos_v1.Resource `protobuf:"bytes,2,rep,name=resources" json:"resources,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Call_UnreserveResources) Reset()                    { *m = Call_UnreserveResources{} }
+func (m *Call_UnreserveResources) String() string            { return proto.CompactTextString(m) }
+func (*Call_UnreserveResources) ProtoMessage()               {}
+func (*Call_UnreserveResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 6} }
+
+func (m *Call_UnreserveResources) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_UnreserveResources) GetResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// Create persistent volumes on reserved resources. The request is forwarded
+// asynchronously to the Mesos agent where the reserved resources are located.
+// That asynchronous message may not be delivered or creating the volumes at
+// the agent might fail. Volume creation can be verified by sending a
+// `GET_VOLUMES` call.
+type Call_CreateVolumes struct {
+	AgentId          *mesos_v1.AgentID    `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Volumes          []*mesos_v1.Resource `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Call_CreateVolumes) Reset()                    { *m = Call_CreateVolumes{} }
+func (m *Call_CreateVolumes) String() string            { return proto.CompactTextString(m) }
+func (*Call_CreateVolumes) ProtoMessage()               {}
+func (*Call_CreateVolumes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 7} }
+
+func (m *Call_CreateVolumes) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_CreateVolumes) GetVolumes() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+// Destroy persistent volumes. The request is forwarded asynchronously to the
+// Mesos agent where the reserved resources are located. That asynchronous
+// message may not be delivered or destroying the volumes at the agent might
+// fail. Volume deletion can be verified by sending a `GET_VOLUMES` call.
+type Call_DestroyVolumes struct {
+	AgentId          *mesos_v1.AgentID    `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Volumes          []*mesos_v1.Resource `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Call_DestroyVolumes) Reset()                    { *m = Call_DestroyVolumes{} }
+func (m *Call_DestroyVolumes) String() string            { return proto.CompactTextString(m) }
+func (*Call_DestroyVolumes) ProtoMessage()               {}
+func (*Call_DestroyVolumes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 8} }
+
+func (m *Call_DestroyVolumes) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_DestroyVolumes) GetVolumes() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+// Grow a volume by an additional disk resource.
+// NOTE: This is currently experimental and only for persistent volumes
+// created on ROOT/PATH disks.
+type Call_GrowVolume struct {
+	// `agent_id` must be set if `volume` is an agent-local resource, and must
+	// be unset if `volume` is an external resource.
+	AgentId          *mesos_v1.AgentID  `protobuf:"bytes,1,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Volume           *mesos_v1.Resource `protobuf:"bytes,2,req,name=volume" json:"volume,omitempty"`
+	Addition         *mesos_v1.Resource `protobuf:"bytes,3,req,name=addition" json:"addition,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *Call_GrowVolume) Reset()                    { *m = Call_GrowVolume{} }
+func (m *Call_GrowVolume) String() string            { return proto.CompactTextString(m) }
+func (*Call_GrowVolume) ProtoMessage()               {}
+func (*Call_GrowVolume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 9} }
+
+func (m *Call_GrowVolume) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_GrowVolume) GetVolume() *mesos_v1.Resource {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *Call_GrowVolume) GetAddition() *mesos_v1.Resource {
+	if m != nil {
+		return m.Addition
+	}
+	return nil
+}
+
+// Shrink a volume by the size specified in the `subtract` field.
+// NOTE: This is currently experimental and only for persistent volumes
+// created on ROOT/PATH disks.
+type Call_ShrinkVolume struct {
+	// `agent_id` must be set if `volume` is an agent-local resource, and must
+	// be unset if `volume` is an external resource.
+	AgentId *mesos_v1.AgentID  `protobuf:"bytes,1,opt,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	Volume  *mesos_v1.Resource `protobuf:"bytes,2,req,name=volume" json:"volume,omitempty"`
+	// See comments in `Value.Scalar` for maximum precision supported.
+	Subtract         *mesos_v1.Value_Scalar `protobuf:"bytes,3,req,name=subtract" json:"subtract,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Call_ShrinkVolume) Reset()                    { *m = Call_ShrinkVolume{} }
+func (m *Call_ShrinkVolume) String() string            { return proto.CompactTextString(m) }
+func (*Call_ShrinkVolume) ProtoMessage()               {}
+func (*Call_ShrinkVolume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 10} }
+
+func (m *Call_ShrinkVolume) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_ShrinkVolume) GetVolume() *mesos_v1.Resource {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *Call_ShrinkVolume) GetSubtract() *mesos_v1.Value_Scalar {
+	if m != nil {
+		return m.Subtract
+	}
+	return nil
+}
+
+// Updates the cluster's maintenance schedule.
+type Call_UpdateMaintenanceSchedule struct {
+	Schedule         *mesos_v1_maintenance.Schedule `protobuf:"bytes,1,req,name=schedule" json:"schedule,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *Call_UpdateMaintenanceSchedule) Reset()         { *m = Call_UpdateMaintenanceSchedule{} }
+func (m *Call_UpdateMaintenanceSchedule) String() string { return proto.CompactTextString(m) }
+func (*Call_UpdateMaintenanceSchedule) ProtoMessage()    {}
+func (*Call_UpdateMaintenanceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0, 11}
+}
+
+func (m *Call_UpdateMaintenanceSchedule) GetSchedule() *mesos_v1_maintenance.Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// Starts the maintenance of the cluster, this would bring a set of machines
+// down.
+type Call_StartMaintenance struct {
+	Machines         []*mesos_v1.MachineID `protobuf:"bytes,1,rep,name=machines" json:"machines,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_StartMaintenance) Reset()                    { *m = Call_StartMaintenance{} }
+func (m *Call_StartMaintenance) String() string            { return proto.CompactTextString(m) }
+func (*Call_StartMaintenance) ProtoMessage()               {}
+func (*Call_StartMaintenance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 12} }
+
+func (m *Call_StartMaintenance) GetMachines() []*mesos_v1.MachineID {
+	if m != nil {
+		return m.Machines
+	}
+	return nil
+}
+
+// Stops the maintenance of the cluster, this would bring a set of machines
+// back up.
+type Call_StopMaintenance struct {
+	Machines         []*mesos_v1.MachineID `protobuf:"bytes,1,rep,name=machines" json:"machines,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_StopMaintenance) Reset()                    { *m = Call_StopMaintenance{} }
+func (m *Call_StopMaintenance) String() string            { return proto.CompactTextString(m) }
+func (*Call_StopMaintenance) ProtoMessage()               {}
+func (*Call_StopMaintenance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 13} }
+
+func (m *Call_StopMaintenance) GetMachines() []*mesos_v1.MachineID {
+	if m != nil {
+		return m.Machines
+	}
+	return nil
+}
+
+// EXPERIMENTAL.
+//
+// Marks an agent for automated draining of tasks.
+// This prevents further tasks from being launched on the agent, by preventing
+// offers from being sent for the agent (see 'DeactivateAgent'), and also
+// begins killing tasks running on the agent.
+type Call_DrainAgent struct {
+	AgentId *mesos_v1.AgentID `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	// An upper bound for tasks with a KillPolicy.
+	// If a task has a KillPolicy grace period greater than this value,
+	// this value will be used instead. This allows the operator to limit
+	// the maximum time it will take the agent to drain.
+	//
+	// NOTE: Grace periods start when the executor receives the associated kill.
+	// If, for example, the agent is unreachable when this call is made,
+	// tasks will still receive their full grace period to kill gracefully.
+	MaxGracePeriod *google_protobuf.Duration `protobuf:"bytes,2,opt,name=max_grace_period,json=maxGracePeriod" json:"max_grace_period,omitempty"`
+	// Whether or not this agent will be removed permanently from the
+	// cluster when draining is complete. This transition is automatic
+	// and does **NOT** require a separate call to `MarkAgentGone`.
+	//
+	// Compared to `MarkAgentGone`, which is used for unreachable agents,
+	// marking agents gone after draining will respect kill policies.
+	// To notify frameworks, tasks terminated during draining will return
+	// a `TASK_GONE_BY_OPERATOR` status update instead of any other terminal
+	// status. Executors will not need to account for this case, because
+	// the terminal status update will be intercepted and modified by the agent.
+	MarkGone         *bool  `protobuf:"varint,3,opt,name=mark_gone,json=markGone,def=0" json:"mark_gone,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Call_DrainAgent) Reset()                    { *m = Call_DrainAgent{} }
+func (m *Call_DrainAgent) String() string            { return proto.CompactTextString(m) }
+func (*Call_DrainAgent) ProtoMessage()               {}
+func (*Call_DrainAgent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 14} }
+
+const Default_Call_DrainAgent_MarkGone bool = false
+
+func (m *Call_DrainAgent) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func (m *Call_DrainAgent) GetMaxGracePeriod() *google_protobuf.Duration {
+	if m != nil {
+		return m.MaxGracePeriod
+	}
+	return nil
+}
+
+func (m *Call_DrainAgent) GetMarkGone() bool {
+	if m != nil && m.MarkGone != nil {
+		return *m.MarkGone
+	}
+	return Default_Call_DrainAgent_MarkGone
+}
+
+// EXPERIMENTAL.
+//
+// Turns off offers for a specific agent.
+// A deactivated agent will continue to run tasks and communicate statuses
+// with the master.
+type Call_DeactivateAgent struct {
+	AgentId          *mesos_v1.AgentID `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Call_DeactivateAgent) Reset()                    { *m = Call_DeactivateAgent{} }
+func (m *Call_DeactivateAgent) String() string            { return proto.CompactTextString(m) }
+func (*Call_DeactivateAgent) ProtoMessage()               {}
+func (*Call_DeactivateAgent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 15} }
+
+func (m *Call_DeactivateAgent) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+// EXPERIMENTAL.
+//
+// Turns on offers for a specific agent, which was previously drained or
+// deactivated.
+type Call_ReactivateAgent struct {
+	AgentId          *mesos_v1.AgentID `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Call_ReactivateAgent) Reset()                    { *m = Call_ReactivateAgent{} }
+func (m *Call_ReactivateAgent) String() string            { return proto.CompactTextString(m) }
+func (*Call_ReactivateAgent) ProtoMessage()               {}
+func (*Call_ReactivateAgent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 16} }
+
+func (m *Call_ReactivateAgent) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+// Updates quota given the provided quota configurations, these configurations
+// are applied in an all-or-nothing manner.
+type Call_UpdateQuota struct {
+	// Determines whether to skip the capacity validation. See
+	// `quota.QuotaConfig` for more details.
+	Force            *bool                         `protobuf:"varint,1,opt,name=force" json:"force,omitempty"`
+	QuotaConfigs     []*mesos_v1_quota.QuotaConfig `protobuf:"bytes,2,rep,name=quota_configs,json=quotaConfigs" json:"quota_configs,omitempty"`
+	XXX_unrecognized []byte                        `json:"-"`
+}
+
+func (m *Call_UpdateQuota) Reset()                    { *m = Call_UpdateQuota{} }
+func (m *Call_UpdateQuota) String() string            { return proto.CompactTextString(m) }
+func (*Call_UpdateQuota) ProtoMessage()               {}
+func (*Call_UpdateQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 17} }
+
+func (m *Call_UpdateQuota) GetForce() bool {
+	if m != nil && m.Force != nil {
+		return *m.Force
+	}
+	return false
+}
+
+func (m *Call_UpdateQuota) GetQuotaConfigs() []*mesos_v1_quota.QuotaConfig {
+	if m != nil {
+		return m.QuotaConfigs
+	}
+	return nil
+}
+
+// Deprecated in favor of `UpdateQuota`.
+type Call_SetQuota struct {
+	QuotaRequest     *mesos_v1_quota.QuotaRequest `protobuf:"bytes,1,req,name=quota_request,json=quotaRequest" json:"quota_request,omitempty"`
+	XXX_unrecognized []byte                       `json:"-"`
+}
+
+func (m *Call_SetQuota) Reset()                    { *m = Call_SetQuota{} }
+func (m *Call_SetQuota) String() string            { return proto.CompactTextString(m) }
+func (*Call_SetQuota) ProtoMessage()               {}
+func (*Call_SetQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 18} }
+
+func (m *Call_SetQuota) GetQuotaRequest() *mesos_v1_quota.QuotaRequest {
+	if m != nil {
+		return m.QuotaRequest
+	}
+	return nil
+}
+
+// Deprecated in favor of `UpdateQuota`.
+type Call_RemoveQuota struct {
+	Role             *string `protobuf:"bytes,1,req,name=role" json:"role,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Call_RemoveQuota) Reset()                    { *m = Call_RemoveQuota{} }
+func (m *Call_RemoveQuota) String() string            { return proto.CompactTextString(m) }
+func (*Call_RemoveQuota) ProtoMessage()               {}
+func (*Call_RemoveQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 19} }
+
+func (m *Call_RemoveQuota) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+// Tears down a running framework by shutting down all tasks/executors and
+// removing the framework.
+type Call_Teardown struct {
+	FrameworkId      *mesos_v1.FrameworkID `protobuf:"bytes,1,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Call_Teardown) Reset()                    { *m = Call_Teardown{} }
+func (m *Call_Teardown) String() string            { return proto.CompactTextString(m) }
+func (*Call_Teardown) ProtoMessage()               {}
+func (*Call_Teardown) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 20} }
+
+func (m *Call_Teardown) GetFrameworkId() *mesos_v1.FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+// Mark an agent as gone. This can be used by an operator to assert
+// that the agent instance has failed and is never coming back (e.g.,
+// ephemeral instance from cloud provider). The master would shutdown
+// the agent and send 'TASK_GONE_BY_OPERATOR' updates for all the running
+// tasks. The persistent volumes/reservations on the agent won't be
+// accessible anymore.
+//
+// NOTE: It is possible that the tasks might still be running
+// if the operator's assertion was wrong and the agent was partitioned
+// away from the master. The agent would be shutdown when it tries to
+// reregister with the master when the partition heals.
+type Call_MarkAgentGone struct {
+	AgentId          *mesos_v1.AgentID `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Call_MarkAgentGone) Reset()                    { *m = Call_MarkAgentGone{} }
+func (m *Call_MarkAgentGone) String() string            { return proto.CompactTextString(m) }
+func (*Call_MarkAgentGone) ProtoMessage()               {}
+func (*Call_MarkAgentGone) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 21} }
+
+func (m *Call_MarkAgentGone) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+// Synchronous responses for all calls (except Call::SUBSCRIBE) made to
+// the v1 master API.
+type Response struct {
+	Type                   *Response_Type                   `protobuf:"varint,1,opt,name=type,enum=mesos.v1.master.Response_Type" json:"type,omitempty"`
+	GetHealth              *Response_GetHealth              `protobuf:"bytes,2,opt,name=get_health,json=getHealth" json:"get_health,omitempty"`
+	GetFlags               *Response_GetFlags               `protobuf:"bytes,3,opt,name=get_flags,json=getFlags" json:"get_flags,omitempty"`
+	GetVersion             *Response_GetVersion             `protobuf:"bytes,4,opt,name=get_version,json=getVersion" json:"get_version,omitempty"`
+	GetMetrics             *Response_GetMetrics             `protobuf:"bytes,5,opt,name=get_metrics,json=getMetrics" json:"get_metrics,omitempty"`
+	GetLoggingLevel        *Response_GetLoggingLevel        `protobuf:"bytes,6,opt,name=get_logging_level,json=getLoggingLevel" json:"get_logging_level,omitempty"`
+	ListFiles              *Response_ListFiles              `protobuf:"bytes,7,opt,name=list_files,json=listFiles" json:"list_files,omitempty"`
+	ReadFile               *Response_ReadFile               `protobuf:"bytes,8,opt,name=read_file,json=readFile" json:"read_file,omitempty"`
+	GetState               *Response_GetState               `protobuf:"bytes,9,opt,name=get_state,json=getState" json:"get_state,omitempty"`
+	GetAgents              *Response_GetAgents              `protobuf:"bytes,10,opt,name=get_agents,json=getAgents" json:"get_agents,omitempty"`
+	GetFrameworks          *Response_GetFrameworks          `protobuf:"bytes,11,opt,name=get_frameworks,json=getFrameworks" json:"get_frameworks,omitempty"`
+	GetExecutors           *Response_GetExecutors           `protobuf:"bytes,12,opt,name=get_executors,json=getExecutors" json:"get_executors,omitempty"`
+	GetOperations          *Response_GetOperations          `protobuf:"bytes,20,opt,name=get_operations,json=getOperations" json:"get_operations,omitempty"`
+	GetTasks               *Response_GetTasks               `protobuf:"bytes,13,opt,name=get_tasks,json=getTasks" json:"get_tasks,omitempty"`
+	GetRoles               *Response_GetRoles               `protobuf:"bytes,14,opt,name=get_roles,json=getRoles" json:"get_roles,omitempty"`
+	GetWeights             *Response_GetWeights             `protobuf:"bytes,15,opt,name=get_weights,json=getWeights" json:"get_weights,omitempty"`
+	GetMaster              *Response_GetMaster              `protobuf:"bytes,16,opt,name=get_master,json=getMaster" json:"get_master,omitempty"`
+	GetMaintenanceStatus   *Response_GetMaintenanceStatus   `protobuf:"bytes,17,opt,name=get_maintenance_status,json=getMaintenanceStatus" json:"get_maintenance_status,omitempty"`
+	GetMaintenanceSchedule *Response_GetMaintenanceSchedule `protobuf:"bytes,18,opt,name=get_maintenance_schedule,json=getMaintenanceSchedule" json:"get_maintenance_schedule,omitempty"`
+	GetQuota               *Response_GetQuota               `protobuf:"bytes,19,opt,name=get_quota,json=getQuota" json:"get_quota,omitempty"`
+	XXX_unrecognized       []byte                           `json:"-"`
+}
+
+func (m *Response) Reset()                    { *m = Response{} }
+func (m *Response) String() string            { return proto.CompactTextString(m) }
+func (*Response) ProtoMessage()               {}
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Response) GetType() Response_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Response_UNKNOWN
+}
+
+func (m *Response) GetGetHealth() *Response_GetHealth {
+	if m != nil {
+		return m.GetHealth
+	}
+	return nil
+}
+
+func (m *Response) GetGetFlags() *Response_GetFlags {
+	if m != nil {
+		return m.GetFlags
+	}
+	return nil
+}
+
+func (m *Response) GetGetVersion() *Response_GetVersion {
+	if m != nil {
+		return m.GetVersion
+	}
+	return nil
+}
+
+func (m *Response) GetGetMetrics() *Response_GetMetrics {
+	if m != nil {
+		return m.GetMetrics
+	}
+	return nil
+}
+
+func (m *Response) GetGetLoggingLevel() *Response_GetLoggingLevel {
+	if m != nil {
+		return m.GetLoggingLevel
+	}
+	return nil
+}
+
+func (m *Response) GetListFiles() *Response_ListFiles {
+	if m != nil {
+		return m.ListFiles
+	}
+	return nil
+}
+
+func (m *Response) GetReadFile() *Response_ReadFile {
+	if m != nil {
+		return m.ReadFile
+	}
+	return nil
+}
+
+func (m *Response) GetGetState() *Response_GetState {
+	if m != nil {
+		return m.GetState
+	}
+	return nil
+}
+
+func (m *Response) GetGetAgents() *Response_GetAgents {
+	if m != nil {
+		return m.GetAgents
+	}
+	return nil
+}
+
+func (m *Response) GetGetFrameworks() *Response_GetFrameworks {
+	if m != nil {
+		return m.GetFrameworks
+	}
+	return nil
+}
+
+func (m *Response) GetGetExecutors() *Response_GetExecutors {
+	if m != nil {
+		return m.GetExecutors
+	}
+	return nil
+}
+
+func (m *Response) GetGetOperations() *Response_GetOperations {
+	if m != nil {
+		return m.GetOperations
+	}
+	return nil
+}
+
+func (m *Response) GetGetTasks() *Response_GetTasks {
+	if m != nil {
+		return m.GetTasks
+	}
+	return nil
+}
+
+func (m *Response) GetGetRoles() *Response_GetRoles {
+	if m != nil {
+		return m.GetRoles
+	}
+	return nil
+}
+
+func (m *Response) GetGetWeights() *Response_GetWeights {
+	if m != nil {
+		return m.GetWeights
+	}
+	return nil
+}
+
+func (m *Response) GetGetMaster() *Response_GetMaster {
+	if m != nil {
+		return m.GetMaster
+	}
+	return nil
+}
+
+func (m *Response) GetGetMaintenanceStatus() *Response_GetMaintenanceStatus {
+	if m != nil {
+		return m.GetMaintenanceStatus
+	}
+	return nil
+}
+
+func (m *Response) GetGetMaintenanceSchedule() *Response_GetMaintenanceSchedule {
+	if m != nil {
+		return m.GetMaintenanceSchedule
+	}
+	return nil
+}
+
+func (m *Response) GetGetQuota() *Response_GetQuota {
+	if m != nil {
+		return m.GetQuota
+	}
+	return nil
+}
+
+// `healthy` would be true if the master is healthy. Delayed responses are
+// also indicative of the poor health of the master.
+type Response_GetHealth struct {
+	Healthy          *bool  `protobuf:"varint,1,req,name=healthy" json:"healthy,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Response_GetHealth) Reset()                    { *m = Response_GetHealth{} }
+func (m *Response_GetHealth) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetHealth) ProtoMessage()               {}
+func (*Response_GetHealth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+func (m *Response_GetHealth) GetHealthy() bool {
+	if m != nil && m.Healthy != nil {
+		return *m.Healthy
+	}
+	return false
+}
+
+// Contains the flag configuration of the master.
+type Response_GetFlags struct {
+	Flags            []*mesos_v1.Flag `protobuf:"bytes,1,rep,name=flags" json:"flags,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Response_GetFlags) Reset()                    { *m = Response_GetFlags{} }
+func (m *Response_GetFlags) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetFlags) ProtoMessage()               {}
+func (*Response_GetFlags) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 1} }
+
+func (m *Response_GetFlags) GetFlags() []*mesos_v1.Flag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// Contains the version information of the master.
+type Response_GetVersion struct {
+	VersionInfo      *mesos_v1.VersionInfo `protobuf:"bytes,1,req,name=version_info,json=versionInfo" json:"version_info,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Response_GetVersion) Reset()                    { *m = Response_GetVersion{} }
+func (m *Response_GetVersion) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetVersion) ProtoMessage()               {}
+func (*Response_GetVersion) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 2} }
+
+func (m *Response_GetVersion) GetVersionInfo() *mesos_v1.VersionInfo {
+	if m != nil {
+		return m.VersionInfo
+	}
+	return nil
+}
+
+// Contains a snapshot of the current metrics.
+type Response_GetMetrics struct {
+	Metrics          []*mesos_v1.Metric `protobuf:"bytes,1,rep,name=metrics" json:"metrics,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *Response_GetMetrics) Reset()                    { *m = Response_GetMetrics{} }
+func (m *Response_GetMetrics) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetMetrics) ProtoMessage()               {}
+func (*Response_GetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 3} }
+
+func (m *Response_GetMetrics) GetMetrics() []*mesos_v1.Metric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+// Contains the logging level of the master.
+type Response_GetLoggingLevel struct {
+	Level            *uint32 `protobuf:"varint,1,req,name=level" json:"level,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Response_GetLoggingLevel) Reset()                    { *m = Response_GetLoggingLevel{} }
+func (m *Response_GetLoggingLevel) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetLoggingLevel) ProtoMessage()               {}
+func (*Response_GetLoggingLevel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 4} }
+
+func (m *Response_GetLoggingLevel) GetLevel() uint32 {
+	if m != nil && m.Level != nil {
+		return *m.Level
+	}
+	return 0
+}
+
+// Contains the file listing(similar to `ls -l`) for a directory.
+type Response_ListFiles struct {
+	FileInfos        []*mesos_v1.FileInfo `protobuf:"bytes,1,rep,name=file_infos,json=fileInfos" json:"file_infos,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Response_ListFiles) Reset()                    { *m = Response_ListFiles{} }
+func (m *Response_ListFiles) String() string            { return proto.CompactTextString(m) }
+func (*Response_ListFiles) ProtoMessage()               {}
+func (*Response_ListFiles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 5} }
+
+func (m *Response_ListFiles) GetFileInfos() []*mesos_v1.FileInfo {
+	if m != nil {
+		return m.FileInfos
+	}
+	return nil
+}
+
+// Contains the file data.
+type Response_ReadFile struct {
+	// The size of file (in bytes).
+	Size             *uint64 `protobuf:"varint,1,req,name=size" json:"size,omitempty"`
+	Data             []byte  `protobuf:"bytes,2,req,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Response_ReadFile) Reset()                    { *m = Response_ReadFile{} }
+func (m *Response_ReadFile) String() string            { return proto.CompactTextString(m) }
+func (*Response_ReadFile) ProtoMessage()               {}
+func (*Response_ReadFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 6} }
+
+func (m *Response_ReadFile) GetSize() uint64 {
+	if m != nil && m.Size != nil {
+		return *m.Size
+	}
+	return 0
+}
+
+func (m *Response_ReadFile) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Contains full state of the master i.e. information about the tasks,
+// agents, frameworks and executors running in the cluster.
+type Response_GetState struct {
+	GetTasks         *Response_GetTasks      `protobuf:"bytes,1,opt,name=get_tasks,json=getTasks" json:"get_tasks,omitempty"`
+	GetExecutors     *Response_GetExecutors  `protobuf:"bytes,2,opt,name=get_executors,json=getExecutors" json:"get_executors,omitempty"`
+	GetFrameworks    *Response_GetFrameworks `protobuf:"bytes,3,opt,name=get_frameworks,json=getFrameworks" json:"get_frameworks,omitempty"`
+	GetAgents        *Response_GetAgents     `protobuf:"bytes,4,opt,name=get_agents,json=getAgents" json:"get_agents,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Response_GetState) Reset()                    { *m = Response_GetState{} }
+func (m *Response_GetState) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetState) ProtoMessage()               {}
+func (*Response_GetState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 7} }
+
+func (m *Response_GetState) GetGetTasks() *Response_GetTasks {
+	if m != nil {
+		return m.GetTasks
+	}
+	return nil
+}
+
+func (m *Response_GetState) GetGetExecutors() *Response_GetExecutors {
+	if m != nil {
+		return m.GetExecutors
+	}
+	return nil
+}
+
+func (m *Response_GetState) GetGetFrameworks() *Response_GetFrameworks {
+	if m != nil {
+		return m.GetFrameworks
+	}
+	return nil
+}
+
+func (m *Response_GetState) GetGetAgents() *Response_GetAgents {
+	if m != nil {
+		return m.GetAgents
+	}
+	return nil
+}
+
+type Response_GetAgents struct {
+	// Registered agents.
+	Agents []*Response_GetAgents_Agent `protobuf:"bytes,1,rep,name=agents" json:"agents,omitempty"`
+	// Agents which are recovered from registry but not reregistered yet.
+	RecoveredAgents  []*mesos_v1.AgentInfo `protobuf:"bytes,2,rep,name=recovered_agents,json=recoveredAgents" json:"recovered_agents,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Response_GetAgents) Reset()                    { *m = Response_GetAgents{} }
+func (m *Response_GetAgents) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetAgents) ProtoMessage()               {}
+func (*Response_GetAgents) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 8} }
+
+func (m *Response_GetAgents) GetAgents() []*Response_GetAgents_Agent {
+	if m != nil {
+		return m.Agents
+	}
+	return nil
+}
+
+func (m *Response_GetAgents) GetRecoveredAgents() []*mesos_v1.AgentInfo {
+	if m != nil {
+		return m.RecoveredAgents
+	}
+	return nil
+}
+
+type Response_GetAgents_Agent struct {
+	AgentInfo        *mesos_v1.AgentInfo `protobuf:"bytes,1,req,name=agent_info,json=agentInfo" json:"agent_info,omitempty"`
+	Active           *bool               `protobuf:"varint,2,req,name=active" json:"active,omitempty"`
+	Deactivated      *bool               `protobuf:"varint,12,opt,name=deactivated" json:"deactivated,omitempty"`
+	Version          *string             `protobuf:"bytes,3,req,name=version" json:"version,omitempty"`
+	Pid              *string             `protobuf:"bytes,4,opt,name=pid" json:"pid,omitempty"`
+	RegisteredTime   *mesos_v1.TimeInfo  `protobuf:"bytes,5,opt,name=registered_time,json=registeredTime" json:"registered_time,omitempty"`
+	ReregisteredTime *mesos_v1.TimeInfo  `protobuf:"bytes,6,opt,name=reregistered_time,json=reregisteredTime" json:"reregistered_time,omitempty"`
+	// Total resources (including oversubscribed resources) the agent
+	// provides.
+	TotalResources          []*mesos_v1.Resource                         `protobuf:"bytes,7,rep,name=total_resources,json=totalResources" json:"total_resources,omitempty"`
+	AllocatedResources      []*mesos_v1.Resource                         `protobuf:"bytes,8,rep,name=allocated_resources,json=allocatedResources" json:"allocated_resources,omitempty"`
+	OfferedResources        []*mesos_v1.Resource                         `protobuf:"bytes,9,rep,name=offered_resources,json=offeredResources" json:"offered_resources,omitempty"`
+	Capabilities            []*mesos_v1.AgentInfo_Capability             `protobuf:"bytes,10,rep,name=capabilities" json:"capabilities,omitempty"`
+	ResourceProviders       []*Response_GetAgents_Agent_ResourceProvider `protobuf:"bytes,11,rep,name=resource_providers,json=resourceProviders" json:"resource_providers,omitempty"`
+	DrainInfo               *mesos_v1.DrainInfo                          `protobuf:"bytes,13,opt,name=drain_info,json=drainInfo" json:"drain_info,omitempty"`
+	EstimatedDrainStartTime *mesos_v1.TimeInfo                           `protobuf:"bytes,14,opt,name=estimated_drain_start_time,json=estimatedDrainStartTime" json:"estimated_drain_start_time,omitempty"`
+	XXX_unrecognized        []byte                                       `json:"-"`
+}
+
+func (m *Response_GetAgents_Agent) Reset()                    { *m = Response_GetAgents_Agent{} }
+func (m *Response_GetAgents_Agent) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetAgents_Agent) ProtoMessage()               {}
+func (*Response_GetAgents_Agent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 8, 0} }
+
+func (m *Response_GetAgents_Agent) GetAgentInfo() *mesos_v1.AgentInfo {
+	if m != nil {
+		return m.AgentInfo
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetActive() bool {
+	if m != nil && m.Active != nil {
+		return *m.Active
+	}
+	return false
+}
+
+func (m *Response_GetAgents_Agent) GetDeactivated() bool {
+	if m != nil && m.Deactivated != nil {
+		return *m.Deactivated
+	}
+	return false
+}
+
+func (m *Response_GetAgents_Agent) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *Response_GetAgents_Agent) GetPid() string {
+	if m != nil && m.Pid != nil {
+		return *m.Pid
+	}
+	return ""
+}
+
+func (m *Response_GetAgents_Agent) GetRegisteredTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetReregisteredTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.ReregisteredTime
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetTotalResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.TotalResources
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetAllocatedResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.AllocatedResources
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetOfferedResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.OfferedResources
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetCapabilities() []*mesos_v1.AgentInfo_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetResourceProviders() []*Response_GetAgents_Agent_ResourceProvider {
+	if m != nil {
+		return m.ResourceProviders
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetDrainInfo() *mesos_v1.DrainInfo {
+	if m != nil {
+		return m.DrainInfo
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent) GetEstimatedDrainStartTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.EstimatedDrainStartTime
+	}
+	return nil
+}
+
+type Response_GetAgents_Agent_ResourceProvider struct {
+	ResourceProviderInfo *mesos_v1.ResourceProviderInfo `protobuf:"bytes,1,req,name=resource_provider_info,json=resourceProviderInfo" json:"resource_provider_info,omitempty"`
+	TotalResources       []*mesos_v1.Resource           `protobuf:"bytes,2,rep,name=total_resources,json=totalResources" json:"total_resources,omitempty"`
+	XXX_unrecognized     []byte                         `json:"-"`
+}
+
+func (m *Response_GetAgents_Agent_ResourceProvider) Reset() {
+	*m = Response_GetAgents_Agent_ResourceProvider{}
+}
+func (m *Response_GetAgents_Agent_ResourceProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Response_GetAgents_Agent_ResourceProvider) ProtoMessage() {}
+func (*Response_GetAgents_Agent_ResourceProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 8, 0, 0}
+}
+
+func (m *Response_GetAgents_Agent_ResourceProvider) GetResourceProviderInfo() *mesos_v1.ResourceProviderInfo {
+	if m != nil {
+		return m.ResourceProviderInfo
+	}
+	return nil
+}
+
+func (m *Response_GetAgents_Agent_ResourceProvider) GetTotalResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.TotalResources
+	}
+	return nil
+}
+
+// Information about all the frameworks known to the master at the current
+// time. Note that there might be frameworks unknown to the master running
+// on partitioned or unsubscribed agents.
+type Response_GetFrameworks struct {
+	// Frameworks that have subscribed with the master. Note that this includes
+	// frameworks that are disconnected and in the process of re-subscribing.
+	Frameworks []*Response_GetFrameworks_Framework `protobuf:"bytes,1,rep,name=frameworks" json:"frameworks,omitempty"`
+	// Frameworks that have been teared down.
+	CompletedFrameworks []*Response_GetFrameworks_Framework `protobuf:"bytes,2,rep,name=completed_frameworks,json=completedFrameworks" json:"completed_frameworks,omitempty"`
+	// This field previously contained frameworks that previously
+	// subscribed but haven't yet re-subscribed after a master failover.
+	// As of Mesos 1.2, this field will always be empty; recovered
+	// frameworks are now reported in the `frameworks` list with the
+	// `recovered` field set to true.
+	//
+	// TODO(neilc): Remove this field in Mesos 2.0.
+	RecoveredFrameworks []*mesos_v1.FrameworkInfo `protobuf:"bytes,3,rep,name=recovered_frameworks,json=recoveredFrameworks" json:"recovered_frameworks,omitempty"`
+	XXX_unrecognized    []byte                    `json:"-"`
+}
+
+func (m *Response_GetFrameworks) Reset()                    { *m = Response_GetFrameworks{} }
+func (m *Response_GetFrameworks) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetFrameworks) ProtoMessage()               {}
+func (*Response_GetFrameworks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 9} }
+
+func (m *Response_GetFrameworks) GetFrameworks() []*Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.Frameworks
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks) GetCompletedFrameworks() []*Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.CompletedFrameworks
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks) GetRecoveredFrameworks() []*mesos_v1.FrameworkInfo {
+	if m != nil {
+		return m.RecoveredFrameworks
+	}
+	return nil
+}
+
+type Response_GetFrameworks_Framework struct {
+	FrameworkInfo *mesos_v1.FrameworkInfo `protobuf:"bytes,1,req,name=framework_info,json=frameworkInfo" json:"framework_info,omitempty"`
+	Active        *bool                   `protobuf:"varint,2,req,name=active" json:"active,omitempty"`
+	Connected     *bool                   `protobuf:"varint,3,req,name=connected" json:"connected,omitempty"`
+	// If true, this framework was previously subscribed but hasn't
+	// yet re-subscribed after a master failover. Recovered frameworks
+	// are only reported if one or more agents running a task or
+	// executor for the framework have reregistered after master
+	// failover.
+	Recovered          *bool                    `protobuf:"varint,11,req,name=recovered" json:"recovered,omitempty"`
+	RegisteredTime     *mesos_v1.TimeInfo       `protobuf:"bytes,4,opt,name=registered_time,json=registeredTime" json:"registered_time,omitempty"`
+	ReregisteredTime   *mesos_v1.TimeInfo       `protobuf:"bytes,5,opt,name=reregistered_time,json=reregisteredTime" json:"reregistered_time,omitempty"`
+	UnregisteredTime   *mesos_v1.TimeInfo       `protobuf:"bytes,6,opt,name=unregistered_time,json=unregisteredTime" json:"unregistered_time,omitempty"`
+	Offers             []*mesos_v1.Offer        `protobuf:"bytes,7,rep,name=offers" json:"offers,omitempty"`
+	InverseOffers      []*mesos_v1.InverseOffer `protobuf:"bytes,8,rep,name=inverse_offers,json=inverseOffers" json:"inverse_offers,omitempty"`
+	AllocatedResources []*mesos_v1.Resource     `protobuf:"bytes,9,rep,name=allocated_resources,json=allocatedResources" json:"allocated_resources,omitempty"`
+	OfferedResources   []*mesos_v1.Resource     `protobuf:"bytes,10,rep,name=offered_resources,json=offeredResources" json:"offered_resources,omitempty"`
+	XXX_unrecognized   []byte                   `json:"-"`
+}
+
+func (m *Response_GetFrameworks_Framework) Reset()         { *m = Response_GetFrameworks_Framework{} }
+func (m *Response_GetFrameworks_Framework) String() string { return proto.CompactTextString(m) }
+func (*Response_GetFrameworks_Framework) ProtoMessage()    {}
+func (*Response_GetFrameworks_Framework) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 9, 0}
+}
+
+func (m *Response_GetFrameworks_Framework) GetFrameworkInfo() *mesos_v1.FrameworkInfo {
+	if m != nil {
+		return m.FrameworkInfo
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetActive() bool {
+	if m != nil && m.Active != nil {
+		return *m.Active
+	}
+	return false
+}
+
+func (m *Response_GetFrameworks_Framework) GetConnected() bool {
+	if m != nil && m.Connected != nil {
+		return *m.Connected
+	}
+	return false
+}
+
+func (m *Response_GetFrameworks_Framework) GetRecovered() bool {
+	if m != nil && m.Recovered != nil {
+		return *m.Recovered
+	}
+	return false
+}
+
+func (m *Response_GetFrameworks_Framework) GetRegisteredTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetReregisteredTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.ReregisteredTime
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetUnregisteredTime() *mesos_v1.TimeInfo {
+	if m != nil {
+		return m.UnregisteredTime
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetOffers() []*mesos_v1.Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetInverseOffers() []*mesos_v1.InverseOffer {
+	if m != nil {
+		return m.InverseOffers
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetAllocatedResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.AllocatedResources
+	}
+	return nil
+}
+
+func (m *Response_GetFrameworks_Framework) GetOfferedResources() []*mesos_v1.Resource {
+	if m != nil {
+		return m.OfferedResources
+	}
+	return nil
+}
+
+// Lists information about all the executors known to the master at the
+// current time. Note that there might be executors unknown to the master
+// running on partitioned or unsubscribed agents.
+type Response_GetExecutors struct {
+	Executors []*Response_GetExecutors_Executor `protobuf:"bytes,1,rep,name=executors" json:"executors,omitempty"`
+	// As of Mesos 1.3.0, this field is deprecated and will always be empty.
+	//
+	// TODO(neilc): Remove this field in Mesos 2.0.
+	OrphanExecutors  []*Response_GetExecutors_Executor `protobuf:"bytes,2,rep,name=orphan_executors,json=orphanExecutors" json:"orphan_executors,omitempty"`
+	XXX_unrecognized []byte                            `json:"-"`
+}
+
+func (m *Response_GetExecutors) Reset()                    { *m = Response_GetExecutors{} }
+func (m *Response_GetExecutors) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetExecutors) ProtoMessage()               {}
+func (*Response_GetExecutors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 10} }
+
+func (m *Response_GetExecutors) GetExecutors() []*Response_GetExecutors_Executor {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *Response_GetExecutors) GetOrphanExecutors() []*Response_GetExecutors_Executor {
+	if m != nil {
+		return m.OrphanExecutors
+	}
+	return nil
+}
+
+type Response_GetExecutors_Executor struct {
+	ExecutorInfo     *mesos_v1.ExecutorInfo `protobuf:"bytes,1,req,name=executor_info,json=executorInfo" json:"executor_info,omitempty"`
+	AgentId          *mesos_v1.AgentID      `protobuf:"bytes,2,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Response_GetExecutors_Executor) Reset()         { *m = Response_GetExecutors_Executor{} }
+func (m *Response_GetExecutors_Executor) String() string { return proto.CompactTextString(m) }
+func (*Response_GetExecutors_Executor) ProtoMessage()    {}
+func (*Response_GetExecutors_Executor) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 10, 0}
+}
+
+func (m *Response_GetExecutors_Executor) GetExecutorInfo() *mesos_v1.ExecutorInfo {
+	if m != nil {
+		return m.ExecutorInfo
+	}
+	return nil
+}
+
+func (m *Response_GetExecutors_Executor) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+// Lists information about all operations known to the master at the
+// current time.
+type Response_GetOperations struct {
+	Operations       []*mesos_v1.Operation `protobuf:"bytes,1,rep,name=operations" json:"operations,omitempty"`
+	XXX_unrecognized []byte                `json:"-"`
+}
+
+func (m *Response_GetOperations) Reset()                    { *m = Response_GetOperations{} }
+func (m *Response_GetOperations) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetOperations) ProtoMessage()               {}
+func (*Response_GetOperations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 11} }
+
+func (m *Response_GetOperations) GetOperations() []*mesos_v1.Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// Lists information about all the tasks known to the master at the current
+// time. Note that there might be tasks unknown to the master running on
+// partitioned or unsubscribed agents.
+type Response_GetTasks struct {
+	// Tasks that are enqueued on the master waiting (e.g., authorizing)
+	// to be launched.
+	PendingTasks []*mesos_v1.Task `protobuf:"bytes,1,rep,name=pending_tasks,json=pendingTasks" json:"pending_tasks,omitempty"`
+	// Tasks that have been forwarded to the agent for launch. This
+	// includes tasks that are staging or running; it also includes
+	// tasks that have reached a terminal state but the terminal status
+	// update has not yet been acknowledged by the scheduler.
+	Tasks []*mesos_v1.Task `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	// Tasks that were running on agents that have become partitioned
+	// from the master. If/when the agent is no longer partitioned,
+	// tasks running on that agent will no longer be unreachable (they
+	// will either be running or completed). Note that the master only
+	// stores a limited number of unreachable tasks; information about
+	// unreachable tasks is also not preserved across master failover.
+	UnreachableTasks []*mesos_v1.Task `protobuf:"bytes,5,rep,name=unreachable_tasks,json=unreachableTasks" json:"unreachable_tasks,omitempty"`
+	// Tasks that have reached terminal state and have all their updates
+	// acknowledged by the scheduler.
+	CompletedTasks []*mesos_v1.Task `protobuf:"bytes,3,rep,name=completed_tasks,json=completedTasks" json:"completed_tasks,omitempty"`
+	// As of Mesos 1.3.0, this field is deprecated and will always be empty.
+	//
+	// TODO(neilc): Remove this field in Mesos 2.0.
+	OrphanTasks      []*mesos_v1.Task `protobuf:"bytes,4,rep,name=orphan_tasks,json=orphanTasks" json:"orphan_tasks,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Response_GetTasks) Reset()                    { *m = Response_GetTasks{} }
+func (m *Response_GetTasks) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetTasks) ProtoMessage()               {}
+func (*Response_GetTasks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 12} }
+
+func (m *Response_GetTasks) GetPendingTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.PendingTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetUnreachableTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.UnreachableTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetCompletedTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.CompletedTasks
+	}
+	return nil
+}
+
+func (m *Response_GetTasks) GetOrphanTasks() []*mesos_v1.Task {
+	if m != nil {
+		return m.OrphanTasks
+	}
+	return nil
+}
+
+// Provides information about every role that is on the role whitelist (if
+// enabled), has one or more registered frameworks or has a non-default weight
+// or quota.
+type Response_GetRoles struct {
+	Roles            []*mesos_v1.Role `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Response_GetRoles) Reset()                    { *m = Response_GetRoles{} }
+func (m *Response_GetRoles) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetRoles) ProtoMessage()               {}
+func (*Response_GetRoles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 13} }
+
+func (m *Response_GetRoles) GetRoles() []*mesos_v1.Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// Provides the weight information about every role.
+type Response_GetWeights struct {
+	WeightInfos      []*mesos_v1.WeightInfo `protobuf:"bytes,1,rep,name=weight_infos,json=weightInfos" json:"weight_infos,omitempty"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *Response_GetWeights) Reset()                    { *m = Response_GetWeights{} }
+func (m *Response_GetWeights) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetWeights) ProtoMessage()               {}
+func (*Response_GetWeights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 14} }
+
+func (m *Response_GetWeights) GetWeightInfos() []*mesos_v1.WeightInfo {
+	if m != nil {
+		return m.WeightInfos
+	}
+	return nil
+}
+
+// Contains the master's information.
+type Response_GetMaster struct {
+	MasterInfo       *mesos_v1.MasterInfo `protobuf:"bytes,1,opt,name=master_info,json=masterInfo" json:"master_info,omitempty"`
+	StartTime        *float64             `protobuf:"fixed64,2,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	ElectedTime      *float64             `protobuf:"fixed64,3,opt,name=elected_time,json=electedTime" json:"elected_time,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *Response_GetMaster) Reset()                    { *m = Response_GetMaster{} }
+func (m *Response_GetMaster) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetMaster) ProtoMessage()               {}
+func (*Response_GetMaster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 15} }
+
+func (m *Response_GetMaster) GetMasterInfo() *mesos_v1.MasterInfo {
+	if m != nil {
+		return m.MasterInfo
+	}
+	return nil
+}
+
+func (m *Response_GetMaster) GetStartTime() float64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *Response_GetMaster) GetElectedTime() float64 {
+	if m != nil && m.ElectedTime != nil {
+		return *m.ElectedTime
+	}
+	return 0
+}
+
+// Contains the cluster's maintenance status.
+type Response_GetMaintenanceStatus struct {
+	Status           *mesos_v1_maintenance.ClusterStatus `protobuf:"bytes,1,req,name=status" json:"status,omitempty"`
+	XXX_unrecognized []byte                              `json:"-"`
+}
+
+func (m *Response_GetMaintenanceStatus) Reset()         { *m = Response_GetMaintenanceStatus{} }
+func (m *Response_GetMaintenanceStatus) String() string { return proto.CompactTextString(m) }
+func (*Response_GetMaintenanceStatus) ProtoMessage()    {}
+func (*Response_GetMaintenanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 16}
+}
+
+func (m *Response_GetMaintenanceStatus) GetStatus() *mesos_v1_maintenance.ClusterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Contains the cluster's maintenance schedule.
+type Response_GetMaintenanceSchedule struct {
+	Schedule         *mesos_v1_maintenance.Schedule `protobuf:"bytes,1,req,name=schedule" json:"schedule,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *Response_GetMaintenanceSchedule) Reset()         { *m = Response_GetMaintenanceSchedule{} }
+func (m *Response_GetMaintenanceSchedule) String() string { return proto.CompactTextString(m) }
+func (*Response_GetMaintenanceSchedule) ProtoMessage()    {}
+func (*Response_GetMaintenanceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1, 17}
+}
+
+func (m *Response_GetMaintenanceSchedule) GetSchedule() *mesos_v1_maintenance.Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// Contains the cluster's configured quotas.
+type Response_GetQuota struct {
+	Status           *mesos_v1_quota.QuotaStatus `protobuf:"bytes,1,req,name=status" json:"status,omitempty"`
+	XXX_unrecognized []byte                      `json:"-"`
+}
+
+func (m *Response_GetQuota) Reset()                    { *m = Response_GetQuota{} }
+func (m *Response_GetQuota) String() string            { return proto.CompactTextString(m) }
+func (*Response_GetQuota) ProtoMessage()               {}
+func (*Response_GetQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 18} }
+
+func (m *Response_GetQuota) GetStatus() *mesos_v1_quota.QuotaStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Streaming response to `Call::SUBSCRIBE` made to the master.
+type Event struct {
+	Type             *Event_Type             `protobuf:"varint,1,opt,name=type,enum=mesos.v1.master.Event_Type" json:"type,omitempty"`
+	Subscribed       *Event_Subscribed       `protobuf:"bytes,2,opt,name=subscribed" json:"subscribed,omitempty"`
+	TaskAdded        *Event_TaskAdded        `protobuf:"bytes,3,opt,name=task_added,json=taskAdded" json:"task_added,omitempty"`
+	TaskUpdated      *Event_TaskUpdated      `protobuf:"bytes,4,opt,name=task_updated,json=taskUpdated" json:"task_updated,omitempty"`
+	AgentAdded       *Event_AgentAdded       `protobuf:"bytes,5,opt,name=agent_added,json=agentAdded" json:"agent_added,omitempty"`
+	AgentRemoved     *Event_AgentRemoved     `protobuf:"bytes,6,opt,name=agent_removed,json=agentRemoved" json:"agent_removed,omitempty"`
+	FrameworkAdded   *Event_FrameworkAdded   `protobuf:"bytes,7,opt,name=framework_added,json=frameworkAdded" json:"framework_added,omitempty"`
+	FrameworkUpdated *Event_FrameworkUpdated `protobuf:"bytes,8,opt,name=framework_updated,json=frameworkUpdated" json:"framework_updated,omitempty"`
+	FrameworkRemoved *Event_FrameworkRemoved `protobuf:"bytes,9,opt,name=framework_removed,json=frameworkRemoved" json:"framework_removed,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Event) GetType() Event_Type {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return Event_UNKNOWN
+}
+
+func (m *Event) GetSubscribed() *Event_Subscribed {
+	if m != nil {
+		return m.Subscribed
+	}
+	return nil
+}
+
+func (m *Event) GetTaskAdded() *Event_TaskAdded {
+	if m != nil {
+		return m.TaskAdded
+	}
+	return nil
+}
+
+func (m *Event) GetTaskUpdated() *Event_TaskUpdated {
+	if m != nil {
+		return m.TaskUpdated
+	}
+	return nil
+}
+
+func (m *Event) GetAgentAdded() *Event_AgentAdded {
+	if m != nil {
+		return m.AgentAdded
+	}
+	return nil
+}
+
+func (m *Event) GetAgentRemoved() *Event_AgentRemoved {
+	if m != nil {
+		return m.AgentRemoved
+	}
+	return nil
+}
+
+func (m *Event) GetFrameworkAdded() *Event_FrameworkAdded {
+	if m != nil {
+		return m.FrameworkAdded
+	}
+	return nil
+}
+
+func (m *Event) GetFrameworkUpdated() *Event_FrameworkUpdated {
+	if m != nil {
+		return m.FrameworkUpdated
+	}
+	return nil
+}
+
+func (m *Event) GetFrameworkRemoved() *Event_FrameworkRemoved {
+	if m != nil {
+		return m.FrameworkRemoved
+	}
+	return nil
+}
+
+// First event received when a client subscribes.
+type Event_Subscribed struct {
+	// Snapshot of the entire cluster state. Further updates to the
+	// cluster state are sent as separate events on the stream.
+	GetState *Response_GetState `protobuf:"bytes,1,opt,name=get_state,json=getState" json:"get_state,omitempty"`
+	// This value will be set if the master is sending heartbeats to
+	// subscribers. See the comment above on 'HEARTBEAT' for more details.
+	HeartbeatIntervalSeconds *float64 `protobuf:"fixed64,2,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds" json:"heartbeat_interval_seconds,omitempty"`
+	XXX_unrecognized         []byte   `json:"-"`
+}
+
+func (m *Event_Subscribed) Reset()                    { *m = Event_Subscribed{} }
+func (m *Event_Subscribed) String() string            { return proto.CompactTextString(m) }
+func (*Event_Subscribed) ProtoMessage()               {}
+func (*Event_Subscribed) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+func (m *Event_Subscribed) GetGetState() *Response_GetState {
+	if m != nil {
+		return m.GetState
+	}
+	return nil
+}
+
+func (m *Event_Subscribed) GetHeartbeatIntervalSeconds() float64 {
+	if m != nil && m.HeartbeatIntervalSeconds != nil {
+		return *m.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+// Forwarded by the master when a task becomes known to it. This can happen
+// when a new task is launched by the scheduler or when the task becomes
+// known to the master upon an agent (re-)registration after a failover.
+type Event_TaskAdded struct {
+	Task             *mesos_v1.Task `protobuf:"bytes,1,req,name=task" json:"task,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Event_TaskAdded) Reset()                    { *m = Event_TaskAdded{} }
+func (m *Event_TaskAdded) String() string            { return proto.CompactTextString(m) }
+func (*Event_TaskAdded) ProtoMessage()               {}
+func (*Event_TaskAdded) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 1} }
+
+func (m *Event_TaskAdded) GetTask() *mesos_v1.Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+// Forwarded by the master when an existing task transitions to a new state.
+type Event_TaskUpdated struct {
+	FrameworkId *mesos_v1.FrameworkID `protobuf:"bytes,1,req,name=framework_id,json=frameworkId" json:"framework_id,omitempty"`
+	// This is the status of the task corresponding to the last
+	// status update acknowledged by the scheduler.
+	Status *mesos_v1.TaskStatus `protobuf:"bytes,2,req,name=status" json:"status,omitempty"`
+	// This is the latest state of the task according to the agent,
+	// which can be more recent than `status` above but intermediate
+	// state changes may be skipped if they happen faster than the
+	// scheduler can acknowledge them.
+	State            *mesos_v1.TaskState `protobuf:"varint,3,req,name=state,enum=mesos.v1.TaskState" json:"state,omitempty"`
+	XXX_unrecognized []byte              `json:"-"`
+}
+
+func (m *Event_TaskUpdated) Reset()                    { *m = Event_TaskUpdated{} }
+func (m *Event_TaskUpdated) String() string            { return proto.CompactTextString(m) }
+func (*Event_TaskUpdated) ProtoMessage()               {}
+func (*Event_TaskUpdated) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 2} }
+
+func (m *Event_TaskUpdated) GetFrameworkId() *mesos_v1.FrameworkID {
+	if m != nil {
+		return m.FrameworkId
+	}
+	return nil
+}
+
+func (m *Event_TaskUpdated) GetStatus() *mesos_v1.TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *Event_TaskUpdated) GetState() mesos_v1.TaskState {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return mesos_v1.TaskState_TASK_STAGING
+}
+
+// Forwarded by the master when a framework becomes known to it.
+// This can happen when a new framework registers with the master.
+type Event_FrameworkAdded struct {
+	Framework        *Response_GetFrameworks_Framework `protobuf:"bytes,1,req,name=framework" json:"framework,omitempty"`
+	XXX_unrecognized []byte                            `json:"-"`
+}
+
+func (m *Event_FrameworkAdded) Reset()                    { *m = Event_FrameworkAdded{} }
+func (m *Event_FrameworkAdded) String() string            { return proto.CompactTextString(m) }
+func (*Event_FrameworkAdded) ProtoMessage()               {}
+func (*Event_FrameworkAdded) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 3} }
+
+func (m *Event_FrameworkAdded) GetFramework() *Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.Framework
+	}
+	return nil
+}
+
+// Forwarded by the master when a framework reregisters with the master
+// upon a disconnection (network error) or upon a master failover.
+type Event_FrameworkUpdated struct {
+	Framework        *Response_GetFrameworks_Framework `protobuf:"bytes,1,req,name=framework" json:"framework,omitempty"`
+	XXX_unrecognized []byte                            `json:"-"`
+}
+
+func (m *Event_FrameworkUpdated) Reset()                    { *m = Event_FrameworkUpdated{} }
+func (m *Event_FrameworkUpdated) String() string            { return proto.CompactTextString(m) }
+func (*Event_FrameworkUpdated) ProtoMessage()               {}
+func (*Event_FrameworkUpdated) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 4} }
+
+func (m *Event_FrameworkUpdated) GetFramework() *Response_GetFrameworks_Framework {
+	if m != nil {
+		return m.Framework
+	}
+	return nil
+}
+
+// Forwarded by the master when a framework is removed. This can happen when
+// a framework is explicitly teardown by the operator or if it fails to
+// reregister with the master within the failover timeout.
+type Event_FrameworkRemoved struct {
+	FrameworkInfo    *mesos_v1.FrameworkInfo `protobuf:"bytes,1,req,name=framework_info,json=frameworkInfo" json:"framework_info,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *Event_FrameworkRemoved) Reset()                    { *m = Event_FrameworkRemoved{} }
+func (m *Event_FrameworkRemoved) String() string            { return proto.CompactTextString(m) }
+func (*Event_FrameworkRemoved) ProtoMessage()               {}
+func (*Event_FrameworkRemoved) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 5} }
+
+func (m *Event_FrameworkRemoved) GetFrameworkInfo() *mesos_v1.FrameworkInfo {
+	if m != nil {
+		return m.FrameworkInfo
+	}
+	return nil
+}
+
+// Forwarded by the master when an agent becomes known to it.
+// This can happen when an agent registered for the first
+// time, or reregistered with the master.
+type Event_AgentAdded struct {
+	Agent            *Response_GetAgents_Agent `protobuf:"bytes,1,req,name=agent" json:"agent,omitempty"`
+	XXX_unrecognized []byte                    `json:"-"`
+}
+
+func (m *Event_AgentAdded) Reset()                    { *m = Event_AgentAdded{} }
+func (m *Event_AgentAdded) String() string            { return proto.CompactTextString(m) }
+func (*Event_AgentAdded) ProtoMessage()               {}
+func (*Event_AgentAdded) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 6} }
+
+func (m *Event_AgentAdded) GetAgent() *Response_GetAgents_Agent {
+	if m != nil {
+		return m.Agent
+	}
+	return nil
+}
+
+// Forwarded by the master when an agent is removed. This
+// can happen when the agent is scheduled for maintenance.
+//
+// NOTE: It's possible that an agent might become
+// active once it has been removed, i.e. if the master
+// has gc'ed its list of known "dead" agents.
+// See MESOS-5965 for context.
+type Event_AgentRemoved struct {
+	AgentId          *mesos_v1.AgentID `protobuf:"bytes,1,req,name=agent_id,json=agentId" json:"agent_id,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *Event_AgentRemoved) Reset()                    { *m = Event_AgentRemoved{} }
+func (m *Event_AgentRemoved) String() string            { return proto.CompactTextString(m) }
+func (*Event_AgentRemoved) ProtoMessage()               {}
+func (*Event_AgentRemoved) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 7} }
+
+func (m *Event_AgentRemoved) GetAgentId() *mesos_v1.AgentID {
+	if m != nil {
+		return m.AgentId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Call)(nil), "mesos.v1.master.Call")
+	proto.RegisterType((*Call_GetMetrics)(nil), "mesos.v1.master.Call.GetMetrics")
+	proto.RegisterType((*Call_SetLoggingLevel)(nil), "mesos.v1.master.Call.SetLoggingLevel")
+	proto.RegisterType((*Call_ListFiles)(nil), "mesos.v1.master.Call.ListFiles")
+	proto.RegisterType((*Call_ReadFile)(nil), "mesos.v1.master.Call.ReadFile")
+	proto.RegisterType((*Call_UpdateWeights)(nil), "mesos.v1.master.Call.UpdateWeights")
+	proto.RegisterType((*Call_ReserveResources)(nil), "mesos.v1.master.Call.ReserveResources")
+	proto.RegisterType((*Call_UnreserveResources)(nil), "mesos.v1.master.Call.UnreserveResources")
+	proto.RegisterType((*Call_CreateVolumes)(nil), "mesos.v1.master.Call.CreateVolumes")
+	proto.RegisterType((*Call_DestroyVolumes)(nil), "mesos.v1.master.Call.DestroyVolumes")
+	proto.RegisterType((*Call_GrowVolume)(nil), "mesos.v1.master.Call.GrowVolume")
+	proto.RegisterType((*Call_ShrinkVolume)(nil), "mesos.v1.master.Call.ShrinkVolume")
+	proto.RegisterType((*Call_UpdateMaintenanceSchedule)(nil), "mesos.v1.master.Call.UpdateMaintenanceSchedule")
+	proto.RegisterType((*Call_StartMaintenance)(nil), "mesos.v1.master.Call.StartMaintenance")
+	proto.RegisterType((*Call_StopMaintenance)(nil), "mesos.v1.master.Call.StopMaintenance")
+	proto.RegisterType((*Call_DrainAgent)(nil), "mesos.v1.master.Call.DrainAgent")
+	proto.RegisterType((*Call_DeactivateAgent)(nil), "mesos.v1.master.Call.DeactivateAgent")
+	proto.RegisterType((*Call_ReactivateAgent)(nil), "mesos.v1.master.Call.ReactivateAgent")
+	proto.RegisterType((*Call_UpdateQuota)(nil), "mesos.v1.master.Call.UpdateQuota")
+	proto.RegisterType((*Call_SetQuota)(nil), "mesos.v1.master.Call.SetQuota")
+	proto.RegisterType((*Call_RemoveQuota)(nil), "mesos.v1.master.Call.RemoveQuota")
+	proto.RegisterType((*Call_Teardown)(nil), "mesos.v1.master.Call.Teardown")
+	proto.RegisterType((*Call_MarkAgentGone)(nil), "mesos.v1.master.Call.MarkAgentGone")
+	proto.RegisterType((*Response)(nil), "mesos.v1.master.Response")
+	proto.RegisterType((*Response_GetHealth)(nil), "mesos.v1.master.Response.GetHealth")
+	proto.RegisterType((*Response_GetFlags)(nil), "mesos.v1.master.Response.GetFlags")
+	proto.RegisterType((*Response_GetVersion)(nil), "mesos.v1.master.Response.GetVersion")
+	proto.RegisterType((*Response_GetMetrics)(nil), "mesos.v1.master.Response.GetMetrics")
+	proto.RegisterType((*Response_GetLoggingLevel)(nil), "mesos.v1.master.Response.GetLoggingLevel")
+	proto.RegisterType((*Response_ListFiles)(nil), "mesos.v1.master.Response.ListFiles")
+	proto.RegisterType((*Response_ReadFile)(nil), "mesos.v1.master.Response.ReadFile")
+	proto.RegisterType((*Response_GetState)(nil), "mesos.v1.master.Response.GetState")
+	proto.RegisterType((*Response_GetAgents)(nil), "mesos.v1.master.Response.GetAgents")
+	proto.RegisterType((*Response_GetAgents_Agent)(nil), "mesos.v1.master.Response.GetAgents.Agent")
+	proto.RegisterType((*Response_GetAgents_Agent_ResourceProvider)(nil), "mesos.v1.master.Response.GetAgents.Agent.ResourceProvider")
+	proto.RegisterType((*Response_GetFrameworks)(nil), "mesos.v1.master.Response.GetFrameworks")
+	proto.RegisterType((*Response_GetFrameworks_Framework)(nil), "mesos.v1.master.Response.GetFrameworks.Framework")
+	proto.RegisterType((*Response_GetExecutors)(nil), "mesos.v1.master.Response.GetExecutors")
+	proto.RegisterType((*Response_GetExecutors_Executor)(nil), "mesos.v1.master.Response.GetExecutors.Executor")
+	proto.RegisterType((*Response_GetOperations)(nil), "mesos.v1.master.Response.GetOperations")
+	proto.RegisterType((*Response_GetTasks)(nil), "mesos.v1.master.Response.GetTasks")
+	proto.RegisterType((*Response_GetRoles)(nil), "mesos.v1.master.Response.GetRoles")
+	proto.RegisterType((*Response_GetWeights)(nil), "mesos.v1.master.Response.GetWeights")
+	proto.RegisterType((*Response_GetMaster)(nil), "mesos.v1.master.Response.GetMaster")
+	proto.RegisterType((*Response_GetMaintenanceStatus)(nil), "mesos.v1.master.Response.GetMaintenanceStatus")
+	proto.RegisterType((*Response_GetMaintenanceSchedule)(nil), "mesos.v1.master.Response.GetMaintenanceSchedule")
+	proto.RegisterType((*Response_GetQuota)(nil), "mesos.v1.master.Response.GetQuota")
+	proto.RegisterType((*Event)(nil), "mesos.v1.master.Event")
+	proto.RegisterType((*Event_Subscribed)(nil), "mesos.v1.master.Event.Subscribed")
+	proto.RegisterType((*Event_TaskAdded)(nil), "mesos.v1.master.Event.TaskAdded")
+	proto.RegisterType((*Event_TaskUpdated)(nil), "mesos.v1.master.Event.TaskUpdated")
+	proto.RegisterType((*Event_FrameworkAdded)(nil), "mesos.v1.master.Event.FrameworkAdded")
+	proto.RegisterType((*Event_FrameworkUpdated)(nil), "mesos.v1.master.Event.FrameworkUpdated")
+	proto.RegisterType((*Event_FrameworkRemoved)(nil), "mesos.v1.master.Event.FrameworkRemoved")
+	proto.RegisterType((*Event_AgentAdded)(nil), "mesos.v1.master.Event.AgentAdded")
+	proto.RegisterType((*Event_AgentRemoved)(nil), "mesos.v1.master.Event.AgentRemoved")
+	proto.RegisterEnum("mesos.v1.master.Call_Type", Call_Type_name, Call_Type_value)
+	proto.RegisterEnum("mesos.v1.master.Response_Type", Response_Type_name, Response_Type_value)
+	proto.RegisterEnum("mesos.v1.master.Event_Type", Event_Type_name, Event_Type_value)
+}
+
+var fileDescriptor0 = []byte{
+	// 3579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x2f, 0x29, 0x52, 0x22, 0x1f, 0xff, 0xad, 0x46, 0xb2, 0xbc, 0x5e, 0x3b, 0xb6, 0x2c, 0x3b,
+	0xb1, 0x13, 0x18, 0xb4, 0xad, 0x20, 0x48, 0x10, 0x27, 0x56, 0x29, 0x72, 0x2d, 0xb1, 0x16, 0x49,
+	0x7b, 0x96, 0x92, 0x1b, 0x14, 0x28, 0xb1, 0xe6, 0x0e, 0xa9, 0xad, 0x48, 0x2e, 0xb3, 0xbb, 0x94,
+	0xe3, 0x9e, 0x7b, 0x6b, 0x6f, 0x05, 0x72, 0x2a, 0x8a, 0x02, 0x45, 0x6f, 0x3d, 0xf6, 0x56, 0x20,
+	0x9f, 0xa0, 0x40, 0x6f, 0xfd, 0x0a, 0xbd, 0xf4, 0x43, 0x14, 0x33, 0xb3, 0xb3, 0x7f, 0xc8, 0x25,
+	0x4d, 0x59, 0x4d, 0x6f, 0x33, 0x6f, 0x7e, 0xef, 0x37, 0x6f, 0xe7, 0xcf, 0x7b, 0x33, 0x6f, 0x16,
+	0xae, 0x0c, 0x89, 0x63, 0x39, 0x9d, 0xf3, 0xc7, 0x9d, 0xa1, 0xee, 0xb8, 0xc4, 0x2e, 0x8f, 0x6d,
+	0xcb, 0xb5, 0x50, 0x89, 0x89, 0xcb, 0xe7, 0x8f, 0xcb, 0x5c, 0xac, 0xdc, 0xec, 0x5b, 0x56, 0x7f,
+	0x40, 0x1e, 0xb2, 0xe6, 0xd7, 0x93, 0xde, 0x43, 0x63, 0x62, 0xeb, 0xae, 0x69, 0x8d, 0xb8, 0x82,
+	0x52, 0x14, 0x3c, 0x5e, 0x5d, 0xf6, 0x79, 0xf5, 0xc1, 0xc0, 0xea, 0xea, 0xae, 0xe5, 0x51, 0x2b,
+	0x4a, 0xa8, 0x47, 0x73, 0xe4, 0x92, 0x91, 0x3e, 0xea, 0x12, 0xaf, 0x6d, 0xd3, 0x6f, 0xfb, 0x76,
+	0x62, 0xb9, 0x3a, 0x97, 0xee, 0xfc, 0x70, 0x07, 0x52, 0x55, 0x7d, 0x30, 0x40, 0x65, 0x48, 0xb9,
+	0x6f, 0xc7, 0x44, 0x4e, 0x6c, 0x27, 0xee, 0x17, 0x77, 0x95, 0xf2, 0x94, 0x91, 0x65, 0x0a, 0x2a,
+	0xb7, 0xdf, 0x8e, 0x09, 0x66, 0x38, 0x54, 0x81, 0x5c, 0x9f, 0xb8, 0x9d, 0x21, 0x71, 0x6d, 0xb3,
+	0xeb, 0xc8, 0xc9, 0xed, 0xc4, 0xfd, 0xdc, 0xee, 0x76, 0xbc, 0xda, 0x01, 0x71, 0x1b, 0x1c, 0x87,
+	0xa1, 0xef, 0x97, 0xd1, 0x4b, 0x58, 0x77, 0x88, 0xdb, 0x19, 0x58, 0xfd, 0xbe, 0x39, 0xea, 0x77,
+	0x06, 0xe4, 0x9c, 0x0c, 0xe4, 0x15, 0x46, 0xf4, 0x61, 0x3c, 0x91, 0x46, 0xdc, 0x23, 0x8e, 0x3e,
+	0xa2, 0x60, 0x5c, 0x72, 0xa2, 0x02, 0xf4, 0x14, 0x60, 0x60, 0x3a, 0x6e, 0xa7, 0x67, 0x0e, 0x88,
+	0x23, 0xa7, 0x18, 0xd7, 0xad, 0x78, 0xae, 0x23, 0xd3, 0x71, 0x9f, 0x51, 0x18, 0xce, 0x0e, 0x44,
+	0x11, 0x3d, 0x81, 0xac, 0x4d, 0x74, 0x83, 0xe9, 0xcb, 0x69, 0xa6, 0x7e, 0x33, 0x5e, 0x1d, 0x13,
+	0xdd, 0xa0, 0x3a, 0x38, 0x63, 0x7b, 0x25, 0xf4, 0x33, 0x28, 0x4e, 0xc6, 0x86, 0xee, 0x92, 0xce,
+	0x1b, 0x62, 0xf6, 0x4f, 0x5d, 0x47, 0x5e, 0x65, 0x0c, 0x77, 0xe2, 0x19, 0x8e, 0x19, 0xf6, 0x15,
+	0x87, 0xe2, 0xc2, 0x24, 0x5c, 0x45, 0x1a, 0xac, 0xdb, 0xc4, 0x21, 0xf6, 0x39, 0xe9, 0xd8, 0xc4,
+	0xb1, 0x26, 0x76, 0x97, 0x38, 0xf2, 0x1a, 0xa3, 0xfb, 0x68, 0x9e, 0x41, 0x0c, 0x8e, 0x05, 0x1a,
+	0x4b, 0xf6, 0x94, 0x04, 0x7d, 0x03, 0x1b, 0x93, 0xd1, 0x2c, 0x6d, 0x86, 0xd1, 0xde, 0x9f, 0x63,
+	0xe5, 0x68, 0x9a, 0x06, 0xa3, 0xc9, 0x8c, 0x8c, 0x7e, 0x7b, 0xd7, 0x26, 0xf4, 0xdb, 0xcf, 0xad,
+	0xc1, 0x64, 0x48, 0x1c, 0x39, 0xbb, 0xe8, 0xdb, 0xab, 0x0c, 0x7b, 0xc2, 0xa1, 0xb8, 0xd0, 0x0d,
+	0x57, 0x51, 0x03, 0x4a, 0x06, 0x71, 0x5c, 0xdb, 0x7a, 0xeb, 0x93, 0x01, 0x23, 0xbb, 0x1b, 0x4f,
+	0x56, 0xe3, 0x60, 0xc1, 0x56, 0x34, 0x22, 0x75, 0xb6, 0x52, 0x6d, 0xeb, 0x8d, 0xc7, 0x25, 0xa3,
+	0x85, 0x2b, 0xd5, 0xb6, 0xde, 0x70, 0x3d, 0x0c, 0x7d, 0xbf, 0x8c, 0x0e, 0xa0, 0xe0, 0x9c, 0xda,
+	0xe6, 0xe8, 0x4c, 0x90, 0x6c, 0x30, 0x92, 0x9d, 0x39, 0xab, 0x94, 0x41, 0x3d, 0x9a, 0xbc, 0x13,
+	0xaa, 0x21, 0x0b, 0xae, 0x7b, 0x4b, 0x24, 0xb4, 0x41, 0x3b, 0x4e, 0xf7, 0x94, 0x18, 0x93, 0x01,
+	0x91, 0x73, 0x8c, 0xf6, 0xe1, 0xa2, 0xf5, 0xd2, 0x08, 0xf4, 0x34, 0x4f, 0x0d, 0x5f, 0x9b, 0xcc,
+	0x6b, 0xa2, 0xeb, 0xc8, 0x71, 0x75, 0xdb, 0x0d, 0xf7, 0x27, 0xe7, 0x17, 0xad, 0x23, 0x8d, 0xc2,
+	0x43, 0x54, 0x58, 0x72, 0xa6, 0x24, 0xe8, 0x05, 0x48, 0x8e, 0x6b, 0x8d, 0x23, 0x9c, 0x85, 0x85,
+	0xfb, 0xd6, 0xb5, 0xc6, 0x61, 0xca, 0x92, 0x13, 0x15, 0xd0, 0x39, 0x32, 0x6c, 0xdd, 0x1c, 0x75,
+	0xf4, 0x3e, 0x19, 0xb9, 0xf2, 0x95, 0x45, 0x73, 0x54, 0xa3, 0xc0, 0x0a, 0xc5, 0x61, 0x30, 0xfc,
+	0x32, 0x35, 0xca, 0x20, 0x7a, 0xd7, 0x35, 0xcf, 0xe9, 0xf0, 0x72, 0x9e, 0xad, 0x45, 0x46, 0xd5,
+	0x7c, 0x34, 0x27, 0x2b, 0x19, 0x51, 0x01, 0x65, 0xb4, 0xa7, 0x19, 0xaf, 0x2e, 0x62, 0xc4, 0xd3,
+	0x8c, 0xf6, 0x14, 0x63, 0x0d, 0xf2, 0xde, 0xf4, 0x33, 0x1f, 0x2c, 0x6f, 0x32, 0xb6, 0xdb, 0x8b,
+	0xe6, 0xfb, 0x25, 0x05, 0xe2, 0xdc, 0x24, 0xa8, 0xa0, 0x2f, 0x21, 0xe3, 0x12, 0xdd, 0x36, 0xac,
+	0x37, 0x23, 0x59, 0x5a, 0xe4, 0xa3, 0xda, 0x1e, 0x0a, 0xfb, 0x78, 0xf4, 0x1c, 0x4a, 0x43, 0xdd,
+	0x3e, 0xe3, 0x5f, 0xd3, 0xe9, 0x5b, 0x23, 0x22, 0xaf, 0x2f, 0xda, 0xa8, 0x0d, 0xdd, 0x3e, 0x63,
+	0xb6, 0x1f, 0x58, 0x23, 0x82, 0x0b, 0xc3, 0x70, 0x15, 0xed, 0x41, 0x96, 0x3a, 0x70, 0xfe, 0x2d,
+	0xc5, 0x45, 0x96, 0x68, 0xc4, 0x65, 0xb6, 0xef, 0x27, 0xe5, 0x04, 0xce, 0x38, 0x5e, 0x0d, 0x1d,
+	0x42, 0xde, 0x26, 0x43, 0xeb, 0x5c, 0x8c, 0x47, 0x69, 0xd1, 0x78, 0x60, 0x86, 0x0c, 0x68, 0x72,
+	0x76, 0x20, 0x50, 0x9e, 0x02, 0x04, 0x51, 0x06, 0x3d, 0x82, 0x35, 0xd7, 0x1c, 0x12, 0x6b, 0xe2,
+	0xb2, 0x78, 0x96, 0xdb, 0xdd, 0x0a, 0x28, 0x6b, 0x5e, 0x70, 0xad, 0x8f, 0x7a, 0x16, 0x16, 0x30,
+	0xe5, 0x17, 0x50, 0x9a, 0x0a, 0x2e, 0x68, 0x13, 0xd2, 0x3c, 0x24, 0x25, 0xb6, 0x93, 0xf7, 0x0b,
+	0x98, 0x57, 0xd0, 0x2e, 0x64, 0x44, 0x78, 0x96, 0x93, 0xdb, 0xc9, 0x05, 0xdc, 0x3e, 0x4e, 0xb9,
+	0x05, 0x59, 0x3f, 0xda, 0x20, 0x04, 0xa9, 0xb1, 0xee, 0x9e, 0x32, 0xd6, 0x2c, 0x66, 0x65, 0xa5,
+	0x09, 0x19, 0x11, 0x4f, 0xe2, 0xda, 0xd1, 0x16, 0xac, 0x5a, 0xbd, 0x9e, 0x43, 0x5c, 0xd6, 0x65,
+	0x0a, 0x7b, 0x35, 0x2a, 0x1f, 0x90, 0x51, 0xdf, 0x3d, 0x65, 0x61, 0x33, 0x85, 0xbd, 0x9a, 0x72,
+	0x08, 0x85, 0x48, 0x74, 0x41, 0x9f, 0x43, 0x9e, 0xc7, 0xa4, 0x8e, 0x39, 0xea, 0x59, 0x8e, 0x9c,
+	0xd8, 0x5e, 0xb9, 0x9f, 0xdb, 0xdd, 0x0c, 0x2c, 0xe7, 0x40, 0x66, 0x77, 0xee, 0x8d, 0x5f, 0x76,
+	0x94, 0x3f, 0x26, 0x40, 0x9a, 0x8e, 0x2c, 0xe8, 0x01, 0x64, 0xf8, 0xfa, 0x31, 0x0d, 0x66, 0x66,
+	0x6e, 0x77, 0x3d, 0x60, 0x62, 0xcb, 0xa3, 0x5e, 0xc3, 0x6b, 0x0c, 0x52, 0x37, 0xd0, 0x27, 0xb0,
+	0xca, 0x15, 0xe5, 0x15, 0xd6, 0x2b, 0x0a, 0xb0, 0x82, 0x12, 0x7b, 0x08, 0xf4, 0x88, 0xc6, 0x5f,
+	0x11, 0x97, 0x92, 0x73, 0xe1, 0x01, 0x48, 0x71, 0x01, 0xcd, 0x86, 0xa8, 0x0b, 0x5a, 0x78, 0xf1,
+	0x5e, 0xcf, 0xa0, 0x10, 0x09, 0x61, 0x17, 0xec, 0xf0, 0x01, 0xac, 0x89, 0xc8, 0x36, 0xbf, 0x3b,
+	0x01, 0x51, 0x06, 0x50, 0x8c, 0x86, 0xb8, 0x1f, 0xb5, 0xb7, 0xef, 0x13, 0x00, 0x41, 0x18, 0x9c,
+	0xea, 0x2a, 0xf1, 0xee, 0xb9, 0xf6, 0x22, 0x24, 0xdf, 0x1b, 0xb1, 0x73, 0xcd, 0x11, 0xa8, 0x0c,
+	0x19, 0xdd, 0x30, 0x4c, 0xb6, 0x93, 0x56, 0xe6, 0xa2, 0x7d, 0x8c, 0xf2, 0x87, 0x04, 0xe4, 0xc3,
+	0xa1, 0xf5, 0x47, 0x34, 0x6d, 0x17, 0x32, 0xce, 0xe4, 0xb5, 0x6b, 0xeb, 0x5d, 0x57, 0x5e, 0x99,
+	0xde, 0xe4, 0x27, 0xfa, 0x60, 0x42, 0xca, 0x5a, 0x57, 0x1f, 0xe8, 0x36, 0xf6, 0x71, 0xca, 0x2b,
+	0xb8, 0x36, 0x37, 0x42, 0x53, 0x97, 0xed, 0x07, 0x79, 0x3e, 0x61, 0x11, 0x47, 0xe9, 0x2b, 0x94,
+	0x85, 0x06, 0xf6, 0xf1, 0x4a, 0x15, 0xa4, 0xe9, 0x98, 0x8c, 0x1e, 0x42, 0x66, 0xa8, 0x77, 0x4f,
+	0xcd, 0x11, 0x11, 0x7b, 0x79, 0x23, 0xe0, 0x6b, 0xf0, 0x96, 0x7a, 0x0d, 0xfb, 0x20, 0x65, 0x1f,
+	0x4a, 0x53, 0x41, 0xf8, 0xe2, 0x1c, 0x7f, 0x4e, 0x00, 0x04, 0xc1, 0xf7, 0x82, 0x8b, 0xb0, 0x0a,
+	0xd2, 0x50, 0xff, 0xae, 0xd3, 0xb7, 0xf5, 0x2e, 0xe9, 0x8c, 0x89, 0x6d, 0x5a, 0x86, 0x77, 0x69,
+	0xb8, 0x56, 0xe6, 0xf7, 0x9f, 0xb2, 0xb8, 0xff, 0xf8, 0x6e, 0x14, 0x17, 0x87, 0xfa, 0x77, 0x07,
+	0x54, 0xe3, 0x05, 0x53, 0x40, 0x3b, 0x90, 0x65, 0xd1, 0x8b, 0xc5, 0x2d, 0xea, 0xf2, 0x32, 0x5f,
+	0xa6, 0x7b, 0xfa, 0xc0, 0x21, 0xd4, 0x4a, 0xfb, 0x8c, 0x06, 0x25, 0x65, 0x0f, 0x4a, 0x53, 0x91,
+	0xfd, 0x62, 0x96, 0x52, 0x02, 0x7c, 0x29, 0x02, 0x02, 0xb9, 0x50, 0xec, 0xa6, 0x71, 0xa4, 0x67,
+	0x51, 0xf7, 0x47, 0xd7, 0x68, 0x06, 0xf3, 0x0a, 0xfa, 0x29, 0x14, 0x58, 0xcc, 0xeb, 0x74, 0xad,
+	0x51, 0xcf, 0xec, 0x8b, 0xad, 0x79, 0x3d, 0xe0, 0x65, 0xcd, 0x65, 0xc6, 0x51, 0x65, 0x18, 0x9c,
+	0xff, 0x36, 0xa8, 0x38, 0x4a, 0x03, 0x32, 0x22, 0xac, 0xa2, 0x8a, 0x60, 0xb3, 0xc9, 0xb7, 0x13,
+	0xe2, 0xb8, 0x9e, 0x95, 0x37, 0x62, 0xd9, 0x30, 0xc7, 0x78, 0x74, 0x5e, 0x4d, 0xb9, 0x0d, 0xb9,
+	0x50, 0x84, 0xa5, 0x61, 0xc8, 0xb6, 0xbc, 0xd5, 0x9a, 0xc5, 0xac, 0xac, 0xd4, 0x20, 0x23, 0x8e,
+	0x14, 0xe8, 0x0b, 0xc8, 0xf7, 0x6c, 0x7d, 0x48, 0xde, 0x58, 0xf6, 0x59, 0x30, 0x2c, 0x57, 0x82,
+	0x0e, 0x9f, 0x89, 0xd6, 0x7a, 0x0d, 0xe7, 0x7c, 0x68, 0xdd, 0x50, 0xbe, 0x86, 0x42, 0xe4, 0x54,
+	0x71, 0xb1, 0xd1, 0xdd, 0xf9, 0xdd, 0x2a, 0xa4, 0xe8, 0x3d, 0x14, 0xe5, 0x60, 0xed, 0xb8, 0xf9,
+	0xbc, 0xd9, 0x7a, 0xd5, 0x94, 0x7e, 0x82, 0x8a, 0x00, 0x07, 0x6a, 0xbb, 0x73, 0xa8, 0x56, 0x8e,
+	0xda, 0x87, 0x52, 0x02, 0x15, 0x20, 0x4b, 0xeb, 0xcf, 0x8e, 0x2a, 0x07, 0x9a, 0x94, 0x44, 0x25,
+	0xc8, 0xd1, 0xea, 0x89, 0x8a, 0xb5, 0x7a, 0xab, 0x29, 0xad, 0x08, 0x41, 0x43, 0x6d, 0xe3, 0x7a,
+	0x55, 0x93, 0x52, 0xe8, 0x0a, 0xac, 0x53, 0xc1, 0x51, 0xeb, 0xe0, 0xa0, 0xde, 0x3c, 0xe8, 0x1c,
+	0xa9, 0x27, 0xea, 0x91, 0x94, 0xa6, 0x62, 0x6d, 0x46, 0xbc, 0x4a, 0xbb, 0x3b, 0xaa, 0x6b, 0xed,
+	0xce, 0xb3, 0xfa, 0x91, 0xaa, 0x49, 0x6b, 0xb4, 0x3b, 0xac, 0x56, 0x6a, 0xac, 0x2e, 0x65, 0x44,
+	0xef, 0x5a, 0xbb, 0xd2, 0x56, 0xa5, 0xac, 0x30, 0xae, 0x72, 0xa0, 0x36, 0xdb, 0x9a, 0x04, 0x08,
+	0x41, 0x91, 0x19, 0x87, 0x2b, 0x0d, 0xf5, 0x55, 0x0b, 0x3f, 0xd7, 0xa4, 0x1c, 0x5a, 0x87, 0x02,
+	0x95, 0xa9, 0x3f, 0x57, 0xab, 0xc7, 0xed, 0x16, 0xd6, 0xa4, 0xbc, 0x80, 0xb5, 0x5e, 0xa8, 0xb8,
+	0xd2, 0xae, 0xb7, 0x9a, 0x9a, 0x74, 0x5b, 0x30, 0xb7, 0x2b, 0xda, 0x73, 0x4d, 0x2a, 0x88, 0x2a,
+	0x6e, 0x51, 0x33, 0x8a, 0xe2, 0xab, 0x5e, 0xa9, 0xf5, 0x83, 0xc3, 0xb6, 0x26, 0x95, 0x28, 0xc5,
+	0xf1, 0x8b, 0x5a, 0xa5, 0xad, 0xfa, 0x32, 0x49, 0x58, 0xd3, 0xa8, 0x68, 0x6d, 0x15, 0x4b, 0xeb,
+	0x94, 0x43, 0x3b, 0xde, 0xd7, 0xaa, 0xb8, 0xbe, 0xaf, 0x4a, 0x88, 0x7e, 0x31, 0x56, 0x35, 0x15,
+	0x9f, 0xa8, 0x1d, 0xac, 0x6a, 0xad, 0x63, 0x5c, 0x55, 0x35, 0x69, 0x03, 0x5d, 0x85, 0x8d, 0xe3,
+	0xe6, 0x6c, 0xc3, 0x26, 0xed, 0xa2, 0x8a, 0x55, 0xda, 0xc5, 0x49, 0xeb, 0xe8, 0xb8, 0xa1, 0x6a,
+	0xd2, 0x15, 0xb4, 0x01, 0xa5, 0x9a, 0xaa, 0xb5, 0x71, 0xeb, 0x1b, 0x5f, 0xb8, 0xc5, 0x8c, 0xc3,
+	0xad, 0x57, 0x9e, 0x44, 0xda, 0xa1, 0x9f, 0xac, 0x1d, 0xe2, 0x7a, 0xf3, 0xb9, 0x10, 0xdd, 0x41,
+	0x0a, 0x6c, 0x71, 0xdb, 0xea, 0xcd, 0xb6, 0xda, 0xac, 0x34, 0xab, 0x2a, 0x1b, 0xc4, 0x63, 0x4d,
+	0xba, 0x8a, 0x6e, 0x80, 0x3c, 0xd3, 0x56, 0x3d, 0x54, 0x6b, 0xc7, 0x47, 0xaa, 0x24, 0xa3, 0x5b,
+	0x70, 0xdd, 0xfb, 0xd2, 0x58, 0xc0, 0x35, 0x36, 0x93, 0xed, 0x0a, 0x8e, 0x10, 0x48, 0x0a, 0xda,
+	0x04, 0x49, 0x6b, 0xb7, 0x5e, 0x44, 0xa4, 0xd7, 0xa9, 0xad, 0x35, 0x5c, 0xa9, 0x37, 0xf9, 0x9c,
+	0x49, 0x1f, 0x52, 0x58, 0x4d, 0xad, 0x54, 0xdb, 0xf5, 0x13, 0xda, 0x05, 0x97, 0x7e, 0x44, 0xa5,
+	0x78, 0x5a, 0x7a, 0x4f, 0x4c, 0xca, 0xcb, 0xe3, 0x56, 0xbb, 0x22, 0xdd, 0x40, 0x12, 0xe4, 0x3d,
+	0xcb, 0xb8, 0xe4, 0x2e, 0x5a, 0x87, 0xac, 0xe6, 0x03, 0x3e, 0x50, 0x92, 0x99, 0x04, 0xda, 0x84,
+	0x3c, 0x56, 0x1b, 0xad, 0x13, 0x01, 0xba, 0xc9, 0xa4, 0x79, 0xc8, 0xb4, 0xd5, 0x0a, 0xae, 0xd1,
+	0x35, 0x7e, 0x8b, 0x8e, 0x6a, 0xa3, 0x82, 0x9f, 0xf3, 0x7e, 0x3a, 0x07, 0xad, 0xa6, 0x2a, 0x6d,
+	0xef, 0xfc, 0xfe, 0x01, 0x3d, 0x3b, 0x3a, 0x63, 0x6b, 0xe4, 0xd0, 0xb8, 0x15, 0x4e, 0xe2, 0xcc,
+	0x9e, 0xc5, 0x05, 0x30, 0x9c, 0xc8, 0xd9, 0x07, 0xa0, 0x89, 0x9c, 0x53, 0xa2, 0x0f, 0xdc, 0x53,
+	0x39, 0x39, 0xe7, 0x32, 0xe0, 0x6b, 0x1e, 0x10, 0xf7, 0x90, 0x41, 0x71, 0xb6, 0x2f, 0x8a, 0xf4,
+	0x22, 0x40, 0x39, 0x7a, 0x03, 0xbd, 0xef, 0xc8, 0x2b, 0x73, 0xee, 0xc6, 0x61, 0x8a, 0x67, 0x14,
+	0x89, 0x33, 0x7d, 0xaf, 0x84, 0x54, 0x9e, 0x4d, 0x3a, 0x27, 0xb6, 0x43, 0x8f, 0x03, 0xa9, 0x39,
+	0xd7, 0xfd, 0x30, 0xc5, 0x09, 0xc7, 0xb2, 0x8c, 0x92, 0x57, 0x46, 0x6a, 0x34, 0x29, 0x95, 0x5e,
+	0x82, 0x26, 0x2e, 0x31, 0x75, 0x0c, 0xeb, 0xfd, 0x99, 0xc4, 0x14, 0xcf, 0xe5, 0x7c, 0xbc, 0x90,
+	0x2c, 0x9a, 0x9c, 0xea, 0x47, 0x05, 0x74, 0xa4, 0x43, 0xc9, 0xa9, 0xb5, 0x77, 0x8d, 0x74, 0x6c,
+	0x82, 0x6a, 0x2f, 0x9c, 0xa0, 0xca, 0xbc, 0x6b, 0xa4, 0x63, 0x92, 0x54, 0xde, 0x54, 0x39, 0xae,
+	0xee, 0x12, 0x39, 0xfb, 0x2e, 0x82, 0x03, 0xe2, 0x6a, 0x14, 0xc9, 0xa6, 0x8a, 0x95, 0xc4, 0x7a,
+	0x61, 0xee, 0x58, 0x24, 0x66, 0x16, 0xaf, 0x17, 0xe6, 0xc2, 0x1d, 0xb6, 0x5e, 0x78, 0x11, 0x35,
+	0xa1, 0xc8, 0xd6, 0x8b, 0x88, 0x0a, 0x8e, 0x97, 0xf9, 0xb8, 0xb7, 0x78, 0xd1, 0xf8, 0x70, 0x5c,
+	0xe8, 0x87, 0xab, 0xe8, 0x39, 0x50, 0x41, 0x87, 0x7c, 0x47, 0xba, 0x13, 0xd7, 0xb2, 0x9d, 0xb9,
+	0x19, 0x8e, 0x30, 0x9d, 0x2a, 0xd0, 0x38, 0xdf, 0x0f, 0xd5, 0x84, 0x71, 0xd6, 0x98, 0xf0, 0x53,
+	0x88, 0x23, 0x6f, 0x2e, 0x61, 0x5c, 0xcb, 0x87, 0x33, 0xe3, 0x82, 0xaa, 0x18, 0x71, 0x57, 0x77,
+	0xce, 0x1c, 0xb9, 0xb0, 0xc4, 0x88, 0xb7, 0x29, 0x92, 0x8d, 0x38, 0x2b, 0x09, 0x02, 0x1a, 0x82,
+	0x1d, 0xb9, 0xb8, 0x04, 0x01, 0xa6, 0x48, 0x46, 0xc0, 0x4a, 0x62, 0x5b, 0x88, 0xac, 0x64, 0x69,
+	0x89, 0x6d, 0x21, 0xd2, 0x92, 0xd0, 0xf7, 0xcb, 0x62, 0xe6, 0x39, 0x5a, 0x96, 0x96, 0x98, 0xf9,
+	0x06, 0x13, 0xb1, 0x99, 0xe7, 0x45, 0x64, 0xc0, 0x16, 0xe7, 0x08, 0x65, 0xbf, 0x5c, 0xdd, 0x9d,
+	0x38, 0x5e, 0x1a, 0xa2, 0xfc, 0x0e, 0xbe, 0xe0, 0x68, 0xcd, 0xb4, 0xf0, 0x66, 0x3f, 0x46, 0x8a,
+	0x7e, 0x05, 0xf2, 0x4c, 0x2f, 0xe2, 0xf8, 0xcd, 0xf3, 0x7f, 0x8f, 0x96, 0xee, 0xc7, 0xd3, 0xc3,
+	0x5b, 0xfd, 0x58, 0xb9, 0x98, 0x1d, 0x9e, 0xc0, 0xd8, 0x58, 0x62, 0x76, 0xf8, 0x19, 0x2c, 0xd3,
+	0xf7, 0x4a, 0xca, 0x87, 0x90, 0xf5, 0x9d, 0x2a, 0x92, 0x61, 0x8d, 0x7b, 0xe2, 0xb7, 0xec, 0x28,
+	0x94, 0xc1, 0xa2, 0xaa, 0x3c, 0x82, 0x8c, 0x70, 0x9c, 0xe8, 0x2e, 0xa4, 0xb9, 0xaf, 0xe5, 0xe7,
+	0xf6, 0x62, 0xe8, 0xd4, 0x35, 0xd0, 0xfb, 0x98, 0x37, 0x2a, 0xcf, 0x58, 0x4e, 0x44, 0xf8, 0xc6,
+	0x2f, 0x20, 0xef, 0xb9, 0x57, 0x96, 0x03, 0x98, 0x3d, 0xb0, 0x79, 0x40, 0x9e, 0x03, 0x38, 0x0f,
+	0x2a, 0xca, 0x17, 0x91, 0xdc, 0xca, 0x27, 0xb0, 0x26, 0xfc, 0x2b, 0xef, 0x5d, 0x0a, 0xdd, 0x1a,
+	0x58, 0x03, 0x16, 0x00, 0xe5, 0x1e, 0x94, 0x0e, 0x96, 0xc9, 0xaa, 0x28, 0x4f, 0xc3, 0x19, 0x92,
+	0xc7, 0x00, 0xd4, 0xbd, 0x45, 0x52, 0x15, 0xa1, 0xdb, 0x1a, 0x05, 0x31, 0x23, 0xb3, 0x3d, 0xaf,
+	0xe4, 0x28, 0xbb, 0xd1, 0x04, 0x8a, 0x63, 0xfe, 0x9a, 0x9f, 0x5c, 0x53, 0x98, 0x95, 0xa9, 0xcc,
+	0xd0, 0x5d, 0x9d, 0x5d, 0xfd, 0xf2, 0x98, 0x95, 0x95, 0xbf, 0x26, 0xd9, 0x88, 0x72, 0xaf, 0x16,
+	0xd9, 0xa4, 0x89, 0xf7, 0xd8, 0xa4, 0x33, 0x2e, 0x28, 0x79, 0x79, 0x17, 0x14, 0xf2, 0x8f, 0x2b,
+	0x97, 0xf2, 0x8f, 0x51, 0x9f, 0x9d, 0x7a, 0x1f, 0x9f, 0xad, 0xfc, 0x33, 0xc3, 0xd6, 0x29, 0xaf,
+	0xa1, 0x0a, 0xac, 0x7a, 0x6c, 0x7c, 0x7e, 0x3e, 0x5e, 0x82, 0x8d, 0x9f, 0xe5, 0xb1, 0xa7, 0x88,
+	0x9e, 0xd2, 0xf4, 0x6a, 0xd7, 0x3a, 0x27, 0x36, 0x31, 0x84, 0x69, 0xc9, 0xe9, 0x7b, 0x28, 0x3f,
+	0xfe, 0xd3, 0xd9, 0x2e, 0xf9, 0x60, 0xcf, 0xa0, 0x1f, 0xd6, 0x20, 0xcd, 0x8a, 0x68, 0x17, 0xc0,
+	0xbb, 0x40, 0x04, 0x0b, 0x3b, 0x96, 0x23, 0xab, 0x8b, 0x22, 0x4d, 0x9d, 0xb1, 0x3b, 0x1e, 0x4f,
+	0x07, 0x64, 0xb0, 0x57, 0x43, 0xdb, 0x90, 0x0b, 0xf2, 0xc0, 0x06, 0x0b, 0x24, 0x19, 0x1c, 0x16,
+	0xd1, 0x2d, 0x2a, 0xce, 0x29, 0x2b, 0xec, 0x72, 0x24, 0xaa, 0x48, 0x82, 0x95, 0xb1, 0x69, 0xb0,
+	0xf1, 0xcd, 0x62, 0x5a, 0x44, 0x4f, 0xa0, 0x64, 0x93, 0xbe, 0x49, 0x07, 0x84, 0x18, 0x1d, 0xd7,
+	0x1c, 0x8a, 0x57, 0xa5, 0xd0, 0x7a, 0x6e, 0x9b, 0x43, 0xbe, 0x9e, 0x8b, 0x01, 0x94, 0xca, 0xd0,
+	0x1e, 0x7d, 0x03, 0x9a, 0x56, 0x5f, 0x9d, 0xab, 0x2e, 0x85, 0xc1, 0x8c, 0xe0, 0x09, 0x94, 0x5c,
+	0xcb, 0xd5, 0x07, 0x91, 0x27, 0xa4, 0x79, 0x09, 0xa0, 0x22, 0x83, 0x06, 0x29, 0xb4, 0x2a, 0x6c,
+	0x78, 0xcf, 0x8b, 0xc4, 0x88, 0x3c, 0x16, 0xcd, 0x23, 0x40, 0x3e, 0x3c, 0x20, 0xd9, 0x83, 0x75,
+	0xab, 0xd7, 0x23, 0x76, 0x84, 0x22, 0x3b, 0x97, 0x42, 0xf2, 0xc0, 0x01, 0xc1, 0x3e, 0xe4, 0xbb,
+	0xfa, 0x58, 0x7f, 0x6d, 0x0e, 0x4c, 0xd7, 0x64, 0x0f, 0x41, 0x2b, 0xd1, 0xe4, 0x89, 0x3f, 0xb9,
+	0xe5, 0xaa, 0xc0, 0xbd, 0xc5, 0x11, 0x1d, 0x64, 0x02, 0x12, 0x9d, 0x77, 0xc6, 0xb6, 0x75, 0x6e,
+	0x1a, 0xc4, 0xa6, 0x27, 0x0e, 0xca, 0xf4, 0xe5, 0xd2, 0xeb, 0xd6, 0xb7, 0xf2, 0x85, 0x47, 0x81,
+	0xd7, 0xed, 0x29, 0x89, 0x43, 0x57, 0x22, 0x7f, 0xc7, 0x60, 0x2b, 0x91, 0x07, 0xfb, 0xd0, 0x4a,
+	0x64, 0xd9, 0x13, 0xbe, 0x12, 0x0d, 0x51, 0x44, 0x2d, 0x50, 0x88, 0xe3, 0x9a, 0x43, 0x36, 0xd0,
+	0x5c, 0x9b, 0x3f, 0xd9, 0xb0, 0xf9, 0x2e, 0xce, 0x9d, 0xef, 0xab, 0xbe, 0x16, 0xa3, 0x65, 0x39,
+	0x22, 0xda, 0xa8, 0xfc, 0x85, 0xe7, 0x6c, 0x23, 0xa6, 0xa1, 0x36, 0x6c, 0xcd, 0x0c, 0x42, 0x78,
+	0xbf, 0xdc, 0x9c, 0x9d, 0x0e, 0xa1, 0xcb, 0x7a, 0xdb, 0xb4, 0x63, 0xa4, 0x71, 0x2b, 0x2c, 0xb9,
+	0xec, 0x0a, 0x53, 0xfe, 0xbd, 0x0a, 0x85, 0x88, 0xdb, 0x42, 0x2f, 0x01, 0x42, 0x3e, 0x8f, 0x7b,
+	0x96, 0xc7, 0x4b, 0xfa, 0xbc, 0x20, 0xe1, 0x80, 0x43, 0x24, 0xc8, 0x80, 0xcd, 0xae, 0x35, 0x1c,
+	0x0f, 0x08, 0x1d, 0xdd, 0x10, 0x79, 0xf2, 0x7d, 0xc9, 0x37, 0x7c, 0xba, 0x90, 0xe1, 0x4d, 0xd8,
+	0x0c, 0x7c, 0x59, 0xc4, 0x6d, 0xd3, 0x5e, 0xae, 0xc6, 0x65, 0x45, 0x46, 0x3d, 0x8b, 0x3d, 0x63,
+	0x6c, 0xf8, 0x8a, 0x01, 0x9f, 0xf2, 0x8f, 0x14, 0x64, 0xfd, 0x2a, 0x7a, 0x0a, 0xc5, 0x50, 0xae,
+	0x25, 0x98, 0xb3, 0x79, 0xbc, 0xb8, 0xd0, 0x0b, 0x57, 0xe7, 0xfa, 0xba, 0x1b, 0x90, 0xed, 0x5a,
+	0xa3, 0x11, 0xe9, 0x52, 0x4f, 0xb7, 0xc2, 0x9a, 0x02, 0x01, 0x6d, 0xf5, 0x4d, 0x93, 0x73, 0xbc,
+	0xd5, 0x17, 0xc4, 0x79, 0xb6, 0xd4, 0xe5, 0x3c, 0x5b, 0xfa, 0x02, 0x9e, 0x6d, 0x0f, 0xd6, 0x27,
+	0xa3, 0x40, 0xf6, 0x4e, 0xd7, 0x18, 0x06, 0x33, 0x82, 0x7b, 0xec, 0x45, 0x85, 0xd8, 0xc2, 0x23,
+	0x96, 0x02, 0xad, 0x16, 0x95, 0x63, 0xaf, 0x19, 0x7d, 0x0d, 0x45, 0x73, 0x44, 0x1d, 0x3c, 0xe9,
+	0x78, 0x0a, 0xdc, 0x03, 0x86, 0x12, 0xc2, 0x75, 0xde, 0xce, 0xf5, 0x0a, 0x66, 0xa8, 0x36, 0xd7,
+	0x8b, 0x66, 0x2f, 0xef, 0x45, 0x61, 0x79, 0x2f, 0xaa, 0xfc, 0x2d, 0x09, 0xf9, 0xf0, 0x71, 0x03,
+	0x35, 0x20, 0x1b, 0x9c, 0x54, 0xf8, 0x3e, 0x7b, 0xb8, 0xdc, 0x49, 0xa5, 0x2c, 0x4a, 0x38, 0x60,
+	0x40, 0xbf, 0x04, 0xc9, 0xb2, 0xc7, 0xa7, 0xfa, 0x28, 0x72, 0xfe, 0x79, 0x1f, 0x56, 0xb6, 0x25,
+	0x4a, 0x9c, 0xcc, 0x6f, 0x55, 0x26, 0x90, 0x11, 0x15, 0xf4, 0x04, 0x0a, 0xa2, 0x93, 0xf0, 0x5e,
+	0x08, 0xcd, 0x87, 0x80, 0xb2, 0xa9, 0xcf, 0x93, 0x50, 0x2d, 0x92, 0x6a, 0x4c, 0xbe, 0x33, 0x91,
+	0x5b, 0x63, 0xfe, 0x29, 0x74, 0x95, 0xfb, 0x14, 0x20, 0x74, 0x2d, 0x9c, 0x49, 0x9a, 0xfb, 0x48,
+	0x1c, 0x82, 0x29, 0xdf, 0xf3, 0x73, 0x26, 0x3f, 0x26, 0x7e, 0x0e, 0x85, 0x31, 0x19, 0x19, 0x34,
+	0xad, 0x20, 0xce, 0x9a, 0x53, 0x27, 0x78, 0x8a, 0x63, 0xa3, 0x90, 0xf7, 0x80, 0x5c, 0xf1, 0x2e,
+	0xa4, 0xb9, 0x42, 0x32, 0x4e, 0x01, 0xf3, 0x46, 0xf4, 0x84, 0xef, 0x0b, 0xbd, 0x7b, 0xaa, 0xbf,
+	0x1e, 0x10, 0xaf, 0x8b, 0x74, 0xac, 0x86, 0x14, 0x02, 0x0a, 0xdb, 0x4a, 0x81, 0xab, 0xe4, 0xaa,
+	0x2b, 0xb1, 0xaa, 0x45, 0x1f, 0xc6, 0x15, 0x3f, 0x83, 0xbc, 0x37, 0xfd, 0x5c, 0x2b, 0x35, 0xf7,
+	0x9b, 0x72, 0x1c, 0xc7, 0xd4, 0xbc, 0x1b, 0x0d, 0xbf, 0xa2, 0xde, 0x85, 0x34, 0xbf, 0xdf, 0xce,
+	0x8c, 0x07, 0x6d, 0xc7, 0xbc, 0x51, 0x51, 0xd9, 0x4d, 0xe4, 0xd2, 0x8f, 0x9a, 0xbf, 0x49, 0xb0,
+	0xa3, 0xac, 0x77, 0x25, 0xfd, 0x0c, 0x72, 0x7c, 0x69, 0x8a, 0xe5, 0x94, 0x88, 0xb2, 0x70, 0x18,
+	0x63, 0x81, 0xa1, 0x5f, 0x46, 0x1f, 0x00, 0x84, 0xc2, 0x34, 0x3d, 0xed, 0x27, 0x70, 0xd6, 0x11,
+	0x41, 0x18, 0xdd, 0x86, 0x3c, 0x19, 0x30, 0x47, 0xca, 0x01, 0x2b, 0x0c, 0x90, 0xf3, 0x64, 0x2c,
+	0x4e, 0x6b, 0xb0, 0x19, 0x77, 0xa7, 0x45, 0x4f, 0x60, 0xd5, 0xbb, 0x13, 0xf3, 0xa5, 0x7d, 0x27,
+	0xfe, 0xa9, 0xa8, 0x3a, 0x98, 0x50, 0x63, 0xb8, 0x12, 0xf6, 0x54, 0x94, 0x36, 0x6c, 0xc5, 0x5f,
+	0x60, 0x2f, 0xf5, 0x06, 0xb5, 0xc7, 0xa6, 0x8a, 0xbf, 0x0c, 0x7c, 0x3a, 0x65, 0x5e, 0xfc, 0x93,
+	0x45, 0xd4, 0xac, 0x9d, 0xff, 0x24, 0xff, 0x9f, 0x59, 0xfb, 0x68, 0x7a, 0x7e, 0x35, 0x9a, 0x9e,
+	0x5f, 0x8b, 0xa6, 0xe7, 0x33, 0x53, 0xe9, 0xf9, 0x6c, 0x4c, 0x7a, 0x1e, 0x66, 0xd3, 0xf3, 0xb9,
+	0x98, 0xf4, 0xfc, 0x46, 0x34, 0x3d, 0x9f, 0x8f, 0xa6, 0xe7, 0x0b, 0xd3, 0xe9, 0xf9, 0xe2, 0x54,
+	0x2a, 0xbe, 0xb4, 0x20, 0xfd, 0x2d, 0x2d, 0x4c, 0x7f, 0xaf, 0x47, 0x73, 0xce, 0x68, 0xe7, 0x5f,
+	0x79, 0x48, 0xab, 0xe7, 0xf4, 0x6e, 0xf4, 0x30, 0x92, 0x12, 0xbe, 0x3e, 0xe3, 0x8e, 0x19, 0x2a,
+	0xfa, 0x63, 0x1f, 0x38, 0x93, 0xd7, 0x4e, 0xd7, 0x36, 0x5f, 0x13, 0xf1, 0x44, 0x77, 0x7b, 0x8e,
+	0x9a, 0xe6, 0x03, 0x71, 0x48, 0x09, 0xed, 0x01, 0x50, 0x47, 0xd0, 0xd1, 0x0d, 0x83, 0x1d, 0x2c,
+	0xe2, 0x7f, 0xe6, 0xf1, 0x7a, 0xd6, 0x9d, 0xb3, 0x0a, 0xc5, 0xe1, 0xac, 0x2b, 0x8a, 0x48, 0x85,
+	0x3c, 0x23, 0xe0, 0x7f, 0xbd, 0x18, 0x72, 0x6a, 0xce, 0x85, 0x3c, 0xa0, 0xe0, 0x0f, 0x6e, 0x06,
+	0xce, 0xb9, 0x41, 0x05, 0xed, 0x43, 0x8e, 0x7b, 0x7b, 0x6e, 0x48, 0x7a, 0xe1, 0xb7, 0x30, 0xef,
+	0xcf, 0x2d, 0x01, 0xdd, 0x2f, 0xa3, 0x43, 0x28, 0x70, 0x0e, 0xfe, 0xb7, 0x89, 0x31, 0xf7, 0x9f,
+	0xbe, 0x10, 0x0b, 0x7f, 0x47, 0x33, 0x70, 0x5e, 0x0f, 0xd5, 0x50, 0x13, 0x4a, 0xc1, 0x29, 0x8e,
+	0x5b, 0xb4, 0x36, 0xe7, 0x6f, 0x22, 0xce, 0xe5, 0x9f, 0xe9, 0xb8, 0x55, 0xc5, 0x5e, 0xa4, 0x8e,
+	0xda, 0xb0, 0x1e, 0xf0, 0x89, 0x91, 0xca, 0xcc, 0xc9, 0x13, 0x4c, 0x31, 0x8a, 0xe1, 0x92, 0x7a,
+	0x53, 0x92, 0x28, 0xab, 0xf8, 0xe6, 0xec, 0x72, 0xac, 0xe2, 0xbb, 0xa5, 0xde, 0x94, 0x44, 0xf9,
+	0x6d, 0x02, 0x40, 0x0b, 0x2f, 0x90, 0x50, 0x12, 0x3a, 0xf1, 0x1e, 0x49, 0xe8, 0xaf, 0x40, 0x39,
+	0x25, 0xba, 0xed, 0xbe, 0x26, 0x3a, 0xf5, 0xfe, 0x2e, 0xb1, 0xcf, 0xf5, 0x41, 0xc7, 0x21, 0x5d,
+	0x6b, 0x64, 0x38, 0x9e, 0x33, 0x96, 0x7d, 0x44, 0xdd, 0x03, 0x68, 0xbc, 0x5d, 0x79, 0x08, 0x59,
+	0x7f, 0xd9, 0xa1, 0x1d, 0x48, 0xd1, 0x35, 0xe3, 0x39, 0xb3, 0xe9, 0x50, 0xc7, 0xda, 0x94, 0x3f,
+	0x25, 0x20, 0x17, 0x5a, 0x65, 0xef, 0xff, 0xf8, 0x89, 0x1e, 0xf8, 0xce, 0x93, 0x1f, 0x3f, 0x36,
+	0xa3, 0xfd, 0x45, 0xbd, 0x26, 0xfa, 0x18, 0xd2, 0x7c, 0x8c, 0xe8, 0xe1, 0xbc, 0xb8, 0xbb, 0x31,
+	0x0b, 0x26, 0x98, 0x23, 0x14, 0x1d, 0x8a, 0xd1, 0xf5, 0x82, 0x5a, 0x90, 0xf5, 0x7b, 0xf6, 0x2c,
+	0x7c, 0x8f, 0xeb, 0x4e, 0xc0, 0xa1, 0x74, 0x41, 0x9a, 0x5e, 0x40, 0xff, 0xfb, 0x4e, 0x70, 0xa8,
+	0x13, 0xb1, 0x73, 0x2e, 0x79, 0xff, 0x51, 0x1a, 0x00, 0xc1, 0xee, 0x46, 0x7b, 0x90, 0xe6, 0xff,
+	0xf2, 0x71, 0x92, 0x0b, 0x64, 0xae, 0xb8, 0x9e, 0xf2, 0x15, 0xe4, 0xc3, 0xdb, 0xfc, 0x82, 0xef,
+	0xd7, 0x7f, 0x4f, 0xcc, 0x89, 0x84, 0xfe, 0x23, 0x6c, 0x4d, 0x4a, 0xd0, 0x3a, 0x0d, 0x22, 0x9d,
+	0x4a, 0xad, 0xa6, 0xd6, 0xa4, 0x24, 0x7d, 0x44, 0x64, 0x75, 0xfe, 0x92, 0x58, 0xe3, 0xb1, 0x90,
+	0x3f, 0x04, 0x72, 0x48, 0x8a, 0x86, 0x28, 0x2e, 0xe0, 0x0f, 0x89, 0x35, 0x29, 0x4d, 0x5f, 0x0c,
+	0xfd, 0x28, 0xe6, 0xe1, 0x56, 0x69, 0xcc, 0x0c, 0x84, 0x82, 0x6f, 0x2d, 0x2a, 0x16, 0x14, 0xec,
+	0x29, 0xfb, 0x50, 0xad, 0xe0, 0xf6, 0xbe, 0x5a, 0x69, 0x4b, 0xd9, 0xfd, 0xbb, 0xa0, 0x58, 0x76,
+	0xbf, 0xac, 0x8f, 0xf5, 0xee, 0x29, 0x99, 0x1e, 0xb9, 0xfd, 0xd5, 0x17, 0xb6, 0xe5, 0x5a, 0xce,
+	0x7f, 0x07, 0x00, 0xd3, 0xdb, 0x0c, 0x40, 0xfa, 0x2e, 0x00, 0x00,
+}
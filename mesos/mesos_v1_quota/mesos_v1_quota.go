@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go.
+// source: mesos_v1_quota.proto
+// DO NOT EDIT!
+
+/*
+Package mesos_v1_quota is a generated protocol buffer package.
+
+It is generated from these files:
+	mesos_v1_quota.proto
+
+It has these top-level messages:
+	QuotaInfo
+	QuotaRequest
+	QuotaConfig
+	QuotaStatus
+*/
+package mesos_v1_quota
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import mesos_v1 "github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+const _ = proto.ProtoPackageIsVersion1
+
+// Describes the resource guarantees for a role.
+// Persisted in the registry.
+type QuotaInfo struct {
+	Role *string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	// Principal which set the quota. Currently only operators can set quotas.
+	Principal        *string              `protobuf:"bytes,2,opt,name=principal" json:"principal,omitempty"`
+	Guarantee        []*mesos_v1.Resource `protobuf:"bytes,3,rep,name=guarantee" json:"guarantee,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *QuotaInfo) Reset()                    { *m = QuotaInfo{} }
+func (m *QuotaInfo) String() string            { return proto.CompactTextString(m) }
+func (*QuotaInfo) ProtoMessage()               {}
+func (*QuotaInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *QuotaInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *QuotaInfo) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *QuotaInfo) GetGuarantee() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Guarantee
+	}
+	return nil
+}
+
+// Describes an update to a role's quota. This is a copy of
+// `QuotaInfo` which omits the principal since it is determined
+// during authentication. Also allows the user to force the update
+// in the case of a guarantee overcommit.
+type QuotaRequest struct {
+	// See `guarantee` for the behavior of `force`.
+	Force *bool   `protobuf:"varint,1,opt,name=force,def=0" json:"force,omitempty"`
+	Role  *string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	// Mesos will try its best to ensure that the role can be
+	// allocated at least as many resources as the guarantee.
+	// Despite this, it's possible for the guarantee to not be
+	// satisfiable, if:
+	//   (1) The operator has overcommitted guarantees.
+	//   (2) There is a loss of agents that such that the
+	//       guarantees overcommit the cluster.
+	//   (3) The scheduler is pickier than mesos knows about,
+	//       e.g. the scheduler needs resources from agents
+	//       with specific attributes.
+	//
+	// The provided guarantee will be validated to ensure it
+	// is not overcommitting the cluster. The operator can
+	// disable this via `QuotaRequest.force`.
+	//
+	// If the guarantee is omitted, there is no guarantee.
+	//
+	// Operators may want to set up alerting to let them know
+	// when a guarantee cannot be satisfied.
+	//
+	// NOTE: The resources must be scalars without additional
+	// metadata like reservations, disk information, etc.
+	Guarantee        []*mesos_v1.Resource `protobuf:"bytes,3,rep,name=guarantee" json:"guarantee,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *QuotaRequest) Reset()                    { *m = QuotaRequest{} }
+func (m *QuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*QuotaRequest) ProtoMessage()               {}
+func (*QuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+const Default_QuotaRequest_Force bool = false
+
+func (m *QuotaRequest) GetForce() bool {
+	if m != nil && m.Force != nil {
+		return *m.Force
+	}
+	return Default_QuotaRequest_Force
+}
+
+func (m *QuotaRequest) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *QuotaRequest) GetGuarantee() []*mesos_v1.Resource {
+	if m != nil {
+		return m.Guarantee
+	}
+	return nil
+}
+
+// Describes a role's quota configuration. This replaces the legacy
+// quota related messages: `QuotaInfo` and `QuotaRequest`. This is
+// used in both `UPDATE_QUOTA` and `GET_QUOTA` calls as well as persisted
+// in the registry.
+//
+// We use this new message in the new call `UPDATE_QUOTA` instead of reusing
+// the legacy `QuotaRequest` message. This avoids confusions with deprecated
+// fields and provides a cleaner interface. In `QuotaStatus` returned by
+// `GET_QUOTA`, the legacy `infos` field is still filled along side `configs`
+// for backwards compatibility.
+type QuotaConfig struct {
+	Role *string `protobuf:"bytes,1,req,name=role" json:"role,omitempty"`
+	// Name-value pairs that define the resource guarantees.
+	// Only scalar resources are currently supported.
+	//
+	// Mesos will try its best to ensure that the role can be
+	// allocated at least as many resources as the guarantee.
+	// Despite this, it's possible for the guarantee to not be
+	// satisfiable, if:
+	//   (1) The operator has overcommitted guarantees.
+	//   (2) There is a loss of agents that such that the
+	//       guarantees overcommit the cluster.
+	//   (3) The scheduler is pickier than mesos knows about,
+	//       e.g. the scheduler needs resources from agents
+	//       with specific attributes.
+	//
+	// The provided guarantees will be validated to ensure it
+	// is not overcommitting the cluster. The operator can
+	// disable this via `UpdateQuota.force`.
+	//
+	// If the guarantee is omitted, there is no guarantee.
+	//
+	// Operators may want to set up alerting to let them know
+	// when a guarantee cannot be satisfied.
+	Guarantees map[string]*mesos_v1.Value_Scalar `protobuf:"bytes,2,rep,name=guarantees" json:"guarantees,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Name-value pairs that define the resource limits.
+	// Only scalar resources are currently supported.
+	//
+	// Imposes a limit on the amount of resources allocated to the
+	// role. Mesos will try its best to ensure that the role does
+	// not exceed this limit. Despite this, the limit can be exceeded
+	// when:
+	//   (1) The limit is lowered below the allocation.
+	//   (2) Some agents are partitioned and re-connect with
+	//       resources allocated to the role.
+	//
+	// The provided limits will be validated to ensure it does not
+	// exceed the total cluster size. The operator can disable
+	// this check via `UpdateQuota.force`.
+	//
+	// If the limit is omitted, there is no limit.
+	//
+	// Operators may want to set up alerting to let them know
+	// when the limit is exceeded.
+	Limits           map[string]*mesos_v1.Value_Scalar `protobuf:"bytes,3,rep,name=limits" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_unrecognized []byte                            `json:"-"`
+}
+
+func (m *QuotaConfig) Reset()                    { *m = QuotaConfig{} }
+func (m *QuotaConfig) String() string            { return proto.CompactTextString(m) }
+func (*QuotaConfig) ProtoMessage()               {}
+func (*QuotaConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *QuotaConfig) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *QuotaConfig) GetGuarantees() map[string]*mesos_v1.Value_Scalar {
+	if m != nil {
+		return m.Guarantees
+	}
+	return nil
+}
+
+func (m *QuotaConfig) GetLimits() map[string]*mesos_v1.Value_Scalar {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// `QuotaStatus` describes the internal representation for the
+// /quota/status response and `GET_QUOTA` `master::Response`.
+type QuotaStatus struct {
+	// Returns all non-default quotas. Those omitted from this
+	// list have the default of: no guarantee and no limit.
+	//
+	// For backwards compatibility, `infos` are still filled along
+	// side `configs`. Note, however `infos` will contain less information
+	// comparing to `configs` (e.g. info does not contain information
+	// regarding quota limits).
+	Infos []*QuotaInfo `protobuf:"bytes,1,rep,name=infos" json:"infos,omitempty"`
+	// Returns all non-default quotas. Those omitted from this
+	// list have the default of: no guarantee and no limit.
+	Configs          []*QuotaConfig `protobuf:"bytes,2,rep,name=configs" json:"configs,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *QuotaStatus) Reset()                    { *m = QuotaStatus{} }
+func (m *QuotaStatus) String() string            { return proto.CompactTextString(m) }
+func (*QuotaStatus) ProtoMessage()               {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *QuotaStatus) GetInfos() []*QuotaInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *QuotaStatus) GetConfigs() []*QuotaConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuotaInfo)(nil), "mesos.v1.quota.QuotaInfo")
+	proto.RegisterType((*QuotaRequest)(nil), "mesos.v1.quota.QuotaRequest")
+	proto.RegisterType((*QuotaConfig)(nil), "mesos.v1.quota.QuotaConfig")
+	proto.RegisterType((*QuotaStatus)(nil), "mesos.v1.quota.QuotaStatus")
+}
+
+var fileDescriptor0 = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x51, 0x4b, 0xf3, 0x30,
+	0x14, 0xa5, 0xdd, 0xd7, 0x7d, 0xf6, 0x56, 0xa6, 0x04, 0x91, 0x6e, 0xf3, 0x61, 0xcc, 0x07, 0x07,
+	0x4a, 0x70, 0x13, 0x41, 0xf6, 0x22, 0x4c, 0x44, 0x44, 0x1f, 0x5c, 0x86, 0xbe, 0x8e, 0x50, 0xd2,
+	0x59, 0xec, 0x9a, 0x2e, 0x49, 0x07, 0xfb, 0xa9, 0xfe, 0x1b, 0x69, 0xd2, 0x75, 0x75, 0xc8, 0x40,
+	0xf0, 0x2d, 0x9c, 0x7b, 0xee, 0x39, 0xf7, 0x9e, 0x5c, 0x38, 0x9a, 0x33, 0xc9, 0xe5, 0x74, 0xd9,
+	0x9f, 0x2e, 0x32, 0xae, 0x28, 0x4e, 0x05, 0x57, 0x1c, 0x35, 0x34, 0x8a, 0x97, 0x7d, 0xac, 0xd1,
+	0x56, 0x63, 0xcd, 0x32, 0xf5, 0x2e, 0x07, 0x77, 0x9c, 0x17, 0x1e, 0x93, 0x90, 0x23, 0x04, 0xff,
+	0x04, 0x8f, 0x99, 0x6f, 0x75, 0xac, 0x9e, 0x4b, 0xf4, 0x1b, 0x9d, 0x80, 0x9b, 0x8a, 0x28, 0x09,
+	0xa2, 0x94, 0xc6, 0xbe, 0xad, 0x0b, 0x1b, 0x00, 0x5d, 0x82, 0x3b, 0xcb, 0xa8, 0xa0, 0x89, 0x62,
+	0xcc, 0xaf, 0x75, 0x6a, 0x3d, 0x6f, 0x80, 0x70, 0x69, 0x49, 0x98, 0xe4, 0x99, 0x08, 0x18, 0xd9,
+	0x90, 0xba, 0x0b, 0xd8, 0xd7, 0x86, 0x84, 0x2d, 0x32, 0x26, 0x15, 0x6a, 0x83, 0x13, 0x72, 0x11,
+	0x18, 0xd3, 0xbd, 0xa1, 0x13, 0xd2, 0x58, 0x32, 0x62, 0xb0, 0x72, 0x20, 0xbb, 0x32, 0xd0, 0xef,
+	0x2d, 0x3f, 0x6d, 0xf0, 0xb4, 0xe7, 0x1d, 0x4f, 0xc2, 0x68, 0x56, 0x59, 0xd3, 0x2e, 0x55, 0x9f,
+	0x00, 0xca, 0x06, 0xe9, 0xdb, 0x5a, 0xf6, 0x1c, 0x7f, 0x0f, 0x0f, 0x57, 0x44, 0xf0, 0x43, 0xc9,
+	0xbe, 0x4f, 0x94, 0x58, 0x91, 0x4a, 0x3b, 0xba, 0x85, 0x7a, 0x1c, 0xcd, 0x23, 0x25, 0x8b, 0xf9,
+	0xce, 0x76, 0x09, 0x3d, 0x6b, 0xa6, 0x11, 0x29, 0xda, 0x5a, 0xaf, 0x70, 0xb0, 0xa5, 0x8f, 0x0e,
+	0xa1, 0xf6, 0xc1, 0x56, 0xc5, 0xd7, 0xe4, 0x4f, 0x74, 0x01, 0xce, 0x92, 0xc6, 0x99, 0x49, 0xc7,
+	0x1b, 0x1c, 0x6f, 0x4c, 0xde, 0x72, 0x18, 0x4f, 0x02, 0x1a, 0x53, 0x41, 0x0c, 0x69, 0x68, 0xdf,
+	0x58, 0xad, 0x31, 0x78, 0x15, 0xb7, 0xbf, 0x90, 0xec, 0xae, 0x8a, 0x68, 0x27, 0x8a, 0xaa, 0x4c,
+	0xa2, 0x2b, 0x70, 0xa2, 0x24, 0xe4, 0xd2, 0xb7, 0xf4, 0xe2, 0xcd, 0x1f, 0x17, 0xcf, 0x6f, 0x6d,
+	0x64, 0xfb, 0x16, 0x31, 0x5c, 0x74, 0x0d, 0xff, 0x03, 0x9d, 0xc5, 0x3a, 0xf8, 0xf6, 0x8e, 0xbc,
+	0xc8, 0x9a, 0x3b, 0x3a, 0x85, 0x26, 0x17, 0x33, 0x4c, 0x53, 0x1a, 0xbc, 0xb3, 0xad, 0x8e, 0x51,
+	0xfd, 0x25, 0x3f, 0x6f, 0xf9, 0x35, 0x00, 0x65, 0xfe, 0xa2, 0xcd, 0x16, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap/core"
+)
+
+// Mesos is renaming its "slave" service to "agent", and the keys of the agent's metrics snapshot are being renamed
+// along with it (e.g. "slave/tasks_running" becomes "agent/tasks_running"), which splits the time series of a metric
+// when an agent is upgraded. This table maps the prefix of each legacy key to its canonical (new) prefix. For more
+// information, see https://issues.apache.org/jira/browse/MESOS-1478.
+var agentNameAliases = []struct {
+	legacy    string
+	canonical string
+}{
+	{"slave/", "agent/"},
+}
+
+// Return the canonical name of an agent snapshot key, e.g. "agent/tasks_running" for "slave/tasks_running". Keys
+// without a legacy prefix are returned unchanged.
+func canonicalAgentKey(key string) string {
+	for _, alias := range agentNameAliases {
+		if strings.HasPrefix(key, alias.legacy) {
+			return alias.canonical + strings.TrimPrefix(key, alias.legacy)
+		}
+	}
+	return key
+}
+
+// Return the legacy name of an agent snapshot key, e.g. "slave/tasks_running" for "agent/tasks_running", or an empty
+// string if the key doesn't have a legacy name.
+func legacyAgentKey(key string) string {
+	for _, alias := range agentNameAliases {
+		if strings.HasPrefix(key, alias.canonical) {
+			return alias.legacy + strings.TrimPrefix(key, alias.canonical)
+		}
+	}
+	return ""
+}
+
+// Return a copy of an agent's metrics snapshot with every key replaced by its canonical name. If an agent reports a
+// metric under both names, the value of the canonical one is kept.
+func normalizeAgentSnapshot(snapshot map[string]float64) map[string]float64 {
+	result := make(map[string]float64, len(snapshot))
+	for key, val := range snapshot {
+		canonical := canonicalAgentKey(key)
+		if _, exists := snapshot[canonical]; exists && canonical != key {
+			log.Debug("Ignoring legacy metric ", key, " since the agent also reports ", canonical)
+			continue
+		}
+		result[canonical] = val
+	}
+	return result
+}
+
+// Return a copy of a normalized snapshot that also holds the legacy name of each key, so that the catalog still
+// provides the metrics requested by existing tasks under their legacy names.
+func withLegacyAgentKeys(snapshot map[string]float64) map[string]float64 {
+	result := make(map[string]float64, len(snapshot))
+	for key, val := range snapshot {
+		result[key] = val
+		if legacy := legacyAgentKey(key); legacy != "" {
+			result[legacy] = val
+		}
+	}
+	return result
+}
+
+// Return the namespace of an agent snapshot metric under its canonical name, e.g. "/intel/mesos/agent/agent/uptime_secs"
+// for "/intel/mesos/agent/slave/uptime_secs", so that a metric is always reported under the same namespace.
+func canonicalAgentNamespace(requested core.Namespace) core.Namespace {
+	key := canonicalAgentKey(strings.Join(requested.Strings()[3:], "/"))
+	return core.NewNamespace(requested.Strings()[:3]...).AddStaticElements(strings.Split(key, "/")...)
+}
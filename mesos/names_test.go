@@ -0,0 +1,136 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+	"github.com/intelsdi-x/snap/core/cdata"
+	"github.com/intelsdi-x/snap/core/ctypes"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_normalizeAgentNames(t *testing.T) {
+	Convey("Normalize the names of agent snapshot metrics", t, func() {
+		Convey("Legacy names should be mapped to canonical names and back", func() {
+			So(canonicalAgentKey("slave/tasks_running"), ShouldEqual, "agent/tasks_running")
+			So(canonicalAgentKey("agent/tasks_running"), ShouldEqual, "agent/tasks_running")
+			So(canonicalAgentKey("containerizer/mesos/container_destroy_errors"), ShouldEqual, "containerizer/mesos/container_destroy_errors")
+			So(legacyAgentKey("agent/tasks_running"), ShouldEqual, "slave/tasks_running")
+			So(legacyAgentKey("system/load_1min"), ShouldEqual, "")
+		})
+
+		Convey("A snapshot should only contain canonical names, preferring the canonical value", func() {
+			snapshot := normalizeAgentSnapshot(map[string]float64{
+				"slave/registered":    1.0,
+				"slave/tasks_running": 2.0,
+				"agent/tasks_running": 3.0,
+				"system/load_1min":    0.5,
+			})
+			So(snapshot, ShouldResemble, map[string]float64{
+				"agent/registered":    1.0,
+				"agent/tasks_running": 3.0,
+				"system/load_1min":    0.5,
+			})
+		})
+
+		Convey("Canonical names should be described like their legacy names", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "agent", "agent", "registered"))
+			So(info.Description, ShouldEqual, "Whether this agent is registered with a master")
+		})
+	})
+}
+
+func TestMesos_normalizeAgentNamesCollection(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/metrics/snapshot":
+			w.Write([]byte(`{"agent/tasks_running": 2.0, "system/load_1min": 0.5}`))
+		case "/monitor/statistics":
+			w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	node := cdata.NewNode()
+	node.AddItem("agent", ctypes.ConfigValueStr{Value: strings.TrimPrefix(ts.URL, "http://")})
+	node.AddItem("normalize_agent_names", ctypes.ConfigValueBool{Value: true})
+	mc := NewMesosCollector()
+
+	Convey("When agent metric names are normalized", t, func() {
+		Convey("The catalog should hold both the legacy and canonical names", func() {
+			mts, err := mc.GetMetricTypes(plugin.ConfigType{ConfigDataNode: node})
+			So(err, ShouldBeNil)
+
+			namespaces := []string{}
+			for _, mt := range mts {
+				namespaces = append(namespaces, mt.Namespace().String())
+			}
+			So(namespaces, ShouldContain, "/intel/mesos/agent/agent/tasks_running")
+			So(namespaces, ShouldContain, "/intel/mesos/agent/slave/tasks_running")
+			So(namespaces, ShouldNotContain, "/intel/mesos/agent/slave/load_1min")
+		})
+
+		Convey("A metric requested by its legacy name should be reported under its canonical name", func() {
+			mts := []plugin.MetricType{
+				{
+					Namespace_: core.NewNamespace(pluginVendor, pluginName, "agent", "slave", "tasks_running"),
+					Config_:    node,
+				},
+				{
+					Namespace_: core.NewNamespace(pluginVendor, pluginName, "agent", "system", "load_1min"),
+					Config_:    node,
+				},
+			}
+
+			metrics, err := mc.CollectMetrics(mts)
+			So(err, ShouldBeNil)
+			So(len(metrics), ShouldEqual, 2)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/agent/agent/tasks_running")
+			So(metrics[0].Data(), ShouldEqual, 2.0)
+			So(metrics[1].Namespace().String(), ShouldEqual, "/intel/mesos/agent/system/load_1min")
+		})
+
+		Convey("A metric requested by both its legacy and canonical names should only be reported once", func() {
+			mts := []plugin.MetricType{
+				{
+					Namespace_: core.NewNamespace(pluginVendor, pluginName, "agent", "slave", "tasks_running"),
+					Config_:    node,
+				},
+				{
+					Namespace_: core.NewNamespace(pluginVendor, pluginName, "agent", "agent", "tasks_running"),
+					Config_:    node,
+				},
+			}
+
+			metrics, err := mc.CollectMetrics(mts)
+			So(err, ShouldBeNil)
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/agent/agent/tasks_running")
+		})
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_agent"
+)
+
+// Send a call to the operator API of the agent, and return the response.
+func callAgent(host string, call *mesos_v1_agent.Call, timeout time.Duration) (*mesos_v1_agent.Response, error) {
+	response := &mesos_v1_agent.Response{}
+
+	c := NewClient(host, defaultContentType, timeout)
+	if err := c.Call(call, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Get the metrics of the agent using the GET_METRICS call. The metrics are returned using the same keys and values
+// as the '/metrics/snapshot' endpoint, e.g. "slave/cpus_total".
+func GetAgentMetrics(host string) (map[string]float64, error) {
+	log.Debug("Getting metrics from the operator API of agent ", host)
+	call := &mesos_v1_agent.Call{Type: mesos_v1_agent.Call_GET_METRICS.Enum()}
+	response, err := callAgent(host, call, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsMap(response.GetGetMetrics().GetMetrics()), nil
+}
+
+// Get the containers running on the agent, along with their status and resource statistics, using the GET_CONTAINERS
+// call. Nested containers (e.g. the tasks of a pod) are only included if requested.
+func GetContainers(host string, nested bool) ([]*mesos_v1_agent.Response_GetContainers_Container, error) {
+	log.Debug("Getting containers from the operator API of agent ", host)
+	call := &mesos_v1_agent.Call{
+		Type:          mesos_v1_agent.Call_GET_CONTAINERS.Enum(),
+		GetContainers: &mesos_v1_agent.Call_GetContainers{ShowNested: proto.Bool(nested)},
+	}
+	response, err := callAgent(host, call, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetGetContainers().GetContainers(), nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+)
+
+// Send a call of the given type to the operator API of the master, and return the response.
+func callMaster(host string, callType mesos_v1_master.Call_Type, timeout time.Duration) (*mesos_v1_master.Response, error) {
+	response := &mesos_v1_master.Response{}
+
+	c := NewClient(host, defaultContentType, timeout)
+	if err := c.Call(&mesos_v1_master.Call{Type: callType.Enum()}, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Get the metrics of the master using the GET_METRICS call. The metrics are returned using the same keys and values
+// as the '/metrics/snapshot' endpoint, e.g. "master/cpus_total".
+func GetMasterMetrics(host string) (map[string]float64, error) {
+	log.Debug("Getting metrics from the operator API of master ", host)
+	response, err := callMaster(host, mesos_v1_master.Call_GET_METRICS, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsMap(response.GetGetMetrics().GetMetrics()), nil
+}
+
+// Get the agents registered with the master using the GET_AGENTS call.
+func GetAgents(host string) ([]*mesos_v1_master.Response_GetAgents_Agent, error) {
+	log.Debug("Getting registered agents from the operator API of master ", host)
+	response, err := callMaster(host, mesos_v1_master.Call_GET_AGENTS, 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetGetAgents().GetAgents(), nil
+}
+
+// Get the tasks known to the master using the GET_TASKS call, including completed and unreachable tasks.
+func GetTasks(host string) (*mesos_v1_master.Response_GetTasks, error) {
+	log.Debug("Getting tasks from the operator API of master ", host)
+	response, err := callMaster(host, mesos_v1_master.Call_GET_TASKS, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetGetTasks(), nil
+}
+
+// Convert the metrics returned by GET_METRICS to a map, keyed by the name of the metric.
+func metricsMap(metrics []*mesos_v1.Metric) map[string]float64 {
+	data := map[string]float64{}
+	for _, metric := range metrics {
+		data[metric.GetName()] = metric.GetValue()
+	}
+	return data
+}
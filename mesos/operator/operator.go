@@ -0,0 +1,190 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+)
+
+// Content types supported by the operator API. A call is encoded using the content type of the client, and the
+// response is requested in the same content type.
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// The content type used by the calls in this package. Protobuf is cheaper to decode than JSON, which matters for the
+// larger responses (e.g. GET_TASKS) on busy clusters.
+const defaultContentType = ContentTypeProtobuf
+
+// Hosts that are known not to provide the operator API, so that they aren't asked for it on every collection.
+var unsupported = struct {
+	sync.Mutex
+	hosts map[string]bool
+}{hosts: map[string]bool{}}
+
+// Define a client for the v1 operator HTTP API ("/api/v1") of a Mesos master/agent. Unlike the legacy endpoints,
+// every request is a typed call (e.g. GET_METRICS) sent as a POST, and every response is a typed message. See
+// http://mesos.apache.org/documentation/latest/operator-http-api/
+type Client struct {
+	httpClient  *http.Client
+	host        string
+	contentType string
+}
+
+// Return a new instance of Client. The content type is either ContentTypeJSON or ContentTypeProtobuf. The timeout
+// covers the whole request, including reading the response; a timeout of zero means no timeout, e.g. for an event
+// stream.
+func NewClient(host string, contentType string, timeout time.Duration) *Client {
+	log.Debug("Creating a new instance of the Mesos operator API client")
+	return &Client{
+		httpClient:  &http.Client{Timeout: timeout},
+		host:        host,
+		contentType: contentType,
+	}
+}
+
+// Return the URL of the operator API as a string.
+func (c *Client) URL() string {
+	u := url.URL{Scheme: "http", Host: c.host, Path: "/api/v1"}
+	return u.String()
+}
+
+// Send a call (e.g. a mesos_v1_master.Call) to the operator API, and unmarshal the response into 'response' (e.g. a
+// mesos_v1_master.Response). If the host responds with HTTP 404, it doesn't provide the operator API, and Supported
+// returns false for it from then on.
+func (c *Client) Call(call proto.Message, response proto.Message) error {
+	resp, err := c.post(call)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		e := fmt.Errorf("read error: %s: %v\n", c.URL(), err)
+		log.Error(e)
+		return e
+	}
+
+	if err := c.unmarshal(b, response); err != nil {
+		e := fmt.Errorf("unmarshal error: %s: %v\n", b, err)
+		log.Error(e)
+		return e
+	}
+
+	return nil
+}
+
+// Send a call to the operator API, and return the response if the API responded with HTTP 200. The caller is
+// responsible for closing the body of the response.
+func (c *Client) post(call proto.Message) (*http.Response, error) {
+	log.Debug("Sending ", call.String(), " to ", c.URL())
+	body, err := c.marshal(call)
+	if err != nil {
+		e := fmt.Errorf("marshal error: %v", err)
+		log.Error(e)
+		return nil, e
+	}
+
+	req, err := http.NewRequest("POST", c.URL(), bytes.NewReader(body))
+	if err != nil {
+		e := fmt.Errorf("request error: %s", err)
+		log.Error(e)
+		return nil, e
+	}
+	req.Header.Set("Content-Type", c.contentType)
+	req.Header.Set("Accept", c.contentType)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		e := &client.StatusError{URL: c.URL(), StatusCode: resp.StatusCode, Status: resp.Status}
+		if client.IsNotFound(e) {
+			log.Warn("Host ", c.host, " doesn't provide the operator API")
+			unsupported.Lock()
+			unsupported.hosts[c.host] = true
+			unsupported.Unlock()
+		}
+		log.Error(e)
+		return nil, e
+	}
+
+	return resp, nil
+}
+
+// Calls are encoded in JSON using the original field names from the protobuf, and enums as strings, which is the
+// format Mesos expects.
+func (c *Client) marshal(call proto.Message) ([]byte, error) {
+	if c.contentType == ContentTypeProtobuf {
+		return proto.Marshal(call)
+	}
+	m := jsonpb.Marshaler{OrigName: true}
+	s, err := m.MarshalToString(call)
+	return []byte(s), err
+}
+
+// Responses in JSON are decoded the same way as the legacy endpoints, rather than with jsonpb, so that fields added in
+// newer versions of Mesos are ignored rather than rejected.
+func (c *Client) unmarshal(b []byte, response proto.Message) error {
+	if c.contentType == ContentTypeProtobuf {
+		return proto.Unmarshal(b, response)
+	}
+	return json.Unmarshal(b, response)
+}
+
+// Returns true if the operator API should be used for the given host: it's available from Mesos 1.0 onwards, unless
+// the host has already responded that it doesn't provide it.
+func Supported(host string) bool {
+	unsupported.Lock()
+	known := unsupported.hosts[host]
+	unsupported.Unlock()
+	if known {
+		return false
+	}
+
+	version, err := client.GetVersion(host)
+	return err == nil && client.VersionAtLeast(version, "1.0")
+}
+
+// Convert a message from the v1 API (mesos_v1) to its unversioned equivalent (mesos_pb2), e.g. for a container status
+// returned by the operator API. The two are wire-compatible, which is also how Mesos converts between them; see
+// https://github.com/apache/mesos/blob/1.9.0/src/internal/devolve.cpp
+func Devolve(v1 proto.Message, pb2 proto.Message) error {
+	b, err := proto.Marshal(v1)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, pb2)
+}
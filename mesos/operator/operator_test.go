@@ -0,0 +1,272 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/client"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient_Call(t *testing.T) {
+	metrics := &mesos_v1_master.Response{
+		Type: mesos_v1_master.Response_GET_METRICS.Enum(),
+		GetMetrics: &mesos_v1_master.Response_GetMetrics{Metrics: []*mesos_v1.Metric{
+			{Name: proto.String("master/cpus_total"), Value: proto.Float64(2.0)},
+			{Name: proto.String("master/elected"), Value: proto.Float64(1.0)},
+		}},
+	}
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Header.Get("Content-Type")+" "+r.Header.Get("Accept"))
+
+		switch r.Header.Get("Content-Type") {
+		case ContentTypeJSON:
+			var call map[string]interface{}
+			if err := json.Unmarshal(body, &call); err != nil || call["type"] != "GET_METRICS" {
+				w.WriteHeader(400)
+				return
+			}
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			w.WriteHeader(200)
+			w.Write([]byte(`{"type": "GET_METRICS", "get_metrics": {"metrics": [
+				{"name": "master/cpus_total", "value": 2.0}, {"name": "master/elected", "value": 1.0}
+			]}, "added_in_a_newer_version": true}`))
+		case ContentTypeProtobuf:
+			call := &mesos_v1_master.Call{}
+			if err := proto.Unmarshal(body, call); err != nil || call.GetType() != mesos_v1_master.Call_GET_METRICS {
+				w.WriteHeader(400)
+				return
+			}
+			b, _ := proto.Marshal(metrics)
+			w.Header().Set("Content-Type", ContentTypeProtobuf)
+			w.WriteHeader(200)
+			w.Write(b)
+		default:
+			w.WriteHeader(415)
+		}
+	}))
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When a call is sent to the operator API", t, func() {
+		call := &mesos_v1_master.Call{Type: mesos_v1_master.Call_GET_METRICS.Enum()}
+
+		Convey("Then calls and responses should be encoded in JSON", func() {
+			response := &mesos_v1_master.Response{}
+			err := NewClient(host, ContentTypeJSON, 5*time.Second).Call(call, response)
+			So(err, ShouldBeNil)
+			So(requests[len(requests)-1], ShouldEqual, ContentTypeJSON+" "+ContentTypeJSON)
+			So(metricsMap(response.GetGetMetrics().GetMetrics()), ShouldResemble,
+				map[string]float64{"master/cpus_total": 2.0, "master/elected": 1.0})
+		})
+
+		Convey("Then calls and responses should be encoded in protobuf", func() {
+			response := &mesos_v1_master.Response{}
+			err := NewClient(host, ContentTypeProtobuf, 5*time.Second).Call(call, response)
+			So(err, ShouldBeNil)
+			So(requests[len(requests)-1], ShouldEqual, ContentTypeProtobuf+" "+ContentTypeProtobuf)
+			So(metricsMap(response.GetGetMetrics().GetMetrics()), ShouldResemble,
+				map[string]float64{"master/cpus_total": 2.0, "master/elected": 1.0})
+		})
+
+		Convey("Then errors from the API should be returned", func() {
+			err := NewClient(host, "text/plain", 5*time.Second).Call(call, &mesos_v1_master.Response{})
+			So(err, ShouldNotBeNil)
+			So(err.(*client.StatusError).StatusCode, ShouldEqual, 415)
+		})
+	})
+}
+
+func TestSupported(t *testing.T) {
+	newServer := func(version string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/version":
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(200)
+				w.Write([]byte(`{"version": "` + version + `"}`))
+			default:
+				w.WriteHeader(404)
+			}
+		}))
+	}
+
+	ts1 := newServer("0.28.2")
+	defer ts1.Close()
+	ts2 := newServer("1.4.0")
+	defer ts2.Close()
+
+	Convey("When checking whether a host provides the operator API", t, func() {
+		Convey("Then hosts running Mesos before 1.0 should not be supported", func() {
+			host, _ := extractHostFromURL(ts1.URL)
+			So(Supported(host), ShouldBeFalse)
+		})
+
+		Convey("Then hosts running Mesos 1.0 or later should be supported, until they respond with HTTP 404", func() {
+			host, _ := extractHostFromURL(ts2.URL)
+			So(Supported(host), ShouldBeTrue)
+
+			_, err := GetMasterMetrics(host)
+			So(client.IsNotFound(err), ShouldBeTrue)
+			So(Supported(host), ShouldBeFalse)
+		})
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	events := []*mesos_v1_master.Event{
+		{
+			Type:       mesos_v1_master.Event_SUBSCRIBED.Enum(),
+			Subscribed: &mesos_v1_master.Event_Subscribed{HeartbeatIntervalSeconds: proto.Float64(15)},
+		},
+		{Type: mesos_v1_master.Event_HEARTBEAT.Enum()},
+		{
+			Type: mesos_v1_master.Event_AGENT_REMOVED.Enum(),
+			AgentRemoved: &mesos_v1_master.Event_AgentRemoved{
+				AgentId: &mesos_v1.AgentID{Value: proto.String("agent-1")},
+			},
+		},
+	}
+
+	// Serve the events, then keep the stream open until hold is closed (if it isn't nil)
+	eventServer := func(hold chan struct{}) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			call := &mesos_v1_master.Call{}
+			if err := proto.Unmarshal(body, call); err != nil || call.GetType() != mesos_v1_master.Call_SUBSCRIBE {
+				w.WriteHeader(400)
+				return
+			}
+			w.Header().Set("Content-Type", ContentTypeProtobuf)
+			w.WriteHeader(200)
+			for _, event := range events {
+				b, _ := proto.Marshal(event)
+				fmt.Fprintf(w, "%d\n", len(b))
+				w.Write(b)
+			}
+			if hold != nil {
+				w.(http.Flusher).Flush()
+				<-hold
+			}
+		}))
+	}
+
+	ts := eventServer(nil)
+	defer ts.Close()
+
+	host, err := extractHostFromURL(ts.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	Convey("When subscribing to the event stream of the master", t, func() {
+		received := []*mesos_v1_master.Event{}
+		err := Subscribe(host, func(event *mesos_v1_master.Event) {
+			received = append(received, event)
+		}, make(chan struct{}))
+
+		Convey("Then every event should be passed to the handler in order", func() {
+			So(len(received), ShouldEqual, 3)
+			for i, event := range received {
+				So(proto.Equal(event, events[i]), ShouldBeTrue)
+			}
+		})
+
+		Convey("Then an error should be returned when the stream ends", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	hold := make(chan struct{})
+	held := eventServer(hold)
+	defer held.Close()
+	defer close(hold)
+
+	heldHost, err := extractHostFromURL(held.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	// The subscription is stopped once all the events were received, while the stream is still open
+	stop := make(chan struct{})
+	received := 0
+	err = Subscribe(heldHost, func(event *mesos_v1_master.Event) {
+		received++
+		if received == len(events) {
+			close(stop)
+		}
+	}, stop)
+
+	Convey("When a subscription that is still open is stopped", t, func() {
+		Convey("Then it should end without an error", func() {
+			So(received, ShouldEqual, len(events))
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestReadRecord(t *testing.T) {
+	Convey("When RecordIO records are read", t, func() {
+		Convey("Then a record should be read up to its length", func() {
+			record, err := readRecord(bufio.NewReader(strings.NewReader("5\nhello3\nabc")))
+			So(err, ShouldBeNil)
+			So(string(record), ShouldEqual, "hello")
+		})
+
+		Convey("Then a length above the maximum should be rejected before reading the record", func() {
+			_, err := readRecord(bufio.NewReader(strings.NewReader(fmt.Sprintf("%d\n", uint64(maxRecordLength)+1))))
+			So(err, ShouldNotBeNil)
+			_, err = readRecord(bufio.NewReader(strings.NewReader("18446744073709551615\n")))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then a header without a newline should be rejected once it fills the buffer", func() {
+			_, err := readRecord(bufio.NewReader(strings.NewReader(strings.Repeat("1", 8192))))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func extractHostFromURL(u string) (string, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Host, nil
+}
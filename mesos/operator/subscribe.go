@@ -0,0 +1,139 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1_master"
+)
+
+// The master sends a HEARTBEAT event at this interval unless it reports another one when subscribing. If no event
+// (including heartbeats) arrives for heartbeatTolerance intervals, the subscription is considered lost, as recommended
+// by the documentation of the HEARTBEAT event.
+const (
+	defaultHeartbeatInterval = 15 * time.Second
+	heartbeatTolerance       = 5
+)
+
+// The largest RecordIO record that is read from the event stream. The SUBSCRIBED event holds the state of the whole
+// cluster, so it's by far the largest; this leaves plenty of room for it, while a corrupt length is rejected rather than
+// allocated.
+const maxRecordLength = 128 << 20
+
+// Subscribe to the event stream of the master using the SUBSCRIBE call, and pass every event to the handler in the
+// order it was received. The first event is SUBSCRIBED, which includes the state of the cluster at that time. This
+// blocks until the stream ends or fails, including when no event arrives in time, or until stop is closed, and returns
+// the error; callers are expected to subscribe again unless they stopped the subscription.
+func Subscribe(host string, handler func(*mesos_v1_master.Event), stop <-chan struct{}) error {
+	c := NewClient(host, defaultContentType, 0)
+	resp, err := c.post(&mesos_v1_master.Call{Type: mesos_v1_master.Call_SUBSCRIBE.Enum()})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	log.Info("Subscribed to the event stream of master ", host)
+
+	// Closing the body makes the pending read fail, which ends the subscription
+	expired := make(chan struct{})
+	timeout := heartbeatTolerance * defaultHeartbeatInterval
+	watchdog := time.AfterFunc(timeout, func() {
+		close(expired)
+		resp.Body.Close()
+	})
+	defer watchdog.Stop()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stop:
+			resp.Body.Close()
+		case <-done:
+		}
+	}()
+
+	// Events are framed using RecordIO: each record is preceded by its length in bytes, in decimal, and a newline.
+	// See http://mesos.apache.org/documentation/latest/recordio/
+	reader := bufio.NewReader(resp.Body)
+	for {
+		record, err := readRecord(reader)
+		if err != nil {
+			select {
+			case <-stop:
+				log.Info("Unsubscribed from the event stream of master ", host)
+				return nil
+			case <-expired:
+				e := fmt.Errorf("error: no events from master %s in %v", host, timeout)
+				log.Error(e)
+				return e
+			default:
+				log.Error(err)
+				return err
+			}
+		}
+
+		event := &mesos_v1_master.Event{}
+		if err := c.unmarshal(record, event); err != nil {
+			e := fmt.Errorf("unmarshal error: %s: %v\n", record, err)
+			log.Error(e)
+			return e
+		}
+
+		if interval := event.GetSubscribed().GetHeartbeatIntervalSeconds(); interval > 0 {
+			timeout = heartbeatTolerance * time.Duration(interval*float64(time.Second))
+		}
+		watchdog.Reset(timeout)
+
+		handler(event)
+	}
+}
+
+// Read a single RecordIO record. Returns io.EOF if the stream ended cleanly between records. Records longer than
+// maxRecordLength, and headers that don't fit in the reader's buffer, are rejected.
+func readRecord(reader *bufio.Reader) ([]byte, error) {
+	header, err := reader.ReadSlice('\n')
+	if err != nil {
+		if err == io.EOF && len(header) > 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err == bufio.ErrBufferFull {
+			return nil, fmt.Errorf("recordio error: record header exceeds %d bytes", len(header))
+		}
+		return nil, err
+	}
+
+	length, err := strconv.ParseUint(strings.TrimSpace(string(header)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("recordio error: invalid record length %q", header)
+	}
+	if length > maxRecordLength {
+		return nil, fmt.Errorf("recordio error: record length %d exceeds the maximum of %d bytes", length, maxRecordLength)
+	}
+
+	record := make([]byte, length)
+	if _, err := io.ReadFull(reader, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+// Build the metric types for the weight and quota of each role (see master.GetRoleQuotas), which are added to the
+// catalog with the (optional) config item:
+//
+//   "role_quota": true
+func roleQuotaMetricTypes() []plugin.MetricType {
+	metricTypes := []plugin.MetricType{}
+
+	for _, key := range master.GetRoleQuotaMetricTypes() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master", "roles").
+			AddDynamicElement("role", "Role name").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	return metricTypes
+}
+
+// Collect a weight or quota metric for each role that matches the requested namespace. Roles that don't report the
+// metric, such as roles without a quota, or a resource without a limit, are skipped.
+func collectRoleQuotas(requested core.Namespace, quotas map[string]*master.RoleQuota, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	key := strings.Join(requested.Strings()[5:], "/")
+
+	for role, quota := range quotas {
+		if !matchesElement(requested[4], role) {
+			continue
+		}
+		val, ok := quota.Metrics()[key]
+		if !ok {
+			log.Debug("Skipping metric ", requested.String(), " which isn't reported for role ", role)
+			continue
+		}
+		// substituting the "role" wildcard with the role name
+		rendered := cloneNamespace(requested)
+		rendered[4].Value = role
+		metrics = append(metrics, newMetric(rendered, now, tags, val))
+	}
+	return metrics
+}
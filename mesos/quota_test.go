@@ -0,0 +1,57 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap/core"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_collectRoleQuotas(t *testing.T) {
+	quotas := map[string]*master.RoleQuota{
+		"dev": {
+			Weight:    2.0,
+			Guarantee: map[string]float64{"cpus": 4.0},
+			Limit:     map[string]float64{},
+			Allocated: map[string]float64{"cpus": 1.5},
+		},
+		"*": {Weight: 1.0},
+	}
+
+	Convey("Collect the weight and quota of each role", t, func() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master", "roles").
+			AddDynamicElement("role", "Role name")
+
+		Convey("Should report the weight of every role", func() {
+			metrics := collectRoleQuotas(namespace.AddStaticElement("weight"), quotas, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 2)
+		})
+
+		Convey("Should only report the headroom of roles with a guarantee", func() {
+			metrics := collectRoleQuotas(namespace.AddStaticElements("quota", "cpus", "headroom"), quotas, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/master/roles/dev/quota/cpus/headroom")
+			So(metrics[0].Data(), ShouldEqual, 2.5)
+		})
+	})
+}
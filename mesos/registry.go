@@ -0,0 +1,591 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/agent"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_v1"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+// metricInfo describes a metric in the catalog: its unit (see http://metrics20.org/spec/#units), a description, and
+// the Go type of the values that CollectMetrics returns for it. Snap's MetricType doesn't have a field for the data
+// type, so it is only used for documentation.
+type metricInfo struct {
+	Unit        string
+	Description string
+	Type        string
+}
+
+// Metrics from the "/metrics/snapshot" endpoint that don't belong to one of the families in snapshotRules. For the
+// descriptions, see http://mesos.apache.org/documentation/latest/monitoring/
+var snapshotMetrics = map[string]metricInfo{
+	"master/elected":                                            {"", "Whether this is the elected master", "float64"},
+	"master/uptime_secs":                                        {"s", "Uptime of the master", "float64"},
+	"master/outstanding_offers":                                 {"", "Number of outstanding resource offers", "float64"},
+	"master/event_queue_messages":                               {"", "Number of messages in the master's event queue", "float64"},
+	"master/event_queue_dispatches":                             {"", "Number of dispatches in the master's event queue", "float64"},
+	"master/event_queue_http_requests":                          {"", "Number of HTTP requests in the master's event queue", "float64"},
+	"master/dropped_messages":                                   {"", "Number of dropped messages", "float64"},
+	"master/recovery_slave_removals":                            {"", "Number of agents not reregistered during master failover", "float64"},
+	"registrar/queued_operations":                               {"", "Number of queued operations in the registrar", "float64"},
+	"registrar/registry_size_bytes":                             {"B", "Size of the registry", "float64"},
+	"allocator/event_queue_dispatches":                          {"", "Number of dispatches in the allocator's event queue", "float64"},
+	"slave/registered":                                          {"", "Whether this agent is registered with a master", "float64"},
+	"slave/uptime_secs":                                         {"s", "Uptime of the agent", "float64"},
+	"slave/recovery_errors":                                     {"", "Number of errors encountered during agent recovery", "float64"},
+	"slave/container_launch_errors":                             {"", "Number of container launch errors", "float64"},
+	"slave/executors_preempted":                                 {"", "Number of executors destroyed due to preemption", "float64"},
+	"slave/executor_directory_max_allowed_age_secs":             {"s", "Maximum age of an executor's sandbox before it's garbage collected", "float64"},
+	"containerizer/mesos/container_destroy_errors":              {"", "Number of containers that the Mesos containerizer failed to destroy", "float64"},
+	"containerizer/mesos/filesystem/containers_new_rootfs":      {"", "Number of containers launched with a new root filesystem", "float64"},
+	"containerizer/mesos/provisioner/remove_container_errors":   {"", "Number of errors removing a container from the provisioner", "float64"},
+	"containerizer/mesos/provisioner/bind/remove_rootfs_errors": {"", "Number of errors removing a root filesystem from the bind backend", "float64"},
+	"allocator/mesos/allocation_runs":                           {"", "Number of allocation runs of the allocator", "float64"},
+	"allocator/mesos/event_queue_dispatches":                    {"", "Number of dispatches in the allocator's event queue", "float64"},
+	"master/slave_unreachable_scheduled":                        {"", "Number of agents scheduled to be marked unreachable", "float64"},
+	"master/slave_unreachable_completed":                        {"", "Number of agents marked unreachable", "float64"},
+	"master/slave_unreachable_canceled":                         {"", "Number of agents whose marking as unreachable was canceled", "float64"},
+	"registrar/log/recovered":                                   {"", "Whether the replicated log of the registrar has recovered", "float64"},
+	"system/cpus_total":                                         {"", "Number of CPUs available on the host", "float64"},
+	"system/mem_total_bytes":                                    {"B", "Total memory of the host", "float64"},
+	"system/mem_free_bytes":                                     {"B", "Free memory of the host", "float64"},
+}
+
+// A family of metrics from the "/metrics/snapshot" endpoint, matched by a regular expression. The description may
+// refer to the submatches of the regular expression, e.g. "$1".
+type snapshotRule struct {
+	pattern *regexp.Regexp
+	info    metricInfo
+}
+
+var resourceNames = map[string]string{"cpus": "CPUs", "gpus": "GPUs", "mem": "memory", "disk": "disk"}
+
+var resourceNamePattern = regexp.MustCompile(`\b(cpus|gpus|mem)\b`)
+
+var snapshotRules = []snapshotRule{
+	{regexp.MustCompile(`^(master|slave|agent)/(cpus|gpus)_(revocable_)?total$`), metricInfo{"", "Number of $3$2", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/(cpus|gpus)_(revocable_)?used$`), metricInfo{"", "Number of allocated $3$2", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/(mem|disk)_(revocable_)?total$`), metricInfo{"MB", "Total $3$2", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/(mem|disk)_(revocable_)?used$`), metricInfo{"MB", "Allocated $3$2", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/(cpus|gpus|mem|disk)_(revocable_)?percent$`), metricInfo{"", "Fraction of $3$2 allocated, from 0 to 1", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/tasks_(\w+)$`), metricInfo{"", "Number of tasks in the $2 state", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/executors_(\w+)$`), metricInfo{"", "Number of executors in the $2 state", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/frameworks_(\w+)$`), metricInfo{"", "Number of $2 frameworks", "float64"}},
+	{regexp.MustCompile(`^master/slaves_(\w+)$`), metricInfo{"", "Number of $1 agents", "float64"}},
+	{regexp.MustCompile(`^master/slave_(registrations|reregistrations|removals|shutdowns_\w+)(/.*)?$`), metricInfo{"", "Number of agent $1$2", "float64"}},
+	{regexp.MustCompile(`^(master|slave|agent)/(valid|invalid)_(\w+)$`), metricInfo{"", "Number of $2 $3", "float64"}},
+	{regexp.MustCompile(`^master/messages_(\w+)$`), metricInfo{"", "Number of $1 messages", "float64"}},
+	{regexp.MustCompile(`^allocator/mesos/resources/(cpus|gpus)/total$`), metricInfo{"", "Number of $1 known to the allocator", "float64"}},
+	{regexp.MustCompile(`^allocator/mesos/resources/(cpus|gpus)/offered_or_allocated$`), metricInfo{"", "Number of offered or allocated $1", "float64"}},
+	{regexp.MustCompile(`^allocator/mesos/resources/(mem|disk)/total$`), metricInfo{"MB", "Total $1 known to the allocator", "float64"}},
+	{regexp.MustCompile(`^allocator/mesos/resources/(mem|disk)/offered_or_allocated$`), metricInfo{"MB", "Offered or allocated $1", "float64"}},
+	{regexp.MustCompile(`^system/load_(\d+)min$`), metricInfo{"", "Load average of the host over the last $1 minute(s)", "float64"}},
+	{regexp.MustCompile(`^(.*)_ms/(count)$`), metricInfo{"", "Number of samples of $1", "float64"}},
+	{regexp.MustCompile(`^(.*)_ms(/(\w+))?$`), metricInfo{"ms", "Duration of $1 $3", "float64"}},
+	{regexp.MustCompile(`^.*_secs$`), metricInfo{"s", "", "float64"}},
+	{regexp.MustCompile(`^.*_bytes$`), metricInfo{"B", "", "float64"}},
+}
+
+// Descriptions of the fields in mesos_v1.ResourceStatistics, based on the comments in mesos.proto. The data type of
+// each field is taken from the protobuf itself. The v1 message is a superset of the one in mesos_pb2, so it describes
+// the statistics reported by any version of Mesos.
+var statisticsDescriptions = map[string]string{
+	"timestamp":                     "Time the statistics were collected, in seconds since the epoch",
+	"processes":                     "Number of processes in the container",
+	"threads":                       "Number of threads in the container",
+	"cpus_user_time_secs":           "Total CPU time spent in user mode",
+	"cpus_system_time_secs":         "Total CPU time spent in kernel mode",
+	"cpus_limit":                    "Number of CPUs allocated",
+	"cpus_soft_limit":               "Soft CPU limit of the container",
+	"cpus_nr_periods":               "Number of CPU scheduler periods that have elapsed",
+	"cpus_nr_throttled":             "Number of times the container has been throttled",
+	"cpus_throttled_time_secs":      "Total time the container has been throttled",
+	"mem_total_bytes":               "Total memory of the container in RAM",
+	"mem_total_memsw_bytes":         "Total memory and swap usage of the container, if swap is enabled",
+	"mem_limit_bytes":               "Hard memory limit for the container",
+	"mem_soft_limit_bytes":          "Soft memory limit for the container",
+	"mem_file_bytes":                "File-backed memory of the container (deprecated)",
+	"mem_anon_bytes":                "Anonymous memory of the container (deprecated)",
+	"mem_cache_bytes":               "Page cache usage of the container",
+	"mem_rss_bytes":                 "Anonymous memory usage of the container",
+	"mem_mapped_file_bytes":         "Memory-mapped files of the container",
+	"mem_swap_bytes":                "Swap usage of the container, if swap is enabled",
+	"mem_unevictable_bytes":         "Memory of the container that can't be reclaimed",
+	"mem_low_pressure_counter":      "Number of low memory pressure events",
+	"mem_medium_pressure_counter":   "Number of medium memory pressure events",
+	"mem_critical_pressure_counter": "Number of critical memory pressure events",
+	"disk_limit_bytes":              "Disk limit for the executor's sandbox",
+	"disk_used_bytes":               "Disk usage of the executor's sandbox",
+	"net_rx_packets":                "Number of packets received",
+	"net_rx_bytes":                  "Number of bytes received",
+	"net_rx_errors":                 "Number of receive errors",
+	"net_rx_dropped":                "Number of received packets dropped",
+	"net_tx_packets":                "Number of packets sent",
+	"net_tx_bytes":                  "Number of bytes sent",
+	"net_tx_errors":                 "Number of send errors",
+	"net_tx_dropped":                "Number of sent packets dropped",
+	"net_tcp_rtt_microsecs_p50":     "50th percentile of the round-trip time of TCP connections",
+	"net_tcp_rtt_microsecs_p90":     "90th percentile of the round-trip time of TCP connections",
+	"net_tcp_rtt_microsecs_p95":     "95th percentile of the round-trip time of TCP connections",
+	"net_tcp_rtt_microsecs_p99":     "99th percentile of the round-trip time of TCP connections",
+	"net_tcp_active_connections":    "Number of active TCP connections",
+	"net_tcp_time_wait_connections": "Number of TCP connections in the TIME_WAIT state",
+	"perf/timestamp":                "Time the perf sample was taken, in seconds since the epoch",
+	"perf/duration":                 "Duration of the perf sample",
+}
+
+// Block I/O statistics of each device, as returned by agent.BlkioDevices(). Statistics that are broken down by
+// operation are described with a "%s" for the operation, and the policy they belong to is appended to the description.
+var blkioMetrics = map[string]metricInfo{
+	"sectors":          {"", "Number of sectors transferred to or from the device", "uint64"},
+	"time":             {"ms", "Disk time allocated to the container on the device", "uint64"},
+	"io_serviced":      {"", "Number of %s I/O operations issued to the device", "uint64"},
+	"io_service_bytes": {"B", "Bytes transferred by %s I/O operations on the device", "uint64"},
+	"io_service_time":  {"ns", "Time between dispatch and completion of %s I/O operations on the device", "uint64"},
+	"io_wait_time":     {"ns", "Time %s I/O operations on the device spent waiting in the scheduler queues", "uint64"},
+	"io_merged":        {"", "Number of %s I/O operations on the device merged into other requests", "uint64"},
+	"io_queued":        {"", "Number of %s I/O operations on the device queued for the container", "uint64"},
+}
+
+var blkioPolicies = map[string]string{
+	"cfq":           "CFQ scheduler",
+	"cfq_recursive": "CFQ scheduler, including descendant cgroups",
+	"throttling":    "throttling policy",
+}
+
+// Descriptions of the resources reported for each framework by the "/master/frameworks" endpoint.
+var frameworkDescriptions = map[string]string{
+	"offered_resources": "offered to the framework",
+	"resources":         "allocated to the framework",
+	"used_resources":    "used by the framework's tasks",
+}
+
+// Outstanding offers of each framework, as returned by master.GetOfferStats().
+var offerMetrics = map[string]metricInfo{
+	"outstanding":     {"", "Number of outstanding offers held by the framework", "uint64"},
+	"oldest_age_secs": {"s", "Time since the oldest outstanding offer of the framework was first collected", "float64"},
+}
+
+// Metadata of the Mesos agent, as returned by agent.GetMetadata().
+var agentMetaMetrics = map[string]metricInfo{
+	"version":              {"", "Mesos version of the agent", "string"},
+	"git_sha":              {"", "Git SHA that the agent was built from", "string"},
+	"start_time":           {"s", "Time the agent was started, in seconds since the epoch", "float64"},
+	"uptime_secs":          {"s", "Time since the agent was started", "float64"},
+	"containerizers":       {"", "Containerizers enabled on the agent (--containerizers)", "string"},
+	"isolation":            {"", "Isolators enabled on the agent (--isolation)", "string"},
+	"attributes/*/value":   {"", "Value of an attribute of the agent (float64 for scalar attributes)", "string"},
+	"resources/*/declared": {"", "Scalar resource declared on the agent (--resources)", "float64"},
+}
+
+// How the executors are grouped by each kind of aggregate, as returned by agent.AggregateStatistics() and
+// getClusterUsage().
+var agentAggregateScopes = map[string]string{
+	"total":     "on the agent",
+	"framework": "of the framework",
+}
+
+var clusterAggregateScopes = map[string]string{
+	"total":     "in the cluster",
+	"framework": "of the framework across the cluster",
+	"role":      "of the frameworks in the role across the cluster",
+}
+
+// Metrics about the cluster-wide aggregation itself, as returned by getClusterUsage().
+var clusterMetrics = map[string]metricInfo{
+	"agents_queried": {"", "Number of active agents queried for their monitoring statistics", "uint64"},
+	"agents_failed":  {"", "Number of agents that could not be queried for their monitoring statistics", "uint64"},
+}
+
+// Metrics of the master's event stream, as returned by master.EventStream.Collect(). Task states and events that are
+// counted per state are described by describeEventMetric.
+var eventMetrics = map[string]metricInfo{
+	"connected":         {"", "Whether the collector is subscribed to the event stream of the master (1 or 0)", "uint64"},
+	"agents":            {"", "Number of agents known to the master, according to the event stream", "uint64"},
+	"frameworks":        {"", "Number of frameworks known to the master, according to the event stream", "uint64"},
+	"task_added":        {"", "Number of TASK_ADDED events received from the master", "uint64"},
+	"agent_added":       {"", "Number of AGENT_ADDED events received from the master", "uint64"},
+	"agent_removed":     {"", "Number of AGENT_REMOVED events received from the master", "uint64"},
+	"framework_added":   {"", "Number of FRAMEWORK_ADDED events received from the master", "uint64"},
+	"framework_updated": {"", "Number of FRAMEWORK_UPDATED events received from the master", "uint64"},
+	"framework_removed": {"", "Number of FRAMEWORK_REMOVED events received from the master", "uint64"},
+}
+
+// Distributions of the task lifecycle of each framework, as returned by master.TaskLifecycles(). Percentiles are
+// described by their distribution, e.g. "restarts" for "restarts/p99".
+var taskLifecycleMetrics = map[string]metricInfo{
+	"staging_to_running_secs":       {"s", "Time from launching a task of the framework to it running", "float64"},
+	"running_to_failure_secs":       {"s", "Time that a task of the framework ran before it failed or was killed", "float64"},
+	"restarts":                      {"", "Number of tasks with the same name that failed after running, per task name of the framework", "float64"},
+	"staging_to_running_secs/count": {"", "Number of tasks of the framework whose time to running is known", "uint64"},
+	"running_to_failure_secs/count": {"", "Number of tasks of the framework that failed or were killed after running", "uint64"},
+	"restarts/count":                {"", "Number of distinct task names of the framework", "uint64"},
+}
+
+// Health of the tasks of each framework, as returned by master.TasksHealth().
+var taskHealthMetrics = map[string]metricInfo{
+	"healthy":        {"", "Number of tasks of the framework whose health check passed", "uint64"},
+	"unhealthy":      {"", "Number of tasks of the framework whose health check failed", "uint64"},
+	"unknown":        {"", "Number of tasks of the framework without a health check, or not checked yet", "uint64"},
+	"task/*/healthy": {"", "Whether the health check of the task passed (1 or 0)", "uint64"},
+}
+
+// History of each agent registered with the master, as returned by master.TrackAgents(), and the number of agents
+// that flapped.
+var agentHistoryMetrics = map[string]metricInfo{
+	"*/registrations":   {"", "Number of times the agent registered with the master since it was first collected", "uint64"},
+	"*/reregistrations": {"", "Number of times the agent reregistered with the master since it was first collected", "uint64"},
+	"*/deactivations":   {"", "Number of times the agent was deactivated since it was first collected", "uint64"},
+	"*/inactive_secs":   {"s", "Time the agent spent inactive since it was first collected", "float64"},
+	"flapping":          {"", "Number of agents that were repeatedly deactivated or reregistered within the flapping window", "uint64"},
+}
+
+// Derived quota metrics of each resource of a role, as returned by master.RoleQuota.Metrics(). The description is
+// completed with the name of the resource, e.g. "CPUs".
+var roleQuotaMetrics = map[string]metricInfo{
+	"guarantee":      {"", "%s guaranteed to the role by its quota", "float64"},
+	"limit":          {"", "Maximum %s that the quota of the role allows to be allocated", "float64"},
+	"allocated":      {"", "%s allocated to the frameworks in the role", "float64"},
+	"headroom":       {"", "%s guaranteed to the role but not allocated, negative if more than the guarantee is allocated", "float64"},
+	"over_guarantee": {"", "Whether more %s than the guarantee of the role is allocated to it (1 or 0)", "float64"},
+	"over_limit":     {"", "Whether more %s than the limit of the role is allocated to it (1 or 0)", "float64"},
+}
+
+// Look up the unit, description, and data type of a metric by its namespace. Dynamic elements may either be
+// wildcards (as in the catalog) or hold a value (as in collected metrics). Metrics that aren't known return an empty
+// metricInfo, apart from the unit and data type where they can be inferred.
+func describeMetric(namespace core.Namespace) metricInfo {
+	parts := []string{}
+	for i := 2; i < len(namespace); i++ {
+		if namespace[i].IsDynamic() {
+			parts = append(parts, "*")
+		} else {
+			parts = append(parts, namespace[i].Value)
+		}
+	}
+	if len(parts) < 2 {
+		return metricInfo{}
+	}
+
+	switch {
+	case parts[0] == "master" && parts[1] == "*" && len(parts) > 2 && parts[2] == "task_lifecycle":
+		return describeTaskLifecycleMetric(parts[3:])
+	case parts[0] == "master" && parts[1] == "*" && len(parts) == 5 && parts[2] == "terminal_tasks":
+		return describeTerminalTaskMetric(parts[3], parts[4])
+	case parts[0] == "master" && parts[1] == "*" && len(parts) > 2 && parts[2] == "task_health":
+		return taskHealthMetrics[strings.Join(parts[3:], "/")]
+	case parts[0] == "master" && parts[1] == "*":
+		return describeFrameworkMetric(parts[2:])
+	case parts[0] == "agent" && parts[1] == "*" && len(parts) > 5 && parts[3] == "blkio":
+		return describeBlkioMetric(parts[5:])
+	case parts[0] == "agent" && parts[1] == "*" && len(parts) > 3:
+		return describeStatisticsMetric(strings.Join(parts[3:], "/"))
+	case parts[0] == "master" && parts[1] == "agents":
+		return agentHistoryMetrics[strings.Join(parts[2:], "/")]
+	case parts[0] == "master" && parts[1] == "roles":
+		return describeRoleQuotaMetric(parts[2:])
+	case parts[0] == "master" && parts[1] == "events":
+		return describeEventMetric(parts[2:])
+	case parts[0] == "master" && parts[1] == "cluster":
+		if info, ok := clusterMetrics[strings.Join(parts[2:], "/")]; ok {
+			return info
+		}
+		return describeAggregateMetric(parts[2:], clusterAggregateScopes)
+	case parts[0] == "agent" && parts[1] == "aggregate":
+		return describeAggregateMetric(parts[2:], agentAggregateScopes)
+	case parts[0] == "agent" && parts[1] == "meta":
+		return agentMetaMetrics[strings.Join(parts[2:], "/")]
+	default:
+		return describeSnapshotMetric(strings.Join(parts[1:], "/"))
+	}
+}
+
+func describeSnapshotMetric(key string) metricInfo {
+	if info, ok := snapshotMetrics[key]; ok {
+		return info
+	}
+	// Agent metrics are described by their legacy name, see agentNameAliases
+	if info, ok := snapshotMetrics[legacyAgentKey(key)]; ok {
+		return info
+	}
+	for _, rule := range snapshotRules {
+		matches := rule.pattern.FindStringSubmatchIndex(key)
+		if matches == nil {
+			continue
+		}
+		info := rule.info
+		description := rule.pattern.ExpandString(nil, info.Description, key, matches)
+		info.Description = strings.TrimSpace(strings.Replace(strings.Replace(string(description), "_", " ", -1), "/", " ", -1))
+		info.Description = resourceNamePattern.ReplaceAllStringFunc(info.Description, func(name string) string {
+			return resourceNames[name]
+		})
+		return info
+	}
+	return metricInfo{Type: "float64"}
+}
+
+// Describe a metric aggregated across executors, e.g. "total/mem_rss_bytes" or "framework/*/mem_rss_bytes", using
+// the description of the monitoring statistic it is the sum of, and the scope of the aggregate (e.g. "total").
+func describeAggregateMetric(parts []string, scopes map[string]string) metricInfo {
+	var key string
+	switch {
+	case len(parts) == 2 && parts[0] == "total":
+		key = parts[1]
+	case len(parts) == 3 && parts[1] == "*":
+		key = parts[2]
+	default:
+		return metricInfo{}
+	}
+	scope, ok := scopes[parts[0]]
+	if !ok {
+		return metricInfo{}
+	}
+
+	if key == "executors" {
+		return metricInfo{"", "Number of executors " + scope, "uint64"}
+	}
+	info := describeStatisticsMetric(key)
+	if info.Description != "" {
+		info.Description = fmt.Sprintf("%s, summed over all executors %s", info.Description, scope)
+	}
+	return info
+}
+
+// Describe a block I/O statistic of a device, e.g. "cfq/io_serviced/read" or "throttling/io_service_bytes/total".
+func describeBlkioMetric(parts []string) metricInfo {
+	policy, ok := blkioPolicies[parts[0]]
+	if !ok || len(parts) < 2 {
+		return metricInfo{}
+	}
+	info, ok := blkioMetrics[parts[1]]
+	if !ok {
+		return metricInfo{}
+	}
+
+	if strings.Contains(info.Description, "%s") {
+		if len(parts) != 3 {
+			return metricInfo{}
+		}
+		operation := parts[2]
+		if operation == "total" {
+			operation = "all"
+		}
+		info.Description = fmt.Sprintf(info.Description, operation)
+	}
+	info.Description = fmt.Sprintf("%s (%s)", info.Description, policy)
+	return info
+}
+
+func describeEventMetric(parts []string) metricInfo {
+	if len(parts) == 2 && parts[0] == "tasks" {
+		state := strings.ToUpper(parts[1])
+		return metricInfo{"", fmt.Sprintf("Number of tasks in the %s state, according to the event stream", state), "uint64"}
+	}
+	if len(parts) == 2 && parts[0] == "task_updated" {
+		state := strings.ToUpper(parts[1])
+		return metricInfo{"", fmt.Sprintf("Number of TASK_UPDATED events to %s received from the master", state), "uint64"}
+	}
+	return eventMetrics[strings.Join(parts, "/")]
+}
+
+func describeTaskLifecycleMetric(parts []string) metricInfo {
+	if len(parts) != 2 {
+		return metricInfo{}
+	}
+	if parts[1] == "count" {
+		return taskLifecycleMetrics[strings.Join(parts, "/")]
+	}
+	info, ok := taskLifecycleMetrics[parts[0]]
+	if !ok {
+		return metricInfo{}
+	}
+	info.Description = fmt.Sprintf("%s, %s", info.Description, parts[1])
+	return info
+}
+
+func describeTerminalTaskMetric(kind string, name string) metricInfo {
+	switch kind {
+	case "reason":
+		description := "Number of terminal tasks retained by the master for the framework whose last status update has reason REASON_%s"
+		return metricInfo{"", fmt.Sprintf(description, strings.ToUpper(name)), "uint64"}
+	case "source":
+		description := "Number of terminal tasks retained by the master for the framework whose last status update was sent by SOURCE_%s"
+		return metricInfo{"", fmt.Sprintf(description, strings.ToUpper(name)), "uint64"}
+	}
+	return metricInfo{}
+}
+
+// Describe the weight of a role, e.g. "*/weight", or a quota metric of one of its resources, e.g.
+// "*/quota/mem/headroom".
+func describeRoleQuotaMetric(parts []string) metricInfo {
+	if len(parts) == 2 && parts[1] == "weight" {
+		return metricInfo{"", "Weight of the role, which sets its fair share of the cluster", "float64"}
+	}
+	if len(parts) != 4 || parts[1] != "quota" {
+		return metricInfo{}
+	}
+	info, ok := roleQuotaMetrics[parts[3]]
+	name, known := resourceNames[parts[2]]
+	if !ok || !known {
+		return metricInfo{}
+	}
+	if strings.HasPrefix(info.Description, "%s") {
+		name = strings.Title(name)
+	}
+	info.Description = fmt.Sprintf(info.Description, name)
+	if (parts[2] == "mem" || parts[2] == "disk") && !strings.HasPrefix(parts[3], "over_") {
+		info.Unit = "MB"
+	}
+	return info
+}
+
+func describeFrameworkMetric(parts []string) metricInfo {
+	if len(parts) != 2 {
+		return metricInfo{}
+	}
+	if parts[0] == "offers" {
+		return offerMetrics[parts[1]]
+	}
+	info := metricInfo{Type: "float64"}
+	if parts[1] == "mem" || parts[1] == "disk" {
+		info.Unit = "MB"
+	}
+	if name, ok := resourceNames[parts[1]]; ok {
+		info.Description = fmt.Sprintf("%s %s", strings.Title(name), frameworkDescriptions[parts[0]])
+	}
+	return info
+}
+
+func describeStatisticsMetric(key string) metricInfo {
+	info := metricInfo{
+		Description: statisticsDescriptions[key],
+		Type:        fieldType(reflect.TypeOf(mesos_v1.ResourceStatistics{}), strings.Split(key, "/")),
+	}
+
+	switch {
+	case strings.HasSuffix(key, "_bytes"):
+		info.Unit = "B"
+	case strings.HasSuffix(key, "_secs"), key == "timestamp", key == "perf/timestamp", key == "perf/duration":
+		info.Unit = "s"
+	case strings.Contains(key, "_microsecs"):
+		info.Unit = "us"
+	}
+
+	if info.Description == "" && strings.HasPrefix(key, "perf/") {
+		info.Description = fmt.Sprintf("Number of %s perf events", strings.Replace(strings.TrimPrefix(key, "perf/"), "_", "-", -1))
+	}
+	if parts := strings.Split(key, "/"); info.Description == "" && len(parts) == 3 && parts[0] == "net_snmp_statistics" {
+		info.Description = fmt.Sprintf("%s counter of %s in /proc/net/snmp", parts[2],
+			strings.ToUpper(strings.TrimSuffix(parts[1], "_stats")))
+	}
+	return info
+}
+
+// Return the name of the Go type of the field found by following the given JSON names through the struct, or an
+// empty string if there's no such field.
+func fieldType(t reflect.Type, path []string) string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if len(path) == 0 {
+		return t.Name()
+	}
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] == path[0] {
+			return fieldType(t.Field(i).Type, path[1:])
+		}
+	}
+	return ""
+}
+
+// Build a metric type for the catalog, including its unit and description.
+func newCatalogMetricType(namespace core.Namespace) plugin.MetricType {
+	info := describeMetric(namespace)
+	return plugin.MetricType{Namespace_: namespace, Unit_: info.Unit, Description_: info.Description}
+}
+
+// Build a collected metric, including the same unit and description as its metric type in the catalog.
+func newMetric(namespace core.Namespace, timestamp time.Time, tags map[string]string, data interface{}) plugin.MetricType {
+	info := describeMetric(namespace)
+	metric := plugin.NewMetricType(namespace, timestamp, tags, info.Unit, data)
+	metric.Description_ = info.Description
+	return *metric
+}
+
+// Build the complete metric catalog from recorded metrics snapshots of a master and an agent, as if every optional
+// feature (e.g. cluster-wide aggregation, or perf events on the agent) was enabled. This is used to generate METRICS.md.
+func CatalogFromSnapshots(masterSnapshot map[string]float64, agentSnapshot map[string]float64) ([]plugin.MetricType, error) {
+	master_mts, err := masterMetricTypes(expandTimers(masterSnapshot))
+	if err != nil {
+		return nil, err
+	}
+
+	cluster_mts, err := clusterMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+	master_mts = append(master_mts, cluster_mts...)
+	master_mts = append(master_mts, eventMetricTypes()...)
+
+	lifecycle_mts, err := taskLifecycleMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+	master_mts = append(master_mts, lifecycle_mts...)
+	master_mts = append(master_mts, terminalTaskMetricTypes()...)
+	master_mts = append(master_mts, taskHealthMetricTypes()...)
+	master_mts = append(master_mts, roleQuotaMetricTypes()...)
+
+	history_mts, err := agentHistoryMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+	master_mts = append(master_mts, history_mts...)
+
+	statistics, err := agent.GetResourceStatisticsMetricTypes(&mesos_v1.ResourceStatistics{})
+	if err != nil {
+		return nil, err
+	}
+
+	blkio, err := agent.GetBlkioStatisticsMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	agent_mts, err := agentMetricTypes(expandTimers(agentSnapshot), statistics, blkio)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(master_mts, agent_mts...), nil
+}
+
+// Return the Go type of the values that CollectMetrics returns for a metric, or an empty string if it isn't known.
+func DataType(namespace core.Namespace) string {
+	return describeMetric(namespace).Type
+}
@@ -0,0 +1,186 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intelsdi-x/snap/core"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_describeMetric(t *testing.T) {
+	Convey("Describe metrics in the catalog", t, func() {
+		Convey("Should describe snapshot metrics listed in the registry", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "master", "uptime_secs"))
+			So(info, ShouldResemble, metricInfo{"s", "Uptime of the master", "float64"})
+		})
+
+		Convey("Should describe snapshot metrics that belong to a family", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "master", "mem_revocable_used"))
+			So(info, ShouldResemble, metricInfo{"MB", "Allocated revocable memory", "float64"})
+
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "agent", "slave", "cpus_percent"))
+			So(info, ShouldResemble, metricInfo{"", "Fraction of CPUs allocated, from 0 to 1", "float64"})
+
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "registrar", "state_store_ms", "p99"))
+			So(info, ShouldResemble, metricInfo{"ms", "Duration of registrar state store p99", "float64"})
+
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "system", "load_5min"))
+			So(info.Description, ShouldEqual, "Load average of the host over the last 5 minute(s)")
+		})
+
+		Convey("Should infer the unit and type of unknown snapshot metrics", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "foo", "bar_bytes"))
+			So(info, ShouldResemble, metricInfo{"B", "", "float64"})
+		})
+
+		Convey("Should describe framework metrics", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElements("used_resources", "mem"))
+			So(info, ShouldResemble, metricInfo{"MB", "Memory used by the framework's tasks", "float64"})
+		})
+
+		Convey("Should describe the task lifecycle of each framework", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElement("task_lifecycle")
+
+			info := describeMetric(namespace.AddStaticElements("staging_to_running_secs", "p99"))
+			So(info, ShouldResemble, metricInfo{"s", "Time from launching a task of the framework to it running, p99", "float64"})
+
+			info = describeMetric(namespace.AddStaticElements("running_to_failure_secs", "count"))
+			So(info, ShouldResemble, metricInfo{"", "Number of tasks of the framework that failed or were killed after running", "uint64"})
+
+			info = describeMetric(namespace.AddStaticElements("restarts", "count"))
+			So(info, ShouldResemble, metricInfo{"", "Number of distinct task names of the framework", "uint64"})
+		})
+
+		Convey("Should describe terminal tasks by reason and source", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElement("terminal_tasks")
+
+			info := describeMetric(namespace.AddStaticElements("reason", "container_limitation_memory"))
+			So(info, ShouldResemble, metricInfo{"", "Number of terminal tasks retained by the master for the framework whose last status update has reason REASON_CONTAINER_LIMITATION_MEMORY", "uint64"})
+		})
+
+		Convey("Should describe the weight and quota of each role", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "master", "roles").
+				AddDynamicElement("role", "Role name")
+
+			info := describeMetric(namespace.AddStaticElements("quota", "mem", "headroom"))
+			So(info, ShouldResemble, metricInfo{"MB", "Memory guaranteed to the role but not allocated, negative if more than the guarantee is allocated", "float64"})
+
+			info = describeMetric(namespace.AddStaticElements("quota", "cpus", "over_limit"))
+			So(info, ShouldResemble, metricInfo{"", "Whether more CPUs than the limit of the role is allocated to it (1 or 0)", "float64"})
+		})
+
+		Convey("Should describe executor metrics, taking the type from the protobuf", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddDynamicElement("executor_id", "Executor ID")
+
+			info := describeMetric(namespace.AddStaticElement("mem_rss_bytes"))
+			So(info, ShouldResemble, metricInfo{"B", "Anonymous memory usage of the container", "uint64"})
+
+			info = describeMetric(namespace.AddStaticElements("perf", "cache_misses"))
+			So(info, ShouldResemble, metricInfo{"", "Number of cache-misses perf events", "uint64"})
+
+			info = describeMetric(namespace.AddStaticElement("threads"))
+			So(info.Type, ShouldEqual, "uint32")
+		})
+
+		Convey("Should describe block I/O statistics of each device", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddDynamicElement("executor_id", "Executor ID").
+				AddStaticElement("blkio").
+				AddDynamicElement("device", "Block device")
+
+			info := describeMetric(namespace.AddStaticElements("throttling", "io_service_bytes", "total"))
+			So(info, ShouldResemble, metricInfo{"B", "Bytes transferred by all I/O operations on the device (throttling policy)", "uint64"})
+
+			info = describeMetric(namespace.AddStaticElements("cfq", "sectors"))
+			So(info, ShouldResemble, metricInfo{"", "Number of sectors transferred to or from the device (CFQ scheduler)", "uint64"})
+		})
+
+		Convey("Should describe metrics of the event stream", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "events", "task_added"))
+			So(info, ShouldResemble, metricInfo{"", "Number of TASK_ADDED events received from the master", "uint64"})
+
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "events", "tasks", "task_running"))
+			So(info, ShouldResemble, metricInfo{"", "Number of tasks in the TASK_RUNNING state, according to the event stream", "uint64"})
+		})
+
+		Convey("Should describe aggregated statistics using the statistic they are the sum of", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "total", "mem_rss_bytes"))
+			So(info, ShouldResemble, metricInfo{"B", "Anonymous memory usage of the container, summed over all executors on the agent", "uint64"})
+
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "agent", "aggregate", "framework").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElement("executors"))
+			So(info, ShouldResemble, metricInfo{"", "Number of executors of the framework", "uint64"})
+		})
+
+		Convey("Should describe collected metrics the same way as their metric types", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "agent").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddDynamicElement("executor_id", "Executor ID").
+				AddStaticElement("cpus_limit")
+			rendered := cloneNamespace(namespace)
+			rendered[3].Value = "frame1"
+			rendered[4].Value = "exec1"
+
+			mt := newCatalogMetricType(namespace)
+			metric := newMetric(rendered, time.Now(), map[string]string{}, 1.0)
+			So(metric.Unit(), ShouldEqual, mt.Unit())
+			So(metric.Description(), ShouldEqual, mt.Description())
+			So(metric.Description(), ShouldEqual, "Number of CPUs allocated")
+		})
+
+		Convey("Should build the complete catalog from recorded snapshots", func() {
+			mts, err := CatalogFromSnapshots(
+				map[string]float64{"master/elected": 1.0},
+				map[string]float64{"slave/registered": 1.0},
+			)
+			So(err, ShouldBeNil)
+
+			namespaces := map[string]bool{}
+			for _, mt := range mts {
+				namespaces[mt.Namespace().String()] = true
+				So(mt.Description(), ShouldNotBeEmpty)
+				So(DataType(mt.Namespace()), ShouldNotBeEmpty)
+			}
+			So(namespaces["/intel/mesos/master/master/elected"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/agent/slave/registered"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/agent/*/*/perf/cache_misses"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/events/task_updated/task_failed"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/*/task_lifecycle/running_to_failure_secs/p50"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/*/task_health/task/*/healthy"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/*/offers/oldest_age_secs"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/roles/*/quota/gpus/over_guarantee"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/agents/*/inactive_secs"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/master/agents/flapping"], ShouldBeTrue)
+			So(namespaces["/intel/mesos/agent/*/*/net_snmp_statistics/tcp_stats/RetransSegs"], ShouldBeTrue)
+		})
+	})
+}
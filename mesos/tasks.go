@@ -0,0 +1,211 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap-plugin-utilities/ns"
+	"github.com/intelsdi-x/snap/control/plugin"
+	"github.com/intelsdi-x/snap/core"
+)
+
+// Framework metrics that are computed from the tasks known to the master (see master.GetTasks), keyed by the element
+// that follows the framework ID in their namespace.
+var taskMetricFamilies = map[string]bool{
+	"task_lifecycle": true,
+	"terminal_tasks": true,
+	"task_health":    true,
+}
+
+// Build the metric types for the task lifecycle of each framework (see master.TaskLifecycles), which are added to the
+// catalog with the (optional) config item:
+//
+//   "task_lifecycle": true
+func taskLifecycleMetricTypes() ([]plugin.MetricType, error) {
+	metricTypes := []plugin.MetricType{}
+
+	lifecycle_mts, err := master.GetTaskLifecycleMetricTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range lifecycle_mts {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElement("task_lifecycle").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	return metricTypes, nil
+}
+
+// Collect a task lifecycle metric for each framework that matches the requested namespace. Percentiles of a
+// distribution without any samples are skipped, while its count is reported as zero.
+func collectTaskLifecycle(requested core.Namespace, lifecycles map[string]*master.TaskLifecycle, frameworks []*master.Framework, labels *labelMapper, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[5:]
+
+	frameworkLabels := frameworkLabelsByID(frameworks)
+
+	for id, lifecycle := range lifecycles {
+		if !matchesElement(requested[3], id) {
+			continue
+		}
+		if n[len(n)-1] != "count" {
+			if count := ns.GetValueByNamespace(lifecycle, append(n[:len(n)-1:len(n)-1], "count")); count == uint64(0) {
+				continue
+			}
+		}
+		val := ns.GetValueByNamespace(lifecycle, n)
+		if val == nil {
+			log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+			continue
+		}
+		// substituting "framework" wildcard with particular framework id
+		rendered := cloneNamespace(requested)
+		rendered[3].Value = id
+		metrics = append(metrics, newMetric(rendered, now, labels.tags(tags, frameworkLabels[id]), val))
+	}
+	return metrics
+}
+
+// Build the metric types for the number of terminal tasks of each framework by reason and source (see
+// master.TerminalTasks), which are added to the catalog with the (optional) config item:
+//
+//   "terminal_tasks": true
+func terminalTaskMetricTypes() []plugin.MetricType {
+	metricTypes := []plugin.MetricType{}
+
+	for _, key := range master.GetTerminalTaskMetricTypes() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElement("terminal_tasks").
+			AddStaticElements(strings.Split(key, "/")...)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	return metricTypes
+}
+
+// Collect the number of terminal tasks with the requested reason or source for each framework that matches the
+// requested namespace.
+func collectTerminalTasks(requested core.Namespace, terminal map[string]map[string]uint64, frameworks []*master.Framework, labels *labelMapper, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	key := strings.Join(requested.Strings()[5:], "/")
+
+	frameworkLabels := frameworkLabelsByID(frameworks)
+
+	for id, counts := range terminal {
+		if !matchesElement(requested[3], id) {
+			continue
+		}
+		// substituting "framework" wildcard with particular framework id
+		rendered := cloneNamespace(requested)
+		rendered[3].Value = id
+		metrics = append(metrics, newMetric(rendered, now, labels.tags(tags, frameworkLabels[id]), counts[key]))
+	}
+	return metrics
+}
+
+// Build the metric types for the health of the tasks of each framework (see master.TasksHealth), which are added to
+// the catalog with the (optional) config item:
+//
+//   "task_health": true
+func taskHealthMetricTypes() []plugin.MetricType {
+	metricTypes := []plugin.MetricType{}
+
+	for _, key := range []string{"healthy", "unhealthy", "unknown"} {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElements("task_health", key)
+		log.Debug("Adding metric to catalog: ", namespace.String())
+		metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+	}
+
+	namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+		AddDynamicElement("framework_id", "Framework ID").
+		AddStaticElements("task_health", "task").
+		AddDynamicElement("task_id", "Task ID").
+		AddStaticElement("healthy")
+	log.Debug("Adding metric to catalog: ", namespace.String())
+	metricTypes = append(metricTypes, newCatalogMetricType(namespace))
+
+	return metricTypes
+}
+
+// Collect the health of the tasks of each framework that matches the requested namespace: either the number of tasks
+// by health, or the health of each task with a health check (1 if healthy, 0 otherwise).
+func collectTaskHealth(requested core.Namespace, health map[string]*master.TaskHealth, frameworks []*master.Framework, labels *labelMapper, now time.Time, tags map[string]string) []plugin.MetricType {
+	metrics := []plugin.MetricType{}
+	n := requested.Strings()[5:]
+
+	frameworkLabels := frameworkLabelsByID(frameworks)
+
+	for id, h := range health {
+		if !matchesElement(requested[3], id) {
+			continue
+		}
+		frameworkTags := labels.tags(tags, frameworkLabels[id])
+
+		if n[0] != "task" {
+			val := ns.GetValueByNamespace(h, n)
+			if val == nil {
+				log.Warn("Attempted to collect metric ", requested.String(), " but it returned nil!")
+				continue
+			}
+			// substituting "framework" wildcard with particular framework id
+			rendered := cloneNamespace(requested)
+			rendered[3].Value = id
+			metrics = append(metrics, newMetric(rendered, now, frameworkTags, val))
+			continue
+		}
+
+		for task, healthy := range h.Tasks {
+			if !matchesElement(requested[6], task) {
+				continue
+			}
+			val := uint64(0)
+			if healthy {
+				val = 1
+			}
+			// substituting the framework and task wildcards with their IDs
+			rendered := cloneNamespace(requested)
+			rendered[3].Value = id
+			rendered[6].Value = task
+			metrics = append(metrics, newMetric(rendered, now, frameworkTags, val))
+		}
+	}
+	return metrics
+}
+
+// Return the labels of each framework, keyed by framework ID, so that metrics computed from tasks can be tagged with
+// the labels of their framework.
+func frameworkLabelsByID(frameworks []*master.Framework) map[string][]*mesos_pb2.Label {
+	labels := map[string][]*mesos_pb2.Label{}
+	for _, framework := range frameworks {
+		labels[framework.ID] = framework.Labels
+	}
+	return labels
+}
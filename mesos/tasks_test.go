@@ -0,0 +1,119 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/master"
+	"github.com/intelsdi-x/snap-plugin-collector-mesos/mesos/mesos_pb2"
+	"github.com/intelsdi-x/snap/core"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_collectTaskLifecycle(t *testing.T) {
+	lifecycles := map[string]*master.TaskLifecycle{
+		"frame1": {StagingToRunning: master.Distribution{Count: 3, P50: 1.5, P90: 4.0, P99: 4.0}},
+		"frame2": {},
+	}
+	frameworks := []*master.Framework{
+		{ID: "frame1", Labels: []*mesos_pb2.Label{{Key: proto.String("team"), Value: proto.String("web")}}},
+	}
+	lm := &labelMapper{include: regexp.MustCompile("^team$"), rename: map[string]string{}}
+
+	Convey("Collect the task lifecycle of each framework", t, func() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElements("task_lifecycle", "staging_to_running_secs")
+
+		Convey("Should skip percentiles of frameworks without any samples", func() {
+			metrics := collectTaskLifecycle(namespace.AddStaticElement("p50"), lifecycles, frameworks, lm, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Namespace().String(), ShouldEqual, "/intel/mesos/master/frame1/task_lifecycle/staging_to_running_secs/p50")
+			So(metrics[0].Data(), ShouldEqual, 1.5)
+			So(metrics[0].Tags()["team"], ShouldEqual, "web")
+		})
+
+		Convey("Should report the count of every framework", func() {
+			metrics := collectTaskLifecycle(namespace.AddStaticElement("count"), lifecycles, frameworks, lm, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestMesos_collectTerminalTasks(t *testing.T) {
+	terminal := map[string]map[string]uint64{
+		"frame1": {"reason/container_limitation_memory": 2},
+		"frame2": {},
+	}
+
+	Convey("Collect the terminal tasks of each framework", t, func() {
+		Convey("Should report zero for frameworks without such tasks", func() {
+			namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+				AddDynamicElement("framework_id", "Framework ID").
+				AddStaticElements("terminal_tasks", "reason", "container_limitation_memory")
+			metrics := collectTerminalTasks(namespace, terminal, nil, &labelMapper{}, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 2)
+
+			data := map[string]interface{}{}
+			for _, metric := range metrics {
+				data[metric.Namespace()[3].Value] = metric.Data()
+			}
+			So(data, ShouldResemble, map[string]interface{}{"frame1": uint64(2), "frame2": uint64(0)})
+		})
+	})
+}
+
+func TestMesos_collectTaskHealth(t *testing.T) {
+	health := map[string]*master.TaskHealth{
+		"frame1": {Healthy: 1, Unhealthy: 1, Tasks: map[string]bool{"task1": true, "task2": false}},
+	}
+
+	Convey("Collect the health of the tasks of each framework", t, func() {
+		namespace := core.NewNamespace(pluginVendor, pluginName, "master").
+			AddDynamicElement("framework_id", "Framework ID").
+			AddStaticElement("task_health")
+
+		Convey("Should report the number of tasks by health", func() {
+			metrics := collectTaskHealth(namespace.AddStaticElement("unhealthy"), health, nil, &labelMapper{}, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 1)
+			So(metrics[0].Data(), ShouldEqual, 1)
+		})
+
+		Convey("Should report the health of each task", func() {
+			requested := namespace.AddStaticElement("task").
+				AddDynamicElement("task_id", "Task ID").
+				AddStaticElement("healthy")
+			metrics := collectTaskHealth(requested, health, nil, &labelMapper{}, time.Now(), map[string]string{})
+			So(len(metrics), ShouldEqual, 2)
+
+			data := map[string]interface{}{}
+			for _, metric := range metrics {
+				data[metric.Namespace().String()] = metric.Data()
+			}
+			So(data, ShouldResemble, map[string]interface{}{
+				"/intel/mesos/master/frame1/task_health/task/task1/healthy": uint64(1),
+				"/intel/mesos/master/frame1/task_health/task/task2/healthy": uint64(0),
+			})
+		})
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"regexp"
+	"strings"
+)
+
+// The statistics that Mesos reports for each of its timers (e.g. "registrar/state_store_ms" or
+// "allocator/mesos/allocation_run_ms"), which are computed over a window of recent samples. The bare key of a timer
+// (e.g. "registrar/state_store_ms") is the duration of the last sample. Timers are always in milliseconds. See
+// https://github.com/apache/mesos/blob/1.9.0/3rdparty/libprocess/include/process/metrics/timer.hpp
+var timerStatistics = []string{"count", "min", "max", "p50", "p90", "p95", "p99", "p999", "p9999"}
+
+var timerKey = regexp.MustCompile(`^(.*_ms)(/(` + strings.Join(timerStatistics, "|") + `))?$`)
+
+// Return the family of a timer's snapshot key, e.g. "registrar/state_store_ms" for "registrar/state_store_ms/p99", or
+// an empty string if the key isn't a timer.
+func timerFamily(key string) string {
+	match := timerKey.FindStringSubmatch(key)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// Return a copy of a metrics snapshot with every statistic of each timer it includes. Mesos leaves out the statistics
+// of a timer that hasn't been sampled within its window (and some timers only report their last sample until then),
+// so the catalog wouldn't otherwise include them. The added statistics are only used to build the catalog.
+func expandTimers(snapshot map[string]float64) map[string]float64 {
+	expanded := make(map[string]float64, len(snapshot))
+	for key, val := range snapshot {
+		expanded[key] = val
+		if family := timerFamily(key); family != "" {
+			for _, statistic := range timerStatistics {
+				if _, ok := snapshot[family+"/"+statistic]; !ok {
+					expanded[family+"/"+statistic] = 0
+				}
+			}
+		}
+	}
+	return expanded
+}
+
+// Return the tags of a metric from a metrics snapshot, with the "metric_family" tag set to the timer's family if the
+// key is a timer, so that publishers which handle histograms natively can group its statistics. The given tags are
+// never modified.
+func snapshotTags(key string, tags map[string]string) map[string]string {
+	family := timerFamily(key)
+	if family == "" {
+		return tags
+	}
+
+	timerTags := make(map[string]string, len(tags)+1)
+	for k, v := range tags {
+		timerTags[k] = v
+	}
+	timerTags["metric_family"] = family
+	return timerTags
+}
@@ -0,0 +1,69 @@
+// +build small
+
+/*
+Copyright 2016 Intel Corporation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesos
+
+import (
+	"testing"
+
+	"github.com/intelsdi-x/snap/core"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMesos_timers(t *testing.T) {
+	Convey("Recognize the timers in a metrics snapshot", t, func() {
+		Convey("The family of a timer should be the key without its statistic", func() {
+			So(timerFamily("registrar/state_store_ms/p99"), ShouldEqual, "registrar/state_store_ms")
+			So(timerFamily("allocator/mesos/allocation_run_ms/p9999"), ShouldEqual, "allocator/mesos/allocation_run_ms")
+			So(timerFamily("registrar/state_fetch_ms"), ShouldEqual, "registrar/state_fetch_ms")
+			So(timerFamily("master/tasks_running"), ShouldEqual, "")
+			So(timerFamily("registrar/state_store_ms/p42"), ShouldEqual, "")
+		})
+
+		Convey("Every statistic of a timer should be added to the snapshot", func() {
+			snapshot := expandTimers(map[string]float64{
+				"registrar/state_fetch_ms":                12.5,
+				"allocator/mesos/allocation_run_ms/count": 3,
+				"master/tasks_running":                    2,
+			})
+			So(len(snapshot), ShouldEqual, 2*len(timerStatistics)+2)
+			So(snapshot["registrar/state_fetch_ms"], ShouldEqual, 12.5)
+			So(snapshot["allocator/mesos/allocation_run_ms/count"], ShouldEqual, 3)
+			So(snapshot, ShouldContainKey, "registrar/state_fetch_ms/p9999")
+			So(snapshot, ShouldContainKey, "allocator/mesos/allocation_run_ms/min")
+			So(snapshot, ShouldNotContainKey, "allocator/mesos/allocation_run_ms")
+		})
+
+		Convey("Only timers should be tagged with their family", func() {
+			tags := map[string]string{"source": "10.0.0.1:5050"}
+			So(snapshotTags("registrar/state_store_ms/p99", tags), ShouldResemble, map[string]string{
+				"source":        "10.0.0.1:5050",
+				"metric_family": "registrar/state_store_ms",
+			})
+			So(snapshotTags("master/tasks_running", tags), ShouldResemble, tags)
+			So(tags, ShouldNotContainKey, "metric_family")
+		})
+
+		Convey("The statistics of a timer should be in milliseconds", func() {
+			info := describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "registrar", "state_store_ms", "max"))
+			So(info.Unit, ShouldEqual, "ms")
+			info = describeMetric(core.NewNamespace(pluginVendor, pluginName, "master", "registrar", "state_store_ms", "count"))
+			So(info.Unit, ShouldEqual, "")
+		})
+	})
+}
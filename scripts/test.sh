@@ -6,9 +6,9 @@
 #   - test coverage (https://blog.golang.org/cover)
 
 COVERALLS_MAX_ATTEMPTS=5
-TEST_DIRS="main.go mesos/"
-PKG_DIRS=". ./mesos/..."
-IGNORE_PKGS="mesos_pb2"
+TEST_DIRS="main.go mesos/ cmd/"
+PKG_DIRS=". ./mesos/... ./cmd/..."
+IGNORE_PKGS="mesos_pb2 mesos_v1 mesos_v1_agent mesos_v1_allocator mesos_v1_maintenance mesos_v1_master mesos_v1_quota"
 
 function _gofmt {
     echo "Running 'gofmt'"
@@ -40,7 +40,7 @@ function _unit_test_with_coverage {
 
     for import_path in $(go list -f={{.ImportPath}} ${PKG_DIRS}); do
         package=$(basename ${import_path})
-        [[ "$IGNORE_PKGS" =~ $package ]] && continue
+        [[ " $IGNORE_PKGS " =~ " $package " ]] && continue
         go test -v --tags=small -covermode=count -coverprofile=./tmp/profile_${package}.cov $import_path
     done
 